@@ -0,0 +1,66 @@
+package gl
+
+// Backend is the set of OpenGL entry points this package issues its calls to.
+// The method set mirrors github.com/go-gl/gl: every method has the same name
+// and signature as the go-gl function it stands for.
+//
+// Init installs the go-gl backend, InitBackend can be used to run the package
+// against anything else, like the FakeBackend for tests. The method set depends
+// on the profile, it only has the entry points the profile has: glgen
+// generates it from the registry with the go-gl backend of every profile, see
+// cmd/glgen/backend.spec.
+type Backend interface {
+	Init() error
+	entryPoints
+	profileEntryPoints
+}
+
+// backend is where every wrapper sends its calls.
+var backend Backend = goglBackend{}
+
+// backendLayer is a Backend of this package wrapping the Backend that was in
+// place when it was installed, like the tracer or the state cache.
+type backendLayer interface {
+	Backend
+	// next returns the field holding the wrapped Backend.
+	next() *Backend
+}
+
+// unlinkLayer removes l from the chain of Backends, wherever it is. It
+// returns false if l is below a Backend from outside of this package, which
+// can't be rewired: l then stays in the chain.
+func unlinkLayer(l backendLayer) bool {
+	for p := &backend; ; {
+		if *p == Backend(l) {
+			*p = *l.next()
+			return true
+		}
+		wrapper, ok := (*p).(backendLayer)
+		if !ok {
+			return false
+		}
+		p = wrapper.next()
+	}
+}
+
+// InitBackend initializes b and makes every function of this package use it
+// instead of go-gl. It must be called before any other function of this
+// package, just like Init, from the thread where the context is current. It
+// locks the calling goroutine to that thread.
+func InitBackend(b Backend) error {
+	if err := b.Init(); err != nil {
+		return err
+	}
+	lockContextThread()
+	backend = b
+	stateCacheLayer = nil
+	traceLayer = nil
+	trackerLayer = nil
+	threadCheck = nil
+	cachedContextInfo = nil
+	resetExtensions()
+	if safetyflag {
+		safetyReset()
+	}
+	return nil
+}
@@ -0,0 +1,700 @@
+// Code generated by glgen from gl.xml. DO NOT EDIT.
+
+//go:build gl41
+
+package gl
+
+import (
+	"github.com/go-gl/gl/v4.1-core/gl"
+	"unsafe"
+)
+
+// Profile is the OpenGL profile this package was built for, selected with the
+// gl41, gl45 and gles30 build tags. The default is 3.3-core.
+const Profile = "4.1-core"
+
+// profileEntryPoints are the methods of Backend only some profiles have.
+type profileEntryPoints interface {
+	desktopEntryPoints
+	gl4EntryPoints
+}
+
+type debugProc = gl.DebugProc
+
+var (
+	glStr       = gl.Str
+	glStrs      = gl.Strs
+	glGoStr     = gl.GoStr
+	glPtrOffset = gl.PtrOffset
+)
+
+// goglBackend is the default Backend, it forwards every call to go-gl.
+type goglBackend struct{}
+
+func (goglBackend) Init() error {
+	return gl.Init()
+}
+
+func (goglBackend) ActiveTexture(texture uint32) {
+	gl.ActiveTexture(texture)
+}
+
+func (goglBackend) AttachShader(program, shader uint32) {
+	gl.AttachShader(program, shader)
+}
+
+func (goglBackend) BeginTransformFeedback(primitiveMode uint32) {
+	gl.BeginTransformFeedback(primitiveMode)
+}
+
+func (goglBackend) BindBuffer(target, buffer uint32) {
+	gl.BindBuffer(target, buffer)
+}
+
+func (goglBackend) BindBufferBase(target, index, buffer uint32) {
+	gl.BindBufferBase(target, index, buffer)
+}
+
+func (goglBackend) BindBufferRange(target, index, buffer uint32, offset, size int) {
+	gl.BindBufferRange(target, index, buffer, offset, size)
+}
+
+func (goglBackend) BindFragDataLocation(program, color uint32, name *uint8) {
+	gl.BindFragDataLocation(program, color, name)
+}
+
+func (goglBackend) BindFramebuffer(target, framebuffer uint32) {
+	gl.BindFramebuffer(target, framebuffer)
+}
+
+func (goglBackend) BindRenderbuffer(target, renderbuffer uint32) {
+	gl.BindRenderbuffer(target, renderbuffer)
+}
+
+func (goglBackend) BindTexture(target, texture uint32) {
+	gl.BindTexture(target, texture)
+}
+
+func (goglBackend) BindTransformFeedback(target, id uint32) {
+	gl.BindTransformFeedback(target, id)
+}
+
+func (goglBackend) BindVertexArray(array uint32) {
+	gl.BindVertexArray(array)
+}
+
+func (goglBackend) BlendColor(red, green, blue, alpha float32) {
+	gl.BlendColor(red, green, blue, alpha)
+}
+
+func (goglBackend) BlendEquationSeparate(modeRGB, modeAlpha uint32) {
+	gl.BlendEquationSeparate(modeRGB, modeAlpha)
+}
+
+func (goglBackend) BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha uint32) {
+	gl.BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha)
+}
+
+func (goglBackend) BufferData(target uint32, size int, data unsafe.Pointer, usage uint32) {
+	gl.BufferData(target, size, data, usage)
+}
+
+func (goglBackend) BufferSubData(target uint32, offset, size int, data unsafe.Pointer) {
+	gl.BufferSubData(target, offset, size, data)
+}
+
+func (goglBackend) CheckFramebufferStatus(target uint32) uint32 {
+	return gl.CheckFramebufferStatus(target)
+}
+
+func (goglBackend) ClearColor(red, green, blue, alpha float32) {
+	gl.ClearColor(red, green, blue, alpha)
+}
+
+func (goglBackend) ClearDepthf(d float32) {
+	gl.ClearDepthf(d)
+}
+
+func (goglBackend) ClearStencil(s int32) {
+	gl.ClearStencil(s)
+}
+
+func (goglBackend) ClientWaitSync(sync uintptr, flags uint32, timeout uint64) uint32 {
+	return gl.ClientWaitSync(sync, flags, timeout)
+}
+
+func (goglBackend) ColorMask(red, green, blue, alpha bool) {
+	gl.ColorMask(red, green, blue, alpha)
+}
+
+func (goglBackend) CompileShader(shader uint32) {
+	gl.CompileShader(shader)
+}
+
+func (goglBackend) CopyBufferSubData(readTarget, writeTarget uint32, readOffset, writeOffset, size int) {
+	gl.CopyBufferSubData(readTarget, writeTarget, readOffset, writeOffset, size)
+}
+
+func (goglBackend) CopyTexImage1D(target uint32, level int32, internalformat uint32, x, y, width, border int32) {
+	gl.CopyTexImage1D(target, level, internalformat, x, y, width, border)
+}
+
+func (goglBackend) CopyTexImage2D(target uint32, level int32, internalformat uint32, x, y, width, height, border int32) {
+	gl.CopyTexImage2D(target, level, internalformat, x, y, width, height, border)
+}
+
+func (goglBackend) CreateProgram() uint32 {
+	return gl.CreateProgram()
+}
+
+func (goglBackend) CreateShader(xtype uint32) uint32 {
+	return gl.CreateShader(xtype)
+}
+
+func (goglBackend) CullFace(mode uint32) {
+	gl.CullFace(mode)
+}
+
+func (goglBackend) DebugMessageCallback(callback debugProc, userParam unsafe.Pointer) {
+	gl.DebugMessageCallback(callback, userParam)
+}
+
+func (goglBackend) DebugMessageCallbackARB(callback debugProc, userParam unsafe.Pointer) {
+	gl.DebugMessageCallbackARB(callback, userParam)
+}
+
+func (goglBackend) DebugMessageControl(source, xtype, severity uint32, count int32, ids *uint32, enabled bool) {
+	gl.DebugMessageControl(source, xtype, severity, count, ids, enabled)
+}
+
+func (goglBackend) DebugMessageControlARB(source, xtype, severity uint32, count int32, ids *uint32, enabled bool) {
+	gl.DebugMessageControlARB(source, xtype, severity, count, ids, enabled)
+}
+
+func (goglBackend) DebugMessageInsert(source, xtype, id, severity uint32, length int32, buf *uint8) {
+	gl.DebugMessageInsert(source, xtype, id, severity, length, buf)
+}
+
+func (goglBackend) DebugMessageInsertARB(source, xtype, id, severity uint32, length int32, buf *uint8) {
+	gl.DebugMessageInsertARB(source, xtype, id, severity, length, buf)
+}
+
+func (goglBackend) DeleteBuffers(n int32, buffers *uint32) {
+	gl.DeleteBuffers(n, buffers)
+}
+
+func (goglBackend) DeleteFramebuffers(n int32, framebuffers *uint32) {
+	gl.DeleteFramebuffers(n, framebuffers)
+}
+
+func (goglBackend) DeleteProgram(program uint32) {
+	gl.DeleteProgram(program)
+}
+
+func (goglBackend) DeleteRenderbuffers(n int32, renderbuffers *uint32) {
+	gl.DeleteRenderbuffers(n, renderbuffers)
+}
+
+func (goglBackend) DeleteShader(shader uint32) {
+	gl.DeleteShader(shader)
+}
+
+func (goglBackend) DeleteSync(sync uintptr) {
+	gl.DeleteSync(sync)
+}
+
+func (goglBackend) DeleteTextures(n int32, textures *uint32) {
+	gl.DeleteTextures(n, textures)
+}
+
+func (goglBackend) DeleteTransformFeedbacks(n int32, ids *uint32) {
+	gl.DeleteTransformFeedbacks(n, ids)
+}
+
+func (goglBackend) DeleteVertexArrays(n int32, arrays *uint32) {
+	gl.DeleteVertexArrays(n, arrays)
+}
+
+func (goglBackend) DepthFunc(xfunc uint32) {
+	gl.DepthFunc(xfunc)
+}
+
+func (goglBackend) DepthMask(flag bool) {
+	gl.DepthMask(flag)
+}
+
+func (goglBackend) DepthRangef(near, far float32) {
+	gl.DepthRangef(near, far)
+}
+
+func (goglBackend) Disable(cap uint32) {
+	gl.Disable(cap)
+}
+
+func (goglBackend) DisableVertexAttribArray(index uint32) {
+	gl.DisableVertexAttribArray(index)
+}
+
+func (goglBackend) DrawBuffer(buf uint32) {
+	gl.DrawBuffer(buf)
+}
+
+func (goglBackend) DrawBuffers(n int32, bufs *uint32) {
+	gl.DrawBuffers(n, bufs)
+}
+
+func (goglBackend) Enable(cap uint32) {
+	gl.Enable(cap)
+}
+
+func (goglBackend) EnableVertexAttribArray(index uint32) {
+	gl.EnableVertexAttribArray(index)
+}
+
+func (goglBackend) EndTransformFeedback() {
+	gl.EndTransformFeedback()
+}
+
+func (goglBackend) FenceSync(condition, flags uint32) uintptr {
+	return gl.FenceSync(condition, flags)
+}
+
+func (goglBackend) FlushMappedBufferRange(target uint32, offset, length int) {
+	gl.FlushMappedBufferRange(target, offset, length)
+}
+
+func (goglBackend) FramebufferRenderbuffer(target, attachment, renderbuffertarget, renderbuffer uint32) {
+	gl.FramebufferRenderbuffer(target, attachment, renderbuffertarget, renderbuffer)
+}
+
+func (goglBackend) FramebufferTexture(target, attachment, texture uint32, level int32) {
+	gl.FramebufferTexture(target, attachment, texture, level)
+}
+
+func (goglBackend) FrontFace(mode uint32) {
+	gl.FrontFace(mode)
+}
+
+func (goglBackend) GenBuffers(n int32, buffers *uint32) {
+	gl.GenBuffers(n, buffers)
+}
+
+func (goglBackend) GenFramebuffers(n int32, framebuffers *uint32) {
+	gl.GenFramebuffers(n, framebuffers)
+}
+
+func (goglBackend) GenRenderbuffers(n int32, renderbuffers *uint32) {
+	gl.GenRenderbuffers(n, renderbuffers)
+}
+
+func (goglBackend) GenTextures(n int32, textures *uint32) {
+	gl.GenTextures(n, textures)
+}
+
+func (goglBackend) GenTransformFeedbacks(n int32, ids *uint32) {
+	gl.GenTransformFeedbacks(n, ids)
+}
+
+func (goglBackend) GenVertexArrays(n int32, arrays *uint32) {
+	gl.GenVertexArrays(n, arrays)
+}
+
+func (goglBackend) GetActiveAttrib(program, index uint32, bufSize int32, length, size *int32, xtype *uint32, name *uint8) {
+	gl.GetActiveAttrib(program, index, bufSize, length, size, xtype, name)
+}
+
+func (goglBackend) GetAttachedShaders(program uint32, maxCount int32, count *int32, shaders *uint32) {
+	gl.GetAttachedShaders(program, maxCount, count, shaders)
+}
+
+func (goglBackend) GetAttribLocation(program uint32, name *uint8) int32 {
+	return gl.GetAttribLocation(program, name)
+}
+
+func (goglBackend) GetBooleani_v(target, index uint32, data *bool) {
+	gl.GetBooleani_v(target, index, data)
+}
+
+func (goglBackend) GetBooleanv(pname uint32, data *bool) {
+	gl.GetBooleanv(pname, data)
+}
+
+func (goglBackend) GetBufferParameteri64v(target, pname uint32, params *int64) {
+	gl.GetBufferParameteri64v(target, pname, params)
+}
+
+func (goglBackend) GetBufferParameteriv(target, pname uint32, params *int32) {
+	gl.GetBufferParameteriv(target, pname, params)
+}
+
+func (goglBackend) GetBufferSubData(target uint32, offset, size int, data unsafe.Pointer) {
+	gl.GetBufferSubData(target, offset, size, data)
+}
+
+func (goglBackend) GetDoublev(pname uint32, data *float64) {
+	gl.GetDoublev(pname, data)
+}
+
+func (goglBackend) GetError() uint32 {
+	return gl.GetError()
+}
+
+func (goglBackend) GetFloatv(pname uint32, data *float32) {
+	gl.GetFloatv(pname, data)
+}
+
+func (goglBackend) GetInteger64i_v(target, index uint32, data *int64) {
+	gl.GetInteger64i_v(target, index, data)
+}
+
+func (goglBackend) GetInteger64v(pname uint32, data *int64) {
+	gl.GetInteger64v(pname, data)
+}
+
+func (goglBackend) GetIntegeri_v(target, index uint32, data *int32) {
+	gl.GetIntegeri_v(target, index, data)
+}
+
+func (goglBackend) GetIntegerv(pname uint32, data *int32) {
+	gl.GetIntegerv(pname, data)
+}
+
+func (goglBackend) GetProgramInfoLog(program uint32, bufSize int32, length *int32, infoLog *uint8) {
+	gl.GetProgramInfoLog(program, bufSize, length, infoLog)
+}
+
+func (goglBackend) GetProgramiv(program, pname uint32, params *int32) {
+	gl.GetProgramiv(program, pname, params)
+}
+
+func (goglBackend) GetShaderInfoLog(shader uint32, bufSize int32, length *int32, infoLog *uint8) {
+	gl.GetShaderInfoLog(shader, bufSize, length, infoLog)
+}
+
+func (goglBackend) GetShaderSource(shader uint32, bufSize int32, length *int32, source *uint8) {
+	gl.GetShaderSource(shader, bufSize, length, source)
+}
+
+func (goglBackend) GetShaderiv(shader, pname uint32, params *int32) {
+	gl.GetShaderiv(shader, pname, params)
+}
+
+func (goglBackend) GetString(name uint32) *uint8 {
+	return gl.GetString(name)
+}
+
+func (goglBackend) GetStringi(name, index uint32) *uint8 {
+	return gl.GetStringi(name, index)
+}
+
+func (goglBackend) GetTexImage(target uint32, level int32, format, xtype uint32, pixels unsafe.Pointer) {
+	gl.GetTexImage(target, level, format, xtype, pixels)
+}
+
+func (goglBackend) GetTexLevelParameteriv(target uint32, level int32, pname uint32, params *int32) {
+	gl.GetTexLevelParameteriv(target, level, pname, params)
+}
+
+func (goglBackend) GetTexParameterIiv(target, pname uint32, params *int32) {
+	gl.GetTexParameterIiv(target, pname, params)
+}
+
+func (goglBackend) GetTexParameterIuiv(target, pname uint32, params *uint32) {
+	gl.GetTexParameterIuiv(target, pname, params)
+}
+
+func (goglBackend) GetTexParameterfv(target, pname uint32, params *float32) {
+	gl.GetTexParameterfv(target, pname, params)
+}
+
+func (goglBackend) GetTexParameteriv(target, pname uint32, params *int32) {
+	gl.GetTexParameteriv(target, pname, params)
+}
+
+func (goglBackend) GetUniformLocation(program uint32, name *uint8) int32 {
+	return gl.GetUniformLocation(program, name)
+}
+
+func (goglBackend) GetVertexAttribiv(index, pname uint32, params *int32) {
+	gl.GetVertexAttribiv(index, pname, params)
+}
+
+func (goglBackend) IsTexture(texture uint32) bool {
+	return gl.IsTexture(texture)
+}
+
+func (goglBackend) LineWidth(width float32) {
+	gl.LineWidth(width)
+}
+
+func (goglBackend) LinkProgram(program uint32) {
+	gl.LinkProgram(program)
+}
+
+func (goglBackend) MapBufferRange(target uint32, offset, length int, access uint32) unsafe.Pointer {
+	return gl.MapBufferRange(target, offset, length, access)
+}
+
+func (goglBackend) PauseTransformFeedback() {
+	gl.PauseTransformFeedback()
+}
+
+func (goglBackend) PixelStorei(pname uint32, param int32) {
+	gl.PixelStorei(pname, param)
+}
+
+func (goglBackend) PolygonMode(face, mode uint32) {
+	gl.PolygonMode(face, mode)
+}
+
+func (goglBackend) PolygonOffset(factor, units float32) {
+	gl.PolygonOffset(factor, units)
+}
+
+func (goglBackend) ReadBuffer(src uint32) {
+	gl.ReadBuffer(src)
+}
+
+func (goglBackend) ReadPixels(x, y, width, height int32, format, xtype uint32, pixels unsafe.Pointer) {
+	gl.ReadPixels(x, y, width, height, format, xtype, pixels)
+}
+
+func (goglBackend) RenderbufferStorage(target, internalformat uint32, width, height int32) {
+	gl.RenderbufferStorage(target, internalformat, width, height)
+}
+
+func (goglBackend) ResumeTransformFeedback() {
+	gl.ResumeTransformFeedback()
+}
+
+func (goglBackend) SampleCoverage(value float32, invert bool) {
+	gl.SampleCoverage(value, invert)
+}
+
+func (goglBackend) Scissor(x, y, width, height int32) {
+	gl.Scissor(x, y, width, height)
+}
+
+func (goglBackend) ShaderSource(shader uint32, count int32, xstring **uint8, length *int32) {
+	gl.ShaderSource(shader, count, xstring, length)
+}
+
+func (goglBackend) StencilFunc(xfunc uint32, ref int32, mask uint32) {
+	gl.StencilFunc(xfunc, ref, mask)
+}
+
+func (goglBackend) StencilFuncSeparate(face, xfunc uint32, ref int32, mask uint32) {
+	gl.StencilFuncSeparate(face, xfunc, ref, mask)
+}
+
+func (goglBackend) StencilMask(mask uint32) {
+	gl.StencilMask(mask)
+}
+
+func (goglBackend) StencilMaskSeparate(face, mask uint32) {
+	gl.StencilMaskSeparate(face, mask)
+}
+
+func (goglBackend) StencilOp(fail, zfail, zpass uint32) {
+	gl.StencilOp(fail, zfail, zpass)
+}
+
+func (goglBackend) StencilOpSeparate(face, sfail, dpfail, dppass uint32) {
+	gl.StencilOpSeparate(face, sfail, dpfail, dppass)
+}
+
+func (goglBackend) TexImage1D(target uint32, level, internalformat, width, border int32, format, xtype uint32, pixels unsafe.Pointer) {
+	gl.TexImage1D(target, level, internalformat, width, border, format, xtype, pixels)
+}
+
+func (goglBackend) TexImage2D(target uint32, level, internalformat, width, height, border int32, format, xtype uint32, pixels unsafe.Pointer) {
+	gl.TexImage2D(target, level, internalformat, width, height, border, format, xtype, pixels)
+}
+
+func (goglBackend) TexImage3D(target uint32, level, internalformat, width, height, depth, border int32, format, xtype uint32, pixels unsafe.Pointer) {
+	gl.TexImage3D(target, level, internalformat, width, height, depth, border, format, xtype, pixels)
+}
+
+func (goglBackend) TexParameterIiv(target, pname uint32, params *int32) {
+	gl.TexParameterIiv(target, pname, params)
+}
+
+func (goglBackend) TexParameterIuiv(target, pname uint32, params *uint32) {
+	gl.TexParameterIuiv(target, pname, params)
+}
+
+func (goglBackend) TexParameterf(target, pname uint32, param float32) {
+	gl.TexParameterf(target, pname, param)
+}
+
+func (goglBackend) TexParameterfv(target, pname uint32, params *float32) {
+	gl.TexParameterfv(target, pname, params)
+}
+
+func (goglBackend) TexParameteri(target, pname uint32, param int32) {
+	gl.TexParameteri(target, pname, param)
+}
+
+func (goglBackend) TexParameteriv(target, pname uint32, params *int32) {
+	gl.TexParameteriv(target, pname, params)
+}
+
+func (goglBackend) Uniform1f(location int32, v0 float32) {
+	gl.Uniform1f(location, v0)
+}
+
+func (goglBackend) Uniform1fv(location, count int32, value *float32) {
+	gl.Uniform1fv(location, count, value)
+}
+
+func (goglBackend) Uniform1i(location, v0 int32) {
+	gl.Uniform1i(location, v0)
+}
+
+func (goglBackend) Uniform1iv(location, count int32, value *int32) {
+	gl.Uniform1iv(location, count, value)
+}
+
+func (goglBackend) Uniform1ui(location int32, v0 uint32) {
+	gl.Uniform1ui(location, v0)
+}
+
+func (goglBackend) Uniform1uiv(location, count int32, value *uint32) {
+	gl.Uniform1uiv(location, count, value)
+}
+
+func (goglBackend) Uniform2f(location int32, v0, v1 float32) {
+	gl.Uniform2f(location, v0, v1)
+}
+
+func (goglBackend) Uniform2fv(location, count int32, value *float32) {
+	gl.Uniform2fv(location, count, value)
+}
+
+func (goglBackend) Uniform2i(location, v0, v1 int32) {
+	gl.Uniform2i(location, v0, v1)
+}
+
+func (goglBackend) Uniform2iv(location, count int32, value *int32) {
+	gl.Uniform2iv(location, count, value)
+}
+
+func (goglBackend) Uniform2ui(location int32, v0, v1 uint32) {
+	gl.Uniform2ui(location, v0, v1)
+}
+
+func (goglBackend) Uniform2uiv(location, count int32, value *uint32) {
+	gl.Uniform2uiv(location, count, value)
+}
+
+func (goglBackend) Uniform3f(location int32, v0, v1, v2 float32) {
+	gl.Uniform3f(location, v0, v1, v2)
+}
+
+func (goglBackend) Uniform3fv(location, count int32, value *float32) {
+	gl.Uniform3fv(location, count, value)
+}
+
+func (goglBackend) Uniform3i(location, v0, v1, v2 int32) {
+	gl.Uniform3i(location, v0, v1, v2)
+}
+
+func (goglBackend) Uniform3iv(location, count int32, value *int32) {
+	gl.Uniform3iv(location, count, value)
+}
+
+func (goglBackend) Uniform3ui(location int32, v0, v1, v2 uint32) {
+	gl.Uniform3ui(location, v0, v1, v2)
+}
+
+func (goglBackend) Uniform3uiv(location, count int32, value *uint32) {
+	gl.Uniform3uiv(location, count, value)
+}
+
+func (goglBackend) Uniform4f(location int32, v0, v1, v2, v3 float32) {
+	gl.Uniform4f(location, v0, v1, v2, v3)
+}
+
+func (goglBackend) Uniform4fv(location, count int32, value *float32) {
+	gl.Uniform4fv(location, count, value)
+}
+
+func (goglBackend) Uniform4i(location, v0, v1, v2, v3 int32) {
+	gl.Uniform4i(location, v0, v1, v2, v3)
+}
+
+func (goglBackend) Uniform4iv(location, count int32, value *int32) {
+	gl.Uniform4iv(location, count, value)
+}
+
+func (goglBackend) Uniform4ui(location int32, v0, v1, v2, v3 uint32) {
+	gl.Uniform4ui(location, v0, v1, v2, v3)
+}
+
+func (goglBackend) Uniform4uiv(location, count int32, value *uint32) {
+	gl.Uniform4uiv(location, count, value)
+}
+
+func (goglBackend) UniformMatrix2fv(location, count int32, transpose bool, value *float32) {
+	gl.UniformMatrix2fv(location, count, transpose, value)
+}
+
+func (goglBackend) UniformMatrix2x3fv(location, count int32, transpose bool, value *float32) {
+	gl.UniformMatrix2x3fv(location, count, transpose, value)
+}
+
+func (goglBackend) UniformMatrix2x4fv(location, count int32, transpose bool, value *float32) {
+	gl.UniformMatrix2x4fv(location, count, transpose, value)
+}
+
+func (goglBackend) UniformMatrix3fv(location, count int32, transpose bool, value *float32) {
+	gl.UniformMatrix3fv(location, count, transpose, value)
+}
+
+func (goglBackend) UniformMatrix3x2fv(location, count int32, transpose bool, value *float32) {
+	gl.UniformMatrix3x2fv(location, count, transpose, value)
+}
+
+func (goglBackend) UniformMatrix3x4fv(location, count int32, transpose bool, value *float32) {
+	gl.UniformMatrix3x4fv(location, count, transpose, value)
+}
+
+func (goglBackend) UniformMatrix4fv(location, count int32, transpose bool, value *float32) {
+	gl.UniformMatrix4fv(location, count, transpose, value)
+}
+
+func (goglBackend) UniformMatrix4x2fv(location, count int32, transpose bool, value *float32) {
+	gl.UniformMatrix4x2fv(location, count, transpose, value)
+}
+
+func (goglBackend) UniformMatrix4x3fv(location, count int32, transpose bool, value *float32) {
+	gl.UniformMatrix4x3fv(location, count, transpose, value)
+}
+
+func (goglBackend) UnmapBuffer(target uint32) bool {
+	return gl.UnmapBuffer(target)
+}
+
+func (goglBackend) UseProgram(program uint32) {
+	gl.UseProgram(program)
+}
+
+func (goglBackend) VertexAttribDivisor(index, divisor uint32) {
+	gl.VertexAttribDivisor(index, divisor)
+}
+
+func (goglBackend) VertexAttribIPointer(index uint32, size int32, xtype uint32, stride int32, pointer unsafe.Pointer) {
+	gl.VertexAttribIPointer(index, size, xtype, stride, pointer)
+}
+
+func (goglBackend) VertexAttribLPointer(index uint32, size int32, xtype uint32, stride int32, pointer unsafe.Pointer) {
+	gl.VertexAttribLPointer(index, size, xtype, stride, pointer)
+}
+
+func (goglBackend) VertexAttribPointer(index uint32, size int32, xtype uint32, normalized bool, stride int32, pointer unsafe.Pointer) {
+	gl.VertexAttribPointer(index, size, xtype, normalized, stride, pointer)
+}
+
+func (goglBackend) Viewport(x, y, width, height int32) {
+	gl.Viewport(x, y, width, height)
+}
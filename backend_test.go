@@ -0,0 +1,32 @@
+package gl
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBackendEntryPoints(t *testing.T) {
+	desktop := Profile != "3.0-es"
+	gl4 := Profile == "4.1-core" || Profile == "4.5-core"
+	gl45 := Profile == "4.5-core"
+	tests := []struct {
+		method string
+		want   bool
+	}{
+		{"BindBuffer", true},
+		{"DebugMessageCallback", true},
+		{"BindFragDataLocation", desktop},
+		{"DebugMessageCallbackARB", desktop},
+		{"GetBufferSubData", desktop},
+		{"VertexAttribLPointer", gl4},
+		{"BufferStorage", gl45},
+		{"InvalidateBufferData", gl45},
+		{"InvalidateBufferSubData", gl45},
+	}
+	backendType := reflect.TypeOf((*Backend)(nil)).Elem()
+	for _, tt := range tests {
+		if _, got := backendType.MethodByName(tt.method); got != tt.want {
+			t.Errorf("Backend of the %s profile has %s: %v, want %v", Profile, tt.method, got, tt.want)
+		}
+	}
+}
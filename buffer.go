@@ -1,58 +1,187 @@
 package gl
 
 import (
-	"github.com/go-gl/gl/v3.3-core/gl"
+	"fmt"
+	"strings"
 	"unsafe"
 )
 
-//TODO: make subtype buffers with restrained functions
-//TODO: make an enum for targets
-
-//Buffer is the high level representation of OpenGL Buffer.
+// Buffer is the high level representation of OpenGL Buffer.
 type Buffer uint32
 
-//GenBuffer is an alias to glGenBuffers(1, &b).
+// Data is an alias for glBufferData.
 //
-//Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glGenBuffers.xml
-func GenBuffer() Buffer {
-	var buff uint32
-	gl.GenBuffers(1, &buff)
-	return Buffer(buff)
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBufferData.xml
+func (b Buffer) Data(target BufferTarget, size int, data unsafe.Pointer, usage BufferUsage) {
+	if safetyflag {
+		safetyCheckBound("Buffer.Data", kindBuffer, uint32(target), uint32(b))
+	}
+	backend.BufferData(uint32(target), size, data, uint32(usage))
 }
 
-//GenBuffers is an alias to glGenBuffers(n, &b[0]).
+// SubData is an alias to glBufferSubData, it replaces size bytes of the buffer
+// starting at offset with data.
 //
-//Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glGenBuffers.xml
-func GenBuffers(n int32) []Buffer {
-	buffs := make([]Buffer, n)
-	gl.GenBuffers(n, (*uint32)(&buffs[0]))
-	return buffs
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBufferSubData.xml
+func (b Buffer) SubData(target BufferTarget, offset, size int, data unsafe.Pointer) {
+	if safetyflag {
+		safetyCheckBound("Buffer.SubData", kindBuffer, uint32(target), uint32(b))
+		safetyCheckBufferRange("Buffer.SubData", uint32(target), offset, size)
+	}
+	backend.BufferSubData(uint32(target), offset, size, data)
 }
 
-//Bind is an alias to glBindBuffer(target, b).
+// GetSubData is an alias to glGetBufferSubData, it reads size bytes of the
+// buffer starting at offset into data. OpenGL ES has no glGetBufferSubData,
+// the range is mapped for reading instead and GetSubData returns
+// ErrMapFailed, leaving data as is, if it can't be mapped. It always returns
+// nil in the desktop profiles.
 //
-//Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindBuffer.xml
-func (b Buffer) Bind(target uint32) {
-	gl.BindBuffer(uint32(target), uint32(b))
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glGetBufferSubData.xml
+func (b Buffer) GetSubData(target BufferTarget, offset, size int, data unsafe.Pointer) error {
+	if safetyflag {
+		safetyCheckBound("Buffer.GetSubData", kindBuffer, uint32(target), uint32(b))
+		safetyCheckBufferRange("Buffer.GetSubData", uint32(target), offset, size)
+	}
+	return getBufferSubData(uint32(target), offset, size, data)
 }
 
-//Unbind is an alias to glBindBuffer(target, 0).
+// CopySubData is an alias to glCopyBufferSubData, it copies size bytes of b
+// starting at readOffset to dst starting at writeOffset. b is left bound to
+// gl.COPY_READ_BUFFER and dst to gl.COPY_WRITE_BUFFER, the targets meant for
+// copies that no other command uses.
 //
-//Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindBuffer.xml
-func (Buffer) Unbind(target uint32) {
-	gl.BindBuffer(target, 0)
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glCopyBufferSubData.xml
+func (b Buffer) CopySubData(dst Buffer, readOffset, writeOffset, size int) {
+	b.Bind(COPY_READ_BUFFER)
+	dst.Bind(COPY_WRITE_BUFFER)
+	if safetyflag {
+		safetyCheckBufferRange("Buffer.CopySubData", uint32(COPY_READ_BUFFER), readOffset, size)
+		safetyCheckBufferRange("Buffer.CopySubData", uint32(COPY_WRITE_BUFFER), writeOffset, size)
+		if b == dst {
+			safetyCheckBufferOverlap("Buffer.CopySubData", readOffset, writeOffset, size)
+		}
+	}
+	backend.CopyBufferSubData(uint32(COPY_READ_BUFFER), uint32(COPY_WRITE_BUFFER), readOffset, writeOffset, size)
 }
 
-//Data is an alias for glBufferData.
+// Invalidate is an alias to glInvalidateBufferData, it tells OpenGL the
+// content of the buffer is no longer needed so a new upload doesn't have to
+// wait for the draws still reading it. See invalidateBuffer for the profiles
+// before 4.5.
 //
-//Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBufferData.xml
-func (Buffer) Data(target uint32, size int, data unsafe.Pointer, usage uint32) {
-	gl.BufferData(target, size, data, usage)
+// Documentation reference: https://www.opengl.org/sdk/docs/man4/html/glInvalidateBufferData.xhtml
+func (b Buffer) Invalidate() {
+	invalidateBuffer(b, 0, -1)
 }
 
-//Delete is an alias to glDeleteBuffers(&b). The buffer should not be used after calling this.
+// InvalidateRange is an alias to glInvalidateBufferSubData, it invalidates
+// size bytes of the buffer starting at offset like Invalidate.
 //
-//Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glDeleteBuffers.xml
-func (b Buffer) Delete() {
-	gl.DeleteBuffers(1, (*uint32)(&b))
+// Documentation reference: https://www.opengl.org/sdk/docs/man4/html/glInvalidateBufferSubData.xhtml
+func (b Buffer) InvalidateRange(offset, size int) {
+	if safetyflag {
+		safetyCheckBufferObjectRange("Buffer.InvalidateRange", uint32(b), offset, size)
+	}
+	invalidateBuffer(b, offset, size)
+}
+
+// invalidateMapped invalidates size bytes of b starting at offset, the whole
+// buffer if size is negative, without glInvalidateBufferData: the range is
+// mapped for writing with the invalidate bits and unmapped right away, which
+// leaves b bound to gl.COPY_WRITE_BUFFER.
+func invalidateMapped(b Buffer, offset, size int) {
+	b.Bind(COPY_WRITE_BUFFER)
+	access := uint32(MAP_WRITE_BIT | MAP_INVALIDATE_RANGE_BIT)
+	if size < 0 {
+		var n int64
+		backend.GetBufferParameteri64v(uint32(COPY_WRITE_BUFFER), BUFFER_SIZE, &n)
+		offset, size, access = 0, int(n), MAP_WRITE_BIT|MAP_INVALIDATE_BUFFER_BIT
+	}
+	if size == 0 {
+		return
+	}
+	if backend.MapBufferRange(uint32(COPY_WRITE_BUFFER), offset, size, access) != nil {
+		backend.UnmapBuffer(uint32(COPY_WRITE_BUFFER))
+	}
+}
+
+// GetSize returns the size in bytes of the data store of the buffer, it is
+// an alias to glGetBufferParameteri64v(target, gl.BUFFER_SIZE, &params).
+func (b Buffer) GetSize(target BufferTarget) int {
+	if safetyflag {
+		safetyCheckBound("Buffer.GetSize", kindBuffer, uint32(target), uint32(b))
+	}
+	var params int64
+	backend.GetBufferParameteri64v(uint32(target), BUFFER_SIZE, &params)
+	return int(params)
+}
+
+// GetUsage returns the usage the data store of the buffer was created with,
+// it is an alias to glGetBufferParameteriv(target, gl.BUFFER_USAGE, &params).
+func (b Buffer) GetUsage(target BufferTarget) BufferUsage {
+	if safetyflag {
+		safetyCheckBound("Buffer.GetUsage", kindBuffer, uint32(target), uint32(b))
+	}
+	var params int32
+	backend.GetBufferParameteriv(uint32(target), BUFFER_USAGE, &params)
+	return BufferUsage(params)
+}
+
+// GetMapped returns true if the buffer is currently mapped, it is an alias to
+// glGetBufferParameteriv(target, gl.BUFFER_MAPPED, &params).
+func (b Buffer) GetMapped(target BufferTarget) bool {
+	if safetyflag {
+		safetyCheckBound("Buffer.GetMapped", kindBuffer, uint32(target), uint32(b))
+	}
+	var params int32
+	backend.GetBufferParameteriv(uint32(target), BUFFER_MAPPED, &params)
+	return params == TRUE
+}
+
+// GetAccessFlags returns the access flags of the current mapping of the
+// buffer, 0 if it isn't mapped. It is an alias to
+// glGetBufferParameteriv(target, gl.BUFFER_ACCESS_FLAGS, &params).
+func (b Buffer) GetAccessFlags(target BufferTarget) MapAccess {
+	if safetyflag {
+		safetyCheckBound("Buffer.GetAccessFlags", kindBuffer, uint32(target), uint32(b))
+	}
+	var params int32
+	backend.GetBufferParameteriv(uint32(target), BUFFER_ACCESS_FLAGS, &params)
+	return MapAccess(params)
+}
+
+// MapAccess is a combination of the gl.MAP_*_BIT flags of glMapBufferRange.
+type MapAccess uint32
+
+var mapAccessNames = []struct {
+	bit  MapAccess
+	name string
+}{
+	{MAP_READ_BIT, "GL_MAP_READ_BIT"},
+	{MAP_WRITE_BIT, "GL_MAP_WRITE_BIT"},
+	{MAP_INVALIDATE_RANGE_BIT, "GL_MAP_INVALIDATE_RANGE_BIT"},
+	{MAP_INVALIDATE_BUFFER_BIT, "GL_MAP_INVALIDATE_BUFFER_BIT"},
+	{MAP_FLUSH_EXPLICIT_BIT, "GL_MAP_FLUSH_EXPLICIT_BIT"},
+	{MAP_UNSYNCHRONIZED_BIT, "GL_MAP_UNSYNCHRONIZED_BIT"},
+	{MAP_PERSISTENT_BIT, "GL_MAP_PERSISTENT_BIT"},
+	{MAP_COHERENT_BIT, "GL_MAP_COHERENT_BIT"},
+}
+
+// String returns the names of the flags of a joined by |, "0" if it has none.
+func (a MapAccess) String() string {
+	if a == 0 {
+		return "0"
+	}
+	var names []string
+	for _, f := range mapAccessNames {
+		if a&f.bit != 0 {
+			names = append(names, f.name)
+			a &^= f.bit
+		}
+	}
+	if a != 0 {
+		names = append(names, fmt.Sprintf("0x%X", uint32(a)))
+	}
+	return strings.Join(names, "|")
 }
@@ -0,0 +1,12 @@
+//go:build !gles30
+
+package gl
+
+import "unsafe"
+
+// getBufferSubData reads size bytes at offset of the buffer bound to target
+// into data, it can't fail.
+func getBufferSubData(target uint32, offset, size int, data unsafe.Pointer) error {
+	backend.GetBufferSubData(target, offset, size, data)
+	return nil
+}
@@ -0,0 +1,35 @@
+//go:build gl45
+
+package gl
+
+// invalidateBuffer invalidates size bytes of b starting at offset, the whole
+// buffer if size is negative. glInvalidateBufferData is core since OpenGL
+// 4.3, older contexts without ARB_invalidate_subdata fall back to
+// invalidateMapped.
+func invalidateBuffer(b Buffer, offset, size int) {
+	if !invalidateAvailable() {
+		invalidateMapped(b, offset, size)
+		return
+	}
+	if size < 0 {
+		backend.InvalidateBufferData(uint32(b))
+		return
+	}
+	backend.InvalidateBufferSubData(uint32(b), offset, size)
+}
+
+// invalidateAvailable returns true if glInvalidateBufferData can be called.
+func invalidateAvailable() bool {
+	return contextInfo().AtLeast(4, 3) || AvailableExtensions().Has(ARB_invalidate_subdata)
+}
+
+// bufferStorageAvailable returns true if glBufferStorage can be called, it is
+// core since OpenGL 4.4.
+func bufferStorageAvailable() bool {
+	return contextInfo().AtLeast(4, 4) || AvailableExtensions().Has(ARB_buffer_storage)
+}
+
+// bufferStorage creates the immutable storage of the buffer bound to target.
+func bufferStorage(target BufferTarget, size int, flags MapAccess) {
+	backend.BufferStorage(uint32(target), size, nil, uint32(flags))
+}
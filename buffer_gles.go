@@ -0,0 +1,21 @@
+//go:build gles30
+
+package gl
+
+import "unsafe"
+
+// getBufferSubData reads size bytes at offset of the buffer bound to target
+// into data. OpenGL ES has no glGetBufferSubData, the range is mapped for
+// reading instead, ErrMapFailed is returned if it can't be.
+func getBufferSubData(target uint32, offset, size int, data unsafe.Pointer) error {
+	if size == 0 {
+		return nil
+	}
+	p := backend.MapBufferRange(target, offset, size, MAP_READ_BIT)
+	if p == nil {
+		return ErrMapFailed
+	}
+	copy(unsafe.Slice((*byte)(data), size), unsafe.Slice((*byte)(p), size))
+	backend.UnmapBuffer(target)
+	return nil
+}
@@ -0,0 +1,19 @@
+//go:build !gl45
+
+package gl
+
+// invalidateBuffer invalidates size bytes of b starting at offset, the whole
+// buffer if size is negative. glInvalidateBufferData is only part of the
+// Backend of the 4.5 profile, the others use invalidateMapped.
+func invalidateBuffer(b Buffer, offset, size int) {
+	invalidateMapped(b, offset, size)
+}
+
+// glBufferStorage is only part of the Backend of the 4.5 profile, these are
+// never called in the others.
+
+func bufferStorageAvailable() bool {
+	return false
+}
+
+func bufferStorage(target BufferTarget, size int, flags MapAccess) {}
@@ -0,0 +1,196 @@
+package gl
+
+import (
+	"bytes"
+	"testing"
+	"unsafe"
+)
+
+func TestBufferCalls(t *testing.T) {
+	data := []byte{1, 2, 3, 4}
+	tests := []struct {
+		name string
+		do   func(b Buffer)
+		want []Call
+	}{
+		{
+			name: "Data",
+			do:   func(b Buffer) { b.Data(ARRAY_BUFFER, 4, unsafe.Pointer(&data[0]), STATIC_DRAW) },
+			want: []Call{call("BufferData", uint32(ARRAY_BUFFER), 4, unsafe.Pointer(&data[0]), uint32(STATIC_DRAW))},
+		},
+		{
+			name: "SubData",
+			do:   func(b Buffer) { b.SubData(ARRAY_BUFFER, 8, 4, unsafe.Pointer(&data[0])) },
+			want: append(safetyCalls(call("GetBufferParameteri64v", uint32(ARRAY_BUFFER), uint32(BUFFER_SIZE), anyArg{})),
+				call("BufferSubData", uint32(ARRAY_BUFFER), 8, 4, unsafe.Pointer(&data[0]))),
+		},
+		{
+			name: "GetSize",
+			do:   func(b Buffer) { b.GetSize(ARRAY_BUFFER) },
+			want: []Call{call("GetBufferParameteri64v", uint32(ARRAY_BUFFER), uint32(BUFFER_SIZE), anyArg{})},
+		},
+		{
+			name: "GetUsage",
+			do:   func(b Buffer) { b.GetUsage(ARRAY_BUFFER) },
+			want: []Call{call("GetBufferParameteriv", uint32(ARRAY_BUFFER), uint32(BUFFER_USAGE), anyArg{})},
+		},
+		{
+			name: "Unbind",
+			do:   func(b Buffer) { b.Unbind(ARRAY_BUFFER) },
+			want: []Call{call("BindBuffer", uint32(ARRAY_BUFFER), uint32(0))},
+		},
+		{
+			name: "Delete",
+			do:   func(b Buffer) { b.Delete() },
+			want: []Call{call("DeleteBuffers", int32(1), anyArg{})},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFake(t)
+			b := GenBuffer()
+			b.Bind(ARRAY_BUFFER)
+			b.Data(ARRAY_BUFFER, 16, nil, STATIC_DRAW)
+			f.Reset()
+			tt.do(b)
+			checkCalls(t, f, tt.want...)
+		})
+	}
+}
+
+func TestBufferContent(t *testing.T) {
+	newFake(t)
+	b := GenBuffer()
+	b.Bind(ARRAY_BUFFER)
+	b.Data(ARRAY_BUFFER, 8, nil, DYNAMIC_DRAW)
+	src := []byte{1, 2, 3, 4}
+	b.SubData(ARRAY_BUFFER, 2, 4, unsafe.Pointer(&src[0]))
+	if got := b.GetSize(ARRAY_BUFFER); got != 8 {
+		t.Errorf("GetSize() = %d, want 8", got)
+	}
+	if got := b.GetUsage(ARRAY_BUFFER); got != DYNAMIC_DRAW {
+		t.Errorf("GetUsage() = %v, want GL_DYNAMIC_DRAW", got)
+	}
+	got := make([]byte, 8)
+	b.GetSubData(ARRAY_BUFFER, 0, 8, unsafe.Pointer(&got[0]))
+	if want := []byte{0, 0, 1, 2, 3, 4, 0, 0}; !bytes.Equal(got, want) {
+		t.Errorf("GetSubData() = %v, want %v", got, want)
+	}
+
+	dst := GenBuffer()
+	dst.Bind(ARRAY_BUFFER)
+	dst.Data(ARRAY_BUFFER, 4, nil, STATIC_DRAW)
+	b.CopySubData(dst, 3, 1, 2)
+	got = got[:4]
+	dst.GetSubData(COPY_WRITE_BUFFER, 0, 4, unsafe.Pointer(&got[0]))
+	if want := []byte{0, 2, 3, 0}; !bytes.Equal(got, want) {
+		t.Errorf("CopySubData() copied %v, want %v", got, want)
+	}
+	if Get.CopyReadBufferBinding() != b || Get.CopyWriteBufferBinding() != dst {
+		t.Errorf("CopySubData() didn't leave the buffers bound to the copy targets")
+	}
+}
+
+func TestMapAccessString(t *testing.T) {
+	tests := []struct {
+		access MapAccess
+		want   string
+	}{
+		{0, "0"},
+		{MAP_READ_BIT, "GL_MAP_READ_BIT"},
+		{MAP_WRITE_BIT | MAP_UNSYNCHRONIZED_BIT, "GL_MAP_WRITE_BIT|GL_MAP_UNSYNCHRONIZED_BIT"},
+	}
+	for _, tt := range tests {
+		if got := tt.access.String(); got != tt.want {
+			t.Errorf("MapAccess(0x%X).String() = %q, want %q", uint32(tt.access), got, tt.want)
+		}
+	}
+}
+
+func TestBufferInvalidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		version    string
+		extensions []string
+		// core is true if glInvalidateBufferData is called, the range is
+		// mapped with the invalidate bits otherwise.
+		core bool
+	}{
+		{"3.3", "3.3.0", nil, false},
+		{"4.3", "4.3.0", nil, Profile == "4.5-core"},
+		{"ARB_invalidate_subdata", "3.3.0", []string{string(ARB_invalidate_subdata)}, Profile == "4.5-core"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFake(t)
+			f.Strings[VERSION] = tt.version
+			f.Extensions = tt.extensions
+			resetExtensions()
+			defer resetExtensions()
+			b := GenBuffer()
+			b.Bind(ARRAY_BUFFER)
+			b.Data(ARRAY_BUFFER, 16, nil, STATIC_DRAW)
+			// The version and extensions are queried once per context.
+			contextInfo()
+			AvailableExtensions()
+			f.Reset()
+			b.Invalidate()
+			b.InvalidateRange(4, 8)
+			// The safety checks bind the buffer to query its size and
+			// restore the binding they know of.
+			check := safetyCalls(
+				call("BindBuffer", uint32(COPY_WRITE_BUFFER), uint32(b)),
+				call("GetBufferParameteri64v", uint32(COPY_WRITE_BUFFER), uint32(BUFFER_SIZE), anyArg{}),
+				call("BindBuffer", uint32(COPY_WRITE_BUFFER), uint32(0)))
+			if tt.core {
+				checkCalls(t, f, append(append([]Call{
+					call("InvalidateBufferData", uint32(b))}, check...),
+					call("InvalidateBufferSubData", uint32(b), 4, 8))...)
+				return
+			}
+			if safetyflag {
+				check[2] = call("BindBuffer", uint32(COPY_WRITE_BUFFER), uint32(b))
+			}
+			checkCalls(t, f, append(append([]Call{
+				call("BindBuffer", uint32(COPY_WRITE_BUFFER), uint32(b)),
+				call("GetBufferParameteri64v", uint32(COPY_WRITE_BUFFER), uint32(BUFFER_SIZE), anyArg{}),
+				call("MapBufferRange", uint32(COPY_WRITE_BUFFER), 0, 16, uint32(MAP_WRITE_BIT|MAP_INVALIDATE_BUFFER_BIT)),
+				call("UnmapBuffer", uint32(COPY_WRITE_BUFFER))}, check...),
+				call("BindBuffer", uint32(COPY_WRITE_BUFFER), uint32(b)),
+				call("MapBufferRange", uint32(COPY_WRITE_BUFFER), 4, 8, uint32(MAP_WRITE_BIT|MAP_INVALIDATE_RANGE_BIT)),
+				call("UnmapBuffer", uint32(COPY_WRITE_BUFFER)))...)
+		})
+	}
+}
+
+func TestBufferInvalidateRangeOutOfBuffer(t *testing.T) {
+	if !safetyflag {
+		t.Skip("the range is only checked with -tags safety")
+	}
+	newFake(t)
+	b := GenBuffer()
+	b.Bind(ARRAY_BUFFER)
+	b.Data(ARRAY_BUFFER, 16, nil, STATIC_DRAW)
+	if v := catchPanic(func() { b.InvalidateRange(8, 16) }); v == nil {
+		t.Errorf("InvalidateRange() out of the buffer didn't report a violation")
+	}
+}
+
+func TestBufferGetSubDataMapFailed(t *testing.T) {
+	f := newFake(t)
+	backend = failMapBackend{f}
+	b := GenBuffer()
+	b.Bind(ARRAY_BUFFER)
+	b.Data(ARRAY_BUFFER, 4, nil, STATIC_DRAW)
+	got := []byte{9, 9, 9, 9}
+	err := b.GetSubData(ARRAY_BUFFER, 0, 4, unsafe.Pointer(&got[0]))
+	if Profile != "3.0-es" {
+		if err != nil {
+			t.Errorf("GetSubData() = %v, want nil", err)
+		}
+		return
+	}
+	if err != ErrMapFailed || !bytes.Equal(got, []byte{9, 9, 9, 9}) {
+		t.Errorf("GetSubData() = %v and read %v, want ErrMapFailed and data left as is", err, got)
+	}
+}
@@ -0,0 +1,325 @@
+package gl
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"sort"
+	"unsafe"
+)
+
+// ErrArenaAllocTooLarge is returned by BufferArena.Alloc when the allocation
+// doesn't fit in one buffer of the arena.
+var ErrArenaAllocTooLarge = errors.New("gl: allocation larger than the buffers of the arena")
+
+// arenaMinOrder is the log2 of the smallest block the arena hands out.
+const arenaMinOrder = 6
+
+// BufferArena packs many small allocations, like the vertices and indices of
+// meshes, into a few large buffers so draws can share them with base vertex
+// offsets instead of binding one buffer per mesh.
+//
+// Each buffer is managed by a buddy allocator: allocations are rounded up to
+// a power of two of at least 64 bytes, and freed blocks merge back with their
+// buddy. Defrag repacks the live allocations into as few buffers as possible.
+//
+// The arena only binds its buffers to gl.COPY_READ_BUFFER and
+// gl.COPY_WRITE_BUFFER, the bindings of the other targets, including the
+// element array of the bound vertex array, are left alone.
+type BufferArena struct {
+	blockOrder int
+	usage      BufferUsage
+	blocks     []*arenaBlock
+	live       map[*ArenaRange]struct{}
+}
+
+// ArenaRange is an allocation of a BufferArena. Its buffer and offset change
+// when the arena is defragmented.
+type ArenaRange struct {
+	// Buffer is the buffer holding the range, Offset the offset of the range
+	// in it, aligned as requested, and Size its size in bytes.
+	Buffer Buffer
+	Offset int
+	Size   int
+
+	arena  *BufferArena
+	block  *arenaBlock
+	start  int // offset of the buddy block
+	order  int
+	align  int
+	active bool
+}
+
+// arenaBlock is a buffer of the arena and its buddy allocator.
+type arenaBlock struct {
+	buffer Buffer
+	// free holds the offsets of the free blocks of every order from
+	// arenaMinOrder, where the order and index of every free offset.
+	free  [][]int
+	where map[int]arenaFree
+	used  int
+}
+
+type arenaFree struct {
+	order, index int
+}
+
+// ArenaStats describes the memory of a BufferArena.
+type ArenaStats struct {
+	// Buffers is the number of buffers and Capacity their total size.
+	Buffers  int
+	Capacity int
+	// Allocations is the number of live allocations, Requested the bytes
+	// they asked for and Reserved the bytes of the blocks holding them.
+	Allocations int
+	Requested   int
+	Reserved    int
+	// Free is the free memory and LargestFree the largest allocation that
+	// fits without creating a buffer.
+	Free        int
+	LargestFree int
+	// Fragmentation is the part of the free memory that isn't in the largest
+	// free block of its buffer: 0 when every buffer has its free memory in
+	// one block and close to 1 when it is scattered in small blocks.
+	Fragmentation float64
+}
+
+// NewBufferArena returns an arena of buffers of blockSize bytes, rounded up
+// to a power of two, created with usage. Buffers are created as allocations
+// need them.
+func NewBufferArena(blockSize int, usage BufferUsage) *BufferArena {
+	order := arenaMinOrder
+	for 1<<order < blockSize {
+		order++
+	}
+	return &BufferArena{blockOrder: order, usage: usage, live: map[*ArenaRange]struct{}{}}
+}
+
+// BlockSize returns the size of the buffers of the arena.
+func (a *BufferArena) BlockSize() int {
+	return 1 << a.blockOrder
+}
+
+// Alloc reserves size bytes aligned on align bytes, any positive alignment
+// like the stride of a vertex. It creates a buffer if none has room and
+// returns ErrArenaAllocTooLarge if the allocation can't fit in one.
+func (a *BufferArena) Alloc(size, align int) (*ArenaRange, error) {
+	if size <= 0 {
+		panic(fmt.Sprintf("gl: BufferArena.Alloc of %d bytes", size))
+	}
+	if align < 1 {
+		align = 1
+	}
+	order := arenaOrder(size, align)
+	if order > a.blockOrder {
+		return nil, ErrArenaAllocTooLarge
+	}
+	r := &ArenaRange{arena: a, Size: size, order: order, align: align}
+	a.place(r)
+	return r, nil
+}
+
+// place puts r in the first buffer with room for it, in a new one if none
+// has.
+func (a *BufferArena) place(r *ArenaRange) {
+	for _, b := range a.blocks {
+		if start, ok := b.alloc(r.order); ok {
+			r.place(b, start)
+			return
+		}
+	}
+	b := a.newBlock()
+	start, _ := b.alloc(r.order)
+	r.place(b, start)
+}
+
+// arenaOrder returns the order of the buddy block holding size bytes aligned
+// on align. Blocks are aligned on their size so power of two alignments are
+// free, the others are padded.
+func arenaOrder(size, align int) int {
+	n := size
+	if align&(align-1) == 0 {
+		n = max(n, align)
+	} else {
+		n += align - 1
+	}
+	return max(arenaMinOrder, bits.Len(uint(n-1)))
+}
+
+// place puts r in the block at start of b.
+func (r *ArenaRange) place(b *arenaBlock, start int) {
+	r.block, r.start, r.active = b, start, true
+	r.Buffer = b.buffer
+	r.Offset = (start + r.align - 1) / r.align * r.align
+	b.used += r.Size
+	r.arena.live[r] = struct{}{}
+}
+
+// newBlock creates a buffer with all its memory free.
+func (a *BufferArena) newBlock() *arenaBlock {
+	b := &arenaBlock{
+		buffer: GenBuffer(),
+		free:   make([][]int, a.blockOrder-arenaMinOrder+1),
+		where:  map[int]arenaFree{},
+	}
+	b.buffer.Bind(COPY_WRITE_BUFFER)
+	backend.BufferData(uint32(COPY_WRITE_BUFFER), 1<<a.blockOrder, nil, uint32(a.usage))
+	b.push(0, a.blockOrder)
+	a.blocks = append(a.blocks, b)
+	return b
+}
+
+func (b *arenaBlock) push(start, order int) {
+	l := &b.free[order-arenaMinOrder]
+	b.where[start] = arenaFree{order: order, index: len(*l)}
+	*l = append(*l, start)
+}
+
+func (b *arenaBlock) remove(start int) {
+	f := b.where[start]
+	l := b.free[f.order-arenaMinOrder]
+	last := l[len(l)-1]
+	l[f.index] = last
+	b.where[last] = arenaFree{order: f.order, index: f.index}
+	b.free[f.order-arenaMinOrder] = l[:len(l)-1]
+	delete(b.where, start)
+}
+
+// alloc takes a free block of order, splitting a larger one if needed.
+func (b *arenaBlock) alloc(order int) (int, bool) {
+	k := order
+	for k-arenaMinOrder < len(b.free) && len(b.free[k-arenaMinOrder]) == 0 {
+		k++
+	}
+	if k-arenaMinOrder >= len(b.free) {
+		return 0, false
+	}
+	l := b.free[k-arenaMinOrder]
+	start := l[len(l)-1]
+	b.remove(start)
+	for k > order {
+		k--
+		b.push(start+1<<k, k)
+	}
+	return start, true
+}
+
+// release frees the block of order at start and merges it with its buddies.
+func (b *arenaBlock) release(start, order int) {
+	for order-arenaMinOrder < len(b.free)-1 {
+		buddy := start ^ 1<<order
+		if f, ok := b.where[buddy]; !ok || f.order != order {
+			break
+		}
+		b.remove(buddy)
+		start = min(start, buddy)
+		order++
+	}
+	b.push(start, order)
+}
+
+// Free releases r, it must not be used anymore. It panics if r was already
+// freed.
+func (a *BufferArena) Free(r *ArenaRange) {
+	if !r.active || r.arena != a {
+		panic("gl: BufferArena.Free of a range that isn't allocated in this arena")
+	}
+	r.block.release(r.start, r.order)
+	r.block.used -= r.Size
+	r.active = false
+	delete(a.live, r)
+}
+
+// SubData copies size bytes of data to the range starting at offset, relative
+// to the start of the range. It binds the buffer of r to gl.COPY_WRITE_BUFFER
+// and panics if the bytes are out of the range.
+func (r *ArenaRange) SubData(offset, size int, data unsafe.Pointer) {
+	if !r.active {
+		panic("gl: ArenaRange.SubData on a freed range")
+	}
+	if offset < 0 || size < 0 || offset+size > r.Size {
+		panic(fmt.Sprintf("gl: ArenaRange.SubData of [%d:%d] out of a range of %d bytes", offset, offset+size, r.Size))
+	}
+	r.Buffer.Bind(COPY_WRITE_BUFFER)
+	backend.BufferSubData(uint32(COPY_WRITE_BUFFER), r.Offset+offset, size, data)
+}
+
+// First returns the index of the first element of r in its buffer, for
+// elements of stride bytes: the base vertex of the draws reading vertices
+// from r, or their first index. r must be aligned on stride.
+func (r *ArenaRange) First(stride int) int32 {
+	return int32(r.Offset / stride)
+}
+
+// Stats returns the memory usage of the arena.
+func (a *BufferArena) Stats() ArenaStats {
+	s := ArenaStats{Buffers: len(a.blocks), Capacity: len(a.blocks) << a.blockOrder, Allocations: len(a.live)}
+	largest := 0
+	for _, b := range a.blocks {
+		s.Requested += b.used
+		block := 0
+		for i, l := range b.free {
+			s.Free += len(l) << (i + arenaMinOrder)
+			if len(l) > 0 {
+				block = 1 << (i + arenaMinOrder)
+			}
+		}
+		largest += block
+		s.LargestFree = max(s.LargestFree, block)
+	}
+	s.Reserved = s.Capacity - s.Free
+	if s.Free > 0 {
+		s.Fragmentation = 1 - float64(largest)/float64(s.Free)
+	}
+	return s
+}
+
+// Defrag repacks the live allocations into new buffers, largest first, so
+// they fill as few buffers as possible with the free memory in one piece at
+// the end. Their content is moved with glCopyBufferSubData and the old
+// buffers are deleted: the Buffer and Offset of the ranges change, the
+// vertex arrays and draws using them must be updated.
+//
+// It needs the memory of the old and new buffers at the same time.
+func (a *BufferArena) Defrag() {
+	index := make(map[*arenaBlock]int, len(a.blocks))
+	for i, b := range a.blocks {
+		index[b] = i
+	}
+	live := make([]*ArenaRange, 0, len(a.live))
+	for r := range a.live {
+		live = append(live, r)
+	}
+	sort.Slice(live, func(i, j int) bool {
+		ri, rj := live[i], live[j]
+		if ri.order != rj.order {
+			return ri.order > rj.order
+		}
+		if ri.block != rj.block {
+			return index[ri.block] < index[rj.block]
+		}
+		return ri.start < rj.start
+	})
+	old := a.blocks
+	a.blocks, a.live = nil, make(map[*ArenaRange]struct{}, len(live))
+	for _, r := range live {
+		buffer, offset := r.Buffer, r.Offset
+		a.place(r)
+		buffer.CopySubData(r.Buffer, offset, r.Offset, r.Size)
+	}
+	for _, b := range old {
+		b.buffer.Delete()
+	}
+}
+
+// Delete deletes every buffer of the arena, its ranges must not be used
+// anymore.
+func (a *BufferArena) Delete() {
+	for _, b := range a.blocks {
+		b.buffer.Delete()
+	}
+	for r := range a.live {
+		r.active = false
+	}
+	a.blocks, a.live = nil, map[*ArenaRange]struct{}{}
+}
@@ -0,0 +1,150 @@
+package gl
+
+import (
+	"bytes"
+	"math"
+	"testing"
+	"unsafe"
+)
+
+func TestArenaOrder(t *testing.T) {
+	tests := []struct {
+		size, align, want int
+	}{
+		{1, 1, 6},
+		{64, 1, 6},
+		{65, 1, 7},
+		{100, 256, 8},
+		{100, 12, 7},
+		{128, 3, 8},
+	}
+	for _, tt := range tests {
+		if got := arenaOrder(tt.size, tt.align); got != tt.want {
+			t.Errorf("arenaOrder(%d, %d) = %d, want %d", tt.size, tt.align, got, tt.want)
+		}
+	}
+}
+
+func TestBufferArenaAlloc(t *testing.T) {
+	tests := []struct {
+		size, align int
+		wantBuffer  int
+		wantOffset  int
+		wantErr     error
+	}{
+		{100, 1, 0, 0, nil},
+		{64, 1, 0, 128, nil},
+		{64, 1, 0, 192, nil},
+		{10, 1, 1, 0, nil},
+		{300, 1, 0, 0, ErrArenaAllocTooLarge},
+		{40, 12, 1, 72, nil},
+	}
+	newFake(t)
+	a := NewBufferArena(200, STATIC_DRAW)
+	if a.BlockSize() != 256 {
+		t.Errorf("BlockSize() = %d, want 256", a.BlockSize())
+	}
+	for _, tt := range tests {
+		r, err := a.Alloc(tt.size, tt.align)
+		if err != tt.wantErr {
+			t.Errorf("Alloc(%d, %d) = %v, want %v", tt.size, tt.align, err, tt.wantErr)
+		}
+		if err != nil {
+			continue
+		}
+		if r.Buffer != a.blocks[tt.wantBuffer].buffer || r.Offset != tt.wantOffset {
+			t.Errorf("Alloc(%d, %d) = %v at %d, want buffer %d at %d", tt.size, tt.align, r.Buffer, r.Offset, tt.wantBuffer, tt.wantOffset)
+		}
+	}
+	want := ArenaStats{Buffers: 2, Capacity: 512, Allocations: 5, Requested: 278, Reserved: 384, Free: 128, LargestFree: 128}
+	if got := a.Stats(); got != want {
+		t.Errorf("Stats() = %+v, want %+v", got, want)
+	}
+}
+
+func TestBufferArenaFree(t *testing.T) {
+	tests := []struct {
+		name              string
+		free              []int
+		wantFree          int
+		wantLargest       int
+		wantFragmentation float64
+	}{
+		{"nothing", nil, 0, 0, 0},
+		{"one", []int{1}, 64, 64, 0},
+		{"buddies merge", []int{2, 3}, 128, 128, 0},
+		{"neighbours that aren't buddies", []int{1, 2}, 128, 64, 0.5},
+		{"scattered", []int{0, 3}, 128, 64, 0.5},
+		{"all", []int{0, 1, 2, 3}, 256, 256, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newFake(t)
+			a := NewBufferArena(256, STATIC_DRAW)
+			ranges := make([]*ArenaRange, 4)
+			for i := range ranges {
+				ranges[i], _ = a.Alloc(64, 1)
+			}
+			for _, i := range tt.free {
+				a.Free(ranges[i])
+			}
+			s := a.Stats()
+			if s.Free != tt.wantFree || s.LargestFree != tt.wantLargest || math.Abs(s.Fragmentation-tt.wantFragmentation) > 1e-9 {
+				t.Errorf("Stats() = %+v, want Free %d, LargestFree %d, Fragmentation %v", s, tt.wantFree, tt.wantLargest, tt.wantFragmentation)
+			}
+			if s.Allocations != 4-len(tt.free) {
+				t.Errorf("Stats().Allocations = %d, want %d", s.Allocations, 4-len(tt.free))
+			}
+			if len(tt.free) > 0 && catchPanic(func() { a.Free(ranges[tt.free[0]]) }) == nil {
+				t.Error("Free of a freed range didn't panic")
+			}
+		})
+	}
+}
+
+func TestBufferArenaDefrag(t *testing.T) {
+	f := newFake(t)
+	a := NewBufferArena(128, STATIC_DRAW)
+	ranges := make([]*ArenaRange, 4)
+	for i := range ranges {
+		ranges[i], _ = a.Alloc(64, 1)
+		data := bytes.Repeat([]byte{byte(i + 1)}, 64)
+		ranges[i].SubData(0, 64, unsafe.Pointer(&data[0]))
+	}
+	a.Free(ranges[1])
+	a.Free(ranges[2])
+	if n := a.Stats().Buffers; n != 2 {
+		t.Fatalf("%d buffers before Defrag, want 2", n)
+	}
+	a.Defrag()
+	if s := a.Stats(); s.Buffers != 1 || s.Allocations != 2 || s.Free != 0 {
+		t.Errorf("Stats() after Defrag = %+v, want 1 full buffer", s)
+	}
+	for _, i := range []int{0, 3} {
+		r := ranges[i]
+		if got := f.buffers[uint32(r.Buffer)][r.Offset : r.Offset+r.Size]; !bytes.Equal(got, bytes.Repeat([]byte{byte(i + 1)}, 64)) {
+			t.Errorf("range %d holds %v after Defrag", i, got)
+		}
+	}
+	if n := f.LiveObjects(); n != 1 {
+		t.Errorf("%d live objects after Defrag, want 1", n)
+	}
+	a.Delete()
+	if n := f.LiveObjects(); n != 0 {
+		t.Errorf("%d live objects after Delete, want 0", n)
+	}
+}
+
+func TestArenaRangeSubDataPanics(t *testing.T) {
+	newFake(t)
+	a := NewBufferArena(256, STATIC_DRAW)
+	r, _ := a.Alloc(16, 1)
+	data := make([]byte, 32)
+	if catchPanic(func() { r.SubData(8, 16, unsafe.Pointer(&data[0])) }) == nil {
+		t.Error("SubData out of the range didn't panic")
+	}
+	a.Free(r)
+	if catchPanic(func() { r.SubData(0, 16, unsafe.Pointer(&data[0])) }) == nil {
+		t.Error("SubData on a freed range didn't panic")
+	}
+}
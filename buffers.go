@@ -0,0 +1,215 @@
+package gl
+
+import "unsafe"
+
+// ArrayBuffer is the high level representation of an OpenGL buffer bound to GL_ARRAY_BUFFER. It restricts the available functions and automatically fills the target.
+type ArrayBuffer Buffer
+
+// Data is an alias to glBufferData(GL_ARRAY_BUFFER, size, data, usage).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBufferData.xml
+func (b ArrayBuffer) Data(size int, data unsafe.Pointer, usage BufferUsage) {
+	if safetyflag {
+		safetyCheckBound("ArrayBuffer.Data", kindBuffer, uint32(ARRAY_BUFFER), uint32(b))
+	}
+	backend.BufferData(uint32(ARRAY_BUFFER), size, data, uint32(usage))
+}
+
+// ElementArrayBuffer is the high level representation of an OpenGL buffer bound to GL_ELEMENT_ARRAY_BUFFER. It remembers the type and the number of the indices it holds so draw calls don't have to repeat them, and only exposes the functions keeping them up to date.
+type ElementArrayBuffer struct {
+	buffer    Buffer
+	indexType IndexType
+	count     int
+}
+
+// NewElementArrayBuffer generates a buffer used as an element array.
+func NewElementArrayBuffer() *ElementArrayBuffer {
+	return &ElementArrayBuffer{buffer: GenBuffer()}
+}
+
+// Bind is an alias to glBindBuffer(GL_ELEMENT_ARRAY_BUFFER, b).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindBuffer.xml
+func (b *ElementArrayBuffer) Bind() {
+	b.buffer.Bind(ELEMENT_ARRAY_BUFFER)
+}
+
+// Unbind is an alias to glBindBuffer(GL_ELEMENT_ARRAY_BUFFER, 0).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindBuffer.xml
+func (b *ElementArrayBuffer) Unbind() {
+	b.buffer.Unbind(ELEMENT_ARRAY_BUFFER)
+}
+
+// Delete is an alias to glDeleteBuffers(1, &b). The buffer should not be used after calling this.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glDeleteBuffers.xml
+func (b *ElementArrayBuffer) Delete() {
+	b.buffer.Delete()
+	b.indexType, b.count = 0, 0
+}
+
+// IndexType returns the type of the indices of the last upload, 0 before the first one.
+func (b *ElementArrayBuffer) IndexType() IndexType {
+	return b.indexType
+}
+
+// Count returns the number of indices of the last upload.
+func (b *ElementArrayBuffer) Count() int {
+	return b.count
+}
+
+// Data is an alias to glBufferData(GL_ELEMENT_ARRAY_BUFFER, size, data, usage). data holds indices of type xtype.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBufferData.xml
+func (b *ElementArrayBuffer) Data(size int, data unsafe.Pointer, xtype IndexType, usage BufferUsage) {
+	if safetyflag {
+		safetyCheckBound("ElementArrayBuffer.Data", kindBuffer, uint32(ELEMENT_ARRAY_BUFFER), uint32(b.buffer))
+	}
+	backend.BufferData(uint32(ELEMENT_ARRAY_BUFFER), size, data, uint32(usage))
+	b.indexType = xtype
+	b.count = size / indexSize(xtype)
+}
+
+// DataUint8 uploads GL_UNSIGNED_BYTE indices.
+func (b *ElementArrayBuffer) DataUint8(indices []uint8, usage BufferUsage) {
+	b.Data(len(indices), unsafe.Pointer(unsafe.SliceData(indices)), UNSIGNED_BYTE, usage)
+}
+
+// DataUint16 uploads GL_UNSIGNED_SHORT indices.
+func (b *ElementArrayBuffer) DataUint16(indices []uint16, usage BufferUsage) {
+	b.Data(2*len(indices), unsafe.Pointer(unsafe.SliceData(indices)), UNSIGNED_SHORT, usage)
+}
+
+// DataUint32 uploads GL_UNSIGNED_INT indices.
+func (b *ElementArrayBuffer) DataUint32(indices []uint32, usage BufferUsage) {
+	b.Data(4*len(indices), unsafe.Pointer(unsafe.SliceData(indices)), UNSIGNED_INT, usage)
+}
+
+// indexSize returns the size in bytes of an index of type xtype.
+func indexSize(xtype IndexType) int {
+	switch xtype {
+	case UNSIGNED_BYTE:
+		return 1
+	case UNSIGNED_SHORT:
+		return 2
+	case UNSIGNED_INT:
+		return 4
+	}
+	panic("gl: invalid index type " + xtype.String())
+}
+
+// UniformBuffer is the high level representation of an OpenGL buffer bound to GL_UNIFORM_BUFFER. It restricts the available functions and automatically fills the target.
+type UniformBuffer Buffer
+
+// Data is an alias to glBufferData(GL_UNIFORM_BUFFER, size, data, usage).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBufferData.xml
+func (b UniformBuffer) Data(size int, data unsafe.Pointer, usage BufferUsage) {
+	if safetyflag {
+		safetyCheckBound("UniformBuffer.Data", kindBuffer, uint32(UNIFORM_BUFFER), uint32(b))
+	}
+	backend.BufferData(uint32(UNIFORM_BUFFER), size, data, uint32(usage))
+}
+
+// BindBase is an alias to glBindBufferBase(GL_UNIFORM_BUFFER, index, b). It binds the whole buffer to the uniform block binding point index.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindBufferBase.xml
+func (b UniformBuffer) BindBase(index uint32) {
+	backend.BindBufferBase(uint32(UNIFORM_BUFFER), index, uint32(b))
+	if safetyflag {
+		safetyBind(kindBuffer, uint32(UNIFORM_BUFFER), uint32(b))
+	}
+}
+
+// BindRange is an alias to glBindBufferRange(GL_UNIFORM_BUFFER, index, b, offset, size). It binds size bytes of the buffer starting at offset to the uniform block binding point index, offset must be a multiple of Get.UniformBufferOffsetAlignment().
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindBufferRange.xml
+func (b UniformBuffer) BindRange(index uint32, offset, size int) {
+	backend.BindBufferRange(uint32(UNIFORM_BUFFER), index, uint32(b), offset, size)
+	if safetyflag {
+		safetyBind(kindBuffer, uint32(UNIFORM_BUFFER), uint32(b))
+	}
+}
+
+// PixelPackBuffer is the high level representation of an OpenGL buffer bound to GL_PIXEL_PACK_BUFFER, the destination of pixel reads. It restricts the available functions and automatically fills the target.
+type PixelPackBuffer Buffer
+
+// Data is an alias to glBufferData(GL_PIXEL_PACK_BUFFER, size, data, usage).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBufferData.xml
+func (b PixelPackBuffer) Data(size int, data unsafe.Pointer, usage BufferUsage) {
+	if safetyflag {
+		safetyCheckBound("PixelPackBuffer.Data", kindBuffer, uint32(PIXEL_PACK_BUFFER), uint32(b))
+	}
+	backend.BufferData(uint32(PIXEL_PACK_BUFFER), size, data, uint32(usage))
+}
+
+// ReadPixels is an alias to glReadPixels with the pixels written to the buffer at offset instead of client memory.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glReadPixels.xml
+func (b PixelPackBuffer) ReadPixels(x, y, width, height int32, format PixelFormat, xtype PixelType, offset int) {
+	if safetyflag {
+		safetyCheckBound("PixelPackBuffer.ReadPixels", kindBuffer, uint32(PIXEL_PACK_BUFFER), uint32(b))
+	}
+	backend.ReadPixels(x, y, width, height, uint32(format), uint32(xtype), glPtrOffset(offset))
+}
+
+// PixelUnpackBuffer is the high level representation of an OpenGL buffer bound to GL_PIXEL_UNPACK_BUFFER, the source of texture uploads: the pixels pointer of TexImage2D is then an offset in the buffer. It restricts the available functions and automatically fills the target.
+type PixelUnpackBuffer Buffer
+
+// Data is an alias to glBufferData(GL_PIXEL_UNPACK_BUFFER, size, data, usage).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBufferData.xml
+func (b PixelUnpackBuffer) Data(size int, data unsafe.Pointer, usage BufferUsage) {
+	if safetyflag {
+		safetyCheckBound("PixelUnpackBuffer.Data", kindBuffer, uint32(PIXEL_UNPACK_BUFFER), uint32(b))
+	}
+	backend.BufferData(uint32(PIXEL_UNPACK_BUFFER), size, data, uint32(usage))
+}
+
+// CopyReadBuffer is the high level representation of an OpenGL buffer bound to GL_COPY_READ_BUFFER, the source of buffer to buffer copies. It restricts the available functions and automatically fills the target.
+type CopyReadBuffer Buffer
+
+// Data is an alias to glBufferData(GL_COPY_READ_BUFFER, size, data, usage).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBufferData.xml
+func (b CopyReadBuffer) Data(size int, data unsafe.Pointer, usage BufferUsage) {
+	if safetyflag {
+		safetyCheckBound("CopyReadBuffer.Data", kindBuffer, uint32(COPY_READ_BUFFER), uint32(b))
+	}
+	backend.BufferData(uint32(COPY_READ_BUFFER), size, data, uint32(usage))
+}
+
+// TransformFeedbackBuffer is the high level representation of an OpenGL buffer bound to GL_TRANSFORM_FEEDBACK_BUFFER, the destination of captured vertices. It restricts the available functions and automatically fills the target.
+type TransformFeedbackBuffer Buffer
+
+// Data is an alias to glBufferData(GL_TRANSFORM_FEEDBACK_BUFFER, size, data, usage).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBufferData.xml
+func (b TransformFeedbackBuffer) Data(size int, data unsafe.Pointer, usage BufferUsage) {
+	if safetyflag {
+		safetyCheckBound("TransformFeedbackBuffer.Data", kindBuffer, uint32(TRANSFORM_FEEDBACK_BUFFER), uint32(b))
+	}
+	backend.BufferData(uint32(TRANSFORM_FEEDBACK_BUFFER), size, data, uint32(usage))
+}
+
+// BindBase is an alias to glBindBufferBase(GL_TRANSFORM_FEEDBACK_BUFFER, index, b). It binds the whole buffer to the transform feedback binding point index.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindBufferBase.xml
+func (b TransformFeedbackBuffer) BindBase(index uint32) {
+	backend.BindBufferBase(uint32(TRANSFORM_FEEDBACK_BUFFER), index, uint32(b))
+	if safetyflag {
+		safetyBind(kindBuffer, uint32(TRANSFORM_FEEDBACK_BUFFER), uint32(b))
+	}
+}
+
+// BindRange is an alias to glBindBufferRange(GL_TRANSFORM_FEEDBACK_BUFFER, index, b, offset, size). It binds size bytes of the buffer starting at offset to the transform feedback binding point index.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindBufferRange.xml
+func (b TransformFeedbackBuffer) BindRange(index uint32, offset, size int) {
+	backend.BindBufferRange(uint32(TRANSFORM_FEEDBACK_BUFFER), index, uint32(b), offset, size)
+	if safetyflag {
+		safetyBind(kindBuffer, uint32(TRANSFORM_FEEDBACK_BUFFER), uint32(b))
+	}
+}
@@ -0,0 +1,18 @@
+//go:build !gles30
+
+package gl
+
+import "unsafe"
+
+// TextureBuffer is the high level representation of an OpenGL buffer bound to GL_TEXTURE_BUFFER, the storage of buffer textures. It restricts the available functions and automatically fills the target.
+type TextureBuffer Buffer
+
+// Data is an alias to glBufferData(GL_TEXTURE_BUFFER, size, data, usage).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBufferData.xml
+func (b TextureBuffer) Data(size int, data unsafe.Pointer, usage BufferUsage) {
+	if safetyflag {
+		safetyCheckBound("TextureBuffer.Data", kindBuffer, TEXTURE_BUFFER, uint32(b))
+	}
+	backend.BufferData(TEXTURE_BUFFER, size, data, uint32(usage))
+}
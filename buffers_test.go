@@ -0,0 +1,109 @@
+package gl
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestBufferSubtypesData(t *testing.T) {
+	tests := []struct {
+		name   string
+		target BufferTarget
+		data   func(b Buffer)
+	}{
+		{"ArrayBuffer", ARRAY_BUFFER, func(b Buffer) { ArrayBuffer(b).Data(4, nil, STREAM_DRAW) }},
+		{"UniformBuffer", UNIFORM_BUFFER, func(b Buffer) { UniformBuffer(b).Data(4, nil, STREAM_DRAW) }},
+		{"PixelPackBuffer", PIXEL_PACK_BUFFER, func(b Buffer) { PixelPackBuffer(b).Data(4, nil, STREAM_DRAW) }},
+		{"PixelUnpackBuffer", PIXEL_UNPACK_BUFFER, func(b Buffer) { PixelUnpackBuffer(b).Data(4, nil, STREAM_DRAW) }},
+		{"CopyReadBuffer", COPY_READ_BUFFER, func(b Buffer) { CopyReadBuffer(b).Data(4, nil, STREAM_DRAW) }},
+		{"TransformFeedbackBuffer", TRANSFORM_FEEDBACK_BUFFER, func(b Buffer) { TransformFeedbackBuffer(b).Data(4, nil, STREAM_DRAW) }},
+		{"ElementArrayBuffer", ELEMENT_ARRAY_BUFFER, func(b Buffer) {
+			(&ElementArrayBuffer{buffer: b}).Data(4, nil, UNSIGNED_SHORT, STREAM_DRAW)
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFake(t)
+			b := GenBuffer()
+			b.Bind(tt.target)
+			tt.data(b)
+			if size, usage := len(f.buffers[uint32(b)]), f.usages[uint32(b)]; size != 4 || usage != uint32(STREAM_DRAW) {
+				t.Errorf("buffer of %d bytes used as %#x, want 4 bytes used as STREAM_DRAW", size, usage)
+			}
+		})
+	}
+}
+
+func TestBufferSubtypesBindIndexed(t *testing.T) {
+	tests := []struct {
+		name string
+		bind func(b Buffer)
+		want Call
+	}{
+		{"UniformBuffer.BindBase", func(b Buffer) { UniformBuffer(b).BindBase(2) }, call("BindBufferBase", uint32(UNIFORM_BUFFER), uint32(2), uint32(1))},
+		{"UniformBuffer.BindRange", func(b Buffer) { UniformBuffer(b).BindRange(2, 256, 64) }, call("BindBufferRange", uint32(UNIFORM_BUFFER), uint32(2), uint32(1), 256, 64)},
+		{"TransformFeedbackBuffer.BindBase", func(b Buffer) { TransformFeedbackBuffer(b).BindBase(1) }, call("BindBufferBase", uint32(TRANSFORM_FEEDBACK_BUFFER), uint32(1), uint32(1))},
+		{"TransformFeedbackBuffer.BindRange", func(b Buffer) { TransformFeedbackBuffer(b).BindRange(1, 0, 16) }, call("BindBufferRange", uint32(TRANSFORM_FEEDBACK_BUFFER), uint32(1), uint32(1), 0, 16)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFake(t)
+			b := GenBuffer()
+			f.Reset()
+			tt.bind(b)
+			checkCalls(t, f, tt.want)
+		})
+	}
+}
+
+func TestElementArrayBuffer(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      func(b *ElementArrayBuffer)
+		wantType  IndexType
+		wantCount int
+		wantBytes []byte
+	}{
+		{"uint8", func(b *ElementArrayBuffer) { b.DataUint8([]uint8{0, 1, 2}, STATIC_DRAW) }, UNSIGNED_BYTE, 3, []byte{0, 1, 2}},
+		{"uint16", func(b *ElementArrayBuffer) { b.DataUint16([]uint16{1, 2}, STATIC_DRAW) }, UNSIGNED_SHORT, 2, []byte{1, 0, 2, 0}},
+		{"uint32", func(b *ElementArrayBuffer) { b.DataUint32([]uint32{1, 2}, STATIC_DRAW) }, UNSIGNED_INT, 2, []byte{1, 0, 0, 0, 2, 0, 0, 0}},
+		{"empty", func(b *ElementArrayBuffer) { b.DataUint16(nil, STATIC_DRAW) }, UNSIGNED_SHORT, 0, []byte{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFake(t)
+			b := NewElementArrayBuffer()
+			if b.IndexType() != 0 || b.Count() != 0 {
+				t.Errorf("new buffer has %d indices of type %v, want none", b.Count(), b.IndexType())
+			}
+			b.Bind()
+			tt.data(b)
+			if b.IndexType() != tt.wantType || b.Count() != tt.wantCount {
+				t.Errorf("buffer has %d indices of type %v, want %d of type %v", b.Count(), b.IndexType(), tt.wantCount, tt.wantType)
+			}
+			if got := f.buffers[uint32(b.buffer)]; !bytes.Equal(got, tt.wantBytes) {
+				t.Errorf("buffer holds %v, want %v", got, tt.wantBytes)
+			}
+		})
+	}
+}
+
+func TestElementArrayBufferDelete(t *testing.T) {
+	f := newFake(t)
+	b := NewElementArrayBuffer()
+	b.Bind()
+	b.DataUint16([]uint16{1, 2, 3}, STATIC_DRAW)
+	b.Delete()
+	if b.IndexType() != 0 || b.Count() != 0 {
+		t.Errorf("deleted buffer has %d indices of type %v, want none", b.Count(), b.IndexType())
+	}
+	if got := f.LiveObjects(); got != 0 {
+		t.Errorf("LiveObjects() = %d after Delete, want 0", got)
+	}
+}
+
+func TestIndexSizeInvalid(t *testing.T) {
+	if catchPanic(func() { indexSize(IndexType(FLOAT)) }) == nil {
+		t.Error("indexSize of GL_FLOAT didn't panic")
+	}
+}
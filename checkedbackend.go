@@ -0,0 +1,908 @@
+package gl
+
+import "unsafe"
+
+// checkedBackend calls before ahead of every call to Backend and after once it
+// returned, with the name of the OpenGL entry point and its arguments. Init
+// and GetError aren't checked, the checks themselves call GetError.
+type checkedBackend struct {
+	Backend
+	before func(call string)
+	after  func(call string, args ...interface{})
+}
+
+func (c *checkedBackend) next() *Backend {
+	return &c.Backend
+}
+
+func noCheckBefore(string) {}
+
+func noCheckAfter(string, ...interface{}) {}
+
+func (c *checkedBackend) ActiveTexture(texture uint32) {
+	c.before("glActiveTexture")
+	c.Backend.ActiveTexture(texture)
+	c.after("glActiveTexture", texture)
+}
+
+func (c *checkedBackend) AttachShader(program, shader uint32) {
+	c.before("glAttachShader")
+	c.Backend.AttachShader(program, shader)
+	c.after("glAttachShader", program, shader)
+}
+
+func (c *checkedBackend) BeginTransformFeedback(primitiveMode uint32) {
+	c.before("glBeginTransformFeedback")
+	c.Backend.BeginTransformFeedback(primitiveMode)
+	c.after("glBeginTransformFeedback", primitiveMode)
+}
+
+func (c *checkedBackend) BindBuffer(target, buffer uint32) {
+	c.before("glBindBuffer")
+	c.Backend.BindBuffer(target, buffer)
+	c.after("glBindBuffer", target, buffer)
+}
+
+func (c *checkedBackend) BindBufferBase(target, index, buffer uint32) {
+	c.before("glBindBufferBase")
+	c.Backend.BindBufferBase(target, index, buffer)
+	c.after("glBindBufferBase", target, index, buffer)
+}
+
+func (c *checkedBackend) BindBufferRange(target, index, buffer uint32, offset, size int) {
+	c.before("glBindBufferRange")
+	c.Backend.BindBufferRange(target, index, buffer, offset, size)
+	c.after("glBindBufferRange", target, index, buffer, offset, size)
+}
+
+func (c *checkedBackend) BindFramebuffer(target, framebuffer uint32) {
+	c.before("glBindFramebuffer")
+	c.Backend.BindFramebuffer(target, framebuffer)
+	c.after("glBindFramebuffer", target, framebuffer)
+}
+
+func (c *checkedBackend) BindRenderbuffer(target, renderbuffer uint32) {
+	c.before("glBindRenderbuffer")
+	c.Backend.BindRenderbuffer(target, renderbuffer)
+	c.after("glBindRenderbuffer", target, renderbuffer)
+}
+
+func (c *checkedBackend) BindTexture(target, texture uint32) {
+	c.before("glBindTexture")
+	c.Backend.BindTexture(target, texture)
+	c.after("glBindTexture", target, texture)
+}
+
+func (c *checkedBackend) BindTransformFeedback(target, id uint32) {
+	c.before("glBindTransformFeedback")
+	c.Backend.BindTransformFeedback(target, id)
+	c.after("glBindTransformFeedback", target, id)
+}
+
+func (c *checkedBackend) BindVertexArray(array uint32) {
+	c.before("glBindVertexArray")
+	c.Backend.BindVertexArray(array)
+	c.after("glBindVertexArray", array)
+}
+
+func (c *checkedBackend) BlendColor(red, green, blue, alpha float32) {
+	c.before("glBlendColor")
+	c.Backend.BlendColor(red, green, blue, alpha)
+	c.after("glBlendColor", red, green, blue, alpha)
+}
+
+func (c *checkedBackend) BlendEquationSeparate(modeRGB, modeAlpha uint32) {
+	c.before("glBlendEquationSeparate")
+	c.Backend.BlendEquationSeparate(modeRGB, modeAlpha)
+	c.after("glBlendEquationSeparate", modeRGB, modeAlpha)
+}
+
+func (c *checkedBackend) BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha uint32) {
+	c.before("glBlendFuncSeparate")
+	c.Backend.BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha)
+	c.after("glBlendFuncSeparate", srcRGB, dstRGB, srcAlpha, dstAlpha)
+}
+
+func (c *checkedBackend) BufferData(target uint32, size int, data unsafe.Pointer, usage uint32) {
+	c.before("glBufferData")
+	c.Backend.BufferData(target, size, data, usage)
+	c.after("glBufferData", target, size, data, usage)
+}
+
+func (c *checkedBackend) BufferSubData(target uint32, offset, size int, data unsafe.Pointer) {
+	c.before("glBufferSubData")
+	c.Backend.BufferSubData(target, offset, size, data)
+	c.after("glBufferSubData", target, offset, size, data)
+}
+
+func (c *checkedBackend) CheckFramebufferStatus(target uint32) uint32 {
+	c.before("glCheckFramebufferStatus")
+	r := c.Backend.CheckFramebufferStatus(target)
+	c.after("glCheckFramebufferStatus", target)
+	return r
+}
+
+func (c *checkedBackend) ClearColor(red, green, blue, alpha float32) {
+	c.before("glClearColor")
+	c.Backend.ClearColor(red, green, blue, alpha)
+	c.after("glClearColor", red, green, blue, alpha)
+}
+
+func (c *checkedBackend) ClearDepthf(d float32) {
+	c.before("glClearDepthf")
+	c.Backend.ClearDepthf(d)
+	c.after("glClearDepthf", d)
+}
+
+func (c *checkedBackend) ClearStencil(s int32) {
+	c.before("glClearStencil")
+	c.Backend.ClearStencil(s)
+	c.after("glClearStencil", s)
+}
+
+func (c *checkedBackend) ClientWaitSync(sync uintptr, flags uint32, timeout uint64) uint32 {
+	c.before("glClientWaitSync")
+	r := c.Backend.ClientWaitSync(sync, flags, timeout)
+	c.after("glClientWaitSync", sync, flags, timeout)
+	return r
+}
+
+func (c *checkedBackend) ColorMask(red, green, blue, alpha bool) {
+	c.before("glColorMask")
+	c.Backend.ColorMask(red, green, blue, alpha)
+	c.after("glColorMask", red, green, blue, alpha)
+}
+
+func (c *checkedBackend) CompileShader(shader uint32) {
+	c.before("glCompileShader")
+	c.Backend.CompileShader(shader)
+	c.after("glCompileShader", shader)
+}
+
+func (c *checkedBackend) CopyBufferSubData(readTarget, writeTarget uint32, readOffset, writeOffset, size int) {
+	c.before("glCopyBufferSubData")
+	c.Backend.CopyBufferSubData(readTarget, writeTarget, readOffset, writeOffset, size)
+	c.after("glCopyBufferSubData", readTarget, writeTarget, readOffset, writeOffset, size)
+}
+
+func (c *checkedBackend) CopyTexImage2D(target uint32, level int32, internalformat uint32, x, y, width, height, border int32) {
+	c.before("glCopyTexImage2D")
+	c.Backend.CopyTexImage2D(target, level, internalformat, x, y, width, height, border)
+	c.after("glCopyTexImage2D", target, level, internalformat, x, y, width, height, border)
+}
+
+func (c *checkedBackend) CreateProgram() uint32 {
+	c.before("glCreateProgram")
+	r := c.Backend.CreateProgram()
+	c.after("glCreateProgram")
+	return r
+}
+
+func (c *checkedBackend) CreateShader(xtype uint32) uint32 {
+	c.before("glCreateShader")
+	r := c.Backend.CreateShader(xtype)
+	c.after("glCreateShader", xtype)
+	return r
+}
+
+func (c *checkedBackend) CullFace(mode uint32) {
+	c.before("glCullFace")
+	c.Backend.CullFace(mode)
+	c.after("glCullFace", mode)
+}
+
+func (c *checkedBackend) DebugMessageCallback(callback debugProc, userParam unsafe.Pointer) {
+	c.before("glDebugMessageCallback")
+	c.Backend.DebugMessageCallback(callback, userParam)
+	c.after("glDebugMessageCallback", callback, userParam)
+}
+
+func (c *checkedBackend) DebugMessageControl(source, xtype, severity uint32, count int32, ids *uint32, enabled bool) {
+	c.before("glDebugMessageControl")
+	c.Backend.DebugMessageControl(source, xtype, severity, count, ids, enabled)
+	c.after("glDebugMessageControl", source, xtype, severity, count, ids, enabled)
+}
+
+func (c *checkedBackend) DebugMessageInsert(source, xtype, id, severity uint32, length int32, buf *uint8) {
+	c.before("glDebugMessageInsert")
+	c.Backend.DebugMessageInsert(source, xtype, id, severity, length, buf)
+	c.after("glDebugMessageInsert", source, xtype, id, severity, length, buf)
+}
+
+func (c *checkedBackend) DeleteBuffers(n int32, buffers *uint32) {
+	c.before("glDeleteBuffers")
+	c.Backend.DeleteBuffers(n, buffers)
+	c.after("glDeleteBuffers", n, buffers)
+}
+
+func (c *checkedBackend) DeleteFramebuffers(n int32, framebuffers *uint32) {
+	c.before("glDeleteFramebuffers")
+	c.Backend.DeleteFramebuffers(n, framebuffers)
+	c.after("glDeleteFramebuffers", n, framebuffers)
+}
+
+func (c *checkedBackend) DeleteProgram(program uint32) {
+	c.before("glDeleteProgram")
+	c.Backend.DeleteProgram(program)
+	c.after("glDeleteProgram", program)
+}
+
+func (c *checkedBackend) DeleteRenderbuffers(n int32, renderbuffers *uint32) {
+	c.before("glDeleteRenderbuffers")
+	c.Backend.DeleteRenderbuffers(n, renderbuffers)
+	c.after("glDeleteRenderbuffers", n, renderbuffers)
+}
+
+func (c *checkedBackend) DeleteShader(shader uint32) {
+	c.before("glDeleteShader")
+	c.Backend.DeleteShader(shader)
+	c.after("glDeleteShader", shader)
+}
+
+func (c *checkedBackend) DeleteSync(sync uintptr) {
+	c.before("glDeleteSync")
+	c.Backend.DeleteSync(sync)
+	c.after("glDeleteSync", sync)
+}
+
+func (c *checkedBackend) DeleteTextures(n int32, textures *uint32) {
+	c.before("glDeleteTextures")
+	c.Backend.DeleteTextures(n, textures)
+	c.after("glDeleteTextures", n, textures)
+}
+
+func (c *checkedBackend) DeleteTransformFeedbacks(n int32, ids *uint32) {
+	c.before("glDeleteTransformFeedbacks")
+	c.Backend.DeleteTransformFeedbacks(n, ids)
+	c.after("glDeleteTransformFeedbacks", n, ids)
+}
+
+func (c *checkedBackend) DeleteVertexArrays(n int32, arrays *uint32) {
+	c.before("glDeleteVertexArrays")
+	c.Backend.DeleteVertexArrays(n, arrays)
+	c.after("glDeleteVertexArrays", n, arrays)
+}
+
+func (c *checkedBackend) DepthFunc(xfunc uint32) {
+	c.before("glDepthFunc")
+	c.Backend.DepthFunc(xfunc)
+	c.after("glDepthFunc", xfunc)
+}
+
+func (c *checkedBackend) DepthMask(flag bool) {
+	c.before("glDepthMask")
+	c.Backend.DepthMask(flag)
+	c.after("glDepthMask", flag)
+}
+
+func (c *checkedBackend) DepthRangef(near, far float32) {
+	c.before("glDepthRangef")
+	c.Backend.DepthRangef(near, far)
+	c.after("glDepthRangef", near, far)
+}
+
+func (c *checkedBackend) Disable(cap uint32) {
+	c.before("glDisable")
+	c.Backend.Disable(cap)
+	c.after("glDisable", cap)
+}
+
+func (c *checkedBackend) DisableVertexAttribArray(index uint32) {
+	c.before("glDisableVertexAttribArray")
+	c.Backend.DisableVertexAttribArray(index)
+	c.after("glDisableVertexAttribArray", index)
+}
+
+func (c *checkedBackend) DrawBuffers(n int32, bufs *uint32) {
+	c.before("glDrawBuffers")
+	c.Backend.DrawBuffers(n, bufs)
+	c.after("glDrawBuffers", n, bufs)
+}
+
+func (c *checkedBackend) Enable(cap uint32) {
+	c.before("glEnable")
+	c.Backend.Enable(cap)
+	c.after("glEnable", cap)
+}
+
+func (c *checkedBackend) EnableVertexAttribArray(index uint32) {
+	c.before("glEnableVertexAttribArray")
+	c.Backend.EnableVertexAttribArray(index)
+	c.after("glEnableVertexAttribArray", index)
+}
+
+func (c *checkedBackend) EndTransformFeedback() {
+	c.before("glEndTransformFeedback")
+	c.Backend.EndTransformFeedback()
+	c.after("glEndTransformFeedback")
+}
+
+func (c *checkedBackend) FenceSync(condition, flags uint32) uintptr {
+	c.before("glFenceSync")
+	r := c.Backend.FenceSync(condition, flags)
+	c.after("glFenceSync", condition, flags)
+	return r
+}
+
+func (c *checkedBackend) FlushMappedBufferRange(target uint32, offset, length int) {
+	c.before("glFlushMappedBufferRange")
+	c.Backend.FlushMappedBufferRange(target, offset, length)
+	c.after("glFlushMappedBufferRange", target, offset, length)
+}
+
+func (c *checkedBackend) FramebufferRenderbuffer(target, attachment, renderbuffertarget, renderbuffer uint32) {
+	c.before("glFramebufferRenderbuffer")
+	c.Backend.FramebufferRenderbuffer(target, attachment, renderbuffertarget, renderbuffer)
+	c.after("glFramebufferRenderbuffer", target, attachment, renderbuffertarget, renderbuffer)
+}
+
+func (c *checkedBackend) FrontFace(mode uint32) {
+	c.before("glFrontFace")
+	c.Backend.FrontFace(mode)
+	c.after("glFrontFace", mode)
+}
+
+func (c *checkedBackend) GenBuffers(n int32, buffers *uint32) {
+	c.before("glGenBuffers")
+	c.Backend.GenBuffers(n, buffers)
+	c.after("glGenBuffers", n, buffers)
+}
+
+func (c *checkedBackend) GenFramebuffers(n int32, framebuffers *uint32) {
+	c.before("glGenFramebuffers")
+	c.Backend.GenFramebuffers(n, framebuffers)
+	c.after("glGenFramebuffers", n, framebuffers)
+}
+
+func (c *checkedBackend) GenRenderbuffers(n int32, renderbuffers *uint32) {
+	c.before("glGenRenderbuffers")
+	c.Backend.GenRenderbuffers(n, renderbuffers)
+	c.after("glGenRenderbuffers", n, renderbuffers)
+}
+
+func (c *checkedBackend) GenTextures(n int32, textures *uint32) {
+	c.before("glGenTextures")
+	c.Backend.GenTextures(n, textures)
+	c.after("glGenTextures", n, textures)
+}
+
+func (c *checkedBackend) GenTransformFeedbacks(n int32, ids *uint32) {
+	c.before("glGenTransformFeedbacks")
+	c.Backend.GenTransformFeedbacks(n, ids)
+	c.after("glGenTransformFeedbacks", n, ids)
+}
+
+func (c *checkedBackend) GenVertexArrays(n int32, arrays *uint32) {
+	c.before("glGenVertexArrays")
+	c.Backend.GenVertexArrays(n, arrays)
+	c.after("glGenVertexArrays", n, arrays)
+}
+
+func (c *checkedBackend) GetActiveAttrib(program, index uint32, bufSize int32, length, size *int32, xtype *uint32, name *uint8) {
+	c.before("glGetActiveAttrib")
+	c.Backend.GetActiveAttrib(program, index, bufSize, length, size, xtype, name)
+	c.after("glGetActiveAttrib", program, index, bufSize, length, size, xtype, name)
+}
+
+func (c *checkedBackend) GetAttachedShaders(program uint32, maxCount int32, count *int32, shaders *uint32) {
+	c.before("glGetAttachedShaders")
+	c.Backend.GetAttachedShaders(program, maxCount, count, shaders)
+	c.after("glGetAttachedShaders", program, maxCount, count, shaders)
+}
+
+func (c *checkedBackend) GetAttribLocation(program uint32, name *uint8) int32 {
+	c.before("glGetAttribLocation")
+	r := c.Backend.GetAttribLocation(program, name)
+	c.after("glGetAttribLocation", program, name)
+	return r
+}
+
+func (c *checkedBackend) GetBooleanv(pname uint32, data *bool) {
+	c.before("glGetBooleanv")
+	c.Backend.GetBooleanv(pname, data)
+	c.after("glGetBooleanv", pname, data)
+}
+
+func (c *checkedBackend) GetBufferParameteri64v(target, pname uint32, params *int64) {
+	c.before("glGetBufferParameteri64v")
+	c.Backend.GetBufferParameteri64v(target, pname, params)
+	c.after("glGetBufferParameteri64v", target, pname, params)
+}
+
+func (c *checkedBackend) GetBufferParameteriv(target, pname uint32, params *int32) {
+	c.before("glGetBufferParameteriv")
+	c.Backend.GetBufferParameteriv(target, pname, params)
+	c.after("glGetBufferParameteriv", target, pname, params)
+}
+
+func (c *checkedBackend) GetFloatv(pname uint32, data *float32) {
+	c.before("glGetFloatv")
+	c.Backend.GetFloatv(pname, data)
+	c.after("glGetFloatv", pname, data)
+}
+
+func (c *checkedBackend) GetInteger64i_v(target, index uint32, data *int64) {
+	c.before("glGetInteger64i_v")
+	c.Backend.GetInteger64i_v(target, index, data)
+	c.after("glGetInteger64i_v", target, index, data)
+}
+
+func (c *checkedBackend) GetInteger64v(pname uint32, data *int64) {
+	c.before("glGetInteger64v")
+	c.Backend.GetInteger64v(pname, data)
+	c.after("glGetInteger64v", pname, data)
+}
+
+func (c *checkedBackend) GetIntegeri_v(target, index uint32, data *int32) {
+	c.before("glGetIntegeri_v")
+	c.Backend.GetIntegeri_v(target, index, data)
+	c.after("glGetIntegeri_v", target, index, data)
+}
+
+func (c *checkedBackend) GetIntegerv(pname uint32, data *int32) {
+	c.before("glGetIntegerv")
+	c.Backend.GetIntegerv(pname, data)
+	c.after("glGetIntegerv", pname, data)
+}
+
+func (c *checkedBackend) GetProgramInfoLog(program uint32, bufSize int32, length *int32, infoLog *uint8) {
+	c.before("glGetProgramInfoLog")
+	c.Backend.GetProgramInfoLog(program, bufSize, length, infoLog)
+	c.after("glGetProgramInfoLog", program, bufSize, length, infoLog)
+}
+
+func (c *checkedBackend) GetProgramiv(program, pname uint32, params *int32) {
+	c.before("glGetProgramiv")
+	c.Backend.GetProgramiv(program, pname, params)
+	c.after("glGetProgramiv", program, pname, params)
+}
+
+func (c *checkedBackend) GetShaderInfoLog(shader uint32, bufSize int32, length *int32, infoLog *uint8) {
+	c.before("glGetShaderInfoLog")
+	c.Backend.GetShaderInfoLog(shader, bufSize, length, infoLog)
+	c.after("glGetShaderInfoLog", shader, bufSize, length, infoLog)
+}
+
+func (c *checkedBackend) GetShaderSource(shader uint32, bufSize int32, length *int32, source *uint8) {
+	c.before("glGetShaderSource")
+	c.Backend.GetShaderSource(shader, bufSize, length, source)
+	c.after("glGetShaderSource", shader, bufSize, length, source)
+}
+
+func (c *checkedBackend) GetShaderiv(shader, pname uint32, params *int32) {
+	c.before("glGetShaderiv")
+	c.Backend.GetShaderiv(shader, pname, params)
+	c.after("glGetShaderiv", shader, pname, params)
+}
+
+func (c *checkedBackend) GetString(name uint32) *uint8 {
+	c.before("glGetString")
+	r := c.Backend.GetString(name)
+	c.after("glGetString", name)
+	return r
+}
+
+func (c *checkedBackend) GetStringi(name, index uint32) *uint8 {
+	c.before("glGetStringi")
+	r := c.Backend.GetStringi(name, index)
+	c.after("glGetStringi", name, index)
+	return r
+}
+
+func (c *checkedBackend) GetTexParameterfv(target, pname uint32, params *float32) {
+	c.before("glGetTexParameterfv")
+	c.Backend.GetTexParameterfv(target, pname, params)
+	c.after("glGetTexParameterfv", target, pname, params)
+}
+
+func (c *checkedBackend) GetTexParameteriv(target, pname uint32, params *int32) {
+	c.before("glGetTexParameteriv")
+	c.Backend.GetTexParameteriv(target, pname, params)
+	c.after("glGetTexParameteriv", target, pname, params)
+}
+
+func (c *checkedBackend) GetUniformLocation(program uint32, name *uint8) int32 {
+	c.before("glGetUniformLocation")
+	r := c.Backend.GetUniformLocation(program, name)
+	c.after("glGetUniformLocation", program, name)
+	return r
+}
+
+func (c *checkedBackend) GetVertexAttribiv(index, pname uint32, params *int32) {
+	c.before("glGetVertexAttribiv")
+	c.Backend.GetVertexAttribiv(index, pname, params)
+	c.after("glGetVertexAttribiv", index, pname, params)
+}
+
+func (c *checkedBackend) IsTexture(texture uint32) bool {
+	c.before("glIsTexture")
+	r := c.Backend.IsTexture(texture)
+	c.after("glIsTexture", texture)
+	return r
+}
+
+func (c *checkedBackend) LineWidth(width float32) {
+	c.before("glLineWidth")
+	c.Backend.LineWidth(width)
+	c.after("glLineWidth", width)
+}
+
+func (c *checkedBackend) LinkProgram(program uint32) {
+	c.before("glLinkProgram")
+	c.Backend.LinkProgram(program)
+	c.after("glLinkProgram", program)
+}
+
+func (c *checkedBackend) MapBufferRange(target uint32, offset, length int, access uint32) unsafe.Pointer {
+	c.before("glMapBufferRange")
+	r := c.Backend.MapBufferRange(target, offset, length, access)
+	c.after("glMapBufferRange", target, offset, length, access)
+	return r
+}
+
+func (c *checkedBackend) PauseTransformFeedback() {
+	c.before("glPauseTransformFeedback")
+	c.Backend.PauseTransformFeedback()
+	c.after("glPauseTransformFeedback")
+}
+
+func (c *checkedBackend) PixelStorei(pname uint32, param int32) {
+	c.before("glPixelStorei")
+	c.Backend.PixelStorei(pname, param)
+	c.after("glPixelStorei", pname, param)
+}
+
+func (c *checkedBackend) PolygonOffset(factor, units float32) {
+	c.before("glPolygonOffset")
+	c.Backend.PolygonOffset(factor, units)
+	c.after("glPolygonOffset", factor, units)
+}
+
+func (c *checkedBackend) ReadBuffer(src uint32) {
+	c.before("glReadBuffer")
+	c.Backend.ReadBuffer(src)
+	c.after("glReadBuffer", src)
+}
+
+func (c *checkedBackend) ReadPixels(x, y, width, height int32, format, xtype uint32, pixels unsafe.Pointer) {
+	c.before("glReadPixels")
+	c.Backend.ReadPixels(x, y, width, height, format, xtype, pixels)
+	c.after("glReadPixels", x, y, width, height, format, xtype, pixels)
+}
+
+func (c *checkedBackend) RenderbufferStorage(target, internalformat uint32, width, height int32) {
+	c.before("glRenderbufferStorage")
+	c.Backend.RenderbufferStorage(target, internalformat, width, height)
+	c.after("glRenderbufferStorage", target, internalformat, width, height)
+}
+
+func (c *checkedBackend) ResumeTransformFeedback() {
+	c.before("glResumeTransformFeedback")
+	c.Backend.ResumeTransformFeedback()
+	c.after("glResumeTransformFeedback")
+}
+
+func (c *checkedBackend) SampleCoverage(value float32, invert bool) {
+	c.before("glSampleCoverage")
+	c.Backend.SampleCoverage(value, invert)
+	c.after("glSampleCoverage", value, invert)
+}
+
+func (c *checkedBackend) Scissor(x, y, width, height int32) {
+	c.before("glScissor")
+	c.Backend.Scissor(x, y, width, height)
+	c.after("glScissor", x, y, width, height)
+}
+
+func (c *checkedBackend) ShaderSource(shader uint32, count int32, xstring **uint8, length *int32) {
+	c.before("glShaderSource")
+	c.Backend.ShaderSource(shader, count, xstring, length)
+	c.after("glShaderSource", shader, count, xstring, length)
+}
+
+func (c *checkedBackend) StencilFunc(xfunc uint32, ref int32, mask uint32) {
+	c.before("glStencilFunc")
+	c.Backend.StencilFunc(xfunc, ref, mask)
+	c.after("glStencilFunc", xfunc, ref, mask)
+}
+
+func (c *checkedBackend) StencilFuncSeparate(face, xfunc uint32, ref int32, mask uint32) {
+	c.before("glStencilFuncSeparate")
+	c.Backend.StencilFuncSeparate(face, xfunc, ref, mask)
+	c.after("glStencilFuncSeparate", face, xfunc, ref, mask)
+}
+
+func (c *checkedBackend) StencilMask(mask uint32) {
+	c.before("glStencilMask")
+	c.Backend.StencilMask(mask)
+	c.after("glStencilMask", mask)
+}
+
+func (c *checkedBackend) StencilMaskSeparate(face, mask uint32) {
+	c.before("glStencilMaskSeparate")
+	c.Backend.StencilMaskSeparate(face, mask)
+	c.after("glStencilMaskSeparate", face, mask)
+}
+
+func (c *checkedBackend) StencilOp(fail, zfail, zpass uint32) {
+	c.before("glStencilOp")
+	c.Backend.StencilOp(fail, zfail, zpass)
+	c.after("glStencilOp", fail, zfail, zpass)
+}
+
+func (c *checkedBackend) StencilOpSeparate(face, sfail, dpfail, dppass uint32) {
+	c.before("glStencilOpSeparate")
+	c.Backend.StencilOpSeparate(face, sfail, dpfail, dppass)
+	c.after("glStencilOpSeparate", face, sfail, dpfail, dppass)
+}
+
+func (c *checkedBackend) TexImage2D(target uint32, level, internalformat, width, height, border int32, format, xtype uint32, pixels unsafe.Pointer) {
+	c.before("glTexImage2D")
+	c.Backend.TexImage2D(target, level, internalformat, width, height, border, format, xtype, pixels)
+	c.after("glTexImage2D", target, level, internalformat, width, height, border, format, xtype, pixels)
+}
+
+func (c *checkedBackend) TexImage3D(target uint32, level, internalformat, width, height, depth, border int32, format, xtype uint32, pixels unsafe.Pointer) {
+	c.before("glTexImage3D")
+	c.Backend.TexImage3D(target, level, internalformat, width, height, depth, border, format, xtype, pixels)
+	c.after("glTexImage3D", target, level, internalformat, width, height, depth, border, format, xtype, pixels)
+}
+
+func (c *checkedBackend) TexParameterf(target, pname uint32, param float32) {
+	c.before("glTexParameterf")
+	c.Backend.TexParameterf(target, pname, param)
+	c.after("glTexParameterf", target, pname, param)
+}
+
+func (c *checkedBackend) TexParameterfv(target, pname uint32, params *float32) {
+	c.before("glTexParameterfv")
+	c.Backend.TexParameterfv(target, pname, params)
+	c.after("glTexParameterfv", target, pname, params)
+}
+
+func (c *checkedBackend) TexParameteri(target, pname uint32, param int32) {
+	c.before("glTexParameteri")
+	c.Backend.TexParameteri(target, pname, param)
+	c.after("glTexParameteri", target, pname, param)
+}
+
+func (c *checkedBackend) TexParameteriv(target, pname uint32, params *int32) {
+	c.before("glTexParameteriv")
+	c.Backend.TexParameteriv(target, pname, params)
+	c.after("glTexParameteriv", target, pname, params)
+}
+
+func (c *checkedBackend) Uniform1f(location int32, v0 float32) {
+	c.before("glUniform1f")
+	c.Backend.Uniform1f(location, v0)
+	c.after("glUniform1f", location, v0)
+}
+
+func (c *checkedBackend) Uniform1fv(location, count int32, value *float32) {
+	c.before("glUniform1fv")
+	c.Backend.Uniform1fv(location, count, value)
+	c.after("glUniform1fv", location, count, value)
+}
+
+func (c *checkedBackend) Uniform1i(location, v0 int32) {
+	c.before("glUniform1i")
+	c.Backend.Uniform1i(location, v0)
+	c.after("glUniform1i", location, v0)
+}
+
+func (c *checkedBackend) Uniform1iv(location, count int32, value *int32) {
+	c.before("glUniform1iv")
+	c.Backend.Uniform1iv(location, count, value)
+	c.after("glUniform1iv", location, count, value)
+}
+
+func (c *checkedBackend) Uniform1ui(location int32, v0 uint32) {
+	c.before("glUniform1ui")
+	c.Backend.Uniform1ui(location, v0)
+	c.after("glUniform1ui", location, v0)
+}
+
+func (c *checkedBackend) Uniform1uiv(location, count int32, value *uint32) {
+	c.before("glUniform1uiv")
+	c.Backend.Uniform1uiv(location, count, value)
+	c.after("glUniform1uiv", location, count, value)
+}
+
+func (c *checkedBackend) Uniform2f(location int32, v0, v1 float32) {
+	c.before("glUniform2f")
+	c.Backend.Uniform2f(location, v0, v1)
+	c.after("glUniform2f", location, v0, v1)
+}
+
+func (c *checkedBackend) Uniform2fv(location, count int32, value *float32) {
+	c.before("glUniform2fv")
+	c.Backend.Uniform2fv(location, count, value)
+	c.after("glUniform2fv", location, count, value)
+}
+
+func (c *checkedBackend) Uniform2i(location, v0, v1 int32) {
+	c.before("glUniform2i")
+	c.Backend.Uniform2i(location, v0, v1)
+	c.after("glUniform2i", location, v0, v1)
+}
+
+func (c *checkedBackend) Uniform2iv(location, count int32, value *int32) {
+	c.before("glUniform2iv")
+	c.Backend.Uniform2iv(location, count, value)
+	c.after("glUniform2iv", location, count, value)
+}
+
+func (c *checkedBackend) Uniform2ui(location int32, v0, v1 uint32) {
+	c.before("glUniform2ui")
+	c.Backend.Uniform2ui(location, v0, v1)
+	c.after("glUniform2ui", location, v0, v1)
+}
+
+func (c *checkedBackend) Uniform2uiv(location, count int32, value *uint32) {
+	c.before("glUniform2uiv")
+	c.Backend.Uniform2uiv(location, count, value)
+	c.after("glUniform2uiv", location, count, value)
+}
+
+func (c *checkedBackend) Uniform3f(location int32, v0, v1, v2 float32) {
+	c.before("glUniform3f")
+	c.Backend.Uniform3f(location, v0, v1, v2)
+	c.after("glUniform3f", location, v0, v1, v2)
+}
+
+func (c *checkedBackend) Uniform3fv(location, count int32, value *float32) {
+	c.before("glUniform3fv")
+	c.Backend.Uniform3fv(location, count, value)
+	c.after("glUniform3fv", location, count, value)
+}
+
+func (c *checkedBackend) Uniform3i(location, v0, v1, v2 int32) {
+	c.before("glUniform3i")
+	c.Backend.Uniform3i(location, v0, v1, v2)
+	c.after("glUniform3i", location, v0, v1, v2)
+}
+
+func (c *checkedBackend) Uniform3iv(location, count int32, value *int32) {
+	c.before("glUniform3iv")
+	c.Backend.Uniform3iv(location, count, value)
+	c.after("glUniform3iv", location, count, value)
+}
+
+func (c *checkedBackend) Uniform3ui(location int32, v0, v1, v2 uint32) {
+	c.before("glUniform3ui")
+	c.Backend.Uniform3ui(location, v0, v1, v2)
+	c.after("glUniform3ui", location, v0, v1, v2)
+}
+
+func (c *checkedBackend) Uniform3uiv(location, count int32, value *uint32) {
+	c.before("glUniform3uiv")
+	c.Backend.Uniform3uiv(location, count, value)
+	c.after("glUniform3uiv", location, count, value)
+}
+
+func (c *checkedBackend) Uniform4f(location int32, v0, v1, v2, v3 float32) {
+	c.before("glUniform4f")
+	c.Backend.Uniform4f(location, v0, v1, v2, v3)
+	c.after("glUniform4f", location, v0, v1, v2, v3)
+}
+
+func (c *checkedBackend) Uniform4fv(location, count int32, value *float32) {
+	c.before("glUniform4fv")
+	c.Backend.Uniform4fv(location, count, value)
+	c.after("glUniform4fv", location, count, value)
+}
+
+func (c *checkedBackend) Uniform4i(location, v0, v1, v2, v3 int32) {
+	c.before("glUniform4i")
+	c.Backend.Uniform4i(location, v0, v1, v2, v3)
+	c.after("glUniform4i", location, v0, v1, v2, v3)
+}
+
+func (c *checkedBackend) Uniform4iv(location, count int32, value *int32) {
+	c.before("glUniform4iv")
+	c.Backend.Uniform4iv(location, count, value)
+	c.after("glUniform4iv", location, count, value)
+}
+
+func (c *checkedBackend) Uniform4ui(location int32, v0, v1, v2, v3 uint32) {
+	c.before("glUniform4ui")
+	c.Backend.Uniform4ui(location, v0, v1, v2, v3)
+	c.after("glUniform4ui", location, v0, v1, v2, v3)
+}
+
+func (c *checkedBackend) Uniform4uiv(location, count int32, value *uint32) {
+	c.before("glUniform4uiv")
+	c.Backend.Uniform4uiv(location, count, value)
+	c.after("glUniform4uiv", location, count, value)
+}
+
+func (c *checkedBackend) UniformMatrix2fv(location, count int32, transpose bool, value *float32) {
+	c.before("glUniformMatrix2fv")
+	c.Backend.UniformMatrix2fv(location, count, transpose, value)
+	c.after("glUniformMatrix2fv", location, count, transpose, value)
+}
+
+func (c *checkedBackend) UniformMatrix2x3fv(location, count int32, transpose bool, value *float32) {
+	c.before("glUniformMatrix2x3fv")
+	c.Backend.UniformMatrix2x3fv(location, count, transpose, value)
+	c.after("glUniformMatrix2x3fv", location, count, transpose, value)
+}
+
+func (c *checkedBackend) UniformMatrix2x4fv(location, count int32, transpose bool, value *float32) {
+	c.before("glUniformMatrix2x4fv")
+	c.Backend.UniformMatrix2x4fv(location, count, transpose, value)
+	c.after("glUniformMatrix2x4fv", location, count, transpose, value)
+}
+
+func (c *checkedBackend) UniformMatrix3fv(location, count int32, transpose bool, value *float32) {
+	c.before("glUniformMatrix3fv")
+	c.Backend.UniformMatrix3fv(location, count, transpose, value)
+	c.after("glUniformMatrix3fv", location, count, transpose, value)
+}
+
+func (c *checkedBackend) UniformMatrix3x2fv(location, count int32, transpose bool, value *float32) {
+	c.before("glUniformMatrix3x2fv")
+	c.Backend.UniformMatrix3x2fv(location, count, transpose, value)
+	c.after("glUniformMatrix3x2fv", location, count, transpose, value)
+}
+
+func (c *checkedBackend) UniformMatrix3x4fv(location, count int32, transpose bool, value *float32) {
+	c.before("glUniformMatrix3x4fv")
+	c.Backend.UniformMatrix3x4fv(location, count, transpose, value)
+	c.after("glUniformMatrix3x4fv", location, count, transpose, value)
+}
+
+func (c *checkedBackend) UniformMatrix4fv(location, count int32, transpose bool, value *float32) {
+	c.before("glUniformMatrix4fv")
+	c.Backend.UniformMatrix4fv(location, count, transpose, value)
+	c.after("glUniformMatrix4fv", location, count, transpose, value)
+}
+
+func (c *checkedBackend) UniformMatrix4x2fv(location, count int32, transpose bool, value *float32) {
+	c.before("glUniformMatrix4x2fv")
+	c.Backend.UniformMatrix4x2fv(location, count, transpose, value)
+	c.after("glUniformMatrix4x2fv", location, count, transpose, value)
+}
+
+func (c *checkedBackend) UniformMatrix4x3fv(location, count int32, transpose bool, value *float32) {
+	c.before("glUniformMatrix4x3fv")
+	c.Backend.UniformMatrix4x3fv(location, count, transpose, value)
+	c.after("glUniformMatrix4x3fv", location, count, transpose, value)
+}
+
+func (c *checkedBackend) UnmapBuffer(target uint32) bool {
+	c.before("glUnmapBuffer")
+	r := c.Backend.UnmapBuffer(target)
+	c.after("glUnmapBuffer", target)
+	return r
+}
+
+func (c *checkedBackend) UseProgram(program uint32) {
+	c.before("glUseProgram")
+	c.Backend.UseProgram(program)
+	c.after("glUseProgram", program)
+}
+
+func (c *checkedBackend) VertexAttribDivisor(index, divisor uint32) {
+	c.before("glVertexAttribDivisor")
+	c.Backend.VertexAttribDivisor(index, divisor)
+	c.after("glVertexAttribDivisor", index, divisor)
+}
+
+func (c *checkedBackend) VertexAttribIPointer(index uint32, size int32, xtype uint32, stride int32, pointer unsafe.Pointer) {
+	c.before("glVertexAttribIPointer")
+	c.Backend.VertexAttribIPointer(index, size, xtype, stride, pointer)
+	c.after("glVertexAttribIPointer", index, size, xtype, stride, pointer)
+}
+
+func (c *checkedBackend) VertexAttribPointer(index uint32, size int32, xtype uint32, normalized bool, stride int32, pointer unsafe.Pointer) {
+	c.before("glVertexAttribPointer")
+	c.Backend.VertexAttribPointer(index, size, xtype, normalized, stride, pointer)
+	c.after("glVertexAttribPointer", index, size, xtype, normalized, stride, pointer)
+}
+
+func (c *checkedBackend) Viewport(x, y, width, height int32) {
+	c.before("glViewport")
+	c.Backend.Viewport(x, y, width, height)
+	c.after("glViewport", x, y, width, height)
+}
@@ -0,0 +1,113 @@
+//go:build !gles30
+
+package gl
+
+import "unsafe"
+
+func (c *checkedBackend) BindFragDataLocation(program, color uint32, name *uint8) {
+	c.before("glBindFragDataLocation")
+	c.Backend.BindFragDataLocation(program, color, name)
+	c.after("glBindFragDataLocation", program, color, name)
+}
+
+func (c *checkedBackend) CopyTexImage1D(target uint32, level int32, internalformat uint32, x, y, width, border int32) {
+	c.before("glCopyTexImage1D")
+	c.Backend.CopyTexImage1D(target, level, internalformat, x, y, width, border)
+	c.after("glCopyTexImage1D", target, level, internalformat, x, y, width, border)
+}
+
+func (c *checkedBackend) DebugMessageCallbackARB(callback debugProc, userParam unsafe.Pointer) {
+	c.before("glDebugMessageCallbackARB")
+	c.Backend.DebugMessageCallbackARB(callback, userParam)
+	c.after("glDebugMessageCallbackARB", callback, userParam)
+}
+
+func (c *checkedBackend) DebugMessageControlARB(source, xtype, severity uint32, count int32, ids *uint32, enabled bool) {
+	c.before("glDebugMessageControlARB")
+	c.Backend.DebugMessageControlARB(source, xtype, severity, count, ids, enabled)
+	c.after("glDebugMessageControlARB", source, xtype, severity, count, ids, enabled)
+}
+
+func (c *checkedBackend) DebugMessageInsertARB(source, xtype, id, severity uint32, length int32, buf *uint8) {
+	c.before("glDebugMessageInsertARB")
+	c.Backend.DebugMessageInsertARB(source, xtype, id, severity, length, buf)
+	c.after("glDebugMessageInsertARB", source, xtype, id, severity, length, buf)
+}
+
+func (c *checkedBackend) DrawBuffer(buf uint32) {
+	c.before("glDrawBuffer")
+	c.Backend.DrawBuffer(buf)
+	c.after("glDrawBuffer", buf)
+}
+
+func (c *checkedBackend) FramebufferTexture(target, attachment, texture uint32, level int32) {
+	c.before("glFramebufferTexture")
+	c.Backend.FramebufferTexture(target, attachment, texture, level)
+	c.after("glFramebufferTexture", target, attachment, texture, level)
+}
+
+func (c *checkedBackend) GetBooleani_v(target, index uint32, data *bool) {
+	c.before("glGetBooleani_v")
+	c.Backend.GetBooleani_v(target, index, data)
+	c.after("glGetBooleani_v", target, index, data)
+}
+
+func (c *checkedBackend) GetBufferSubData(target uint32, offset, size int, data unsafe.Pointer) {
+	c.before("glGetBufferSubData")
+	c.Backend.GetBufferSubData(target, offset, size, data)
+	c.after("glGetBufferSubData", target, offset, size, data)
+}
+
+func (c *checkedBackend) GetDoublev(pname uint32, data *float64) {
+	c.before("glGetDoublev")
+	c.Backend.GetDoublev(pname, data)
+	c.after("glGetDoublev", pname, data)
+}
+
+func (c *checkedBackend) GetTexImage(target uint32, level int32, format, xtype uint32, pixels unsafe.Pointer) {
+	c.before("glGetTexImage")
+	c.Backend.GetTexImage(target, level, format, xtype, pixels)
+	c.after("glGetTexImage", target, level, format, xtype, pixels)
+}
+
+func (c *checkedBackend) GetTexLevelParameteriv(target uint32, level int32, pname uint32, params *int32) {
+	c.before("glGetTexLevelParameteriv")
+	c.Backend.GetTexLevelParameteriv(target, level, pname, params)
+	c.after("glGetTexLevelParameteriv", target, level, pname, params)
+}
+
+func (c *checkedBackend) GetTexParameterIiv(target, pname uint32, params *int32) {
+	c.before("glGetTexParameterIiv")
+	c.Backend.GetTexParameterIiv(target, pname, params)
+	c.after("glGetTexParameterIiv", target, pname, params)
+}
+
+func (c *checkedBackend) GetTexParameterIuiv(target, pname uint32, params *uint32) {
+	c.before("glGetTexParameterIuiv")
+	c.Backend.GetTexParameterIuiv(target, pname, params)
+	c.after("glGetTexParameterIuiv", target, pname, params)
+}
+
+func (c *checkedBackend) PolygonMode(face, mode uint32) {
+	c.before("glPolygonMode")
+	c.Backend.PolygonMode(face, mode)
+	c.after("glPolygonMode", face, mode)
+}
+
+func (c *checkedBackend) TexImage1D(target uint32, level, internalformat, width, border int32, format, xtype uint32, pixels unsafe.Pointer) {
+	c.before("glTexImage1D")
+	c.Backend.TexImage1D(target, level, internalformat, width, border, format, xtype, pixels)
+	c.after("glTexImage1D", target, level, internalformat, width, border, format, xtype, pixels)
+}
+
+func (c *checkedBackend) TexParameterIiv(target, pname uint32, params *int32) {
+	c.before("glTexParameterIiv")
+	c.Backend.TexParameterIiv(target, pname, params)
+	c.after("glTexParameterIiv", target, pname, params)
+}
+
+func (c *checkedBackend) TexParameterIuiv(target, pname uint32, params *uint32) {
+	c.before("glTexParameterIuiv")
+	c.Backend.TexParameterIuiv(target, pname, params)
+	c.after("glTexParameterIuiv", target, pname, params)
+}
@@ -0,0 +1,11 @@
+//go:build gl41 || gl45
+
+package gl
+
+import "unsafe"
+
+func (c *checkedBackend) VertexAttribLPointer(index uint32, size int32, xtype uint32, stride int32, pointer unsafe.Pointer) {
+	c.before("glVertexAttribLPointer")
+	c.Backend.VertexAttribLPointer(index, size, xtype, stride, pointer)
+	c.after("glVertexAttribLPointer", index, size, xtype, stride, pointer)
+}
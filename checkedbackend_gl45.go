@@ -0,0 +1,23 @@
+//go:build gl45
+
+package gl
+
+import "unsafe"
+
+func (c *checkedBackend) InvalidateBufferData(buffer uint32) {
+	c.before("glInvalidateBufferData")
+	c.Backend.InvalidateBufferData(buffer)
+	c.after("glInvalidateBufferData", buffer)
+}
+
+func (c *checkedBackend) InvalidateBufferSubData(buffer uint32, offset, length int) {
+	c.before("glInvalidateBufferSubData")
+	c.Backend.InvalidateBufferSubData(buffer, offset, length)
+	c.after("glInvalidateBufferSubData", buffer, offset, length)
+}
+
+func (c *checkedBackend) BufferStorage(target uint32, size int, data unsafe.Pointer, flags uint32) {
+	c.before("glBufferStorage")
+	c.Backend.BufferStorage(target, size, data, flags)
+	c.after("glBufferStorage", target, size, data, flags)
+}
@@ -0,0 +1,271 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"os"
+	"sort"
+	"strings"
+)
+
+// backendMethod is a method of Backend, see backend.spec.
+type backendMethod struct {
+	Name    string
+	Params  string // parameter list, without the parentheses
+	Results string
+	Calls   []backendCall
+}
+
+// backendCall is a go-gl call a method of the go-gl backend can make.
+type backendCall struct {
+	Expr      string // like ClearDepth(float64(d))
+	Extension string // extension the call is available through, "" for none
+}
+
+// command returns the OpenGL command the call makes.
+func (c backendCall) command() string {
+	name, _, _ := strings.Cut(c.Expr, "(")
+	return name
+}
+
+// readBackendSpec reads the backend spec, see backend.spec for its format.
+func readBackendSpec(path string) ([]backendMethod, error) {
+	var methods []backendMethod
+	err := readSpecLines(path, func(n int, line string) error {
+		parts := strings.Split(line, ";")
+		sig, ext := cutExtension(parts[0])
+		lp, rp := strings.Index(sig, "("), strings.Index(sig, ")")
+		if lp <= 0 || rp < lp {
+			return fmt.Errorf("%s:%d: want Name(params) results", path, n)
+		}
+		m := backendMethod{Name: sig[:lp], Params: sig[lp+1 : rp], Results: strings.TrimSpace(sig[rp+1:])}
+		args, err := paramNames(m.Params)
+		if err != nil {
+			return fmt.Errorf("%s:%d: %v", path, n, err)
+		}
+		m.Calls = append(m.Calls, backendCall{Expr: m.Name + "(" + strings.Join(args, ", ") + ")", Extension: ext})
+		for _, p := range parts[1:] {
+			expr, ext := cutExtension(p)
+			m.Calls = append(m.Calls, backendCall{Expr: expr, Extension: ext})
+		}
+		methods = append(methods, m)
+		return nil
+	})
+	return methods, err
+}
+
+// cutExtension splits "call if extension" into the call and the extension.
+func cutExtension(s string) (call, ext string) {
+	call, ext, _ = strings.Cut(s, " if ")
+	return strings.TrimSpace(call), strings.TrimSpace(ext)
+}
+
+// paramNames returns the names of the parameters of a parameter list.
+func paramNames(params string) ([]string, error) {
+	x, err := parser.ParseExpr("func(" + params + ")")
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, f := range x.(*ast.FuncType).Params.List {
+		for _, name := range f.Names {
+			names = append(names, name.Name)
+		}
+	}
+	return names, nil
+}
+
+// has returns true if profile p can make the call.
+func (reg *registry) has(avail availability, p profile, c backendCall) bool {
+	if avail[p.Name][c.command()] {
+		return true
+	}
+	if c.Extension == "" {
+		return false
+	}
+	ext, ok := reg.extension(c.Extension)
+	if !ok || !supports(ext.Supported, p) {
+		return false
+	}
+	for _, r := range ext.Require {
+		if r.API != "" && r.API != p.API {
+			continue
+		}
+		for _, n := range r.names() {
+			if n == c.command() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// supports returns true if the supported attribute of an extension lists the
+// API of p.
+func supports(supported string, p profile) bool {
+	for _, api := range strings.Split(supported, "|") {
+		if api == p.API || p.Core && api == "glcore" {
+			return true
+		}
+	}
+	return false
+}
+
+// backendFile is the go-gl backend of a profile.
+type backendFile struct {
+	profile profile
+	methods []string
+	buckets map[string]bool // keys of profileFiles with methods of the profile
+}
+
+// backends generates the entry points of Backend, split by the profiles
+// having them, and the go-gl backend of every profile.
+func (g *wrapperGen) backends(methods []backendMethod) (*entryPoints, []*backendFile, error) {
+	points := &entryPoints{methods: map[string][]string{}}
+	files := make([]*backendFile, len(profiles))
+	for i, p := range profiles {
+		files[i] = &backendFile{profile: p, buckets: map[string]bool{}}
+	}
+	for _, m := range methods {
+		var in []string
+		for i, p := range profiles {
+			for _, c := range m.Calls {
+				if !g.reg.has(g.avail, p, c) {
+					continue
+				}
+				body := "gl." + c.Expr
+				if m.Results != "" {
+					body = "return " + body
+				}
+				files[i].methods = append(files[i].methods, fmt.Sprintf("func (goglBackend) %s(%s) %s {\n\t%s\n}\n", m.Name, m.Params, m.Results, body))
+				in = append(in, p.Name)
+				break
+			}
+		}
+		sort.Strings(in)
+		key := strings.Join(in, ",")
+		if len(in) == 0 {
+			return nil, nil, fmt.Errorf("backend: no profile can make a call of %s", m.Name)
+		}
+		if _, ok := profileFiles[key]; !ok {
+			return nil, nil, fmt.Errorf("backend: %s is only available in %s, which has no file", m.Name, key)
+		}
+		points.methods[key] = append(points.methods[key], fmt.Sprintf("\t%s(%s) %s\n", m.Name, m.Params, m.Results))
+		for i, p := range profiles {
+			if strings.Contains(","+key+",", ","+p.Name+",") {
+				files[i].buckets[key] = true
+			}
+		}
+	}
+	return points, files, nil
+}
+
+// entryPoints accumulates the methods of Backend by the file of their
+// profiles, each file declares an interface embedded by the Backend of its
+// profiles.
+type entryPoints struct {
+	methods map[string][]string // by profile set
+}
+
+// entryPointsType returns the interface of the entry points of a file of
+// profileFiles.
+func entryPointsType(key string) string {
+	name := strings.TrimPrefix(profileFiles[key].Suffix, "_")
+	if name == "" {
+		return "entryPoints"
+	}
+	return name + "EntryPoints"
+}
+
+func (e *entryPoints) write(dir string) error {
+	for key, f := range profileFiles {
+		path := dir + "/entrypoints" + f.Suffix + ".go"
+		methods := e.methods[key]
+		if len(methods) == 0 {
+			if old, err := os.ReadFile(path); err == nil && strings.HasPrefix(string(old), generatedHeader) {
+				if err := os.Remove(path); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		var b strings.Builder
+		b.WriteString(generatedHeader)
+		if f.Constraint != "" {
+			fmt.Fprintf(&b, "//go:build %s\n\n", f.Constraint)
+		}
+		b.WriteString("package gl\n\n")
+		src := strings.Join(methods, "")
+		if strings.Contains(src, "unsafe.") {
+			b.WriteString("import \"unsafe\"\n\n")
+		}
+		profiles := "the " + strings.ReplaceAll(key, ",", ", ") + " profiles"
+		if !strings.Contains(key, ",") {
+			profiles = "the " + key + " profile"
+		}
+		fmt.Fprintf(&b, "// %s are the methods of Backend in %s.\n", entryPointsType(key), profiles)
+		fmt.Fprintf(&b, "type %s interface {\n%s}\n", entryPointsType(key), src)
+		if err := writeGo(path, []byte(b.String())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *backendFile) write(dir string) error {
+	p := f.profile
+	var embedded []string
+	for key := range f.buckets {
+		if key != strings.Join(profileNames(), ",") {
+			embedded = append(embedded, entryPointsType(key))
+		}
+	}
+	sort.Strings(embedded)
+
+	var b strings.Builder
+	b.WriteString(generatedHeader)
+	fmt.Fprintf(&b, "//go:build %s\n\npackage gl\n\n", p.Constraint)
+	fmt.Fprintf(&b, "import (\n\t%s\n\t\"unsafe\"\n)\n\n", p.Import)
+	b.WriteString("// Profile is the OpenGL profile this package was built for, selected with the\n// gl41, gl45 and gles30 build tags. The default is 3.3-core.\n")
+	fmt.Fprintf(&b, "const Profile = %q\n\n", p.Name)
+	b.WriteString("// profileEntryPoints are the methods of Backend only some profiles have.\n")
+	if len(embedded) == 0 {
+		b.WriteString("type profileEntryPoints interface{}\n\n")
+	} else {
+		fmt.Fprintf(&b, "type profileEntryPoints interface {\n\t%s\n}\n\n", strings.Join(embedded, "\n\t"))
+	}
+	b.WriteString(backendPrelude)
+	for _, m := range f.methods {
+		b.WriteString(m)
+		b.WriteString("\n")
+	}
+	return writeGo(dir+"/"+p.File, []byte(b.String()))
+}
+
+func profileNames() []string {
+	names := make([]string, len(profiles))
+	for i, p := range profiles {
+		names[i] = p.Name
+	}
+	sort.Strings(names)
+	return names
+}
+
+const backendPrelude = `type debugProc = gl.DebugProc
+
+var (
+	glStr       = gl.Str
+	glStrs      = gl.Strs
+	glGoStr     = gl.GoStr
+	glPtrOffset = gl.PtrOffset
+)
+
+// goglBackend is the default Backend, it forwards every call to go-gl.
+type goglBackend struct{}
+
+func (goglBackend) Init() error {
+	return gl.Init()
+}
+
+`
@@ -0,0 +1,182 @@
+# The methods of Backend and of the go-gl backend of every profile, one per
+# line:
+#
+#	Name(params) results[ if extension][; call[ if extension]]...
+#
+# Every method is the go-gl function of the same name and signature, the
+# go-gl backend of a profile calls it if the profile has its command. When it
+# doesn't, the calls after the semicolons are tried in order, like
+# ClearDepth(float64(d)) for glClearDepthf in OpenGL 3.3. A call marked with an
+# extension is also available in the profiles of every API supporting the
+# extension, its callers must check the extension is there. The methods no
+# call of a profile can make aren't part of its Backend.
+
+ActiveTexture(texture uint32)
+AttachShader(program, shader uint32)
+BeginTransformFeedback(primitiveMode uint32)
+BindBuffer(target, buffer uint32)
+BindBufferBase(target, index, buffer uint32)
+BindBufferRange(target, index, buffer uint32, offset, size int)
+BindFragDataLocation(program, color uint32, name *uint8)
+BindFramebuffer(target, framebuffer uint32)
+BindRenderbuffer(target, renderbuffer uint32)
+BindTexture(target, texture uint32)
+BindTransformFeedback(target, id uint32)
+BindVertexArray(array uint32)
+BlendColor(red, green, blue, alpha float32)
+BlendEquationSeparate(modeRGB, modeAlpha uint32)
+BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha uint32)
+BufferData(target uint32, size int, data unsafe.Pointer, usage uint32)
+BufferStorage(target uint32, size int, data unsafe.Pointer, flags uint32)
+BufferSubData(target uint32, offset, size int, data unsafe.Pointer)
+CheckFramebufferStatus(target uint32) uint32
+ClearColor(red, green, blue, alpha float32)
+ClearDepthf(d float32); ClearDepth(float64(d))
+ClearStencil(s int32)
+ClientWaitSync(sync uintptr, flags uint32, timeout uint64) uint32
+ColorMask(red, green, blue, alpha bool)
+CompileShader(shader uint32)
+CopyBufferSubData(readTarget, writeTarget uint32, readOffset, writeOffset, size int)
+CopyTexImage1D(target uint32, level int32, internalformat uint32, x, y, width, border int32)
+CopyTexImage2D(target uint32, level int32, internalformat uint32, x, y, width, height, border int32)
+CreateProgram() uint32
+CreateShader(xtype uint32) uint32
+CullFace(mode uint32)
+DebugMessageCallback(callback debugProc, userParam unsafe.Pointer) if GL_KHR_debug; DebugMessageCallbackKHR(callback, userParam) if GL_KHR_debug
+DebugMessageCallbackARB(callback debugProc, userParam unsafe.Pointer) if GL_ARB_debug_output
+DebugMessageControl(source, xtype, severity uint32, count int32, ids *uint32, enabled bool) if GL_KHR_debug; DebugMessageControlKHR(source, xtype, severity, count, ids, enabled) if GL_KHR_debug
+DebugMessageControlARB(source, xtype, severity uint32, count int32, ids *uint32, enabled bool) if GL_ARB_debug_output
+DebugMessageInsert(source, xtype, id, severity uint32, length int32, buf *uint8) if GL_KHR_debug; DebugMessageInsertKHR(source, xtype, id, severity, length, buf) if GL_KHR_debug
+DebugMessageInsertARB(source, xtype, id, severity uint32, length int32, buf *uint8) if GL_ARB_debug_output
+DeleteBuffers(n int32, buffers *uint32)
+DeleteFramebuffers(n int32, framebuffers *uint32)
+DeleteProgram(program uint32)
+DeleteRenderbuffers(n int32, renderbuffers *uint32)
+DeleteShader(shader uint32)
+DeleteSync(sync uintptr)
+DeleteTextures(n int32, textures *uint32)
+DeleteTransformFeedbacks(n int32, ids *uint32)
+DeleteVertexArrays(n int32, arrays *uint32)
+DepthFunc(xfunc uint32)
+DepthMask(flag bool)
+DepthRangef(near, far float32); DepthRange(float64(near), float64(far))
+Disable(cap uint32)
+DisableVertexAttribArray(index uint32)
+DrawBuffer(buf uint32)
+DrawBuffers(n int32, bufs *uint32)
+Enable(cap uint32)
+EnableVertexAttribArray(index uint32)
+EndTransformFeedback()
+FenceSync(condition, flags uint32) uintptr
+FlushMappedBufferRange(target uint32, offset, length int)
+FramebufferRenderbuffer(target, attachment, renderbuffertarget, renderbuffer uint32)
+FramebufferTexture(target, attachment, texture uint32, level int32)
+FrontFace(mode uint32)
+GenBuffers(n int32, buffers *uint32)
+GenFramebuffers(n int32, framebuffers *uint32)
+GenRenderbuffers(n int32, renderbuffers *uint32)
+GenTextures(n int32, textures *uint32)
+GenTransformFeedbacks(n int32, ids *uint32)
+GenVertexArrays(n int32, arrays *uint32)
+GetActiveAttrib(program, index uint32, bufSize int32, length, size *int32, xtype *uint32, name *uint8)
+GetAttachedShaders(program uint32, maxCount int32, count *int32, shaders *uint32)
+GetAttribLocation(program uint32, name *uint8) int32
+GetBooleani_v(target, index uint32, data *bool)
+GetBooleanv(pname uint32, data *bool)
+GetBufferParameteri64v(target, pname uint32, params *int64)
+GetBufferParameteriv(target, pname uint32, params *int32)
+GetBufferSubData(target uint32, offset, size int, data unsafe.Pointer)
+GetDoublev(pname uint32, data *float64)
+GetError() uint32
+GetFloatv(pname uint32, data *float32)
+GetInteger64i_v(target, index uint32, data *int64)
+GetInteger64v(pname uint32, data *int64)
+GetIntegeri_v(target, index uint32, data *int32)
+GetIntegerv(pname uint32, data *int32)
+GetProgramInfoLog(program uint32, bufSize int32, length *int32, infoLog *uint8)
+GetProgramiv(program, pname uint32, params *int32)
+GetShaderInfoLog(shader uint32, bufSize int32, length *int32, infoLog *uint8)
+GetShaderSource(shader uint32, bufSize int32, length *int32, source *uint8)
+GetShaderiv(shader, pname uint32, params *int32)
+GetString(name uint32) *uint8
+GetStringi(name, index uint32) *uint8
+GetTexImage(target uint32, level int32, format, xtype uint32, pixels unsafe.Pointer)
+GetTexLevelParameteriv(target uint32, level int32, pname uint32, params *int32)
+GetTexParameterIiv(target, pname uint32, params *int32)
+GetTexParameterIuiv(target, pname uint32, params *uint32)
+GetTexParameterfv(target, pname uint32, params *float32)
+GetTexParameteriv(target, pname uint32, params *int32)
+GetUniformLocation(program uint32, name *uint8) int32
+GetVertexAttribiv(index, pname uint32, params *int32)
+InvalidateBufferData(buffer uint32)
+InvalidateBufferSubData(buffer uint32, offset, length int)
+IsTexture(texture uint32) bool
+LineWidth(width float32)
+LinkProgram(program uint32)
+MapBufferRange(target uint32, offset, length int, access uint32) unsafe.Pointer
+PauseTransformFeedback()
+PixelStorei(pname uint32, param int32)
+PolygonMode(face, mode uint32)
+PolygonOffset(factor, units float32)
+ReadBuffer(src uint32)
+ReadPixels(x, y, width, height int32, format, xtype uint32, pixels unsafe.Pointer)
+RenderbufferStorage(target, internalformat uint32, width, height int32)
+ResumeTransformFeedback()
+SampleCoverage(value float32, invert bool)
+Scissor(x, y, width, height int32)
+ShaderSource(shader uint32, count int32, xstring **uint8, length *int32)
+StencilFunc(xfunc uint32, ref int32, mask uint32)
+StencilFuncSeparate(face, xfunc uint32, ref int32, mask uint32)
+StencilMask(mask uint32)
+StencilMaskSeparate(face, mask uint32)
+StencilOp(fail, zfail, zpass uint32)
+StencilOpSeparate(face, sfail, dpfail, dppass uint32)
+TexImage1D(target uint32, level, internalformat, width, border int32, format, xtype uint32, pixels unsafe.Pointer)
+TexImage2D(target uint32, level, internalformat, width, height, border int32, format, xtype uint32, pixels unsafe.Pointer)
+TexImage3D(target uint32, level, internalformat, width, height, depth, border int32, format, xtype uint32, pixels unsafe.Pointer)
+TexParameterIiv(target, pname uint32, params *int32)
+TexParameterIuiv(target, pname uint32, params *uint32)
+TexParameterf(target, pname uint32, param float32)
+TexParameterfv(target, pname uint32, params *float32)
+TexParameteri(target, pname uint32, param int32)
+TexParameteriv(target, pname uint32, params *int32)
+Uniform1f(location int32, v0 float32)
+Uniform1fv(location, count int32, value *float32)
+Uniform1i(location, v0 int32)
+Uniform1iv(location, count int32, value *int32)
+Uniform1ui(location int32, v0 uint32)
+Uniform1uiv(location, count int32, value *uint32)
+Uniform2f(location int32, v0, v1 float32)
+Uniform2fv(location, count int32, value *float32)
+Uniform2i(location, v0, v1 int32)
+Uniform2iv(location, count int32, value *int32)
+Uniform2ui(location int32, v0, v1 uint32)
+Uniform2uiv(location, count int32, value *uint32)
+Uniform3f(location int32, v0, v1, v2 float32)
+Uniform3fv(location, count int32, value *float32)
+Uniform3i(location, v0, v1, v2 int32)
+Uniform3iv(location, count int32, value *int32)
+Uniform3ui(location int32, v0, v1, v2 uint32)
+Uniform3uiv(location, count int32, value *uint32)
+Uniform4f(location int32, v0, v1, v2, v3 float32)
+Uniform4fv(location, count int32, value *float32)
+Uniform4i(location, v0, v1, v2, v3 int32)
+Uniform4iv(location, count int32, value *int32)
+Uniform4ui(location int32, v0, v1, v2, v3 uint32)
+Uniform4uiv(location, count int32, value *uint32)
+UniformMatrix2fv(location, count int32, transpose bool, value *float32)
+UniformMatrix2x3fv(location, count int32, transpose bool, value *float32)
+UniformMatrix2x4fv(location, count int32, transpose bool, value *float32)
+UniformMatrix3fv(location, count int32, transpose bool, value *float32)
+UniformMatrix3x2fv(location, count int32, transpose bool, value *float32)
+UniformMatrix3x4fv(location, count int32, transpose bool, value *float32)
+UniformMatrix4fv(location, count int32, transpose bool, value *float32)
+UniformMatrix4x2fv(location, count int32, transpose bool, value *float32)
+UniformMatrix4x3fv(location, count int32, transpose bool, value *float32)
+UnmapBuffer(target uint32) bool
+UseProgram(program uint32)
+VertexAttribDivisor(index, divisor uint32)
+VertexAttribIPointer(index uint32, size int32, xtype uint32, stride int32, pointer unsafe.Pointer)
+VertexAttribLPointer(index uint32, size int32, xtype uint32, stride int32, pointer unsafe.Pointer)
+VertexAttribPointer(index uint32, size int32, xtype uint32, normalized bool, stride int32, pointer unsafe.Pointer)
+Viewport(x, y, width, height int32)
@@ -0,0 +1,222 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// enumSpec maps a Go type to a gl.xml group.
+type enumSpec struct {
+	Type     string
+	Group    string
+	Excluded map[string]bool
+	Untyped  map[string]bool
+	Doc      string
+}
+
+// readEnumSpec reads the enum spec, one type per line:
+//
+//	Type Group [-EXCLUDED ~UNTYPED ...] : doc
+//
+// Excluded members are dropped from the group, gl.xml groups also list the
+// values that are only valid in the compatibility profile. Untyped members
+// stay in the group but their constant keeps no type. The doc completes
+// "Type is" in the doc comment of the type.
+func readEnumSpec(path string) ([]enumSpec, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var specs []enumSpec
+	s := bufio.NewScanner(f)
+	for n := 1; s.Scan(); n++ {
+		line, _, _ := strings.Cut(s.Text(), "#")
+		line, doc, _ := strings.Cut(line, ":")
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("%s:%d: want a type and a group", path, n)
+		}
+		spec := enumSpec{
+			Type:     fields[0],
+			Group:    fields[1],
+			Excluded: map[string]bool{},
+			Untyped:  map[string]bool{},
+			Doc:      strings.TrimSpace(doc),
+		}
+		for _, m := range fields[2:] {
+			switch m[0] {
+			case '-':
+				spec.Excluded[m[1:]] = true
+			case '~':
+				spec.Untyped[m[1:]] = true
+			default:
+				return nil, fmt.Errorf("%s:%d: %q is neither -EXCLUDED nor ~UNTYPED", path, n, m)
+			}
+		}
+		specs = append(specs, spec)
+	}
+	return specs, s.Err()
+}
+
+// constants are the constants of const.go, in order.
+type constants struct {
+	Names  []string
+	Values map[string]string
+}
+
+// readConsts reads the names and values of the constants of path. The names
+// decide which constants the package exports, gl.xml only supplies their
+// values and groups.
+func readConsts(path string) (*constants, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	c := &constants{Values: map[string]string{}}
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			vs := spec.(*ast.ValueSpec)
+			for i, name := range vs.Names {
+				lit, ok := vs.Values[i].(*ast.BasicLit)
+				if !ok {
+					return nil, fmt.Errorf("%s: %s isn't a literal", path, name.Name)
+				}
+				c.Names = append(c.Names, name.Name)
+				c.Values[name.Name] = lit.Value
+			}
+		}
+	}
+	return c, nil
+}
+
+// enum is a generated enum type.
+type enum struct {
+	enumSpec
+	Members []string // without duplicated values
+}
+
+var vendorSuffix = regexp.MustCompile(`_(ARB|EXT|KHR|OES|NV|NVX|AMD|ATI|APPLE|INTEL|IBM|SGI|SGIS|SGIX|MESA|OVR|QCOM|IMG|ANGLE|ARM|3DFX|S3|SUN|HP|INGR|PGI|REND|OML|GREMEDY|WIN)$`)
+
+// buildEnums resolves the members of every enum and the value of every
+// constant.
+func buildEnums(reg *registry, specs []enumSpec, consts *constants) []enum {
+	groups := reg.groups()
+	values := reg.values()
+	for _, name := range consts.Names {
+		if v, ok := values[name]; ok {
+			consts.Values[name] = v
+		}
+	}
+	var enums []enum
+	for _, spec := range specs {
+		members, ok := groups[spec.Group]
+		if !ok {
+			fmt.Fprintf(os.Stderr, "glgen: warning: gl.xml has no group %s\n", spec.Group)
+		}
+		var kept []string
+		for _, m := range members {
+			if _, ok := consts.Values[m]; ok && !spec.Excluded[m] {
+				kept = append(kept, m)
+			}
+		}
+		// Core names win over the vendor ones with the same value.
+		sort.SliceStable(kept, func(i, j int) bool {
+			return !vendorSuffix.MatchString(kept[i]) && vendorSuffix.MatchString(kept[j])
+		})
+		e := enum{enumSpec: spec}
+		seen := map[uint64]bool{}
+		for _, m := range kept {
+			v := parseValue(consts.Values[m])
+			if seen[v] {
+				continue
+			}
+			seen[v] = true
+			e.Members = append(e.Members, m)
+		}
+		sort.Slice(e.Members, func(i, j int) bool {
+			return parseValue(consts.Values[e.Members[i]]) < parseValue(consts.Values[e.Members[j]])
+		})
+		enums = append(enums, e)
+	}
+	return enums
+}
+
+func parseValue(s string) uint64 {
+	v, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		panic(fmt.Sprintf("bad enum value %q", s))
+	}
+	return v
+}
+
+// types returns the type of every constant that is a member of a single enum
+// and isn't marked untyped.
+func types(enums []enum) map[string]string {
+	count := map[string]int{}
+	typ := map[string]string{}
+	for _, e := range enums {
+		for _, m := range e.Members {
+			count[m]++
+			typ[m] = e.Type
+			if e.Untyped[m] {
+				count[m]++
+			}
+		}
+	}
+	for m, n := range count {
+		if n > 1 {
+			delete(typ, m)
+		}
+	}
+	return typ
+}
+
+const generatedHeader = "// Code generated by glgen from gl.xml. DO NOT EDIT.\n\n"
+
+func genConsts(consts *constants, enums []enum) []byte {
+	typ := types(enums)
+	var b bytes.Buffer
+	b.WriteString(generatedHeader)
+	b.WriteString("package gl\n\n")
+	b.WriteString("// All OpenGL 3.3 constants. Their values are the same in every profile. The\n")
+	b.WriteString("// ones that belong to a single enum type of enums.go have that type, the\n")
+	b.WriteString("// others are untyped.\n")
+	b.WriteString("const (\n")
+	for _, name := range consts.Names {
+		fmt.Fprintf(&b, "\t%s %s = %s\n", name, typ[name], consts.Values[name])
+	}
+	b.WriteString(")\n")
+	return b.Bytes()
+}
+
+func genEnums(enums []enum) []byte {
+	var b bytes.Buffer
+	b.WriteString(generatedHeader)
+	b.WriteString("package gl\n\nimport \"fmt\"\n")
+	for _, e := range enums {
+		fmt.Fprintf(&b, "\n// %s is %s\ntype %s uint32\n\n", e.Type, e.Doc, e.Type)
+		fmt.Fprintf(&b, "func (e %s) String() string {\n\tswitch e {\n", e.Type)
+		for _, m := range e.Members {
+			fmt.Fprintf(&b, "\tcase %s:\n\t\treturn %q\n", m, "GL_"+m)
+		}
+		fmt.Fprintf(&b, "\tdefault:\n\t\treturn fmt.Sprintf(\"%s(0x%%X)\", uint32(e))\n\t}\n}\n", e.Type)
+	}
+	return b.Bytes()
+}
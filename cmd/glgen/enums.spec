@@ -0,0 +1,34 @@
+# The enum types of package gl and the gl.xml groups they are made of.
+#
+#	Type Group [-EXCLUDED ~UNTYPED ...] : doc
+#
+# -NAME drops a member of the group, typically a value only valid in the
+# compatibility profile. ~NAME keeps it in String but leaves the constant
+# untyped, for the values that are also commonly passed as plain integers.
+# Constants that are members of several types are left untyped too.
+
+BufferTarget          BufferTargetARB : a binding point of buffers.
+BufferUsage           BufferUsageARB : the expected usage pattern of the data store of a buffer.
+TextureTarget         TextureTarget -RENDERBUFFER : a binding point of textures, or a face of a cube map.
+TextureParameter      TextureParameterName : a parameter of a texture.
+ShaderType            ShaderType : the stage a shader is compiled for.
+Capability            EnableCap -TEXTURE_1D -TEXTURE_2D -TEXTURE_3D -TEXTURE_CUBE_MAP -TEXTURE_RECTANGLE -VERTEX_ARRAY : a server-side capability, toggled by Enable and Disable.
+StencilOp             StencilOp ~ZERO : an action taken on the stencil buffer.
+StencilFunc           StencilFunction : a comparison of the stencil test.
+DepthFunc             DepthFunction : a comparison of the depth test.
+InternalFormat        InternalFormat : the format a texture or render buffer stores its pixels in.
+PixelFormat           PixelFormat : the format of pixel data in client memory.
+PixelType             PixelType : the data type of pixel data in client memory.
+VertexAttribType      VertexAttribPointerType : the data type of the components of a vertex attribute.
+PrimitiveType         PrimitiveType : a kind of primitive to render.
+FramebufferTarget     FramebufferTarget : a binding point of framebuffers.
+FramebufferAttachment FramebufferAttachment : an attachment point of a framebuffer.
+ColorBuffer           ColorBuffer ~NONE ~FRONT ~BACK ~FRONT_AND_BACK : a color buffer to draw into or read from.
+BlendEquation         BlendEquationModeEXT : how the blended source and destination colors are combined.
+BlendFactor           BlendingFactor ~ZERO ~ONE : a factor the source or destination color is multiplied by before blending.
+Face                  TriangleFace : a face of polygons, for culling and the stencil test.
+Winding               FrontFaceDirection : the orientation of front-facing polygons.
+PolygonMode           PolygonMode : how polygons are rasterized.
+IndexType             DrawElementsType : the type of the indices of an element array.
+SyncStatus            SyncStatus : the result of waiting on a sync object.
+AttributeType         AttributeType : the type of an active attribute of a program, as declared in the shaders.
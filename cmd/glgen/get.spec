@@ -0,0 +1,230 @@
+# The getters generated into get.go, and the parameter getters of the objects
+# generated into objects.go, one per line after the section of their receiver:
+#
+#	Method PNAME Type [indexed] [len=Method] : doc
+#
+# The type decides the glGet* command, bool uses glGetBooleanv, float32
+# glGetFloatv, int64 glGetInteger64v and every other type glGetIntegerv. [N]T
+# reads N values, []T reads as many values as the len method returns and
+# indexed getters take the index of glGet*i_v. The getters that use an enum or
+# a command a profile doesn't have are only generated for the other profiles.
+#
+# Without doc the comment says which OpenGL call the getter is an alias to.
+
+[Get]
+ActiveTexture ACTIVE_TEXTURE int32 : params returns a single value indicating the active multitexture unit. The initial value is GL_TEXTURE0. See glActiveTexture.
+AliasedLineWidthRange ALIASED_LINE_WIDTH_RANGE [2]float32 : params returns a pair of values indicating the range of widths supported for aliased lines. See glLineWidth.
+SmoothLineWidthRange SMOOTH_LINE_WIDTH_RANGE [2]float32 : params returns a pair of values indicating the range of widths supported for smooth (antialiased) lines. See glLineWidth.
+SmoothLineWidthGranularity SMOOTH_LINE_WIDTH_GRANULARITY float32 : params returns a single value indicating the level of quantization applied to smooth line width parameters.
+ArrayBufferBinding ARRAY_BUFFER_BINDING Buffer : params returns a single value, the name of the buffer object currently bound to the target GL_ARRAY_BUFFER. If no buffer object is bound to this target, 0 is returned. The initial value is 0. See glBindBuffer.
+Blend BLEND bool : params returns a single boolean value indicating whether blending is enabled. The initial value is GL_FALSE. See glBlendFunc.
+BlendColor BLEND_COLOR [4]float32 : params returns four values, the red, green, blue, and alpha values which are the components of the blend color. See glBlendColor.
+BlendDstAlpha BLEND_DST_ALPHA int32 : params returns one value, the symbolic constant identifying the alpha destination blend function. The initial value is GL_ZERO. See glBlendFunc and glBlendFuncSeparate.
+BlendDstRgb BLEND_DST_RGB int32 : params returns one value, the symbolic constant identifying the RGB destination blend function. The initial value is GL_ZERO. See glBlendFunc and glBlendFuncSeparate.
+BlendEquationRgb BLEND_EQUATION_RGB int32 : params returns one value, a symbolic constant indicating whether the RGB blend equation is GL_FUNC_ADD, GL_FUNC_SUBTRACT, GL_FUNC_REVERSE_SUBTRACT, GL_MIN or GL_MAX. See glBlendEquationSeparate.
+BlendEquationAlpha BLEND_EQUATION_ALPHA int32 : params returns one value, a symbolic constant indicating whether the Alpha blend equation is GL_FUNC_ADD, GL_FUNC_SUBTRACT, GL_FUNC_REVERSE_SUBTRACT, GL_MIN or GL_MAX. See glBlendEquationSeparate.
+BlendSrcAlpha BLEND_SRC_ALPHA int32 : params returns one value, the symbolic constant identifying the alpha source blend function. The initial value is GL_ONE. See glBlendFunc and glBlendFuncSeparate.
+BlendSrcRgb BLEND_SRC_RGB int32 : params returns one value, the symbolic constant identifying the RGB source blend function. The initial value is GL_ONE. See glBlendFunc and glBlendFuncSeparate.
+ColorClearValue COLOR_CLEAR_VALUE [4]float32 : params returns four values: the red, green, blue, and alpha values used to clear the color buffers. Integer values, if requested, are linearly mapped from the internal floating-point representation such that 1.0 returns the most positive representable integer value, and -1.0 returns the most negative representable integer value. The initial value is (0, 0, 0, 0). See glClearColor.
+ColorLogicOp COLOR_LOGIC_OP bool : params returns a single boolean value indicating whether a fragment's RGBA color values are merged into the framebuffer using a logical operation. The initial value is GL_FALSE. See glLogicOp.
+ColorWritemask COLOR_WRITEMASK [4]bool : params returns four boolean values: the red, green, blue, and alpha write enables for the color buffers. The initial value is (GL_TRUE, GL_TRUE, GL_TRUE, GL_TRUE). See glColorMask.
+CopyReadBufferBinding COPY_READ_BUFFER Buffer : params returns a single value, the name of the buffer object currently bound to the target GL_COPY_READ_BUFFER. If no buffer object is bound to this target, 0 is returned. The initial value is 0. See glBindBuffer. GL_COPY_READ_BUFFER_BINDING is an alias of GL_COPY_READ_BUFFER added in OpenGL 4.2.
+CopyWriteBufferBinding COPY_WRITE_BUFFER Buffer : params returns a single value, the name of the buffer object currently bound to the target GL_COPY_WRITE_BUFFER. If no buffer object is bound to this target, 0 is returned. The initial value is 0. See glBindBuffer. GL_COPY_WRITE_BUFFER_BINDING is an alias of GL_COPY_WRITE_BUFFER added in OpenGL 4.2.
+CompressedTextureFormats COMPRESSED_TEXTURE_FORMATS []int32 len=NumCompressedTextureFormats : params returns a list of symbolic constants of length GL_NUM_COMPRESSED_TEXTURE_FORMATS indicating which compressed texture formats are available. See glCompressedTexImage2D.
+CullFace CULL_FACE bool : params returns a single boolean value indicating whether polygon culling is enabled. The initial value is GL_FALSE. See glCullFace.
+CullFaceMode CULL_FACE_MODE int32 : params returns a single value indicating the mode of polygon culling. The initial value is GL_BACK. See glCullFace.
+CurrentProgram CURRENT_PROGRAM Program : params returns one value, the name of the program object that is currently active, or 0 if no program object is active. See glUseProgram.
+DepthClearValue DEPTH_CLEAR_VALUE float32 : params returns one value, the value that is used to clear the depth buffer. Integer values, if requested, are linearly mapped from the internal floating-point representation such that 1.0 returns the most positive representable integer value, and -1.0 returns the most negative representable integer value. The initial value is 1. See glClearDepth.
+DepthFunc DEPTH_FUNC int32 : params returns one value, the symbolic constant that indicates the depth comparison function. The initial value is GL_LESS. See glDepthFunc.
+DepthRange DEPTH_RANGE [2]float32 : params returns two values: the near and far mapping limits for the depth buffer. Integer values, if requested, are linearly mapped from the internal floating-point representation such that 1.0 returns the most positive representable integer value, and -1.0 returns the most negative representable integer value. The initial value is (0, 1). See glDepthRange.
+DepthTest DEPTH_TEST bool : params returns a single boolean value indicating whether depth testing of fragments is enabled. The initial value is GL_FALSE. See glDepthFunc and glDepthRange.
+DepthWritemask DEPTH_WRITEMASK bool : params returns a single boolean value indicating if the depth buffer is enabled for writing. The initial value is GL_TRUE. See glDepthMask.
+Dither DITHER bool : params returns a single boolean value indicating whether dithering of fragment colors and indices is enabled. The initial value is GL_TRUE.
+Doublebuffer DOUBLEBUFFER bool : params returns a single boolean value indicating whether double buffering is supported.
+DrawBuffer DRAW_BUFFER int32 : params returns one value, a symbolic constant indicating which buffers are being drawn to. See glDrawBuffer. The initial value is GL_BACK if there are back buffers, otherwise it is GL_FRONT.
+DrawFramebufferBinding DRAW_FRAMEBUFFER_BINDING Framebuffer : params returns one value, the name of the framebuffer object currently bound to the GL_DRAW_FRAMEBUFFER target. If the default framebuffer is bound, this value will be zero. The initial value is zero. See glBindFramebuffer.
+ReadFramebufferBinding READ_FRAMEBUFFER_BINDING Framebuffer : params returns one value, the name of the framebuffer object currently bound to the GL_READ_FRAMEBUFFER target. If the default framebuffer is bound, this value will be zero. The initial value is zero. See glBindFramebuffer.
+ElementArrayBufferBinding ELEMENT_ARRAY_BUFFER_BINDING Buffer : params returns a single value, the name of the buffer object currently bound to the target GL_ELEMENT_ARRAY_BUFFER. If no buffer object is bound to this target, 0 is returned. The initial value is 0. See glBindBuffer.
+FrontFace FRONT_FACE int32 : params returns one value, a symbolic constant indicating whether clockwise or counterclockwise polygon winding is treated as front-facing. The initial value is GL_CCW. See glFrontFace.
+RenderbufferBinding RENDERBUFFER_BINDING RenderBuffer : params returns a single value, the name of the renderbuffer object currently bound to the target GL_RENDERBUFFER. If no renderbuffer object is bound to this target, 0 is returned. The initial value is 0. See glBindRenderbuffer.
+FragmentShaderDerivativeHint FRAGMENT_SHADER_DERIVATIVE_HINT int32 : params returns one value, a symbolic constant indicating the mode of the derivative accuracy hint for fragment shaders. The initial value is GL_DONT_CARE. See glHint.
+LineSmooth LINE_SMOOTH bool : params returns a single boolean value indicating whether antialiasing of lines is enabled. The initial value is GL_FALSE. See glLineWidth.
+LineSmoothHint LINE_SMOOTH_HINT int32 : params returns one value, a symbolic constant indicating the mode of the line antialiasing hint. The initial value is GL_DONT_CARE. See glHint.
+LineWidth LINE_WIDTH float32 : params returns one value, the line width as specified with glLineWidth. The initial value is 1.
+LogicOpMode LOGIC_OP_MODE int32 : params returns one value, a symbolic constant indicating the selected logic operation mode. The initial value is GL_COPY. See glLogicOp.
+Max3dTextureSize MAX_3D_TEXTURE_SIZE int64 : params returns one value, a rough estimate of the largest 3D texture that the GL can handle. The value must be at least 64. Use GL_PROXY_TEXTURE_3D to determine if a texture is too large. See glTexImage3D.
+MaxClipDistances MAX_CLIP_DISTANCES int32 : params returns one value, the maximum number of application-defined clipping distances. The value must be at least 8.
+MaxCombinedFragmentUniformComponents MAX_COMBINED_FRAGMENT_UNIFORM_COMPONENTS int32 : params returns one value, the number of words for fragment shader uniform variables in all uniform blocks (including default). The value must be at least 1. See glUniform.
+MaxCombinedTextureImageUnits MAX_COMBINED_TEXTURE_IMAGE_UNITS int32 : params returns one value, the maximum supported texture image units that can be used to access texture maps from the vertex shader and the fragment processor combined. If both the vertex shader and the fragment processing stage access the same texture image unit, then that counts as using two texture image units against this limit. The value must be at least 48. See glActiveTexture.
+MaxCombinedVertexUniformComponents MAX_COMBINED_VERTEX_UNIFORM_COMPONENTS int32 : params returns one value, the number of words for vertex shader uniform variables in all uniform blocks (including default). The value must be at least 1. See glUniform.
+MaxCombinedGeometryUniformComponents MAX_COMBINED_GEOMETRY_UNIFORM_COMPONENTS int32 : params returns one value, the number of words for geometry shader uniform variables in all uniform blocks (including default). The value must be at least 1. See glUniform.
+MaxVaryingComponents MAX_VARYING_COMPONENTS int32 : params returns one value, the number components for varying variables, which must be at least 60.
+MaxCombinedUniformBlocks MAX_COMBINED_UNIFORM_BLOCKS int32 : params returns one value, the maximum number of uniform blocks per program. The value must be at least 36. See glUniformBlockBinding.
+MaxCubeMapTextureSize MAX_CUBE_MAP_TEXTURE_SIZE int32 : params returns one value. The value gives a rough estimate of the largest cube-map texture that the GL can handle. The value must be at least 1024. Use GL_PROXY_TEXTURE_CUBE_MAP to determine if a texture is too large. See glTexImage2D.
+MaxDrawBuffers MAX_DRAW_BUFFERS int32 : params returns one value, the maximum number of simultaneous outputs that may be written in a fragment shader. The value must be at least 8. See glDrawBuffers.
+MaxDualSourceDrawBuffers MAX_DUAL_SOURCE_DRAW_BUFFERS int32 : params returns one value, the maximum number of active draw buffers when using dual-source blending. The value must be at least 1. See glBlendFunc and glBlendFuncSeparate.
+MaxElementsIndices MAX_ELEMENTS_INDICES int32 : params returns one value, the recommended maximum number of vertex array indices. See glDrawRangeElements.
+MaxElementsVertices MAX_ELEMENTS_VERTICES int32 : params returns one value, the recommended maximum number of vertex array vertices. See glDrawRangeElements.
+MaxFragmentUniformComponents MAX_FRAGMENT_UNIFORM_COMPONENTS int32 : params returns one value, the maximum number of individual floating-point, integer, or boolean values that can be held in uniform variable storage for a fragment shader. The value must be at least 1024. See glUniform.
+MaxFragmentUniformBlocks MAX_FRAGMENT_UNIFORM_BLOCKS int32 : params returns one value, the maximum number of uniform blocks per fragment shader. The value must be at least 12. See glUniformBlockBinding.
+MaxFragmentInputComponents MAX_FRAGMENT_INPUT_COMPONENTS int32 : params returns one value, the maximum number of components of the inputs read by the fragment shader, which must be at least 128.
+MinProgramTexelOffset MIN_PROGRAM_TEXEL_OFFSET int32 : params returns one value, the minimum texel offset allowed in a texture lookup, which must be at most -8.
+MaxProgramTexelOffset MAX_PROGRAM_TEXEL_OFFSET int32 : params returns one value, the maximum texel offset allowed in a texture lookup, which must be at least 7.
+MaxRectangleTextureSize MAX_RECTANGLE_TEXTURE_SIZE int32 : params returns one value. The value gives a rough estimate of the largest rectangular texture that the GL can handle. The value must be at least 1024. Use GL_PROXY_TEXTURE_RECTANGLE to determine if a texture is too large. See glTexImage2D.
+MaxTextureImageUnits MAX_TEXTURE_IMAGE_UNITS int32 : params returns one value, the maximum supported texture image units that can be used to access texture maps from the fragment shader. The value must be at least 16. See glActiveTexture.
+MaxTextureLodBias MAX_TEXTURE_LOD_BIAS float32 : params returns one value, the maximum, absolute value of the texture level-of-detail bias. The value must be at least 2.0.
+MaxTextureSize MAX_TEXTURE_SIZE int32 : params returns one value. The value gives a rough estimate of the largest texture that the GL can handle. The value must be at least 1024. Use a proxy texture target such as GL_PROXY_TEXTURE_1D or GL_PROXY_TEXTURE_2D to determine if a texture is too large. See glTexImage1D and glTexImage2D.
+MaxRenderbufferSize MAX_RENDERBUFFER_SIZE int32 : params returns one value. The value indicates the maximum supported size for renderbuffers. See glFramebufferRenderbuffer.
+MaxArrayTextureLayers MAX_ARRAY_TEXTURE_LAYERS int32 : params returns one value. The value indicates the maximum number of layers allowed in an array texture, and must be at least 256. See glTexImage2D.
+MaxTextureBufferSize MAX_TEXTURE_BUFFER_SIZE int32 : params returns one value. The value gives the maximum number of texels allowed in the texel array of a texture buffer object. Value must be at least 65536.
+MaxUniformBlockSize MAX_UNIFORM_BLOCK_SIZE int32 : params returns one value, the maximum size in basic machine units of a uniform block. The value must be at least 16384. See glUniformBlockBinding.
+MaxVaryingFloats MAX_VARYING_FLOATS int32 : params returns one value, the maximum number of interpolators available for processing varying variables used by vertex and fragment shaders. This value represents the number of individual floating-point values that can be interpolated; varying variables declared as vectors, matrices, and arrays will all consume multiple interpolators. The value must be at least 32.
+MaxVertexAttribs MAX_VERTEX_ATTRIBS int32 : params returns one value, the maximum number of 4-component generic vertex attributes accessible to a vertex shader. The value must be at least 16. See glVertexAttrib.
+MaxVertexTextureImageUnits MAX_VERTEX_TEXTURE_IMAGE_UNITS int32 : params returns one value, the maximum supported texture image units that can be used to access texture maps from the vertex shader. The value may be at least 16. See glActiveTexture.
+MaxGeometryTextureImageUnits MAX_GEOMETRY_TEXTURE_IMAGE_UNITS int32 : params returns one value, the maximum supported texture image units that can be used to access texture maps from the geometry shader. The value must be at least 16. See glActiveTexture.
+MaxVertexUniformComponents MAX_VERTEX_UNIFORM_COMPONENTS int32 : params returns one value, the maximum number of individual floating-point, integer, or boolean values that can be held in uniform variable storage for a vertex shader. The value must be at least 1024. See glUniform.
+MaxVertexOutputComponents MAX_VERTEX_OUTPUT_COMPONENTS int32 : params returns one value, the maximum number of components of output written by a vertex shader, which must be at least 64.
+MaxGeometryUniformComponents MAX_GEOMETRY_UNIFORM_COMPONENTS int32 : params returns one value, the maximum number of individual floating-point, integer, or boolean values that can be held in uniform variable storage for a geometry shader. The value must be at least 1024. See glUniform.
+MaxSampleMaskWords MAX_SAMPLE_MASK_WORDS int32 : params returns one value, the maximum number of sample mask words.
+MaxColorTextureSamples MAX_COLOR_TEXTURE_SAMPLES int32 : params returns one value, the maximum number of samples in a color multisample texture.
+MaxDepthTextureSamples MAX_DEPTH_TEXTURE_SAMPLES int32 : params returns one value, the maximum number of samples in a multisample depth or depth-stencil texture.
+MaxIntegerSamples MAX_INTEGER_SAMPLES int32 : params returns one value, the maximum number of samples supported in integer format multisample buffers.
+MaxServerWaitTimeout MAX_SERVER_WAIT_TIMEOUT int32 : params returns one value, the maximum glWaitSync timeout interval.
+MaxUniformBufferBindings MAX_UNIFORM_BUFFER_BINDINGS int32 : params returns one value, the maximum number of uniform buffer binding points on the context, which must be at least 36.
+UniformBufferOffsetAlignment UNIFORM_BUFFER_OFFSET_ALIGNMENT int32 : params returns one value, the minimum required alignment for uniform buffer sizes and offsets.
+MaxVertexUniformBlocks MAX_VERTEX_UNIFORM_BLOCKS int32 : params returns one value, the maximum number of uniform blocks per vertex shader. The value must be at least 12. See glUniformBlockBinding.
+MaxGeometryUniformBlocks MAX_GEOMETRY_UNIFORM_BLOCKS int32 : params returns one value, the maximum number of uniform blocks per geometry shader. The value must be at least 12. See glUniformBlockBinding.
+MaxGeometryInputComponents MAX_GEOMETRY_INPUT_COMPONENTS int32 : params returns one value, the maximum number of components of inputs read by a geometry shader, which must be at least 64.
+MaxGeometryOutputComponents MAX_GEOMETRY_OUTPUT_COMPONENTS int32 : params returns one value, the maximum number of components of outputs written by a geometry shader, which must be at least 128.
+MaxViewportDims MAX_VIEWPORT_DIMS [2]int32 : params returns two values: the maximum supported width and height of the viewport. These must be at least as large as the visible dimensions of the display being rendered to. See glViewport.
+NumCompressedTextureFormats NUM_COMPRESSED_TEXTURE_FORMATS int32 : params returns a single integer value indicating the number of available compressed texture formats. The minimum value is 4. See glCompressedTexImage2D.
+PackAlignment PACK_ALIGNMENT int32 : params returns one value, the byte alignment used for writing pixel data to memory. The initial value is 4. See glPixelStore.
+PackImageHeight PACK_IMAGE_HEIGHT int32 : params returns one value, the image height used for writing pixel data to memory. The initial value is 0. See glPixelStore.
+PackLsbFirst PACK_LSB_FIRST bool : params returns a single boolean value indicating whether single-bit pixels being written to memory are written first to the least significant bit of each unsigned byte. The initial value is GL_FALSE. See glPixelStore.
+PackRowLength PACK_ROW_LENGTH int32 : params returns one value, the row length used for writing pixel data to memory. The initial value is 0. See glPixelStore.
+PackSkipImages PACK_SKIP_IMAGES int32 : params returns one value, the number of pixel images skipped before the first pixel is written into memory. The initial value is 0. See glPixelStore.
+PackSkipPixels PACK_SKIP_PIXELS int32 : params returns one value, the number of pixel locations skipped before the first pixel is written into memory. The initial value is 0. See glPixelStore.
+PackSkipRows PACK_SKIP_ROWS int32 : params returns one value, the number of rows of pixel locations skipped before the first pixel is written into memory. The initial value is 0. See glPixelStore.
+PackSwapBytes PACK_SWAP_BYTES bool : params returns a single boolean value indicating whether the bytes of two-byte and four-byte pixel indices and components are swapped before being written to memory. The initial value is GL_FALSE. See glPixelStore.
+PixelPackBufferBinding PIXEL_PACK_BUFFER_BINDING Buffer : params returns a single value, the name of the buffer object currently bound to the target GL_PIXEL_PACK_BUFFER. If no buffer object is bound to this target, 0 is returned. The initial value is 0. See glBindBuffer.
+PixelUnpackBufferBinding PIXEL_UNPACK_BUFFER_BINDING Buffer : params returns a single value, the name of the buffer object currently bound to the target GL_PIXEL_UNPACK_BUFFER. If no buffer object is bound to this target, 0 is returned. The initial value is 0. See glBindBuffer.
+PointFadeThresholdSize POINT_FADE_THRESHOLD_SIZE float32 : params returns one value, the point size threshold for determining the point size. See glPointParameter.
+PrimitiveRestartIndex PRIMITIVE_RESTART_INDEX int32 : params returns one value, the current primitive restart index. The initial value is 0. See glPrimitiveRestartIndex.
+ProgramPointSize PROGRAM_POINT_SIZE bool : params returns a single boolean value indicating whether vertex program point size mode is enabled. If enabled, then the point size is taken from the shader built-in gl_PointSize. If disabled, then the point size is taken from the point state as specified by glPointSize. The initial value is GL_FALSE.
+ProvokingVertex PROVOKING_VERTEX int32 : params returns one value, the currently selected provoking vertex convention. The initial value is GL_LAST_VERTEX_CONVENTION. See glProvokingVertex.
+PointSize POINT_SIZE float32 : params returns one value, the point size as specified by glPointSize. The initial value is 1.
+PointSizeGranularity POINT_SIZE_GRANULARITY float32 : params returns one value, the size difference between adjacent supported sizes for antialiased points. See glPointSize.
+PointSizeRange POINT_SIZE_RANGE [2]float32 : params returns two values: the smallest and largest supported sizes for antialiased points. The smallest size must be at most 1, and the largest size must be at least 1. See glPointSize.
+PolygonMode POLYGON_MODE [2]int32 : params returns two values: symbolic constants indicating whether front-facing and back-facing polygons are rasterized as points, lines, or filled polygons. The initial value is GL_FILL. See glPolygonMode.
+PolygonOffsetFactor POLYGON_OFFSET_FACTOR float32 : params returns one value, the scaling factor used to determine the variable offset that is added to the depth value of each fragment generated when a polygon is rasterized. The initial value is 0. See glPolygonOffset.
+PolygonOffsetUnits POLYGON_OFFSET_UNITS float32 : params returns one value. This value is multiplied by an implementation-specific value and then added to the depth value of each fragment generated when a polygon is rasterized. The initial value is 0. See glPolygonOffset.
+PolygonOffsetFill POLYGON_OFFSET_FILL bool : params returns a single boolean value indicating whether polygon offset is enabled for polygons in fill mode. The initial value is GL_FALSE. See glPolygonOffset.
+PolygonOffsetLine POLYGON_OFFSET_LINE bool : params returns a single boolean value indicating whether polygon offset is enabled for polygons in line mode. The initial value is GL_FALSE. See glPolygonOffset.
+PolygonOffsetPoint POLYGON_OFFSET_POINT bool : params returns a single boolean value indicating whether polygon offset is enabled for polygons in point mode. The initial value is GL_FALSE. See glPolygonOffset.
+PolygonSmooth POLYGON_SMOOTH bool : params returns a single boolean value indicating whether antialiasing of polygons is enabled. The initial value is GL_FALSE. See glPolygonMode.
+PolygonSmoothHint POLYGON_SMOOTH_HINT int32 : params returns one value, a symbolic constant indicating the mode of the polygon antialiasing hint. The initial value is GL_DONT_CARE. See glHint.
+ReadBuffer READ_BUFFER int32 : params returns one value, a symbolic constant indicating which color buffer is selected for reading. The initial value is GL_BACK if there is a back buffer, otherwise it is GL_FRONT. See glReadPixels.
+SampleBuffers SAMPLE_BUFFERS int32 : params returns a single integer value indicating the number of sample buffers associated with the framebuffer. See glSampleCoverage.
+SampleCoverageValue SAMPLE_COVERAGE_VALUE float32 : params returns a single positive floating-point value indicating the current sample coverage value. See glSampleCoverage.
+SampleCoverageInvert SAMPLE_COVERAGE_INVERT bool : params returns a single boolean value indicating if the temporary coverage value should be inverted. See glSampleCoverage.
+SamplerBinding SAMPLER_BINDING int32 : params returns a single value, the name of the sampler object currently bound to the active texture unit. The initial value is 0. See glBindSampler.
+Samples SAMPLES int32 : params returns a single integer value indicating the coverage mask size. See glSampleCoverage.
+ScissorBox SCISSOR_BOX [4]int32 : params returns four values: the x and y window coordinates of the scissor box, followed by its width and height. Initially the x and y window coordinates are both 0 and the width and height are set to the size of the window. See glScissor.
+ScissorTest SCISSOR_TEST bool : params returns a single boolean value indicating whether scissoring is enabled. The initial value is GL_FALSE. See glScissor.
+StencilBackFail STENCIL_BACK_FAIL int32 : params returns one value, a symbolic constant indicating what action is taken for back-facing polygons when the stencil test fails. The initial value is GL_KEEP. See glStencilOpSeparate.
+StencilBackFunc STENCIL_BACK_FUNC int32 : params returns one value, a symbolic constant indicating what function is used for back-facing polygons to compare the stencil reference value with the stencil buffer value. The initial value is GL_ALWAYS. See glStencilFuncSeparate.
+StencilBackPassDepthFail STENCIL_BACK_PASS_DEPTH_FAIL int32 : params returns one value, a symbolic constant indicating what action is taken for back-facing polygons when the stencil test passes, but the depth test fails. The initial value is GL_KEEP. See glStencilOpSeparate.
+StencilBackPassDepthPass STENCIL_BACK_PASS_DEPTH_PASS int32 : params returns one value, a symbolic constant indicating what action is taken for back-facing polygons when the stencil test passes and the depth test passes. The initial value is GL_KEEP. See glStencilOpSeparate.
+StencilBackRef STENCIL_BACK_REF int32 : params returns one value, the reference value that is compared with the contents of the stencil buffer for back-facing polygons. The initial value is 0. See glStencilFuncSeparate.
+StencilBackValueMask STENCIL_BACK_VALUE_MASK int32 : params returns one value, the mask that is used for back-facing polygons to mask both the stencil reference value and the stencil buffer value before they are compared. The initial value is all 1's. See glStencilFuncSeparate.
+StencilBackWritemask STENCIL_BACK_WRITEMASK int32 : params returns one value, the mask that controls writing of the stencil bitplanes for back-facing polygons. The initial value is all 1's. See glStencilMaskSeparate.
+StencilClearValue STENCIL_CLEAR_VALUE int32 : params returns one value, the index to which the stencil bitplanes are cleared. The initial value is 0. See glClearStencil.
+StencilFail STENCIL_FAIL int32 : params returns one value, a symbolic constant indicating what action is taken when the stencil test fails. The initial value is GL_KEEP. See glStencilOp. This stencil state only affects non-polygons and front-facing polygons. Back-facing polygons use separate stencil state. See glStencilOpSeparate.
+StencilFunc STENCIL_FUNC int32 : params returns one value, a symbolic constant indicating what function is used to compare the stencil reference value with the stencil buffer value. The initial value is GL_ALWAYS. See glStencilFunc. This stencil state only affects non-polygons and front-facing polygons. Back-facing polygons use separate stencil state. See glStencilFuncSeparate.
+StencilPassDepthFail STENCIL_PASS_DEPTH_FAIL int32 : params returns one value, a symbolic constant indicating what action is taken when the stencil test passes, but the depth test fails. The initial value is GL_KEEP. See glStencilOp. This stencil state only affects non-polygons and front-facing polygons. Back-facing polygons use separate stencil state. See glStencilOpSeparate.
+StencilPassDepthPass STENCIL_PASS_DEPTH_PASS int32 : params returns one value, a symbolic constant indicating what action is taken when the stencil test passes and the depth test passes. The initial value is GL_KEEP. See glStencilOp. This stencil state only affects non-polygons and front-facing polygons. Back-facing polygons use separate stencil state. See glStencilOpSeparate.
+StencilRef STENCIL_REF int32 : params returns one value, the reference value that is compared with the contents of the stencil buffer. The initial value is 0. See glStencilFunc. This stencil state only affects non-polygons and front-facing polygons. Back-facing polygons use separate stencil state. See glStencilFuncSeparate.
+StencilTest STENCIL_TEST bool : params returns a single boolean value indicating whether stencil testing of fragments is enabled. The initial value is GL_FALSE. See glStencilFunc and glStencilOp.
+StencilValueMask STENCIL_VALUE_MASK int32 : params returns one value, the mask that is used to mask both the stencil reference value and the stencil buffer value before they are compared. The initial value is all 1's. See glStencilFunc. This stencil state only affects non-polygons and front-facing polygons. Back-facing polygons use separate stencil state. See glStencilFuncSeparate.
+StencilWritemask STENCIL_WRITEMASK int32 : params returns one value, the mask that controls writing of the stencil bitplanes. The initial value is all 1's. See glStencilMask. This stencil state only affects non-polygons and front-facing polygons. Back-facing polygons use separate stencil state. See glStencilMaskSeparate.
+Stereo STEREO bool : params returns a single boolean value indicating whether stereo buffers (left and right) are supported.
+SubpixelBits SUBPIXEL_BITS int32 : params returns one value, an estimate of the number of bits of subpixel resolution that are used to position rasterized geometry in window coordinates. The value must be at least 4.
+TextureBinding1D TEXTURE_BINDING_1D Texture : params returns a single value, the name of the texture currently bound to the target GL_TEXTURE_1D. The initial value is 0. See glBindTexture.
+TextureBinding1DArray TEXTURE_BINDING_1D_ARRAY Texture : params returns a single value, the name of the texture currently bound to the target GL_TEXTURE_1D_ARRAY. The initial value is 0. See glBindTexture.
+TextureBinding2D TEXTURE_BINDING_2D Texture : params returns a single value, the name of the texture currently bound to the target GL_TEXTURE_2D. The initial value is 0. See glBindTexture.
+TextureBinding2DArray TEXTURE_BINDING_2D_ARRAY Texture : params returns a single value, the name of the texture currently bound to the target GL_TEXTURE_2D_ARRAY. The initial value is 0. See glBindTexture.
+TextureBinding2DMultisample TEXTURE_BINDING_2D_MULTISAMPLE Texture : params returns a single value, the name of the texture currently bound to the target GL_TEXTURE_2D_MULTISAMPLE. The initial value is 0. See glBindTexture.
+TextureBinding2DMultisampleArray TEXTURE_BINDING_2D_MULTISAMPLE_ARRAY Texture : params returns a single value, the name of the texture currently bound to the target GL_TEXTURE_2D_MULTISAMPLE_ARRAY. The initial value is 0. See glBindTexture.
+TextureBinding3D TEXTURE_BINDING_3D Texture : params returns a single value, the name of the texture currently bound to the target GL_TEXTURE_3D. The initial value is 0. See glBindTexture.
+TextureBindingBuffer TEXTURE_BINDING_BUFFER Texture : params returns a single value, the name of the texture currently bound to the target GL_TEXTURE_BUFFER. The initial value is 0. See glBindTexture.
+TextureBindingCubeMap TEXTURE_BINDING_CUBE_MAP Texture : params returns a single value, the name of the texture currently bound to the target GL_TEXTURE_CUBE_MAP. The initial value is 0. See glBindTexture.
+TextureBindingRectangle TEXTURE_BINDING_RECTANGLE Texture : params returns a single value, the name of the texture currently bound to the target GL_TEXTURE_RECTANGLE. The initial value is 0. See glBindTexture.
+TextureCompressionHint TEXTURE_COMPRESSION_HINT int32 : params returns a single value indicating the mode of the texture compression hint. The initial value is GL_DONT_CARE.
+Timestamp TIMESTAMP int64 : params returns a single value, the 64-bit value of the current GL time. See glQueryCounter.
+TransformFeedbackBufferBinding TRANSFORM_FEEDBACK_BUFFER_BINDING Buffer : When used with non-indexed variants of glGet (such as glGetIntegerv), params returns a single value, the name of the buffer object currently bound to the target GL_TRANSFORM_FEEDBACK_BUFFER. If no buffer object is bound to this target, 0 is returned. When used with indexed variants of glGet (such as glGetIntegeri_v), params returns a single value, the name of the buffer object bound to the indexed transform feedback attribute stream. The initial value is 0 for all targets. See glBindBuffer, glBindBufferBase, and glBindBufferRange.
+IndexedTransformFeedbackBufferBinding TRANSFORM_FEEDBACK_BUFFER_BINDING Buffer indexed : When used with indexed variants of glGet (such as glGetIntegeri_v), params returns a single value, the name of the buffer object bound to the indexed transform feedback attribute stream. The initial value is 0 for all streams. See glBindBufferBase and glBindBufferRange.
+TransformFeedbackBinding TRANSFORM_FEEDBACK_BINDING TransformFeedback : params returns a single value, the name of the transform feedback object currently bound to the GL_TRANSFORM_FEEDBACK target. The initial value is 0. See glBindTransformFeedback.
+TransformFeedbackBufferStart TRANSFORM_FEEDBACK_BUFFER_START int64 indexed : When used with indexed variants of glGet (such as glGetInteger64i_v), params returns a single value, the start offset of the binding range for each transform feedback attribute stream. The initial value is 0 for all streams. See glBindBufferRange.
+TransformFeedbackBufferSize TRANSFORM_FEEDBACK_BUFFER_SIZE int64 indexed : When used with indexed variants of glGet (such as glGetInteger64i_v), params returns a single value, the size of the binding range for each transform feedback attribute stream. The initial value is 0 for all streams. See glBindBufferRange.
+UniformBufferBinding UNIFORM_BUFFER_BINDING Buffer : When used with non-indexed variants of glGet (such as glGetIntegerv), params returns a single value, the name of the buffer object currently bound to the target GL_UNIFORM_BUFFER. If no buffer object is bound to this target, 0 is returned. When used with indexed variants of glGet (such as glGetIntegeri_v), params returns a single value, the name of the buffer object bound to the indexed uniform buffer binding point. The initial value is 0 for all targets. See glBindBuffer, glBindBufferBase, and glBindBufferRange.
+IndexedUniformBufferBinding UNIFORM_BUFFER_BINDING Buffer indexed : When used with indexed variants of glGet (such as glGetIntegeri_v), params returns a single value, the name of the buffer object bound to the indexed uniform buffer binding point. The initial value is 0 for all binding points. See glBindBufferBase and glBindBufferRange.
+UniformBufferStart UNIFORM_BUFFER_START int64 indexed : When used with indexed variants of glGet (such as glGetInteger64i_v), params returns a single value, the start offset of the binding range for each indexed uniform buffer binding. The initial value is 0 for all bindings. See glBindBufferRange.
+UniformBufferSize UNIFORM_BUFFER_SIZE int64 indexed : When used with indexed variants of glGet (such as glGetInteger64i_v), params returns a single value, the size of the binding range for each indexed uniform buffer binding. The initial value is 0 for all bindings. See glBindBufferRange.
+UnpackAlignment UNPACK_ALIGNMENT int32 : params returns one value, the byte alignment used for reading pixel data from memory. The initial value is 4. See glPixelStore.
+UnpackImageHeight UNPACK_IMAGE_HEIGHT int32 : params returns one value, the image height used for reading pixel data from memory. The initial is 0. See glPixelStore.
+UnpackLsbFirst UNPACK_LSB_FIRST bool : params returns a single boolean value indicating whether single-bit pixels being read from memory are read first from the least significant bit of each unsigned byte. The initial value is GL_FALSE. See glPixelStore.
+UnpackRowLength UNPACK_ROW_LENGTH int32 : params returns one value, the row length used for reading pixel data from memory. The initial value is 0. See glPixelStore.
+UnpackSkipImages UNPACK_SKIP_IMAGES int32 : params returns one value, the number of pixel images skipped before the first pixel is read from memory. The initial value is 0. See glPixelStore.
+UnpackSkipPixels UNPACK_SKIP_PIXELS int32 : params returns one value, the number of pixel locations skipped before the first pixel is read from memory. The initial value is 0. See glPixelStore.
+UnpackSkipRows UNPACK_SKIP_ROWS int32 : params returns one value, the number of rows of pixel locations skipped before the first pixel is read from memory. The initial value is 0. See glPixelStore.
+UnpackSwapBytes UNPACK_SWAP_BYTES bool : params returns a single boolean value indicating whether the bytes of two-byte and four-byte pixel indices and components are swapped after being read from memory. The initial value is GL_FALSE. See glPixelStore.
+VertexArrayBinding VERTEX_ARRAY_BINDING VertexArray : params returns a single value, the name of the vertex array object currently bound to the context. If no vertex array object is bound to the context, 0 is returned. The initial value is 0. See glBindVertexArray.
+NumExtensions NUM_EXTENSIONS int32 : params returns one value, the number of extensions supported by the GL implementation for the current context. See glGetString.
+MajorVersion MAJOR_VERSION int32 : params returns one value, the major version number of the OpenGL API supported by the current context.
+MinorVersion MINOR_VERSION int32 : params returns one value, the minor version number of the OpenGL API supported by the current context.
+ContextFlags CONTEXT_FLAGS int32 : params returns one value, the flags with which the context was created (such as debugging functionality).
+Viewport VIEWPORT [4]int32 : params returns four values: the x and y window coordinates of the viewport, followed by its width and height. Initially the x and y window coordinates are both set to 0, and the width and height are set to the width and height of the window into which the GL will do its rendering. See glViewport.
+
+[Shader]
+GetShaderType SHADER_TYPE int32 : GetShaderType returns this shaders shader type.
+GetDeleteStatus DELETE_STATUS bool : GetDeleteStatus returns true if shader is currently flagged for deletion, and false otherwise.
+GetCompileStatus COMPILE_STATUS bool : GetCompileStatus returns true if the last compile operation on shader was successful, and false otherwise.
+GetInfoLogLength INFO_LOG_LENGTH int : GetInfoLogLength returns the number of characters in the information log for shader including the null termination character (i.e., the size of the character buffer required to store the information log). If shader has no information log, a value of 0 is returned.
+GetShaderSourceLength SHADER_SOURCE_LENGTH int : GetShaderSourceLength returns the length of the concatenation of the source strings that make up the shader source for the shader, including the null termination character. (i.e., the size of the character buffer required to store the shader source). If no source code exists, 0 is returned.
+
+[Program]
+GetDeleteStatus DELETE_STATUS bool : GetDeleteStatus returns true if program is currently flagged for deletion, and false otherwise.
+GetLinkStatus LINK_STATUS bool : GetLinkStatus returns true if the last link operation on program was successful, and false otherwise.
+GetValidateStatus VALIDATE_STATUS bool : GetValidateStatus returns true or if the last validation operation on program was successful, and false otherwise.
+GetInfoLogLength INFO_LOG_LENGTH int : GetInfoLogLength returns the number of characters in the information log for program including the null termination character (i.e., the size of the character buffer required to store the information log). If program has no information log, a value of 0 is returned.
+GetNumAttachedShaders ATTACHED_SHADERS int : GetNumAttachedShaders returns the number of shader objects attached to program.
+GetActiveAttributes ACTIVE_ATTRIBUTES int : GetActiveAttributes returns the number of active attribute variables for program.
+GetActiveAttributeMaxLength ACTIVE_ATTRIBUTE_MAX_LENGTH int : GetActiveAttributeMaxLength returns the length of the longest active attribute name for program, including the null termination character (i.e., the size of the character buffer required to store the longest attribute name). If no active attributes exist, 0 is returned.
+GetNumActiveUniforms ACTIVE_UNIFORMS int : GetNumActiveUniforms returns the number of active uniform variables for program.
+GetActiveUniformMaxLength ACTIVE_UNIFORM_MAX_LENGTH int : GetActiveUniformMaxLength returns the length of the longest active uniform variable name for program, including the null termination character (i.e., the size of the character buffer required to store the longest uniform variable name). If no active uniform variables exist, 0 is returned.
+GetTransformFeedbackBufferMode TRANSFORM_FEEDBACK_BUFFER_MODE int32 : GetTransformFeedbackBufferMode returns a symbolic constant indicating the buffer mode used when transform feedback is active. This may be GL_SEPARATE_ATTRIBS or GL_INTERLEAVED_ATTRIBS.
+GetNumTransformFeedbackVaryings TRANSFORM_FEEDBACK_VARYINGS int : GetNumTransformFeedbackVaryings returns the number of varying variables to capture in transform feedback mode for the program.
+GetTransformFeedbackVaryingMaxLength TRANSFORM_FEEDBACK_VARYING_MAX_LENGTH int : GetTransformFeedbackVaryingMaxLength returns the length of the longest variable name to be used for transform feedback, including the null-terminator.
+GetNumGeometryVerticesOut GEOMETRY_VERTICES_OUT int : GetNumGeometryVerticesOut returns the maximum number of vertices that the geometry shader in program will output.
+GetGeometryInputType GEOMETRY_INPUT_TYPE int32 : GetGeometryInputType returns a symbolic constant indicating the primitive type accepted as input to the geometry shader contained in program.
+GetGeometryOutputType GEOMETRY_OUTPUT_TYPE int32 : GetGeometryOutputType returns a symbolic constant indicating the primitive type that will be output by the geometry shader contained in program.
+
+[Texture2D]
+GetBaseLevel TEXTURE_BASE_LEVEL int32 : 
+GetBorderColor TEXTURE_BORDER_COLOR [4]float32 : 
+GetCompareMode TEXTURE_COMPARE_MODE int32 : 
+GetCompareFunc TEXTURE_COMPARE_FUNC int32 : 
+GetLODBias TEXTURE_LOD_BIAS float32 : 
+GetMagFilter TEXTURE_MAG_FILTER int32 : 
+GetMaxLevel TEXTURE_MAX_LEVEL int32 : 
+GetMaxLOD TEXTURE_MAX_LOD int32 : 
+GetMinFilter TEXTURE_MIN_FILTER int32 : 
+GetMinLOD TEXTURE_MIN_LOD int32 : 
+GetSwizzleR TEXTURE_SWIZZLE_R int32 : 
+GetSwizzleG TEXTURE_SWIZZLE_G int32 : 
+GetSwizzleB TEXTURE_SWIZZLE_B int32 : 
+GetSwizzleA TEXTURE_SWIZZLE_A int32 : 
+GetSwizzleRGBA TEXTURE_SWIZZLE_RGBA [4]int32 : 
+GetWrapS TEXTURE_WRAP_S int32 : 
+GetWrapT TEXTURE_WRAP_T int32 : 
+GetWrapR TEXTURE_WRAP_R int32 : 
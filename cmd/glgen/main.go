@@ -0,0 +1,248 @@
+// Command glgen generates the parts of package gl that are derived from the
+// Khronos OpenGL registry, gl.xml: the constants and their enum types, the
+// functions every object type has, the getters of the objects and of GetObj,
+// the methods of Backend and the go-gl backend of every profile. Each profile
+// only gets the functions whose enums and commands it has. It is run by go
+// generate from the root of the package:
+//
+//	go generate
+//
+// The registry is read from cmd/glgen/gl.xml, vendored so that the generated
+// code only changes when the registry is updated on purpose. Pass -registry
+// with a path or URL to use another one, the URL of a commit of the Khronos
+// repository rather than of a branch that moves.
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+)
+
+const defaultRegistry = "cmd/glgen/gl.xml"
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("glgen: ")
+	registryPath := flag.String("registry", defaultRegistry, "path or URL of gl.xml")
+	enumsSpec := flag.String("enums", "cmd/glgen/enums.spec", "mapping of the Go enum types to gl.xml groups")
+	constsPath := flag.String("consts", "const.go", "constants of the package, rewritten in place with their types")
+	enumsPath := flag.String("out-enums", "enums.go", "output file of the enum types")
+	objectsSpec := flag.String("objects", "cmd/glgen/objects.spec", "objects whose functions are generated")
+	getSpec := flag.String("getters", "cmd/glgen/get.spec", "getters of GetObj and of the objects")
+	backendSpec := flag.String("backend", "cmd/glgen/backend.spec", "methods of Backend and the go-gl calls they make")
+	dir := flag.String("dir", ".", "directory of package gl, where the wrappers are written")
+	flag.Parse()
+
+	reg, err := loadRegistry(*registryPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	spec, err := readEnumSpec(*enumsSpec)
+	if err != nil {
+		log.Fatal(err)
+	}
+	consts, err := readConsts(*constsPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	enums := buildEnums(reg, spec, consts)
+	if err := writeGo(*constsPath, genConsts(consts, enums)); err != nil {
+		log.Fatal(err)
+	}
+	if err := writeGo(*enumsPath, genEnums(enums)); err != nil {
+		log.Fatal(err)
+	}
+
+	objs, err := readObjectSpec(*objectsSpec)
+	if err != nil {
+		log.Fatal(err)
+	}
+	getters, err := readGetterSpec(*getSpec)
+	if err != nil {
+		log.Fatal(err)
+	}
+	g := &wrapperGen{reg: reg, avail: reg.features(), typ: types(enums)}
+	objects, err := g.objects(objs, getters)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := objects.write(*dir, ""); err != nil {
+		log.Fatal(err)
+	}
+	get, err := g.getters(getters)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := get.write(*dir, getPrelude); err != nil {
+		log.Fatal(err)
+	}
+
+	methods, err := readBackendSpec(*backendSpec)
+	if err != nil {
+		log.Fatal(err)
+	}
+	points, backends, err := g.backends(methods)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := points.write(*dir); err != nil {
+		log.Fatal(err)
+	}
+	for _, b := range backends {
+		if err := b.write(*dir); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// registry is the subset of gl.xml glgen uses.
+type registry struct {
+	Enums []struct {
+		Enums []registryEnum `xml:"enum"`
+	} `xml:"enums"`
+	// Groups is the <groups> block of the older registries, newer ones put
+	// the groups in the group attribute of every enum.
+	Groups []struct {
+		Name  string `xml:"name,attr"`
+		Enums []struct {
+			Name string `xml:"name,attr"`
+		} `xml:"enum"`
+	} `xml:"groups>group"`
+	Features []struct {
+		API     string        `xml:"api,attr"`
+		Number  string        `xml:"number,attr"`
+		Require []featureList `xml:"require"`
+		Remove  []featureList `xml:"remove"`
+	} `xml:"feature"`
+	Extensions []registryExtension `xml:"extensions>extension"`
+}
+
+type registryExtension struct {
+	Name      string        `xml:"name,attr"`
+	Supported string        `xml:"supported,attr"` // APIs, like gl|glcore
+	Require   []featureList `xml:"require"`
+}
+
+// featureList is a <require> or <remove> block of a feature.
+type featureList struct {
+	Profile string `xml:"profile,attr"`
+	API     string `xml:"api,attr"` // API of the block in an extension
+	Enums   []struct {
+		Name string `xml:"name,attr"`
+	} `xml:"enum"`
+	Commands []struct {
+		Name string `xml:"name,attr"`
+	} `xml:"command"`
+}
+
+// names returns the enums and commands of l without their GL_ and gl
+// prefixes. Enum and command names can't collide, enums are in capitals.
+func (l featureList) names() []string {
+	var names []string
+	for _, e := range l.Enums {
+		names = append(names, strings.TrimPrefix(e.Name, "GL_"))
+	}
+	for _, c := range l.Commands {
+		names = append(names, strings.TrimPrefix(c.Name, "gl"))
+	}
+	return names
+}
+
+type registryEnum struct {
+	Name   string `xml:"name,attr"`
+	Value  string `xml:"value,attr"`
+	API    string `xml:"api,attr"`
+	Groups string `xml:"group,attr"`
+}
+
+func loadRegistry(path string) (*registry, error) {
+	var r io.Reader
+	if strings.HasPrefix(path, "https://") || strings.HasPrefix(path, "http://") {
+		resp, err := http.Get(path)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("%s: %s", path, resp.Status)
+		}
+		r = resp.Body
+	} else {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+	var reg registry
+	if err := xml.NewDecoder(r).Decode(&reg); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return &reg, nil
+}
+
+// groups returns the members of every group of the registry, without their
+// GL_ prefix, in registry order.
+func (reg *registry) groups() map[string][]string {
+	groups := map[string][]string{}
+	seen := map[string]bool{}
+	add := func(group, name string) {
+		name = strings.TrimPrefix(name, "GL_")
+		if seen[group+" "+name] {
+			return
+		}
+		seen[group+" "+name] = true
+		groups[group] = append(groups[group], name)
+	}
+	for _, g := range reg.Groups {
+		for _, e := range g.Enums {
+			add(g.Name, e.Name)
+		}
+	}
+	for _, block := range reg.Enums {
+		for _, e := range block.Enums {
+			if e.Groups == "" {
+				continue
+			}
+			for _, g := range strings.Split(e.Groups, ",") {
+				add(g, e.Name)
+			}
+		}
+	}
+	return groups
+}
+
+// values returns the value of every enum of the desktop API, without their
+// GL_ prefix.
+func (reg *registry) values() map[string]string {
+	values := map[string]string{}
+	for _, block := range reg.Enums {
+		for _, e := range block.Enums {
+			if e.API != "" && e.API != "gl" {
+				continue
+			}
+			values[strings.TrimPrefix(e.Name, "GL_")] = e.Value
+		}
+	}
+	return values
+}
+
+func writeGo(path string, src []byte) error {
+	out, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	if old, err := os.ReadFile(path); err == nil && bytes.Equal(old, out) {
+		return nil
+	}
+	return os.WriteFile(path, out, 0o644)
+}
@@ -0,0 +1,30 @@
+# The OpenGL objects whose Gen, Delete, Bind and Unbind functions are generated
+# into objects.go, one per line:
+#
+#	Type key=value ...
+#
+#	gl      the name of the object in the OpenGL commands, glGen<gl>s.
+#	plural  the name of the function generating several objects, GenTypes by
+#	        default.
+#	recv    the name of the receiver.
+#	kind    the objectKind of the safety checks.
+#	target  the target of glBind<gl>: an enum type makes it a parameter of
+#	        Bind and Unbind, a constant is always passed.
+#	binding the binding point of the safety checks when glBind<gl> has no
+#	        target.
+#	is      generate the Is<gl> method.
+
+Buffer            gl=Buffer            recv=b   kind=kindBuffer            target=BufferTarget
+Texture           gl=Texture           recv=t   kind=kindTexture           target=TextureTarget is
+Texture2D         gl=Texture           recv=t   kind=kindTexture           target=TEXTURE_2D plural=Textures2D
+Framebuffer       gl=Framebuffer       recv=fbo kind=kindFramebuffer       target=FramebufferTarget
+RenderBuffer      gl=Renderbuffer      recv=rb  kind=kindRenderBuffer      target=RENDERBUFFER
+VertexArray       gl=VertexArray       recv=vao kind=kindVertexArray       binding=VERTEX_ARRAY_BINDING
+TransformFeedback gl=TransformFeedback recv=tf  kind=kindTransformFeedback target=TRANSFORM_FEEDBACK
+ArrayBuffer             gl=Buffer recv=b kind=kindBuffer target=ARRAY_BUFFER
+UniformBuffer           gl=Buffer recv=b kind=kindBuffer target=UNIFORM_BUFFER
+PixelPackBuffer         gl=Buffer recv=b kind=kindBuffer target=PIXEL_PACK_BUFFER
+PixelUnpackBuffer       gl=Buffer recv=b kind=kindBuffer target=PIXEL_UNPACK_BUFFER
+CopyReadBuffer          gl=Buffer recv=b kind=kindBuffer target=COPY_READ_BUFFER
+TextureBuffer           gl=Buffer recv=b kind=kindBuffer target=TEXTURE_BUFFER
+TransformFeedbackBuffer gl=Buffer recv=b kind=kindBuffer target=TRANSFORM_FEEDBACK_BUFFER
@@ -0,0 +1,234 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// profile is an OpenGL profile package gl is built for.
+type profile struct {
+	Name    string // value of gl.Profile
+	API     string // api attribute of the gl.xml features
+	Version string // last feature included
+	Core    bool   // drop what the core profile removes
+	// Extensions are included too, the go-gl bindings of the profile load
+	// them and every driver of the version has them.
+	Extensions []string
+
+	File       string // go-gl backend of the profile
+	Constraint string // build constraint selecting the profile
+	Import     string // import of the go-gl package, named gl
+}
+
+var profiles = []profile{
+	{
+		Name: "3.3-core", API: "gl", Version: "3.3", Core: true, Extensions: []string{"GL_ARB_transform_feedback2"},
+		File: "backend_gl33.go", Constraint: "!gl41 && !gl45 && !gles30", Import: `"github.com/go-gl/gl/v3.3-core/gl"`,
+	},
+	{
+		Name: "4.1-core", API: "gl", Version: "4.1", Core: true,
+		File: "backend_gl41.go", Constraint: "gl41", Import: `"github.com/go-gl/gl/v4.1-core/gl"`,
+	},
+	{
+		Name: "4.5-core", API: "gl", Version: "4.5", Core: true,
+		File: "backend_gl45.go", Constraint: "gl45", Import: `"github.com/go-gl/gl/v4.5-core/gl"`,
+	},
+	{
+		Name: "3.0-es", API: "gles2", Version: "3.0",
+		File: "backend_gles30.go", Constraint: "gles30", Import: `gl "github.com/go-gl/gl/v3.0/gles2"`,
+	},
+}
+
+// profileFiles are the file suffix and build constraint of the code that is
+// only available in some profiles. The profile names are sorted.
+var profileFiles = map[string]struct{ Suffix, Constraint string }{
+	"3.0-es,3.3-core,4.1-core,4.5-core": {"", ""},
+	"3.3-core,4.1-core,4.5-core":        {"_desktop", "!gles30"},
+	"4.1-core,4.5-core":                 {"_gl4", "gl41 || gl45"},
+	"4.5-core":                          {"_gl45", "gl45"},
+	"3.0-es":                            {"_gles", "gles30"},
+}
+
+// features returns the enums and commands of every profile, without their GL_
+// and gl prefixes.
+func (reg *registry) features() map[string]map[string]bool {
+	names := map[string]map[string]bool{}
+	for _, p := range profiles {
+		set := map[string]bool{}
+		for _, f := range reg.Features {
+			if f.API != p.API || !versionAtMost(f.Number, p.Version) {
+				continue
+			}
+			for _, r := range f.Require {
+				if r.Profile != "" && (r.Profile == "core") != p.Core {
+					continue
+				}
+				for _, n := range r.names() {
+					set[n] = true
+				}
+			}
+			for _, r := range f.Remove {
+				if r.Profile != "" && (r.Profile == "core") != p.Core {
+					continue
+				}
+				for _, n := range r.names() {
+					delete(set, n)
+				}
+			}
+		}
+		for _, name := range p.Extensions {
+			ext, ok := reg.extension(name)
+			if !ok {
+				fmt.Fprintf(os.Stderr, "glgen: warning: gl.xml has no extension %s\n", name)
+			}
+			for _, r := range ext.Require {
+				if r.API != "" && r.API != p.API {
+					continue
+				}
+				for _, n := range r.names() {
+					set[n] = true
+				}
+			}
+		}
+		names[p.Name] = set
+	}
+	return names
+}
+
+func (reg *registry) extension(name string) (registryExtension, bool) {
+	for _, ext := range reg.Extensions {
+		if ext.Name == name {
+			return ext, true
+		}
+	}
+	return registryExtension{}, false
+}
+
+// commandVersion returns the first version of api requiring the command, or
+// "" if none does.
+func (reg *registry) commandVersion(api, command string) string {
+	version := ""
+	for _, f := range reg.Features {
+		if f.API != api {
+			continue
+		}
+		for _, r := range f.Require {
+			for _, c := range r.Commands {
+				if c.Name == "gl"+command && (version == "" || versionAtMost(f.Number, version)) {
+					version = f.Number
+				}
+			}
+		}
+	}
+	return version
+}
+
+// docURL returns the reference page of command.
+func (reg *registry) docURL(command string) string {
+	switch v := reg.commandVersion("gl", command); {
+	case v != "" && versionAtMost(v, "3.3"):
+		return "https://www.opengl.org/sdk/docs/man3/xhtml/gl" + command + ".xml"
+	case v != "":
+		return "https://www.opengl.org/sdk/docs/man/html/gl" + command + ".xhtml"
+	default:
+		return "https://www.khronos.org/opengles/sdk/docs/man3/html/gl" + command + ".xhtml"
+	}
+}
+
+func versionAtMost(v, max string) bool {
+	a, b := parseVersion(v), parseVersion(max)
+	return a[0] < b[0] || a[0] == b[0] && a[1] <= b[1]
+}
+
+func parseVersion(v string) [2]int {
+	major, minor, _ := strings.Cut(v, ".")
+	x, err1 := strconv.Atoi(major)
+	y, err2 := strconv.Atoi(minor)
+	if err1 != nil || err2 != nil {
+		panic(fmt.Sprintf("bad version %q", v))
+	}
+	return [2]int{x, y}
+}
+
+// availability finds the profiles having every enum and command a generated
+// function uses.
+type availability map[string]map[string]bool
+
+// profiles returns the sorted names of the profiles having every name.
+func (a availability) profiles(names ...string) []string {
+	var in []string
+	for _, p := range profiles {
+		ok := true
+		for _, n := range names {
+			if !a[p.Name][n] {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			in = append(in, p.Name)
+		}
+	}
+	sort.Strings(in)
+	return in
+}
+
+// splitFile accumulates generated functions by the file of their profiles.
+type splitFile struct {
+	base  string              // file name without .go
+	funcs map[string][]string // by profile set
+}
+
+func newSplitFile(base string) *splitFile {
+	return &splitFile{base: base, funcs: map[string][]string{}}
+}
+
+// add adds the source of a function available in profiles. It returns an
+// error if no profile has it.
+func (s *splitFile) add(name string, profiles []string, src string) error {
+	if len(profiles) == 0 {
+		return fmt.Errorf("%s: no profile has every enum and command of %s", s.base, name)
+	}
+	key := strings.Join(profiles, ",")
+	if _, ok := profileFiles[key]; !ok {
+		return fmt.Errorf("%s: %s is only available in %s, which has no file", s.base, name, key)
+	}
+	s.funcs[key] = append(s.funcs[key], src)
+	return nil
+}
+
+// write writes every file of s and removes the generated ones left empty.
+func (s *splitFile) write(dir, prelude string) error {
+	for key, f := range profileFiles {
+		path := dir + "/" + s.base + f.Suffix + ".go"
+		funcs := s.funcs[key]
+		if len(funcs) == 0 && (f.Suffix != "" || prelude == "") {
+			if old, err := os.ReadFile(path); err == nil && strings.HasPrefix(string(old), generatedHeader) {
+				if err := os.Remove(path); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		var b strings.Builder
+		b.WriteString(generatedHeader)
+		if f.Constraint != "" {
+			fmt.Fprintf(&b, "//go:build %s\n\n", f.Constraint)
+		}
+		b.WriteString("package gl\n\n")
+		if f.Suffix == "" {
+			b.WriteString(prelude)
+		}
+		for _, fn := range funcs {
+			b.WriteString(fn)
+			b.WriteString("\n")
+		}
+		if err := writeGo(path, []byte(b.String())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,459 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// objectSpec describes the generated functions of an OpenGL object type.
+type objectSpec struct {
+	Type    string
+	GL      string
+	Plural  string
+	Recv    string
+	Kind    string
+	Target  string
+	Binding string
+	Is      bool
+}
+
+// readObjectSpec reads the object spec, one type per line:
+//
+//	Type key=value ...
+//
+// See objects.spec for the keys.
+func readObjectSpec(path string) ([]objectSpec, error) {
+	var specs []objectSpec
+	err := readSpecLines(path, func(n int, line string) error {
+		fields := strings.Fields(line)
+		o := objectSpec{Type: fields[0], Plural: fields[0] + "s"}
+		for _, f := range fields[1:] {
+			key, value, _ := strings.Cut(f, "=")
+			switch key {
+			case "gl":
+				o.GL = value
+			case "plural":
+				o.Plural = value
+			case "recv":
+				o.Recv = value
+			case "kind":
+				o.Kind = value
+			case "target":
+				o.Target = value
+			case "binding":
+				o.Binding = value
+			case "is":
+				o.Is = true
+			default:
+				return fmt.Errorf("%s:%d: unknown key %q", path, n, key)
+			}
+		}
+		if o.GL == "" || o.Recv == "" || o.Kind == "" || (o.Target == "") == (o.Binding == "") {
+			return fmt.Errorf("%s:%d: want gl, recv, kind and either target or binding", path, n)
+		}
+		specs = append(specs, o)
+		return nil
+	})
+	return specs, err
+}
+
+// getterSpec is a generated getter.
+type getterSpec struct {
+	Recv    string // section of the spec
+	Name    string
+	Pname   string
+	Type    string
+	Indexed bool
+	Len     string
+	Doc     string
+}
+
+// readGetterSpec reads the getter spec, see get.spec for its format.
+func readGetterSpec(path string) ([]getterSpec, error) {
+	var specs []getterSpec
+	recv := ""
+	err := readSpecLines(path, func(n int, line string) error {
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			recv = line[1 : len(line)-1]
+			if _, ok := getterReceivers[recv]; !ok {
+				return fmt.Errorf("%s:%d: unknown receiver %s", path, n, recv)
+			}
+			return nil
+		}
+		if recv == "" {
+			return fmt.Errorf("%s:%d: getter before the first [Receiver]", path, n)
+		}
+		line, doc, _ := strings.Cut(line, ":")
+		fields := strings.Fields(line)
+		if len(fields) < 3 {
+			return fmt.Errorf("%s:%d: want a method, a pname and a type", path, n)
+		}
+		g := getterSpec{Recv: recv, Name: fields[0], Pname: fields[1], Type: fields[2], Doc: strings.TrimSpace(doc)}
+		for _, f := range fields[3:] {
+			switch {
+			case f == "indexed":
+				g.Indexed = true
+			case strings.HasPrefix(f, "len="):
+				g.Len = f[len("len="):]
+			default:
+				return fmt.Errorf("%s:%d: unknown option %q", path, n, f)
+			}
+		}
+		specs = append(specs, g)
+		return nil
+	})
+	return specs, err
+}
+
+// readSpecLines calls fn for every line of path that isn't blank or a
+// comment.
+func readSpecLines(path string, fn func(n int, line string) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	s.Buffer(nil, 1<<20)
+	for n := 1; s.Scan(); n++ {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if err := fn(n, line); err != nil {
+			return err
+		}
+	}
+	return s.Err()
+}
+
+// getterReceiver is how the getters of a receiver query their value.
+type getterReceiver struct {
+	Recv   string // receiver, "" for the GetObj getters
+	Iv, Fv string // commands taking (recv, pname, params)
+	Target string // target constant passed before pname, with a safety check
+}
+
+var getterReceivers = map[string]getterReceiver{
+	"Get":       {},
+	"Shader":    {Recv: "s", Iv: "GetShaderiv"},
+	"Program":   {Recv: "p", Iv: "GetProgramiv"},
+	"Texture2D": {Recv: "t", Iv: "GetTexParameteriv", Fv: "GetTexParameterfv", Target: "TEXTURE_2D"},
+}
+
+// rawGetters are the glGet* commands GetObj exposes as is.
+var rawGetters = []struct{ Name, Params, Args string }{
+	{"GetBooleanv", "pname uint32, params *bool", "pname, params"},
+	{"GetDoublev", "pname uint32, params *float64", "pname, params"},
+	{"GetFloatv", "pname uint32, params *float32", "pname, params"},
+	{"GetIntegerv", "pname uint32, params *int32", "pname, params"},
+	{"GetInteger64v", "pname uint32, params *int64", "pname, params"},
+	{"GetBooleani_v", "pname uint32, index uint32, data *bool", "pname, index, data"},
+	{"GetIntegeri_v", "pname uint32, index uint32, data *int32", "pname, index, data"},
+	{"GetInteger64i_v", "pname uint32, index uint32, data *int64", "pname, index, data"},
+}
+
+// wrapperGen generates the wrappers of package gl.
+type wrapperGen struct {
+	reg   *registry
+	avail availability
+	typ   map[string]string // type of the typed constants
+}
+
+// enum returns the expression passing the constant name to a backend
+// method.
+func (g *wrapperGen) enum(name string) string {
+	if g.typ[name] != "" {
+		return "uint32(" + name + ")"
+	}
+	return name
+}
+
+func isEnumType(s string) bool {
+	return s != "" && unicode.IsUpper(rune(s[0])) && strings.IndexFunc(s, unicode.IsLower) >= 0
+}
+
+func (g *wrapperGen) objects(objs []objectSpec, getters []getterSpec) (*splitFile, error) {
+	out := newSplitFile("objects")
+	for _, o := range objs {
+		if err := g.object(out, o); err != nil {
+			return nil, err
+		}
+	}
+	for _, gs := range getters {
+		if gs.Recv == "Get" {
+			continue
+		}
+		if err := g.paramGetter(out, gs); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func (g *wrapperGen) object(out *splitFile, o objectSpec) error {
+	var b strings.Builder
+	gen, del, bind, is := "Gen"+o.GL+"s", "Delete"+o.GL+"s", "Bind"+o.GL, "Is"+o.GL
+	r, T := o.Recv, o.Type
+	noun := strings.ToLower(splitCamel(o.GL))
+	// An object with a constant target only exists where its target does,
+	// like the buffers of GL_TEXTURE_BUFFER.
+	var only []string
+	if o.Binding == "" && !isEnumType(o.Target) {
+		only = []string{o.Target}
+	}
+
+	fmt.Fprintf(&b, "//Gen%s is an alias to gl%s(1, &%s).\n//\n//Documentation reference: %s\n", T, gen, r, g.reg.docURL(gen))
+	fmt.Fprintf(&b, "func Gen%s() %s {\n\tvar %s uint32\n\tbackend.%s(1, &%s)\n\treturn %s(%s)\n}\n", T, T, r, gen, r, T, r)
+	if err := out.add("Gen"+T, g.avail.profiles(append([]string{gen}, only...)...), b.String()); err != nil {
+		return err
+	}
+
+	b.Reset()
+	fmt.Fprintf(&b, "//Gen%s is an alias to gl%s(n, &%s[0]). It returns nil when n is 0.\n//\n//Documentation reference: %s\n", o.Plural, gen, r, g.reg.docURL(gen))
+	fmt.Fprintf(&b, "func Gen%s(n int32) []%s {\n\tif n == 0 {\n\t\treturn nil\n\t}\n", o.Plural, T)
+	fmt.Fprintf(&b, "\t%s := make([]%s, n)\n\tbackend.%s(n, (*uint32)(&%s[0]))\n\treturn %s\n}\n", r, T, gen, r, r)
+	if err := out.add("Gen"+o.Plural, g.avail.profiles(append([]string{gen}, only...)...), b.String()); err != nil {
+		return err
+	}
+
+	// param is the parameter of Bind and Unbind, target the expression
+	// passed to glBind*, key the binding point of the safety checks.
+	var param, target, doc, key string
+	bindNames := []string{bind}
+	switch {
+	case o.Binding != "":
+		key = g.enum(o.Binding)
+	case isEnumType(o.Target):
+		param, target, doc, key = "target "+o.Target, "uint32(target), ", "target, ", "uint32(target)"
+	default:
+		target, doc, key = g.enum(o.Target)+", ", "gl."+o.Target+", ", g.enum(o.Target)
+		bindNames = append(bindNames, only...)
+	}
+
+	b.Reset()
+	fmt.Fprintf(&b, "//Bind is an alias to gl%s(%s%s).\n//\n//Documentation reference: %s\n", bind, doc, r, g.reg.docURL(bind))
+	fmt.Fprintf(&b, "func (%s %s) Bind(%s) {\n\tbackend.%s(%suint32(%s))\n", r, T, param, bind, target, r)
+	fmt.Fprintf(&b, "\tif safetyflag {\n\t\tsafetyBind(%s, %s, uint32(%s))\n\t}\n}\n", o.Kind, key, r)
+	if err := out.add(T+".Bind", g.avail.profiles(bindNames...), b.String()); err != nil {
+		return err
+	}
+
+	b.Reset()
+	fmt.Fprintf(&b, "//Unbind is an alias to gl%s(%s0).\n//\n//Documentation reference: %s\n", bind, doc, g.reg.docURL(bind))
+	fmt.Fprintf(&b, "func (%s %s) Unbind(%s) {\n", r, T, param)
+	fmt.Fprintf(&b, "\tif safetyflag {\n\t\tsafetyCheckBound(%q, %s, %s, uint32(%s))\n\t}\n", T+".Unbind", o.Kind, key, r)
+	fmt.Fprintf(&b, "\tbackend.%s(%s0)\n", bind, target)
+	fmt.Fprintf(&b, "\tif safetyflag {\n\t\tsafetyBind(%s, %s, 0)\n\t}\n}\n", o.Kind, key)
+	if err := out.add(T+".Unbind", g.avail.profiles(bindNames...), b.String()); err != nil {
+		return err
+	}
+
+	b.Reset()
+	fmt.Fprintf(&b, "//Delete is an alias to gl%s(1, &%s). The %s should not be used after calling this.\n//\n//Documentation reference: %s\n", del, r, noun, g.reg.docURL(del))
+	fmt.Fprintf(&b, "func (%s %s) Delete() {\n\tbackend.%s(1, (*uint32)(&%s))\n", r, T, del, r)
+	fmt.Fprintf(&b, "\tif safetyflag {\n\t\tsafetyDelete(%s, uint32(%s))\n\t}\n}\n", o.Kind, r)
+	if err := out.add(T+".Delete", g.avail.profiles(append([]string{del}, only...)...), b.String()); err != nil {
+		return err
+	}
+
+	if o.Is {
+		b.Reset()
+		fmt.Fprintf(&b, "//%s is an alias to gl%s(%s).\n//\n//Documentation reference: %s\n", is, is, r, g.reg.docURL(is))
+		fmt.Fprintf(&b, "func (%s %s) %s() bool {\n\treturn backend.%s(uint32(%s))\n}\n", r, T, is, is, r)
+		if err := out.add(T+"."+is, g.avail.profiles(append([]string{is}, only...)...), b.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var arrayType = regexp.MustCompile(`^\[(\d*)\](\w+)$`)
+
+// valueType splits a getter type in its element type and its number of
+// values, 0 for a single value and -1 for a slice.
+func valueType(typ string) (elem string, n int) {
+	m := arrayType.FindStringSubmatch(typ)
+	if m == nil {
+		return typ, 0
+	}
+	if m[1] == "" {
+		return m[2], -1
+	}
+	n, _ = strconv.Atoi(m[1])
+	return m[2], n
+}
+
+func (g *wrapperGen) getters(getters []getterSpec) (*splitFile, error) {
+	out := newSplitFile("get")
+	for _, raw := range rawGetters {
+		src := fmt.Sprintf("//%s is an alias to gl%s(%s).\nfunc (GetObj) %s(%s) {\n\tbackend.%s(%s)\n}\n",
+			raw.Name, raw.Name, raw.Args, raw.Name, raw.Params, raw.Name, raw.Args)
+		if err := out.add("Get."+raw.Name, g.avail.profiles(raw.Name), src); err != nil {
+			return nil, err
+		}
+	}
+	for _, gs := range getters {
+		if gs.Recv != "Get" {
+			continue
+		}
+		if err := g.getter(out, gs); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+const getPrelude = `//GetObj is the type of Get, the receiver of the glGet* getters.
+type GetObj struct{}
+
+//Get queries the state of the context.
+var Get = GetObj{}
+
+`
+
+func (g *wrapperGen) getter(out *splitFile, gs getterSpec) error {
+	elem, n := valueType(gs.Type)
+	var command, vtype string
+	switch elem {
+	case "bool":
+		command, vtype = "GetBooleanv", "bool"
+	case "float32":
+		command, vtype = "GetFloatv", "float32"
+	case "float64":
+		command, vtype = "GetDoublev", "float64"
+	case "int64":
+		command, vtype = "GetInteger64v", "int64"
+	default:
+		command, vtype = "GetIntegerv", "int32"
+	}
+	if gs.Indexed {
+		command = strings.TrimSuffix(command, "v") + "i_v"
+	}
+	var b strings.Builder
+	if gs.Doc != "" {
+		fmt.Fprintf(&b, "//%s\n", gs.Doc)
+	} else {
+		fmt.Fprintf(&b, "//%s is an alias to gl%s(gl.%s, &params).\n", gs.Name, command, gs.Pname)
+	}
+	param, index := "", ""
+	if gs.Indexed {
+		param, index = "index uint32", "index, "
+	}
+	fmt.Fprintf(&b, "func (GetObj) %s(%s) %s {\n", gs.Name, param, gs.Type)
+	ret := "params"
+	switch {
+	case n < 0:
+		if gs.Len == "" {
+			return fmt.Errorf("get.spec: %s reads a slice without len", gs.Name)
+		}
+		fmt.Fprintf(&b, "\tvar params = make([]%s, Get.%s())\n", vtype, gs.Len)
+	case n > 0:
+		fmt.Fprintf(&b, "\tvar params [%d]%s\n", n, vtype)
+	default:
+		fmt.Fprintf(&b, "\tvar params %s\n", vtype)
+		if elem != vtype {
+			ret = elem + "(params)"
+		}
+	}
+	ptr := "&params"
+	if n != 0 {
+		ptr = "&params[0]"
+	}
+	fmt.Fprintf(&b, "\tbackend.%s(%s, %s%s)\n\treturn %s\n}\n", command, g.enum(gs.Pname), index, ptr, ret)
+	return out.add("Get."+gs.Name, g.avail.profiles(command, gs.Pname), b.String())
+}
+
+func (g *wrapperGen) paramGetter(out *splitFile, gs getterSpec) error {
+	r := getterReceivers[gs.Recv]
+	elem, n := valueType(gs.Type)
+	if n < 0 || gs.Indexed {
+		return fmt.Errorf("get.spec: %s.%s: object getters read a fixed number of values", gs.Recv, gs.Name)
+	}
+	command, vtype := r.Iv, "int32"
+	if elem == "float32" && r.Fv != "" {
+		command, vtype = r.Fv, "float32"
+	}
+	target := ""
+	if r.Target != "" {
+		target = g.enum(r.Target) + ", "
+	}
+	var b strings.Builder
+	if gs.Doc != "" {
+		b.WriteString(wrapComment(gs.Doc))
+	} else {
+		doc := ""
+		if r.Target != "" {
+			doc = "gl." + r.Target + ", "
+		} else {
+			doc = r.Recv + ", "
+		}
+		ptr := "&params"
+		if n > 0 {
+			ptr = "&params[0]"
+		}
+		fmt.Fprintf(&b, "//%s is an alias to gl%s(%sgl.%s, %s).\n", gs.Name, command, doc, gs.Pname, ptr)
+	}
+	fmt.Fprintf(&b, "func (%s %s) %s() %s {\n", r.Recv, gs.Recv, gs.Name, gs.Type)
+	if r.Target != "" {
+		fmt.Fprintf(&b, "\tif safetyflag {\n\t\tsafetyCheckBound(%q, kindTexture, %s, uint32(%s))\n\t}\n", gs.Recv+"."+gs.Name, g.enum(r.Target), r.Recv)
+	}
+	recv := ""
+	if r.Target == "" {
+		recv = "uint32(" + r.Recv + "), "
+	}
+	ret := "params"
+	switch {
+	case n > 0:
+		fmt.Fprintf(&b, "\tvar params [%d]%s\n", n, vtype)
+		fmt.Fprintf(&b, "\tbackend.%s(%s%s%s, &params[0])\n", command, recv, target, g.enum(gs.Pname))
+	default:
+		fmt.Fprintf(&b, "\tvar params %s\n", vtype)
+		fmt.Fprintf(&b, "\tbackend.%s(%s%s%s, &params)\n", command, recv, target, g.enum(gs.Pname))
+		switch elem {
+		case vtype:
+		case "bool":
+			ret = "params == TRUE"
+		default:
+			ret = elem + "(params)"
+		}
+	}
+	fmt.Fprintf(&b, "\treturn %s\n}\n", ret)
+	names := []string{command, gs.Pname}
+	if r.Target != "" {
+		names = append(names, r.Target)
+	}
+	return out.add(gs.Recv+"."+gs.Name, g.avail.profiles(names...), b.String())
+}
+
+// wrapComment formats doc as a // comment wrapped at 80 columns.
+func wrapComment(doc string) string {
+	var b strings.Builder
+	line := "//"
+	for _, w := range strings.Fields(doc) {
+		if len(line)+1+len(w) > 80 && line != "//" {
+			b.WriteString(line + "\n")
+			line = "//"
+		}
+		line += " " + w
+	}
+	b.WriteString(line + "\n")
+	return b.String()
+}
+
+// splitCamel inserts spaces between the words of a CamelCase name.
+func splitCamel(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if i > 0 && unicode.IsUpper(r) {
+			b.WriteByte(' ')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
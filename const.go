@@ -1,1621 +1,1624 @@
-package gl
+// Code generated by glgen from gl.xml. DO NOT EDIT.
 
-import (
-	"github.com/go-gl/gl/v3.3-core/gl"
-)
+package gl
 
-// All OpenGL 3.3 constants.
+// All OpenGL 3.3 constants. Their values are the same in every profile. The
+// ones that belong to a single enum type of enums.go have that type, the
+// others are untyped.
 const (
-	ACTIVE_ATOMIC_COUNTER_BUFFERS                              = gl.ACTIVE_ATOMIC_COUNTER_BUFFERS
-	ACTIVE_ATTRIBUTES                                          = gl.ACTIVE_ATTRIBUTES
-	ACTIVE_ATTRIBUTE_MAX_LENGTH                                = gl.ACTIVE_ATTRIBUTE_MAX_LENGTH
-	ACTIVE_PROGRAM                                             = gl.ACTIVE_PROGRAM
-	ACTIVE_PROGRAM_EXT                                         = gl.ACTIVE_PROGRAM_EXT
-	ACTIVE_RESOURCES                                           = gl.ACTIVE_RESOURCES
-	ACTIVE_SUBROUTINES                                         = gl.ACTIVE_SUBROUTINES
-	ACTIVE_SUBROUTINE_MAX_LENGTH                               = gl.ACTIVE_SUBROUTINE_MAX_LENGTH
-	ACTIVE_SUBROUTINE_UNIFORMS                                 = gl.ACTIVE_SUBROUTINE_UNIFORMS
-	ACTIVE_SUBROUTINE_UNIFORM_LOCATIONS                        = gl.ACTIVE_SUBROUTINE_UNIFORM_LOCATIONS
-	ACTIVE_SUBROUTINE_UNIFORM_MAX_LENGTH                       = gl.ACTIVE_SUBROUTINE_UNIFORM_MAX_LENGTH
-	ACTIVE_TEXTURE                                             = gl.ACTIVE_TEXTURE
-	ACTIVE_UNIFORMS                                            = gl.ACTIVE_UNIFORMS
-	ACTIVE_UNIFORM_BLOCKS                                      = gl.ACTIVE_UNIFORM_BLOCKS
-	ACTIVE_UNIFORM_BLOCK_MAX_NAME_LENGTH                       = gl.ACTIVE_UNIFORM_BLOCK_MAX_NAME_LENGTH
-	ACTIVE_UNIFORM_MAX_LENGTH                                  = gl.ACTIVE_UNIFORM_MAX_LENGTH
-	ACTIVE_VARIABLES                                           = gl.ACTIVE_VARIABLES
-	ALIASED_LINE_WIDTH_RANGE                                   = gl.ALIASED_LINE_WIDTH_RANGE
-	ALL_BARRIER_BITS                                           = gl.ALL_BARRIER_BITS
-	ALL_COMPLETED_NV                                           = gl.ALL_COMPLETED_NV
-	ALL_SHADER_BITS                                            = gl.ALL_SHADER_BITS
-	ALL_SHADER_BITS_EXT                                        = gl.ALL_SHADER_BITS_EXT
-	ALPHA                                                      = gl.ALPHA
-	ALREADY_SIGNALED                                           = gl.ALREADY_SIGNALED
-	ALWAYS                                                     = gl.ALWAYS
-	AND                                                        = gl.AND
-	AND_INVERTED                                               = gl.AND_INVERTED
-	AND_REVERSE                                                = gl.AND_REVERSE
-	ANY_SAMPLES_PASSED                                         = gl.ANY_SAMPLES_PASSED
-	ANY_SAMPLES_PASSED_CONSERVATIVE                            = gl.ANY_SAMPLES_PASSED_CONSERVATIVE
-	ARRAY_BUFFER                                               = gl.ARRAY_BUFFER
-	ARRAY_BUFFER_BINDING                                       = gl.ARRAY_BUFFER_BINDING
-	ARRAY_SIZE                                                 = gl.ARRAY_SIZE
-	ARRAY_STRIDE                                               = gl.ARRAY_STRIDE
-	ATOMIC_COUNTER_BARRIER_BIT                                 = gl.ATOMIC_COUNTER_BARRIER_BIT
-	ATOMIC_COUNTER_BUFFER                                      = gl.ATOMIC_COUNTER_BUFFER
-	ATOMIC_COUNTER_BUFFER_ACTIVE_ATOMIC_COUNTERS               = gl.ATOMIC_COUNTER_BUFFER_ACTIVE_ATOMIC_COUNTERS
-	ATOMIC_COUNTER_BUFFER_ACTIVE_ATOMIC_COUNTER_INDICES        = gl.ATOMIC_COUNTER_BUFFER_ACTIVE_ATOMIC_COUNTER_INDICES
-	ATOMIC_COUNTER_BUFFER_BINDING                              = gl.ATOMIC_COUNTER_BUFFER_BINDING
-	ATOMIC_COUNTER_BUFFER_DATA_SIZE                            = gl.ATOMIC_COUNTER_BUFFER_DATA_SIZE
-	ATOMIC_COUNTER_BUFFER_INDEX                                = gl.ATOMIC_COUNTER_BUFFER_INDEX
-	ATOMIC_COUNTER_BUFFER_REFERENCED_BY_COMPUTE_SHADER         = gl.ATOMIC_COUNTER_BUFFER_REFERENCED_BY_COMPUTE_SHADER
-	ATOMIC_COUNTER_BUFFER_REFERENCED_BY_FRAGMENT_SHADER        = gl.ATOMIC_COUNTER_BUFFER_REFERENCED_BY_FRAGMENT_SHADER
-	ATOMIC_COUNTER_BUFFER_REFERENCED_BY_GEOMETRY_SHADER        = gl.ATOMIC_COUNTER_BUFFER_REFERENCED_BY_GEOMETRY_SHADER
-	ATOMIC_COUNTER_BUFFER_REFERENCED_BY_TESS_CONTROL_SHADER    = gl.ATOMIC_COUNTER_BUFFER_REFERENCED_BY_TESS_CONTROL_SHADER
-	ATOMIC_COUNTER_BUFFER_REFERENCED_BY_TESS_EVALUATION_SHADER = gl.ATOMIC_COUNTER_BUFFER_REFERENCED_BY_TESS_EVALUATION_SHADER
-	ATOMIC_COUNTER_BUFFER_REFERENCED_BY_VERTEX_SHADER          = gl.ATOMIC_COUNTER_BUFFER_REFERENCED_BY_VERTEX_SHADER
-	ATOMIC_COUNTER_BUFFER_SIZE                                 = gl.ATOMIC_COUNTER_BUFFER_SIZE
-	ATOMIC_COUNTER_BUFFER_START                                = gl.ATOMIC_COUNTER_BUFFER_START
-	ATTACHED_SHADERS                                           = gl.ATTACHED_SHADERS
-	AUTO_GENERATE_MIPMAP                                       = gl.AUTO_GENERATE_MIPMAP
-	BACK                                                       = gl.BACK
-	BACK_LEFT                                                  = gl.BACK_LEFT
-	BACK_RIGHT                                                 = gl.BACK_RIGHT
-	BGR                                                        = gl.BGR
-	BGRA                                                       = gl.BGRA
-	BGRA_INTEGER                                               = gl.BGRA_INTEGER
-	BGR_INTEGER                                                = gl.BGR_INTEGER
-	BLEND                                                      = gl.BLEND
-	BLEND_ADVANCED_COHERENT_KHR                                = gl.BLEND_ADVANCED_COHERENT_KHR
-	BLEND_ADVANCED_COHERENT_NV                                 = gl.BLEND_ADVANCED_COHERENT_NV
-	BLEND_COLOR                                                = gl.BLEND_COLOR
-	BLEND_DST                                                  = gl.BLEND_DST
-	BLEND_DST_ALPHA                                            = gl.BLEND_DST_ALPHA
-	BLEND_DST_RGB                                              = gl.BLEND_DST_RGB
-	BLEND_EQUATION                                             = gl.BLEND_EQUATION
-	BLEND_EQUATION_ALPHA                                       = gl.BLEND_EQUATION_ALPHA
-	BLEND_EQUATION_EXT                                         = gl.BLEND_EQUATION_EXT
-	BLEND_EQUATION_RGB                                         = gl.BLEND_EQUATION_RGB
-	BLEND_OVERLAP_NV                                           = gl.BLEND_OVERLAP_NV
-	BLEND_PREMULTIPLIED_SRC_NV                                 = gl.BLEND_PREMULTIPLIED_SRC_NV
-	BLEND_SRC                                                  = gl.BLEND_SRC
-	BLEND_SRC_ALPHA                                            = gl.BLEND_SRC_ALPHA
-	BLEND_SRC_RGB                                              = gl.BLEND_SRC_RGB
-	BLOCK_INDEX                                                = gl.BLOCK_INDEX
-	BLUE                                                       = gl.BLUE
-	BLUE_INTEGER                                               = gl.BLUE_INTEGER
-	BLUE_NV                                                    = gl.BLUE_NV
-	BOOL                                                       = gl.BOOL
-	BOOL_VEC2                                                  = gl.BOOL_VEC2
-	BOOL_VEC3                                                  = gl.BOOL_VEC3
-	BOOL_VEC4                                                  = gl.BOOL_VEC4
-	BUFFER                                                     = gl.BUFFER
-	BUFFER_ACCESS                                              = gl.BUFFER_ACCESS
-	BUFFER_ACCESS_FLAGS                                        = gl.BUFFER_ACCESS_FLAGS
-	BUFFER_BINDING                                             = gl.BUFFER_BINDING
-	BUFFER_DATA_SIZE                                           = gl.BUFFER_DATA_SIZE
-	BUFFER_IMMUTABLE_STORAGE                                   = gl.BUFFER_IMMUTABLE_STORAGE
-	BUFFER_KHR                                                 = gl.BUFFER_KHR
-	BUFFER_MAPPED                                              = gl.BUFFER_MAPPED
-	BUFFER_MAP_LENGTH                                          = gl.BUFFER_MAP_LENGTH
-	BUFFER_MAP_OFFSET                                          = gl.BUFFER_MAP_OFFSET
-	BUFFER_MAP_POINTER                                         = gl.BUFFER_MAP_POINTER
-	BUFFER_OBJECT_EXT                                          = gl.BUFFER_OBJECT_EXT
-	BUFFER_SIZE                                                = gl.BUFFER_SIZE
-	BUFFER_STORAGE_FLAGS                                       = gl.BUFFER_STORAGE_FLAGS
-	BUFFER_UPDATE_BARRIER_BIT                                  = gl.BUFFER_UPDATE_BARRIER_BIT
-	BUFFER_USAGE                                               = gl.BUFFER_USAGE
-	BUFFER_VARIABLE                                            = gl.BUFFER_VARIABLE
-	BYTE                                                       = gl.BYTE
-	CAVEAT_SUPPORT                                             = gl.CAVEAT_SUPPORT
-	CCW                                                        = gl.CCW
-	CLAMP_READ_COLOR                                           = gl.CLAMP_READ_COLOR
-	CLAMP_TO_BORDER                                            = gl.CLAMP_TO_BORDER
-	CLAMP_TO_EDGE                                              = gl.CLAMP_TO_EDGE
-	CLEAR                                                      = gl.CLEAR
-	CLEAR_BUFFER                                               = gl.CLEAR_BUFFER
-	CLEAR_TEXTURE                                              = gl.CLEAR_TEXTURE
-	CLIENT_MAPPED_BUFFER_BARRIER_BIT                           = gl.CLIENT_MAPPED_BUFFER_BARRIER_BIT
-	CLIENT_STORAGE_BIT                                         = gl.CLIENT_STORAGE_BIT
-	CLIPPING_INPUT_PRIMITIVES_ARB                              = gl.CLIPPING_INPUT_PRIMITIVES_ARB
-	CLIPPING_OUTPUT_PRIMITIVES_ARB                             = gl.CLIPPING_OUTPUT_PRIMITIVES_ARB
-	CLIP_DEPTH_MODE                                            = gl.CLIP_DEPTH_MODE
-	CLIP_DISTANCE0                                             = gl.CLIP_DISTANCE0
-	CLIP_DISTANCE1                                             = gl.CLIP_DISTANCE1
-	CLIP_DISTANCE2                                             = gl.CLIP_DISTANCE2
-	CLIP_DISTANCE3                                             = gl.CLIP_DISTANCE3
-	CLIP_DISTANCE4                                             = gl.CLIP_DISTANCE4
-	CLIP_DISTANCE5                                             = gl.CLIP_DISTANCE5
-	CLIP_DISTANCE6                                             = gl.CLIP_DISTANCE6
-	CLIP_DISTANCE7                                             = gl.CLIP_DISTANCE7
-	CLIP_ORIGIN                                                = gl.CLIP_ORIGIN
-	COLOR                                                      = gl.COLOR
-	COLORBURN_KHR                                              = gl.COLORBURN_KHR
-	COLORBURN_NV                                               = gl.COLORBURN_NV
-	COLORDODGE_KHR                                             = gl.COLORDODGE_KHR
-	COLORDODGE_NV                                              = gl.COLORDODGE_NV
-	COLOR_ATTACHMENT0                                          = gl.COLOR_ATTACHMENT0
-	COLOR_ATTACHMENT1                                          = gl.COLOR_ATTACHMENT1
-	COLOR_ATTACHMENT10                                         = gl.COLOR_ATTACHMENT10
-	COLOR_ATTACHMENT11                                         = gl.COLOR_ATTACHMENT11
-	COLOR_ATTACHMENT12                                         = gl.COLOR_ATTACHMENT12
-	COLOR_ATTACHMENT13                                         = gl.COLOR_ATTACHMENT13
-	COLOR_ATTACHMENT14                                         = gl.COLOR_ATTACHMENT14
-	COLOR_ATTACHMENT15                                         = gl.COLOR_ATTACHMENT15
-	COLOR_ATTACHMENT2                                          = gl.COLOR_ATTACHMENT2
-	COLOR_ATTACHMENT3                                          = gl.COLOR_ATTACHMENT3
-	COLOR_ATTACHMENT4                                          = gl.COLOR_ATTACHMENT4
-	COLOR_ATTACHMENT5                                          = gl.COLOR_ATTACHMENT5
-	COLOR_ATTACHMENT6                                          = gl.COLOR_ATTACHMENT6
-	COLOR_ATTACHMENT7                                          = gl.COLOR_ATTACHMENT7
-	COLOR_ATTACHMENT8                                          = gl.COLOR_ATTACHMENT8
-	COLOR_ATTACHMENT9                                          = gl.COLOR_ATTACHMENT9
-	COLOR_BUFFER_BIT                                           = gl.COLOR_BUFFER_BIT
-	COLOR_CLEAR_VALUE                                          = gl.COLOR_CLEAR_VALUE
-	COLOR_COMPONENTS                                           = gl.COLOR_COMPONENTS
-	COLOR_ENCODING                                             = gl.COLOR_ENCODING
-	COLOR_LOGIC_OP                                             = gl.COLOR_LOGIC_OP
-	COLOR_RENDERABLE                                           = gl.COLOR_RENDERABLE
-	COLOR_WRITEMASK                                            = gl.COLOR_WRITEMASK
-	COMMAND_BARRIER_BIT                                        = gl.COMMAND_BARRIER_BIT
-	COMPARE_REF_TO_TEXTURE                                     = gl.COMPARE_REF_TO_TEXTURE
-	COMPATIBLE_SUBROUTINES                                     = gl.COMPATIBLE_SUBROUTINES
-	COMPILE_STATUS                                             = gl.COMPILE_STATUS
-	COMPRESSED_R11_EAC                                         = gl.COMPRESSED_R11_EAC
-	COMPRESSED_RED                                             = gl.COMPRESSED_RED
-	COMPRESSED_RED_RGTC1                                       = gl.COMPRESSED_RED_RGTC1
-	COMPRESSED_RG                                              = gl.COMPRESSED_RG
-	COMPRESSED_RG11_EAC                                        = gl.COMPRESSED_RG11_EAC
-	COMPRESSED_RGB                                             = gl.COMPRESSED_RGB
-	COMPRESSED_RGB8_ETC2                                       = gl.COMPRESSED_RGB8_ETC2
-	COMPRESSED_RGB8_PUNCHTHROUGH_ALPHA1_ETC2                   = gl.COMPRESSED_RGB8_PUNCHTHROUGH_ALPHA1_ETC2
-	COMPRESSED_RGBA                                            = gl.COMPRESSED_RGBA
-	COMPRESSED_RGBA8_ETC2_EAC                                  = gl.COMPRESSED_RGBA8_ETC2_EAC
-	COMPRESSED_RGBA_ASTC_10x10_KHR                             = gl.COMPRESSED_RGBA_ASTC_10x10_KHR
-	COMPRESSED_RGBA_ASTC_10x5_KHR                              = gl.COMPRESSED_RGBA_ASTC_10x5_KHR
-	COMPRESSED_RGBA_ASTC_10x6_KHR                              = gl.COMPRESSED_RGBA_ASTC_10x6_KHR
-	COMPRESSED_RGBA_ASTC_10x8_KHR                              = gl.COMPRESSED_RGBA_ASTC_10x8_KHR
-	COMPRESSED_RGBA_ASTC_12x10_KHR                             = gl.COMPRESSED_RGBA_ASTC_12x10_KHR
-	COMPRESSED_RGBA_ASTC_12x12_KHR                             = gl.COMPRESSED_RGBA_ASTC_12x12_KHR
-	COMPRESSED_RGBA_ASTC_4x4_KHR                               = gl.COMPRESSED_RGBA_ASTC_4x4_KHR
-	COMPRESSED_RGBA_ASTC_5x4_KHR                               = gl.COMPRESSED_RGBA_ASTC_5x4_KHR
-	COMPRESSED_RGBA_ASTC_5x5_KHR                               = gl.COMPRESSED_RGBA_ASTC_5x5_KHR
-	COMPRESSED_RGBA_ASTC_6x5_KHR                               = gl.COMPRESSED_RGBA_ASTC_6x5_KHR
-	COMPRESSED_RGBA_ASTC_6x6_KHR                               = gl.COMPRESSED_RGBA_ASTC_6x6_KHR
-	COMPRESSED_RGBA_ASTC_8x5_KHR                               = gl.COMPRESSED_RGBA_ASTC_8x5_KHR
-	COMPRESSED_RGBA_ASTC_8x6_KHR                               = gl.COMPRESSED_RGBA_ASTC_8x6_KHR
-	COMPRESSED_RGBA_ASTC_8x8_KHR                               = gl.COMPRESSED_RGBA_ASTC_8x8_KHR
-	COMPRESSED_RGBA_BPTC_UNORM_ARB                             = gl.COMPRESSED_RGBA_BPTC_UNORM_ARB
-	COMPRESSED_RGBA_S3TC_DXT1_EXT                              = gl.COMPRESSED_RGBA_S3TC_DXT1_EXT
-	COMPRESSED_RGBA_S3TC_DXT3_EXT                              = gl.COMPRESSED_RGBA_S3TC_DXT3_EXT
-	COMPRESSED_RGBA_S3TC_DXT5_EXT                              = gl.COMPRESSED_RGBA_S3TC_DXT5_EXT
-	COMPRESSED_RGB_BPTC_SIGNED_FLOAT_ARB                       = gl.COMPRESSED_RGB_BPTC_SIGNED_FLOAT_ARB
-	COMPRESSED_RGB_BPTC_UNSIGNED_FLOAT_ARB                     = gl.COMPRESSED_RGB_BPTC_UNSIGNED_FLOAT_ARB
-	COMPRESSED_RGB_S3TC_DXT1_EXT                               = gl.COMPRESSED_RGB_S3TC_DXT1_EXT
-	COMPRESSED_RG_RGTC2                                        = gl.COMPRESSED_RG_RGTC2
-	COMPRESSED_SIGNED_R11_EAC                                  = gl.COMPRESSED_SIGNED_R11_EAC
-	COMPRESSED_SIGNED_RED_RGTC1                                = gl.COMPRESSED_SIGNED_RED_RGTC1
-	COMPRESSED_SIGNED_RG11_EAC                                 = gl.COMPRESSED_SIGNED_RG11_EAC
-	COMPRESSED_SIGNED_RG_RGTC2                                 = gl.COMPRESSED_SIGNED_RG_RGTC2
-	COMPRESSED_SRGB                                            = gl.COMPRESSED_SRGB
-	COMPRESSED_SRGB8_ALPHA8_ASTC_10x10_KHR                     = gl.COMPRESSED_SRGB8_ALPHA8_ASTC_10x10_KHR
-	COMPRESSED_SRGB8_ALPHA8_ASTC_10x5_KHR                      = gl.COMPRESSED_SRGB8_ALPHA8_ASTC_10x5_KHR
-	COMPRESSED_SRGB8_ALPHA8_ASTC_10x6_KHR                      = gl.COMPRESSED_SRGB8_ALPHA8_ASTC_10x6_KHR
-	COMPRESSED_SRGB8_ALPHA8_ASTC_10x8_KHR                      = gl.COMPRESSED_SRGB8_ALPHA8_ASTC_10x8_KHR
-	COMPRESSED_SRGB8_ALPHA8_ASTC_12x10_KHR                     = gl.COMPRESSED_SRGB8_ALPHA8_ASTC_12x10_KHR
-	COMPRESSED_SRGB8_ALPHA8_ASTC_12x12_KHR                     = gl.COMPRESSED_SRGB8_ALPHA8_ASTC_12x12_KHR
-	COMPRESSED_SRGB8_ALPHA8_ASTC_4x4_KHR                       = gl.COMPRESSED_SRGB8_ALPHA8_ASTC_4x4_KHR
-	COMPRESSED_SRGB8_ALPHA8_ASTC_5x4_KHR                       = gl.COMPRESSED_SRGB8_ALPHA8_ASTC_5x4_KHR
-	COMPRESSED_SRGB8_ALPHA8_ASTC_5x5_KHR                       = gl.COMPRESSED_SRGB8_ALPHA8_ASTC_5x5_KHR
-	COMPRESSED_SRGB8_ALPHA8_ASTC_6x5_KHR                       = gl.COMPRESSED_SRGB8_ALPHA8_ASTC_6x5_KHR
-	COMPRESSED_SRGB8_ALPHA8_ASTC_6x6_KHR                       = gl.COMPRESSED_SRGB8_ALPHA8_ASTC_6x6_KHR
-	COMPRESSED_SRGB8_ALPHA8_ASTC_8x5_KHR                       = gl.COMPRESSED_SRGB8_ALPHA8_ASTC_8x5_KHR
-	COMPRESSED_SRGB8_ALPHA8_ASTC_8x6_KHR                       = gl.COMPRESSED_SRGB8_ALPHA8_ASTC_8x6_KHR
-	COMPRESSED_SRGB8_ALPHA8_ASTC_8x8_KHR                       = gl.COMPRESSED_SRGB8_ALPHA8_ASTC_8x8_KHR
-	COMPRESSED_SRGB8_ALPHA8_ETC2_EAC                           = gl.COMPRESSED_SRGB8_ALPHA8_ETC2_EAC
-	COMPRESSED_SRGB8_ETC2                                      = gl.COMPRESSED_SRGB8_ETC2
-	COMPRESSED_SRGB8_PUNCHTHROUGH_ALPHA1_ETC2                  = gl.COMPRESSED_SRGB8_PUNCHTHROUGH_ALPHA1_ETC2
-	COMPRESSED_SRGB_ALPHA                                      = gl.COMPRESSED_SRGB_ALPHA
-	COMPRESSED_SRGB_ALPHA_BPTC_UNORM_ARB                       = gl.COMPRESSED_SRGB_ALPHA_BPTC_UNORM_ARB
-	COMPRESSED_TEXTURE_FORMATS                                 = gl.COMPRESSED_TEXTURE_FORMATS
-	COMPUTE_SHADER                                             = gl.COMPUTE_SHADER
-	COMPUTE_SHADER_BIT                                         = gl.COMPUTE_SHADER_BIT
-	COMPUTE_SHADER_INVOCATIONS_ARB                             = gl.COMPUTE_SHADER_INVOCATIONS_ARB
-	COMPUTE_SUBROUTINE                                         = gl.COMPUTE_SUBROUTINE
-	COMPUTE_SUBROUTINE_UNIFORM                                 = gl.COMPUTE_SUBROUTINE_UNIFORM
-	COMPUTE_TEXTURE                                            = gl.COMPUTE_TEXTURE
-	COMPUTE_WORK_GROUP_SIZE                                    = gl.COMPUTE_WORK_GROUP_SIZE
-	CONDITION_SATISFIED                                        = gl.CONDITION_SATISFIED
-	CONJOINT_NV                                                = gl.CONJOINT_NV
-	CONSTANT_ALPHA                                             = gl.CONSTANT_ALPHA
-	CONSTANT_COLOR                                             = gl.CONSTANT_COLOR
-	CONTEXT_COMPATIBILITY_PROFILE_BIT                          = gl.CONTEXT_COMPATIBILITY_PROFILE_BIT
-	CONTEXT_CORE_PROFILE_BIT                                   = gl.CONTEXT_CORE_PROFILE_BIT
-	CONTEXT_FLAGS                                              = gl.CONTEXT_FLAGS
-	CONTEXT_FLAG_DEBUG_BIT                                     = gl.CONTEXT_FLAG_DEBUG_BIT
-	CONTEXT_FLAG_DEBUG_BIT_KHR                                 = gl.CONTEXT_FLAG_DEBUG_BIT_KHR
-	CONTEXT_FLAG_FORWARD_COMPATIBLE_BIT                        = gl.CONTEXT_FLAG_FORWARD_COMPATIBLE_BIT
-	CONTEXT_FLAG_ROBUST_ACCESS_BIT_ARB                         = gl.CONTEXT_FLAG_ROBUST_ACCESS_BIT_ARB
-	CONTEXT_LOST                                               = gl.CONTEXT_LOST
-	CONTEXT_LOST_KHR                                           = gl.CONTEXT_LOST_KHR
-	CONTEXT_PROFILE_MASK                                       = gl.CONTEXT_PROFILE_MASK
-	CONTEXT_RELEASE_BEHAVIOR                                   = gl.CONTEXT_RELEASE_BEHAVIOR
-	CONTEXT_RELEASE_BEHAVIOR_FLUSH                             = gl.CONTEXT_RELEASE_BEHAVIOR_FLUSH
-	CONTEXT_RELEASE_BEHAVIOR_FLUSH_KHR                         = gl.CONTEXT_RELEASE_BEHAVIOR_FLUSH_KHR
-	CONTEXT_RELEASE_BEHAVIOR_KHR                               = gl.CONTEXT_RELEASE_BEHAVIOR_KHR
-	CONTEXT_ROBUST_ACCESS                                      = gl.CONTEXT_ROBUST_ACCESS
-	CONTEXT_ROBUST_ACCESS_KHR                                  = gl.CONTEXT_ROBUST_ACCESS_KHR
-	CONTRAST_NV                                                = gl.CONTRAST_NV
-	COPY                                                       = gl.COPY
-	COPY_INVERTED                                              = gl.COPY_INVERTED
-	COPY_READ_BUFFER                                           = gl.COPY_READ_BUFFER
-	COPY_READ_BUFFER_BINDING                                   = gl.COPY_READ_BUFFER_BINDING
-	COPY_WRITE_BUFFER                                          = gl.COPY_WRITE_BUFFER
-	COPY_WRITE_BUFFER_BINDING                                  = gl.COPY_WRITE_BUFFER_BINDING
-	COUNTER_RANGE_AMD                                          = gl.COUNTER_RANGE_AMD
-	COUNTER_TYPE_AMD                                           = gl.COUNTER_TYPE_AMD
-	CULL_FACE                                                  = gl.CULL_FACE
-	CULL_FACE_MODE                                             = gl.CULL_FACE_MODE
-	CURRENT_PROGRAM                                            = gl.CURRENT_PROGRAM
-	CURRENT_QUERY                                              = gl.CURRENT_QUERY
-	CURRENT_VERTEX_ATTRIB                                      = gl.CURRENT_VERTEX_ATTRIB
-	CW                                                         = gl.CW
-	DARKEN_KHR                                                 = gl.DARKEN_KHR
-	DARKEN_NV                                                  = gl.DARKEN_NV
-	DEBUG_CALLBACK_FUNCTION                                    = gl.DEBUG_CALLBACK_FUNCTION
-	DEBUG_CALLBACK_FUNCTION_ARB                                = gl.DEBUG_CALLBACK_FUNCTION_ARB
-	DEBUG_CALLBACK_FUNCTION_KHR                                = gl.DEBUG_CALLBACK_FUNCTION_KHR
-	DEBUG_CALLBACK_USER_PARAM                                  = gl.DEBUG_CALLBACK_USER_PARAM
-	DEBUG_CALLBACK_USER_PARAM_ARB                              = gl.DEBUG_CALLBACK_USER_PARAM_ARB
-	DEBUG_CALLBACK_USER_PARAM_KHR                              = gl.DEBUG_CALLBACK_USER_PARAM_KHR
-	DEBUG_GROUP_STACK_DEPTH                                    = gl.DEBUG_GROUP_STACK_DEPTH
-	DEBUG_GROUP_STACK_DEPTH_KHR                                = gl.DEBUG_GROUP_STACK_DEPTH_KHR
-	DEBUG_LOGGED_MESSAGES                                      = gl.DEBUG_LOGGED_MESSAGES
-	DEBUG_LOGGED_MESSAGES_ARB                                  = gl.DEBUG_LOGGED_MESSAGES_ARB
-	DEBUG_LOGGED_MESSAGES_KHR                                  = gl.DEBUG_LOGGED_MESSAGES_KHR
-	DEBUG_NEXT_LOGGED_MESSAGE_LENGTH                           = gl.DEBUG_NEXT_LOGGED_MESSAGE_LENGTH
-	DEBUG_NEXT_LOGGED_MESSAGE_LENGTH_ARB                       = gl.DEBUG_NEXT_LOGGED_MESSAGE_LENGTH_ARB
-	DEBUG_NEXT_LOGGED_MESSAGE_LENGTH_KHR                       = gl.DEBUG_NEXT_LOGGED_MESSAGE_LENGTH_KHR
-	DEBUG_OUTPUT                                               = gl.DEBUG_OUTPUT
-	DEBUG_OUTPUT_KHR                                           = gl.DEBUG_OUTPUT_KHR
-	DEBUG_OUTPUT_SYNCHRONOUS                                   = gl.DEBUG_OUTPUT_SYNCHRONOUS
-	DEBUG_OUTPUT_SYNCHRONOUS_ARB                               = gl.DEBUG_OUTPUT_SYNCHRONOUS_ARB
-	DEBUG_OUTPUT_SYNCHRONOUS_KHR                               = gl.DEBUG_OUTPUT_SYNCHRONOUS_KHR
-	DEBUG_SEVERITY_HIGH                                        = gl.DEBUG_SEVERITY_HIGH
-	DEBUG_SEVERITY_HIGH_ARB                                    = gl.DEBUG_SEVERITY_HIGH_ARB
-	DEBUG_SEVERITY_HIGH_KHR                                    = gl.DEBUG_SEVERITY_HIGH_KHR
-	DEBUG_SEVERITY_LOW                                         = gl.DEBUG_SEVERITY_LOW
-	DEBUG_SEVERITY_LOW_ARB                                     = gl.DEBUG_SEVERITY_LOW_ARB
-	DEBUG_SEVERITY_LOW_KHR                                     = gl.DEBUG_SEVERITY_LOW_KHR
-	DEBUG_SEVERITY_MEDIUM                                      = gl.DEBUG_SEVERITY_MEDIUM
-	DEBUG_SEVERITY_MEDIUM_ARB                                  = gl.DEBUG_SEVERITY_MEDIUM_ARB
-	DEBUG_SEVERITY_MEDIUM_KHR                                  = gl.DEBUG_SEVERITY_MEDIUM_KHR
-	DEBUG_SEVERITY_NOTIFICATION                                = gl.DEBUG_SEVERITY_NOTIFICATION
-	DEBUG_SEVERITY_NOTIFICATION_KHR                            = gl.DEBUG_SEVERITY_NOTIFICATION_KHR
-	DEBUG_SOURCE_API                                           = gl.DEBUG_SOURCE_API
-	DEBUG_SOURCE_API_ARB                                       = gl.DEBUG_SOURCE_API_ARB
-	DEBUG_SOURCE_API_KHR                                       = gl.DEBUG_SOURCE_API_KHR
-	DEBUG_SOURCE_APPLICATION                                   = gl.DEBUG_SOURCE_APPLICATION
-	DEBUG_SOURCE_APPLICATION_ARB                               = gl.DEBUG_SOURCE_APPLICATION_ARB
-	DEBUG_SOURCE_APPLICATION_KHR                               = gl.DEBUG_SOURCE_APPLICATION_KHR
-	DEBUG_SOURCE_OTHER                                         = gl.DEBUG_SOURCE_OTHER
-	DEBUG_SOURCE_OTHER_ARB                                     = gl.DEBUG_SOURCE_OTHER_ARB
-	DEBUG_SOURCE_OTHER_KHR                                     = gl.DEBUG_SOURCE_OTHER_KHR
-	DEBUG_SOURCE_SHADER_COMPILER                               = gl.DEBUG_SOURCE_SHADER_COMPILER
-	DEBUG_SOURCE_SHADER_COMPILER_ARB                           = gl.DEBUG_SOURCE_SHADER_COMPILER_ARB
-	DEBUG_SOURCE_SHADER_COMPILER_KHR                           = gl.DEBUG_SOURCE_SHADER_COMPILER_KHR
-	DEBUG_SOURCE_THIRD_PARTY                                   = gl.DEBUG_SOURCE_THIRD_PARTY
-	DEBUG_SOURCE_THIRD_PARTY_ARB                               = gl.DEBUG_SOURCE_THIRD_PARTY_ARB
-	DEBUG_SOURCE_THIRD_PARTY_KHR                               = gl.DEBUG_SOURCE_THIRD_PARTY_KHR
-	DEBUG_SOURCE_WINDOW_SYSTEM                                 = gl.DEBUG_SOURCE_WINDOW_SYSTEM
-	DEBUG_SOURCE_WINDOW_SYSTEM_ARB                             = gl.DEBUG_SOURCE_WINDOW_SYSTEM_ARB
-	DEBUG_SOURCE_WINDOW_SYSTEM_KHR                             = gl.DEBUG_SOURCE_WINDOW_SYSTEM_KHR
-	DEBUG_TYPE_DEPRECATED_BEHAVIOR                             = gl.DEBUG_TYPE_DEPRECATED_BEHAVIOR
-	DEBUG_TYPE_DEPRECATED_BEHAVIOR_ARB                         = gl.DEBUG_TYPE_DEPRECATED_BEHAVIOR_ARB
-	DEBUG_TYPE_DEPRECATED_BEHAVIOR_KHR                         = gl.DEBUG_TYPE_DEPRECATED_BEHAVIOR_KHR
-	DEBUG_TYPE_ERROR                                           = gl.DEBUG_TYPE_ERROR
-	DEBUG_TYPE_ERROR_ARB                                       = gl.DEBUG_TYPE_ERROR_ARB
-	DEBUG_TYPE_ERROR_KHR                                       = gl.DEBUG_TYPE_ERROR_KHR
-	DEBUG_TYPE_MARKER                                          = gl.DEBUG_TYPE_MARKER
-	DEBUG_TYPE_MARKER_KHR                                      = gl.DEBUG_TYPE_MARKER_KHR
-	DEBUG_TYPE_OTHER                                           = gl.DEBUG_TYPE_OTHER
-	DEBUG_TYPE_OTHER_ARB                                       = gl.DEBUG_TYPE_OTHER_ARB
-	DEBUG_TYPE_OTHER_KHR                                       = gl.DEBUG_TYPE_OTHER_KHR
-	DEBUG_TYPE_PERFORMANCE                                     = gl.DEBUG_TYPE_PERFORMANCE
-	DEBUG_TYPE_PERFORMANCE_ARB                                 = gl.DEBUG_TYPE_PERFORMANCE_ARB
-	DEBUG_TYPE_PERFORMANCE_KHR                                 = gl.DEBUG_TYPE_PERFORMANCE_KHR
-	DEBUG_TYPE_POP_GROUP                                       = gl.DEBUG_TYPE_POP_GROUP
-	DEBUG_TYPE_POP_GROUP_KHR                                   = gl.DEBUG_TYPE_POP_GROUP_KHR
-	DEBUG_TYPE_PORTABILITY                                     = gl.DEBUG_TYPE_PORTABILITY
-	DEBUG_TYPE_PORTABILITY_ARB                                 = gl.DEBUG_TYPE_PORTABILITY_ARB
-	DEBUG_TYPE_PORTABILITY_KHR                                 = gl.DEBUG_TYPE_PORTABILITY_KHR
-	DEBUG_TYPE_PUSH_GROUP                                      = gl.DEBUG_TYPE_PUSH_GROUP
-	DEBUG_TYPE_PUSH_GROUP_KHR                                  = gl.DEBUG_TYPE_PUSH_GROUP_KHR
-	DEBUG_TYPE_UNDEFINED_BEHAVIOR                              = gl.DEBUG_TYPE_UNDEFINED_BEHAVIOR
-	DEBUG_TYPE_UNDEFINED_BEHAVIOR_ARB                          = gl.DEBUG_TYPE_UNDEFINED_BEHAVIOR_ARB
-	DEBUG_TYPE_UNDEFINED_BEHAVIOR_KHR                          = gl.DEBUG_TYPE_UNDEFINED_BEHAVIOR_KHR
-	DECODE_EXT                                                 = gl.DECODE_EXT
-	DECR                                                       = gl.DECR
-	DECR_WRAP                                                  = gl.DECR_WRAP
-	DELETE_STATUS                                              = gl.DELETE_STATUS
-	DEPTH                                                      = gl.DEPTH
-	DEPTH24_STENCIL8                                           = gl.DEPTH24_STENCIL8
-	DEPTH32F_STENCIL8                                          = gl.DEPTH32F_STENCIL8
-	DEPTH_ATTACHMENT                                           = gl.DEPTH_ATTACHMENT
-	DEPTH_BUFFER_BIT                                           = gl.DEPTH_BUFFER_BIT
-	DEPTH_CLAMP                                                = gl.DEPTH_CLAMP
-	DEPTH_CLEAR_VALUE                                          = gl.DEPTH_CLEAR_VALUE
-	DEPTH_COMPONENT                                            = gl.DEPTH_COMPONENT
-	DEPTH_COMPONENT16                                          = gl.DEPTH_COMPONENT16
-	DEPTH_COMPONENT24                                          = gl.DEPTH_COMPONENT24
-	DEPTH_COMPONENT32                                          = gl.DEPTH_COMPONENT32
-	DEPTH_COMPONENT32F                                         = gl.DEPTH_COMPONENT32F
-	DEPTH_COMPONENTS                                           = gl.DEPTH_COMPONENTS
-	DEPTH_FUNC                                                 = gl.DEPTH_FUNC
-	DEPTH_RANGE                                                = gl.DEPTH_RANGE
-	DEPTH_RENDERABLE                                           = gl.DEPTH_RENDERABLE
-	DEPTH_STENCIL                                              = gl.DEPTH_STENCIL
-	DEPTH_STENCIL_ATTACHMENT                                   = gl.DEPTH_STENCIL_ATTACHMENT
-	DEPTH_STENCIL_TEXTURE_MODE                                 = gl.DEPTH_STENCIL_TEXTURE_MODE
-	DEPTH_TEST                                                 = gl.DEPTH_TEST
-	DEPTH_WRITEMASK                                            = gl.DEPTH_WRITEMASK
-	DIFFERENCE_KHR                                             = gl.DIFFERENCE_KHR
-	DIFFERENCE_NV                                              = gl.DIFFERENCE_NV
-	DISJOINT_NV                                                = gl.DISJOINT_NV
-	DISPATCH_INDIRECT_BUFFER                                   = gl.DISPATCH_INDIRECT_BUFFER
-	DISPATCH_INDIRECT_BUFFER_BINDING                           = gl.DISPATCH_INDIRECT_BUFFER_BINDING
-	DITHER                                                     = gl.DITHER
-	DONT_CARE                                                  = gl.DONT_CARE
-	DOUBLE                                                     = gl.DOUBLE
-	DOUBLEBUFFER                                               = gl.DOUBLEBUFFER
-	DOUBLE_MAT2                                                = gl.DOUBLE_MAT2
-	DOUBLE_MAT2x3                                              = gl.DOUBLE_MAT2x3
-	DOUBLE_MAT2x4                                              = gl.DOUBLE_MAT2x4
-	DOUBLE_MAT3                                                = gl.DOUBLE_MAT3
-	DOUBLE_MAT3x2                                              = gl.DOUBLE_MAT3x2
-	DOUBLE_MAT3x4                                              = gl.DOUBLE_MAT3x4
-	DOUBLE_MAT4                                                = gl.DOUBLE_MAT4
-	DOUBLE_MAT4x2                                              = gl.DOUBLE_MAT4x2
-	DOUBLE_MAT4x3                                              = gl.DOUBLE_MAT4x3
-	DOUBLE_VEC2                                                = gl.DOUBLE_VEC2
-	DOUBLE_VEC3                                                = gl.DOUBLE_VEC3
-	DOUBLE_VEC4                                                = gl.DOUBLE_VEC4
-	DRAW_BUFFER                                                = gl.DRAW_BUFFER
-	DRAW_BUFFER0                                               = gl.DRAW_BUFFER0
-	DRAW_BUFFER1                                               = gl.DRAW_BUFFER1
-	DRAW_BUFFER10                                              = gl.DRAW_BUFFER10
-	DRAW_BUFFER11                                              = gl.DRAW_BUFFER11
-	DRAW_BUFFER12                                              = gl.DRAW_BUFFER12
-	DRAW_BUFFER13                                              = gl.DRAW_BUFFER13
-	DRAW_BUFFER14                                              = gl.DRAW_BUFFER14
-	DRAW_BUFFER15                                              = gl.DRAW_BUFFER15
-	DRAW_BUFFER2                                               = gl.DRAW_BUFFER2
-	DRAW_BUFFER3                                               = gl.DRAW_BUFFER3
-	DRAW_BUFFER4                                               = gl.DRAW_BUFFER4
-	DRAW_BUFFER5                                               = gl.DRAW_BUFFER5
-	DRAW_BUFFER6                                               = gl.DRAW_BUFFER6
-	DRAW_BUFFER7                                               = gl.DRAW_BUFFER7
-	DRAW_BUFFER8                                               = gl.DRAW_BUFFER8
-	DRAW_BUFFER9                                               = gl.DRAW_BUFFER9
-	DRAW_FRAMEBUFFER                                           = gl.DRAW_FRAMEBUFFER
-	DRAW_FRAMEBUFFER_BINDING                                   = gl.DRAW_FRAMEBUFFER_BINDING
-	DRAW_INDIRECT_BUFFER                                       = gl.DRAW_INDIRECT_BUFFER
-	DRAW_INDIRECT_BUFFER_BINDING                               = gl.DRAW_INDIRECT_BUFFER_BINDING
-	DST_ALPHA                                                  = gl.DST_ALPHA
-	DST_ATOP_NV                                                = gl.DST_ATOP_NV
-	DST_COLOR                                                  = gl.DST_COLOR
-	DST_IN_NV                                                  = gl.DST_IN_NV
-	DST_NV                                                     = gl.DST_NV
-	DST_OUT_NV                                                 = gl.DST_OUT_NV
-	DST_OVER_NV                                                = gl.DST_OVER_NV
-	DYNAMIC_COPY                                               = gl.DYNAMIC_COPY
-	DYNAMIC_DRAW                                               = gl.DYNAMIC_DRAW
-	DYNAMIC_READ                                               = gl.DYNAMIC_READ
-	DYNAMIC_STORAGE_BIT                                        = gl.DYNAMIC_STORAGE_BIT
-	ELEMENT_ARRAY_BARRIER_BIT                                  = gl.ELEMENT_ARRAY_BARRIER_BIT
-	ELEMENT_ARRAY_BUFFER                                       = gl.ELEMENT_ARRAY_BUFFER
-	ELEMENT_ARRAY_BUFFER_BINDING                               = gl.ELEMENT_ARRAY_BUFFER_BINDING
-	EQUAL                                                      = gl.EQUAL
-	EQUIV                                                      = gl.EQUIV
-	EXCLUSION_KHR                                              = gl.EXCLUSION_KHR
-	EXCLUSION_NV                                               = gl.EXCLUSION_NV
-	EXTENSIONS                                                 = gl.EXTENSIONS
-	FALSE                                                      = gl.FALSE
-	FASTEST                                                    = gl.FASTEST
-	FENCE_CONDITION_NV                                         = gl.FENCE_CONDITION_NV
-	FENCE_STATUS_NV                                            = gl.FENCE_STATUS_NV
-	FILL                                                       = gl.FILL
-	FILTER                                                     = gl.FILTER
-	FIRST_VERTEX_CONVENTION                                    = gl.FIRST_VERTEX_CONVENTION
-	FIXED                                                      = gl.FIXED
-	FIXED_OES                                                  = gl.FIXED_OES
-	FIXED_ONLY                                                 = gl.FIXED_ONLY
-	FLOAT                                                      = gl.FLOAT
-	FLOAT_32_UNSIGNED_INT_24_8_REV                             = gl.FLOAT_32_UNSIGNED_INT_24_8_REV
-	FLOAT_MAT2                                                 = gl.FLOAT_MAT2
-	FLOAT_MAT2x3                                               = gl.FLOAT_MAT2x3
-	FLOAT_MAT2x4                                               = gl.FLOAT_MAT2x4
-	FLOAT_MAT3                                                 = gl.FLOAT_MAT3
-	FLOAT_MAT3x2                                               = gl.FLOAT_MAT3x2
-	FLOAT_MAT3x4                                               = gl.FLOAT_MAT3x4
-	FLOAT_MAT4                                                 = gl.FLOAT_MAT4
-	FLOAT_MAT4x2                                               = gl.FLOAT_MAT4x2
-	FLOAT_MAT4x3                                               = gl.FLOAT_MAT4x3
-	FLOAT_VEC2                                                 = gl.FLOAT_VEC2
-	FLOAT_VEC3                                                 = gl.FLOAT_VEC3
-	FLOAT_VEC4                                                 = gl.FLOAT_VEC4
-	FRACTIONAL_EVEN                                            = gl.FRACTIONAL_EVEN
-	FRACTIONAL_ODD                                             = gl.FRACTIONAL_ODD
-	FRAGMENT_INTERPOLATION_OFFSET_BITS                         = gl.FRAGMENT_INTERPOLATION_OFFSET_BITS
-	FRAGMENT_SHADER                                            = gl.FRAGMENT_SHADER
-	FRAGMENT_SHADER_BIT                                        = gl.FRAGMENT_SHADER_BIT
-	FRAGMENT_SHADER_BIT_EXT                                    = gl.FRAGMENT_SHADER_BIT_EXT
-	FRAGMENT_SHADER_DERIVATIVE_HINT                            = gl.FRAGMENT_SHADER_DERIVATIVE_HINT
-	FRAGMENT_SHADER_INVOCATIONS_ARB                            = gl.FRAGMENT_SHADER_INVOCATIONS_ARB
-	FRAGMENT_SUBROUTINE                                        = gl.FRAGMENT_SUBROUTINE
-	FRAGMENT_SUBROUTINE_UNIFORM                                = gl.FRAGMENT_SUBROUTINE_UNIFORM
-	FRAGMENT_TEXTURE                                           = gl.FRAGMENT_TEXTURE
-	FRAMEBUFFER                                                = gl.FRAMEBUFFER
-	FRAMEBUFFER_ATTACHMENT_ALPHA_SIZE                          = gl.FRAMEBUFFER_ATTACHMENT_ALPHA_SIZE
-	FRAMEBUFFER_ATTACHMENT_BLUE_SIZE                           = gl.FRAMEBUFFER_ATTACHMENT_BLUE_SIZE
-	FRAMEBUFFER_ATTACHMENT_COLOR_ENCODING                      = gl.FRAMEBUFFER_ATTACHMENT_COLOR_ENCODING
-	FRAMEBUFFER_ATTACHMENT_COMPONENT_TYPE                      = gl.FRAMEBUFFER_ATTACHMENT_COMPONENT_TYPE
-	FRAMEBUFFER_ATTACHMENT_DEPTH_SIZE                          = gl.FRAMEBUFFER_ATTACHMENT_DEPTH_SIZE
-	FRAMEBUFFER_ATTACHMENT_GREEN_SIZE                          = gl.FRAMEBUFFER_ATTACHMENT_GREEN_SIZE
-	FRAMEBUFFER_ATTACHMENT_LAYERED                             = gl.FRAMEBUFFER_ATTACHMENT_LAYERED
-	FRAMEBUFFER_ATTACHMENT_OBJECT_NAME                         = gl.FRAMEBUFFER_ATTACHMENT_OBJECT_NAME
-	FRAMEBUFFER_ATTACHMENT_OBJECT_TYPE                         = gl.FRAMEBUFFER_ATTACHMENT_OBJECT_TYPE
-	FRAMEBUFFER_ATTACHMENT_RED_SIZE                            = gl.FRAMEBUFFER_ATTACHMENT_RED_SIZE
-	FRAMEBUFFER_ATTACHMENT_STENCIL_SIZE                        = gl.FRAMEBUFFER_ATTACHMENT_STENCIL_SIZE
-	FRAMEBUFFER_ATTACHMENT_TEXTURE_CUBE_MAP_FACE               = gl.FRAMEBUFFER_ATTACHMENT_TEXTURE_CUBE_MAP_FACE
-	FRAMEBUFFER_ATTACHMENT_TEXTURE_LAYER                       = gl.FRAMEBUFFER_ATTACHMENT_TEXTURE_LAYER
-	FRAMEBUFFER_ATTACHMENT_TEXTURE_LEVEL                       = gl.FRAMEBUFFER_ATTACHMENT_TEXTURE_LEVEL
-	FRAMEBUFFER_BARRIER_BIT                                    = gl.FRAMEBUFFER_BARRIER_BIT
-	FRAMEBUFFER_BINDING                                        = gl.FRAMEBUFFER_BINDING
-	FRAMEBUFFER_BLEND                                          = gl.FRAMEBUFFER_BLEND
-	FRAMEBUFFER_COMPLETE                                       = gl.FRAMEBUFFER_COMPLETE
-	FRAMEBUFFER_DEFAULT                                        = gl.FRAMEBUFFER_DEFAULT
-	FRAMEBUFFER_DEFAULT_FIXED_SAMPLE_LOCATIONS                 = gl.FRAMEBUFFER_DEFAULT_FIXED_SAMPLE_LOCATIONS
-	FRAMEBUFFER_DEFAULT_HEIGHT                                 = gl.FRAMEBUFFER_DEFAULT_HEIGHT
-	FRAMEBUFFER_DEFAULT_LAYERS                                 = gl.FRAMEBUFFER_DEFAULT_LAYERS
-	FRAMEBUFFER_DEFAULT_SAMPLES                                = gl.FRAMEBUFFER_DEFAULT_SAMPLES
-	FRAMEBUFFER_DEFAULT_WIDTH                                  = gl.FRAMEBUFFER_DEFAULT_WIDTH
-	FRAMEBUFFER_INCOMPLETE_ATTACHMENT                          = gl.FRAMEBUFFER_INCOMPLETE_ATTACHMENT
-	FRAMEBUFFER_INCOMPLETE_DRAW_BUFFER                         = gl.FRAMEBUFFER_INCOMPLETE_DRAW_BUFFER
-	FRAMEBUFFER_INCOMPLETE_LAYER_TARGETS                       = gl.FRAMEBUFFER_INCOMPLETE_LAYER_TARGETS
-	FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT                  = gl.FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT
-	FRAMEBUFFER_INCOMPLETE_MULTISAMPLE                         = gl.FRAMEBUFFER_INCOMPLETE_MULTISAMPLE
-	FRAMEBUFFER_INCOMPLETE_READ_BUFFER                         = gl.FRAMEBUFFER_INCOMPLETE_READ_BUFFER
-	FRAMEBUFFER_RENDERABLE                                     = gl.FRAMEBUFFER_RENDERABLE
-	FRAMEBUFFER_RENDERABLE_LAYERED                             = gl.FRAMEBUFFER_RENDERABLE_LAYERED
-	FRAMEBUFFER_SRGB                                           = gl.FRAMEBUFFER_SRGB
-	FRAMEBUFFER_UNDEFINED                                      = gl.FRAMEBUFFER_UNDEFINED
-	FRAMEBUFFER_UNSUPPORTED                                    = gl.FRAMEBUFFER_UNSUPPORTED
-	FRONT                                                      = gl.FRONT
-	FRONT_AND_BACK                                             = gl.FRONT_AND_BACK
-	FRONT_FACE                                                 = gl.FRONT_FACE
-	FRONT_LEFT                                                 = gl.FRONT_LEFT
-	FRONT_RIGHT                                                = gl.FRONT_RIGHT
-	FULL_SUPPORT                                               = gl.FULL_SUPPORT
-	FUNC_ADD                                                   = gl.FUNC_ADD
-	FUNC_ADD_EXT                                               = gl.FUNC_ADD_EXT
-	FUNC_REVERSE_SUBTRACT                                      = gl.FUNC_REVERSE_SUBTRACT
-	FUNC_SUBTRACT                                              = gl.FUNC_SUBTRACT
-	GEOMETRY_INPUT_TYPE                                        = gl.GEOMETRY_INPUT_TYPE
-	GEOMETRY_OUTPUT_TYPE                                       = gl.GEOMETRY_OUTPUT_TYPE
-	GEOMETRY_SHADER                                            = gl.GEOMETRY_SHADER
-	GEOMETRY_SHADER_BIT                                        = gl.GEOMETRY_SHADER_BIT
-	GEOMETRY_SHADER_INVOCATIONS                                = gl.GEOMETRY_SHADER_INVOCATIONS
-	GEOMETRY_SHADER_PRIMITIVES_EMITTED_ARB                     = gl.GEOMETRY_SHADER_PRIMITIVES_EMITTED_ARB
-	GEOMETRY_SUBROUTINE                                        = gl.GEOMETRY_SUBROUTINE
-	GEOMETRY_SUBROUTINE_UNIFORM                                = gl.GEOMETRY_SUBROUTINE_UNIFORM
-	GEOMETRY_TEXTURE                                           = gl.GEOMETRY_TEXTURE
-	GEOMETRY_VERTICES_OUT                                      = gl.GEOMETRY_VERTICES_OUT
-	GEQUAL                                                     = gl.GEQUAL
-	GET_TEXTURE_IMAGE_FORMAT                                   = gl.GET_TEXTURE_IMAGE_FORMAT
-	GET_TEXTURE_IMAGE_TYPE                                     = gl.GET_TEXTURE_IMAGE_TYPE
-	GREATER                                                    = gl.GREATER
-	GREEN                                                      = gl.GREEN
-	GREEN_INTEGER                                              = gl.GREEN_INTEGER
-	GREEN_NV                                                   = gl.GREEN_NV
-	GUILTY_CONTEXT_RESET                                       = gl.GUILTY_CONTEXT_RESET
-	GUILTY_CONTEXT_RESET_ARB                                   = gl.GUILTY_CONTEXT_RESET_ARB
-	GUILTY_CONTEXT_RESET_KHR                                   = gl.GUILTY_CONTEXT_RESET_KHR
-	HALF_FLOAT                                                 = gl.HALF_FLOAT
-	HARDLIGHT_KHR                                              = gl.HARDLIGHT_KHR
-	HARDLIGHT_NV                                               = gl.HARDLIGHT_NV
-	HARDMIX_NV                                                 = gl.HARDMIX_NV
-	HIGH_FLOAT                                                 = gl.HIGH_FLOAT
-	HIGH_INT                                                   = gl.HIGH_INT
-	HSL_COLOR_KHR                                              = gl.HSL_COLOR_KHR
-	HSL_COLOR_NV                                               = gl.HSL_COLOR_NV
-	HSL_HUE_KHR                                                = gl.HSL_HUE_KHR
-	HSL_HUE_NV                                                 = gl.HSL_HUE_NV
-	HSL_LUMINOSITY_KHR                                         = gl.HSL_LUMINOSITY_KHR
-	HSL_LUMINOSITY_NV                                          = gl.HSL_LUMINOSITY_NV
-	HSL_SATURATION_KHR                                         = gl.HSL_SATURATION_KHR
-	HSL_SATURATION_NV                                          = gl.HSL_SATURATION_NV
-	IMAGE_1D                                                   = gl.IMAGE_1D
-	IMAGE_1D_ARRAY                                             = gl.IMAGE_1D_ARRAY
-	IMAGE_2D                                                   = gl.IMAGE_2D
-	IMAGE_2D_ARRAY                                             = gl.IMAGE_2D_ARRAY
-	IMAGE_2D_MULTISAMPLE                                       = gl.IMAGE_2D_MULTISAMPLE
-	IMAGE_2D_MULTISAMPLE_ARRAY                                 = gl.IMAGE_2D_MULTISAMPLE_ARRAY
-	IMAGE_2D_RECT                                              = gl.IMAGE_2D_RECT
-	IMAGE_3D                                                   = gl.IMAGE_3D
-	IMAGE_BINDING_ACCESS                                       = gl.IMAGE_BINDING_ACCESS
-	IMAGE_BINDING_FORMAT                                       = gl.IMAGE_BINDING_FORMAT
-	IMAGE_BINDING_LAYER                                        = gl.IMAGE_BINDING_LAYER
-	IMAGE_BINDING_LAYERED                                      = gl.IMAGE_BINDING_LAYERED
-	IMAGE_BINDING_LEVEL                                        = gl.IMAGE_BINDING_LEVEL
-	IMAGE_BINDING_NAME                                         = gl.IMAGE_BINDING_NAME
-	IMAGE_BUFFER                                               = gl.IMAGE_BUFFER
-	IMAGE_CLASS_10_10_10_2                                     = gl.IMAGE_CLASS_10_10_10_2
-	IMAGE_CLASS_11_11_10                                       = gl.IMAGE_CLASS_11_11_10
-	IMAGE_CLASS_1_X_16                                         = gl.IMAGE_CLASS_1_X_16
-	IMAGE_CLASS_1_X_32                                         = gl.IMAGE_CLASS_1_X_32
-	IMAGE_CLASS_1_X_8                                          = gl.IMAGE_CLASS_1_X_8
-	IMAGE_CLASS_2_X_16                                         = gl.IMAGE_CLASS_2_X_16
-	IMAGE_CLASS_2_X_32                                         = gl.IMAGE_CLASS_2_X_32
-	IMAGE_CLASS_2_X_8                                          = gl.IMAGE_CLASS_2_X_8
-	IMAGE_CLASS_4_X_16                                         = gl.IMAGE_CLASS_4_X_16
-	IMAGE_CLASS_4_X_32                                         = gl.IMAGE_CLASS_4_X_32
-	IMAGE_CLASS_4_X_8                                          = gl.IMAGE_CLASS_4_X_8
-	IMAGE_COMPATIBILITY_CLASS                                  = gl.IMAGE_COMPATIBILITY_CLASS
-	IMAGE_CUBE                                                 = gl.IMAGE_CUBE
-	IMAGE_CUBE_MAP_ARRAY                                       = gl.IMAGE_CUBE_MAP_ARRAY
-	IMAGE_FORMAT_COMPATIBILITY_BY_CLASS                        = gl.IMAGE_FORMAT_COMPATIBILITY_BY_CLASS
-	IMAGE_FORMAT_COMPATIBILITY_BY_SIZE                         = gl.IMAGE_FORMAT_COMPATIBILITY_BY_SIZE
-	IMAGE_FORMAT_COMPATIBILITY_TYPE                            = gl.IMAGE_FORMAT_COMPATIBILITY_TYPE
-	IMAGE_PIXEL_FORMAT                                         = gl.IMAGE_PIXEL_FORMAT
-	IMAGE_PIXEL_TYPE                                           = gl.IMAGE_PIXEL_TYPE
-	IMAGE_TEXEL_SIZE                                           = gl.IMAGE_TEXEL_SIZE
-	IMPLEMENTATION_COLOR_READ_FORMAT                           = gl.IMPLEMENTATION_COLOR_READ_FORMAT
-	IMPLEMENTATION_COLOR_READ_FORMAT_OES                       = gl.IMPLEMENTATION_COLOR_READ_FORMAT_OES
-	IMPLEMENTATION_COLOR_READ_TYPE                             = gl.IMPLEMENTATION_COLOR_READ_TYPE
-	IMPLEMENTATION_COLOR_READ_TYPE_OES                         = gl.IMPLEMENTATION_COLOR_READ_TYPE_OES
-	INCR                                                       = gl.INCR
-	INCR_WRAP                                                  = gl.INCR_WRAP
-	INFO_LOG_LENGTH                                            = gl.INFO_LOG_LENGTH
-	INNOCENT_CONTEXT_RESET                                     = gl.INNOCENT_CONTEXT_RESET
-	INNOCENT_CONTEXT_RESET_ARB                                 = gl.INNOCENT_CONTEXT_RESET_ARB
-	INNOCENT_CONTEXT_RESET_KHR                                 = gl.INNOCENT_CONTEXT_RESET_KHR
-	INT                                                        = gl.INT
-	INTERLEAVED_ATTRIBS                                        = gl.INTERLEAVED_ATTRIBS
-	INTERNALFORMAT_ALPHA_SIZE                                  = gl.INTERNALFORMAT_ALPHA_SIZE
-	INTERNALFORMAT_ALPHA_TYPE                                  = gl.INTERNALFORMAT_ALPHA_TYPE
-	INTERNALFORMAT_BLUE_SIZE                                   = gl.INTERNALFORMAT_BLUE_SIZE
-	INTERNALFORMAT_BLUE_TYPE                                   = gl.INTERNALFORMAT_BLUE_TYPE
-	INTERNALFORMAT_DEPTH_SIZE                                  = gl.INTERNALFORMAT_DEPTH_SIZE
-	INTERNALFORMAT_DEPTH_TYPE                                  = gl.INTERNALFORMAT_DEPTH_TYPE
-	INTERNALFORMAT_GREEN_SIZE                                  = gl.INTERNALFORMAT_GREEN_SIZE
-	INTERNALFORMAT_GREEN_TYPE                                  = gl.INTERNALFORMAT_GREEN_TYPE
-	INTERNALFORMAT_PREFERRED                                   = gl.INTERNALFORMAT_PREFERRED
-	INTERNALFORMAT_RED_SIZE                                    = gl.INTERNALFORMAT_RED_SIZE
-	INTERNALFORMAT_RED_TYPE                                    = gl.INTERNALFORMAT_RED_TYPE
-	INTERNALFORMAT_SHARED_SIZE                                 = gl.INTERNALFORMAT_SHARED_SIZE
-	INTERNALFORMAT_STENCIL_SIZE                                = gl.INTERNALFORMAT_STENCIL_SIZE
-	INTERNALFORMAT_STENCIL_TYPE                                = gl.INTERNALFORMAT_STENCIL_TYPE
-	INTERNALFORMAT_SUPPORTED                                   = gl.INTERNALFORMAT_SUPPORTED
-	INT_2_10_10_10_REV                                         = gl.INT_2_10_10_10_REV
-	INT_IMAGE_1D                                               = gl.INT_IMAGE_1D
-	INT_IMAGE_1D_ARRAY                                         = gl.INT_IMAGE_1D_ARRAY
-	INT_IMAGE_2D                                               = gl.INT_IMAGE_2D
-	INT_IMAGE_2D_ARRAY                                         = gl.INT_IMAGE_2D_ARRAY
-	INT_IMAGE_2D_MULTISAMPLE                                   = gl.INT_IMAGE_2D_MULTISAMPLE
-	INT_IMAGE_2D_MULTISAMPLE_ARRAY                             = gl.INT_IMAGE_2D_MULTISAMPLE_ARRAY
-	INT_IMAGE_2D_RECT                                          = gl.INT_IMAGE_2D_RECT
-	INT_IMAGE_3D                                               = gl.INT_IMAGE_3D
-	INT_IMAGE_BUFFER                                           = gl.INT_IMAGE_BUFFER
-	INT_IMAGE_CUBE                                             = gl.INT_IMAGE_CUBE
-	INT_IMAGE_CUBE_MAP_ARRAY                                   = gl.INT_IMAGE_CUBE_MAP_ARRAY
-	INT_SAMPLER_1D                                             = gl.INT_SAMPLER_1D
-	INT_SAMPLER_1D_ARRAY                                       = gl.INT_SAMPLER_1D_ARRAY
-	INT_SAMPLER_2D                                             = gl.INT_SAMPLER_2D
-	INT_SAMPLER_2D_ARRAY                                       = gl.INT_SAMPLER_2D_ARRAY
-	INT_SAMPLER_2D_MULTISAMPLE                                 = gl.INT_SAMPLER_2D_MULTISAMPLE
-	INT_SAMPLER_2D_MULTISAMPLE_ARRAY                           = gl.INT_SAMPLER_2D_MULTISAMPLE_ARRAY
-	INT_SAMPLER_2D_RECT                                        = gl.INT_SAMPLER_2D_RECT
-	INT_SAMPLER_3D                                             = gl.INT_SAMPLER_3D
-	INT_SAMPLER_BUFFER                                         = gl.INT_SAMPLER_BUFFER
-	INT_SAMPLER_CUBE                                           = gl.INT_SAMPLER_CUBE
-	INT_SAMPLER_CUBE_MAP_ARRAY_ARB                             = gl.INT_SAMPLER_CUBE_MAP_ARRAY_ARB
-	INT_VEC2                                                   = gl.INT_VEC2
-	INT_VEC3                                                   = gl.INT_VEC3
-	INT_VEC4                                                   = gl.INT_VEC4
-	INVALID_ENUM                                               = gl.INVALID_ENUM
-	INVALID_FRAMEBUFFER_OPERATION                              = gl.INVALID_FRAMEBUFFER_OPERATION
-	INVALID_INDEX                                              = gl.INVALID_INDEX
-	INVALID_OPERATION                                          = gl.INVALID_OPERATION
-	INVALID_VALUE                                              = gl.INVALID_VALUE
-	INVERT                                                     = gl.INVERT
-	INVERT_OVG_NV                                              = gl.INVERT_OVG_NV
-	INVERT_RGB_NV                                              = gl.INVERT_RGB_NV
-	ISOLINES                                                   = gl.ISOLINES
-	IS_PER_PATCH                                               = gl.IS_PER_PATCH
-	IS_ROW_MAJOR                                               = gl.IS_ROW_MAJOR
-	KEEP                                                       = gl.KEEP
-	LAST_VERTEX_CONVENTION                                     = gl.LAST_VERTEX_CONVENTION
-	LAYER_PROVOKING_VERTEX                                     = gl.LAYER_PROVOKING_VERTEX
-	LEFT                                                       = gl.LEFT
-	LEQUAL                                                     = gl.LEQUAL
-	LESS                                                       = gl.LESS
-	LIGHTEN_KHR                                                = gl.LIGHTEN_KHR
-	LIGHTEN_NV                                                 = gl.LIGHTEN_NV
-	LINE                                                       = gl.LINE
-	LINEAR                                                     = gl.LINEAR
-	LINEARBURN_NV                                              = gl.LINEARBURN_NV
-	LINEARDODGE_NV                                             = gl.LINEARDODGE_NV
-	LINEARLIGHT_NV                                             = gl.LINEARLIGHT_NV
-	LINEAR_MIPMAP_LINEAR                                       = gl.LINEAR_MIPMAP_LINEAR
-	LINEAR_MIPMAP_NEAREST                                      = gl.LINEAR_MIPMAP_NEAREST
-	LINES                                                      = gl.LINES
-	LINES_ADJACENCY                                            = gl.LINES_ADJACENCY
-	LINE_LOOP                                                  = gl.LINE_LOOP
-	LINE_SMOOTH                                                = gl.LINE_SMOOTH
-	LINE_SMOOTH_HINT                                           = gl.LINE_SMOOTH_HINT
-	LINE_STRIP                                                 = gl.LINE_STRIP
-	LINE_STRIP_ADJACENCY                                       = gl.LINE_STRIP_ADJACENCY
-	LINE_WIDTH                                                 = gl.LINE_WIDTH
-	LINE_WIDTH_GRANULARITY                                     = gl.LINE_WIDTH_GRANULARITY
-	LINE_WIDTH_RANGE                                           = gl.LINE_WIDTH_RANGE
-	LINK_STATUS                                                = gl.LINK_STATUS
-	LOCATION                                                   = gl.LOCATION
-	LOCATION_COMPONENT                                         = gl.LOCATION_COMPONENT
-	LOCATION_INDEX                                             = gl.LOCATION_INDEX
-	LOGIC_OP_MODE                                              = gl.LOGIC_OP_MODE
-	LOSE_CONTEXT_ON_RESET                                      = gl.LOSE_CONTEXT_ON_RESET
-	LOSE_CONTEXT_ON_RESET_ARB                                  = gl.LOSE_CONTEXT_ON_RESET_ARB
-	LOSE_CONTEXT_ON_RESET_KHR                                  = gl.LOSE_CONTEXT_ON_RESET_KHR
-	LOWER_LEFT                                                 = gl.LOWER_LEFT
-	LOW_FLOAT                                                  = gl.LOW_FLOAT
-	LOW_INT                                                    = gl.LOW_INT
-	MAJOR_VERSION                                              = gl.MAJOR_VERSION
-	MANUAL_GENERATE_MIPMAP                                     = gl.MANUAL_GENERATE_MIPMAP
-	MAP_COHERENT_BIT                                           = gl.MAP_COHERENT_BIT
-	MAP_FLUSH_EXPLICIT_BIT                                     = gl.MAP_FLUSH_EXPLICIT_BIT
-	MAP_INVALIDATE_BUFFER_BIT                                  = gl.MAP_INVALIDATE_BUFFER_BIT
-	MAP_INVALIDATE_RANGE_BIT                                   = gl.MAP_INVALIDATE_RANGE_BIT
-	MAP_PERSISTENT_BIT                                         = gl.MAP_PERSISTENT_BIT
-	MAP_READ_BIT                                               = gl.MAP_READ_BIT
-	MAP_UNSYNCHRONIZED_BIT                                     = gl.MAP_UNSYNCHRONIZED_BIT
-	MAP_WRITE_BIT                                              = gl.MAP_WRITE_BIT
-	MATRIX_STRIDE                                              = gl.MATRIX_STRIDE
-	MAX                                                        = gl.MAX
-	MAX_3D_TEXTURE_SIZE                                        = gl.MAX_3D_TEXTURE_SIZE
-	MAX_ARRAY_TEXTURE_LAYERS                                   = gl.MAX_ARRAY_TEXTURE_LAYERS
-	MAX_ATOMIC_COUNTER_BUFFER_BINDINGS                         = gl.MAX_ATOMIC_COUNTER_BUFFER_BINDINGS
-	MAX_ATOMIC_COUNTER_BUFFER_SIZE                             = gl.MAX_ATOMIC_COUNTER_BUFFER_SIZE
-	MAX_CLIP_DISTANCES                                         = gl.MAX_CLIP_DISTANCES
-	MAX_COLOR_ATTACHMENTS                                      = gl.MAX_COLOR_ATTACHMENTS
-	MAX_COLOR_TEXTURE_SAMPLES                                  = gl.MAX_COLOR_TEXTURE_SAMPLES
-	MAX_COMBINED_ATOMIC_COUNTERS                               = gl.MAX_COMBINED_ATOMIC_COUNTERS
-	MAX_COMBINED_ATOMIC_COUNTER_BUFFERS                        = gl.MAX_COMBINED_ATOMIC_COUNTER_BUFFERS
-	MAX_COMBINED_CLIP_AND_CULL_DISTANCES                       = gl.MAX_COMBINED_CLIP_AND_CULL_DISTANCES
-	MAX_COMBINED_COMPUTE_UNIFORM_COMPONENTS                    = gl.MAX_COMBINED_COMPUTE_UNIFORM_COMPONENTS
-	MAX_COMBINED_DIMENSIONS                                    = gl.MAX_COMBINED_DIMENSIONS
-	MAX_COMBINED_FRAGMENT_UNIFORM_COMPONENTS                   = gl.MAX_COMBINED_FRAGMENT_UNIFORM_COMPONENTS
-	MAX_COMBINED_GEOMETRY_UNIFORM_COMPONENTS                   = gl.MAX_COMBINED_GEOMETRY_UNIFORM_COMPONENTS
-	MAX_COMBINED_IMAGE_UNIFORMS                                = gl.MAX_COMBINED_IMAGE_UNIFORMS
-	MAX_COMBINED_IMAGE_UNITS_AND_FRAGMENT_OUTPUTS              = gl.MAX_COMBINED_IMAGE_UNITS_AND_FRAGMENT_OUTPUTS
-	MAX_COMBINED_SHADER_OUTPUT_RESOURCES                       = gl.MAX_COMBINED_SHADER_OUTPUT_RESOURCES
-	MAX_COMBINED_SHADER_STORAGE_BLOCKS                         = gl.MAX_COMBINED_SHADER_STORAGE_BLOCKS
-	MAX_COMBINED_TESS_CONTROL_UNIFORM_COMPONENTS               = gl.MAX_COMBINED_TESS_CONTROL_UNIFORM_COMPONENTS
-	MAX_COMBINED_TESS_EVALUATION_UNIFORM_COMPONENTS            = gl.MAX_COMBINED_TESS_EVALUATION_UNIFORM_COMPONENTS
-	MAX_COMBINED_TEXTURE_IMAGE_UNITS                           = gl.MAX_COMBINED_TEXTURE_IMAGE_UNITS
-	MAX_COMBINED_UNIFORM_BLOCKS                                = gl.MAX_COMBINED_UNIFORM_BLOCKS
-	MAX_COMBINED_VERTEX_UNIFORM_COMPONENTS                     = gl.MAX_COMBINED_VERTEX_UNIFORM_COMPONENTS
-	MAX_COMPUTE_ATOMIC_COUNTERS                                = gl.MAX_COMPUTE_ATOMIC_COUNTERS
-	MAX_COMPUTE_ATOMIC_COUNTER_BUFFERS                         = gl.MAX_COMPUTE_ATOMIC_COUNTER_BUFFERS
-	MAX_COMPUTE_FIXED_GROUP_INVOCATIONS_ARB                    = gl.MAX_COMPUTE_FIXED_GROUP_INVOCATIONS_ARB
-	MAX_COMPUTE_FIXED_GROUP_SIZE_ARB                           = gl.MAX_COMPUTE_FIXED_GROUP_SIZE_ARB
-	MAX_COMPUTE_IMAGE_UNIFORMS                                 = gl.MAX_COMPUTE_IMAGE_UNIFORMS
-	MAX_COMPUTE_SHADER_STORAGE_BLOCKS                          = gl.MAX_COMPUTE_SHADER_STORAGE_BLOCKS
-	MAX_COMPUTE_SHARED_MEMORY_SIZE                             = gl.MAX_COMPUTE_SHARED_MEMORY_SIZE
-	MAX_COMPUTE_TEXTURE_IMAGE_UNITS                            = gl.MAX_COMPUTE_TEXTURE_IMAGE_UNITS
-	MAX_COMPUTE_UNIFORM_BLOCKS                                 = gl.MAX_COMPUTE_UNIFORM_BLOCKS
-	MAX_COMPUTE_UNIFORM_COMPONENTS                             = gl.MAX_COMPUTE_UNIFORM_COMPONENTS
-	MAX_COMPUTE_VARIABLE_GROUP_INVOCATIONS_ARB                 = gl.MAX_COMPUTE_VARIABLE_GROUP_INVOCATIONS_ARB
-	MAX_COMPUTE_VARIABLE_GROUP_SIZE_ARB                        = gl.MAX_COMPUTE_VARIABLE_GROUP_SIZE_ARB
-	MAX_COMPUTE_WORK_GROUP_COUNT                               = gl.MAX_COMPUTE_WORK_GROUP_COUNT
-	MAX_COMPUTE_WORK_GROUP_INVOCATIONS                         = gl.MAX_COMPUTE_WORK_GROUP_INVOCATIONS
-	MAX_COMPUTE_WORK_GROUP_SIZE                                = gl.MAX_COMPUTE_WORK_GROUP_SIZE
-	MAX_CUBE_MAP_TEXTURE_SIZE                                  = gl.MAX_CUBE_MAP_TEXTURE_SIZE
-	MAX_CULL_DISTANCES                                         = gl.MAX_CULL_DISTANCES
-	MAX_DEBUG_GROUP_STACK_DEPTH                                = gl.MAX_DEBUG_GROUP_STACK_DEPTH
-	MAX_DEBUG_GROUP_STACK_DEPTH_KHR                            = gl.MAX_DEBUG_GROUP_STACK_DEPTH_KHR
-	MAX_DEBUG_LOGGED_MESSAGES                                  = gl.MAX_DEBUG_LOGGED_MESSAGES
-	MAX_DEBUG_LOGGED_MESSAGES_ARB                              = gl.MAX_DEBUG_LOGGED_MESSAGES_ARB
-	MAX_DEBUG_LOGGED_MESSAGES_KHR                              = gl.MAX_DEBUG_LOGGED_MESSAGES_KHR
-	MAX_DEBUG_MESSAGE_LENGTH                                   = gl.MAX_DEBUG_MESSAGE_LENGTH
-	MAX_DEBUG_MESSAGE_LENGTH_ARB                               = gl.MAX_DEBUG_MESSAGE_LENGTH_ARB
-	MAX_DEBUG_MESSAGE_LENGTH_KHR                               = gl.MAX_DEBUG_MESSAGE_LENGTH_KHR
-	MAX_DEPTH                                                  = gl.MAX_DEPTH
-	MAX_DEPTH_TEXTURE_SAMPLES                                  = gl.MAX_DEPTH_TEXTURE_SAMPLES
-	MAX_DRAW_BUFFERS                                           = gl.MAX_DRAW_BUFFERS
-	MAX_DUAL_SOURCE_DRAW_BUFFERS                               = gl.MAX_DUAL_SOURCE_DRAW_BUFFERS
-	MAX_ELEMENTS_INDICES                                       = gl.MAX_ELEMENTS_INDICES
-	MAX_ELEMENTS_VERTICES                                      = gl.MAX_ELEMENTS_VERTICES
-	MAX_ELEMENT_INDEX                                          = gl.MAX_ELEMENT_INDEX
-	MAX_EXT                                                    = gl.MAX_EXT
-	MAX_FRAGMENT_ATOMIC_COUNTERS                               = gl.MAX_FRAGMENT_ATOMIC_COUNTERS
-	MAX_FRAGMENT_ATOMIC_COUNTER_BUFFERS                        = gl.MAX_FRAGMENT_ATOMIC_COUNTER_BUFFERS
-	MAX_FRAGMENT_IMAGE_UNIFORMS                                = gl.MAX_FRAGMENT_IMAGE_UNIFORMS
-	MAX_FRAGMENT_INPUT_COMPONENTS                              = gl.MAX_FRAGMENT_INPUT_COMPONENTS
-	MAX_FRAGMENT_INTERPOLATION_OFFSET                          = gl.MAX_FRAGMENT_INTERPOLATION_OFFSET
-	MAX_FRAGMENT_SHADER_STORAGE_BLOCKS                         = gl.MAX_FRAGMENT_SHADER_STORAGE_BLOCKS
-	MAX_FRAGMENT_UNIFORM_BLOCKS                                = gl.MAX_FRAGMENT_UNIFORM_BLOCKS
-	MAX_FRAGMENT_UNIFORM_COMPONENTS                            = gl.MAX_FRAGMENT_UNIFORM_COMPONENTS
-	MAX_FRAGMENT_UNIFORM_VECTORS                               = gl.MAX_FRAGMENT_UNIFORM_VECTORS
-	MAX_FRAMEBUFFER_HEIGHT                                     = gl.MAX_FRAMEBUFFER_HEIGHT
-	MAX_FRAMEBUFFER_LAYERS                                     = gl.MAX_FRAMEBUFFER_LAYERS
-	MAX_FRAMEBUFFER_SAMPLES                                    = gl.MAX_FRAMEBUFFER_SAMPLES
-	MAX_FRAMEBUFFER_WIDTH                                      = gl.MAX_FRAMEBUFFER_WIDTH
-	MAX_GEOMETRY_ATOMIC_COUNTERS                               = gl.MAX_GEOMETRY_ATOMIC_COUNTERS
-	MAX_GEOMETRY_ATOMIC_COUNTER_BUFFERS                        = gl.MAX_GEOMETRY_ATOMIC_COUNTER_BUFFERS
-	MAX_GEOMETRY_IMAGE_UNIFORMS                                = gl.MAX_GEOMETRY_IMAGE_UNIFORMS
-	MAX_GEOMETRY_INPUT_COMPONENTS                              = gl.MAX_GEOMETRY_INPUT_COMPONENTS
-	MAX_GEOMETRY_OUTPUT_COMPONENTS                             = gl.MAX_GEOMETRY_OUTPUT_COMPONENTS
-	MAX_GEOMETRY_OUTPUT_VERTICES                               = gl.MAX_GEOMETRY_OUTPUT_VERTICES
-	MAX_GEOMETRY_SHADER_INVOCATIONS                            = gl.MAX_GEOMETRY_SHADER_INVOCATIONS
-	MAX_GEOMETRY_SHADER_STORAGE_BLOCKS                         = gl.MAX_GEOMETRY_SHADER_STORAGE_BLOCKS
-	MAX_GEOMETRY_TEXTURE_IMAGE_UNITS                           = gl.MAX_GEOMETRY_TEXTURE_IMAGE_UNITS
-	MAX_GEOMETRY_TOTAL_OUTPUT_COMPONENTS                       = gl.MAX_GEOMETRY_TOTAL_OUTPUT_COMPONENTS
-	MAX_GEOMETRY_UNIFORM_BLOCKS                                = gl.MAX_GEOMETRY_UNIFORM_BLOCKS
-	MAX_GEOMETRY_UNIFORM_COMPONENTS                            = gl.MAX_GEOMETRY_UNIFORM_COMPONENTS
-	MAX_HEIGHT                                                 = gl.MAX_HEIGHT
-	MAX_IMAGE_SAMPLES                                          = gl.MAX_IMAGE_SAMPLES
-	MAX_IMAGE_UNITS                                            = gl.MAX_IMAGE_UNITS
-	MAX_INTEGER_SAMPLES                                        = gl.MAX_INTEGER_SAMPLES
-	MAX_LABEL_LENGTH                                           = gl.MAX_LABEL_LENGTH
-	MAX_LABEL_LENGTH_KHR                                       = gl.MAX_LABEL_LENGTH_KHR
-	MAX_LAYERS                                                 = gl.MAX_LAYERS
-	MAX_NAME_LENGTH                                            = gl.MAX_NAME_LENGTH
-	MAX_NUM_ACTIVE_VARIABLES                                   = gl.MAX_NUM_ACTIVE_VARIABLES
-	MAX_NUM_COMPATIBLE_SUBROUTINES                             = gl.MAX_NUM_COMPATIBLE_SUBROUTINES
-	MAX_PATCH_VERTICES                                         = gl.MAX_PATCH_VERTICES
-	MAX_PROGRAM_TEXEL_OFFSET                                   = gl.MAX_PROGRAM_TEXEL_OFFSET
-	MAX_PROGRAM_TEXTURE_GATHER_COMPONENTS_ARB                  = gl.MAX_PROGRAM_TEXTURE_GATHER_COMPONENTS_ARB
-	MAX_PROGRAM_TEXTURE_GATHER_OFFSET_ARB                      = gl.MAX_PROGRAM_TEXTURE_GATHER_OFFSET_ARB
-	MAX_RECTANGLE_TEXTURE_SIZE                                 = gl.MAX_RECTANGLE_TEXTURE_SIZE
-	MAX_RENDERBUFFER_SIZE                                      = gl.MAX_RENDERBUFFER_SIZE
-	MAX_SAMPLES                                                = gl.MAX_SAMPLES
-	MAX_SAMPLE_MASK_WORDS                                      = gl.MAX_SAMPLE_MASK_WORDS
-	MAX_SERVER_WAIT_TIMEOUT                                    = gl.MAX_SERVER_WAIT_TIMEOUT
-	MAX_SHADER_STORAGE_BLOCK_SIZE                              = gl.MAX_SHADER_STORAGE_BLOCK_SIZE
-	MAX_SHADER_STORAGE_BUFFER_BINDINGS                         = gl.MAX_SHADER_STORAGE_BUFFER_BINDINGS
-	MAX_SPARSE_3D_TEXTURE_SIZE_ARB                             = gl.MAX_SPARSE_3D_TEXTURE_SIZE_ARB
-	MAX_SPARSE_ARRAY_TEXTURE_LAYERS_ARB                        = gl.MAX_SPARSE_ARRAY_TEXTURE_LAYERS_ARB
-	MAX_SPARSE_TEXTURE_SIZE_ARB                                = gl.MAX_SPARSE_TEXTURE_SIZE_ARB
-	MAX_SUBROUTINES                                            = gl.MAX_SUBROUTINES
-	MAX_SUBROUTINE_UNIFORM_LOCATIONS                           = gl.MAX_SUBROUTINE_UNIFORM_LOCATIONS
-	MAX_TESS_CONTROL_ATOMIC_COUNTERS                           = gl.MAX_TESS_CONTROL_ATOMIC_COUNTERS
-	MAX_TESS_CONTROL_ATOMIC_COUNTER_BUFFERS                    = gl.MAX_TESS_CONTROL_ATOMIC_COUNTER_BUFFERS
-	MAX_TESS_CONTROL_IMAGE_UNIFORMS                            = gl.MAX_TESS_CONTROL_IMAGE_UNIFORMS
-	MAX_TESS_CONTROL_INPUT_COMPONENTS                          = gl.MAX_TESS_CONTROL_INPUT_COMPONENTS
-	MAX_TESS_CONTROL_OUTPUT_COMPONENTS                         = gl.MAX_TESS_CONTROL_OUTPUT_COMPONENTS
-	MAX_TESS_CONTROL_SHADER_STORAGE_BLOCKS                     = gl.MAX_TESS_CONTROL_SHADER_STORAGE_BLOCKS
-	MAX_TESS_CONTROL_TEXTURE_IMAGE_UNITS                       = gl.MAX_TESS_CONTROL_TEXTURE_IMAGE_UNITS
-	MAX_TESS_CONTROL_TOTAL_OUTPUT_COMPONENTS                   = gl.MAX_TESS_CONTROL_TOTAL_OUTPUT_COMPONENTS
-	MAX_TESS_CONTROL_UNIFORM_BLOCKS                            = gl.MAX_TESS_CONTROL_UNIFORM_BLOCKS
-	MAX_TESS_CONTROL_UNIFORM_COMPONENTS                        = gl.MAX_TESS_CONTROL_UNIFORM_COMPONENTS
-	MAX_TESS_EVALUATION_ATOMIC_COUNTERS                        = gl.MAX_TESS_EVALUATION_ATOMIC_COUNTERS
-	MAX_TESS_EVALUATION_ATOMIC_COUNTER_BUFFERS                 = gl.MAX_TESS_EVALUATION_ATOMIC_COUNTER_BUFFERS
-	MAX_TESS_EVALUATION_IMAGE_UNIFORMS                         = gl.MAX_TESS_EVALUATION_IMAGE_UNIFORMS
-	MAX_TESS_EVALUATION_INPUT_COMPONENTS                       = gl.MAX_TESS_EVALUATION_INPUT_COMPONENTS
-	MAX_TESS_EVALUATION_OUTPUT_COMPONENTS                      = gl.MAX_TESS_EVALUATION_OUTPUT_COMPONENTS
-	MAX_TESS_EVALUATION_SHADER_STORAGE_BLOCKS                  = gl.MAX_TESS_EVALUATION_SHADER_STORAGE_BLOCKS
-	MAX_TESS_EVALUATION_TEXTURE_IMAGE_UNITS                    = gl.MAX_TESS_EVALUATION_TEXTURE_IMAGE_UNITS
-	MAX_TESS_EVALUATION_UNIFORM_BLOCKS                         = gl.MAX_TESS_EVALUATION_UNIFORM_BLOCKS
-	MAX_TESS_EVALUATION_UNIFORM_COMPONENTS                     = gl.MAX_TESS_EVALUATION_UNIFORM_COMPONENTS
-	MAX_TESS_GEN_LEVEL                                         = gl.MAX_TESS_GEN_LEVEL
-	MAX_TESS_PATCH_COMPONENTS                                  = gl.MAX_TESS_PATCH_COMPONENTS
-	MAX_TEXTURE_BUFFER_SIZE                                    = gl.MAX_TEXTURE_BUFFER_SIZE
-	MAX_TEXTURE_IMAGE_UNITS                                    = gl.MAX_TEXTURE_IMAGE_UNITS
-	MAX_TEXTURE_LOD_BIAS                                       = gl.MAX_TEXTURE_LOD_BIAS
-	MAX_TEXTURE_LOD_BIAS_EXT                                   = gl.MAX_TEXTURE_LOD_BIAS_EXT
-	MAX_TEXTURE_MAX_ANISOTROPY_EXT                             = gl.MAX_TEXTURE_MAX_ANISOTROPY_EXT
-	MAX_TEXTURE_SIZE                                           = gl.MAX_TEXTURE_SIZE
-	MAX_TRANSFORM_FEEDBACK_BUFFERS                             = gl.MAX_TRANSFORM_FEEDBACK_BUFFERS
-	MAX_TRANSFORM_FEEDBACK_INTERLEAVED_COMPONENTS              = gl.MAX_TRANSFORM_FEEDBACK_INTERLEAVED_COMPONENTS
-	MAX_TRANSFORM_FEEDBACK_SEPARATE_ATTRIBS                    = gl.MAX_TRANSFORM_FEEDBACK_SEPARATE_ATTRIBS
-	MAX_TRANSFORM_FEEDBACK_SEPARATE_COMPONENTS                 = gl.MAX_TRANSFORM_FEEDBACK_SEPARATE_COMPONENTS
-	MAX_UNIFORM_BLOCK_SIZE                                     = gl.MAX_UNIFORM_BLOCK_SIZE
-	MAX_UNIFORM_BUFFER_BINDINGS                                = gl.MAX_UNIFORM_BUFFER_BINDINGS
-	MAX_UNIFORM_LOCATIONS                                      = gl.MAX_UNIFORM_LOCATIONS
-	MAX_VARYING_COMPONENTS                                     = gl.MAX_VARYING_COMPONENTS
-	MAX_VARYING_FLOATS                                         = gl.MAX_VARYING_FLOATS
-	MAX_VARYING_VECTORS                                        = gl.MAX_VARYING_VECTORS
-	MAX_VERTEX_ATOMIC_COUNTERS                                 = gl.MAX_VERTEX_ATOMIC_COUNTERS
-	MAX_VERTEX_ATOMIC_COUNTER_BUFFERS                          = gl.MAX_VERTEX_ATOMIC_COUNTER_BUFFERS
-	MAX_VERTEX_ATTRIBS                                         = gl.MAX_VERTEX_ATTRIBS
-	MAX_VERTEX_ATTRIB_BINDINGS                                 = gl.MAX_VERTEX_ATTRIB_BINDINGS
-	MAX_VERTEX_ATTRIB_RELATIVE_OFFSET                          = gl.MAX_VERTEX_ATTRIB_RELATIVE_OFFSET
-	MAX_VERTEX_IMAGE_UNIFORMS                                  = gl.MAX_VERTEX_IMAGE_UNIFORMS
-	MAX_VERTEX_OUTPUT_COMPONENTS                               = gl.MAX_VERTEX_OUTPUT_COMPONENTS
-	MAX_VERTEX_SHADER_STORAGE_BLOCKS                           = gl.MAX_VERTEX_SHADER_STORAGE_BLOCKS
-	MAX_VERTEX_STREAMS                                         = gl.MAX_VERTEX_STREAMS
-	MAX_VERTEX_TEXTURE_IMAGE_UNITS                             = gl.MAX_VERTEX_TEXTURE_IMAGE_UNITS
-	MAX_VERTEX_UNIFORM_BLOCKS                                  = gl.MAX_VERTEX_UNIFORM_BLOCKS
-	MAX_VERTEX_UNIFORM_COMPONENTS                              = gl.MAX_VERTEX_UNIFORM_COMPONENTS
-	MAX_VERTEX_UNIFORM_VECTORS                                 = gl.MAX_VERTEX_UNIFORM_VECTORS
-	MAX_VIEWPORTS                                              = gl.MAX_VIEWPORTS
-	MAX_VIEWPORT_DIMS                                          = gl.MAX_VIEWPORT_DIMS
-	MAX_WIDTH                                                  = gl.MAX_WIDTH
-	MEDIUM_FLOAT                                               = gl.MEDIUM_FLOAT
-	MEDIUM_INT                                                 = gl.MEDIUM_INT
-	MIN                                                        = gl.MIN
-	MINOR_VERSION                                              = gl.MINOR_VERSION
-	MINUS_CLAMPED_NV                                           = gl.MINUS_CLAMPED_NV
-	MINUS_NV                                                   = gl.MINUS_NV
-	MIN_EXT                                                    = gl.MIN_EXT
-	MIN_FRAGMENT_INTERPOLATION_OFFSET                          = gl.MIN_FRAGMENT_INTERPOLATION_OFFSET
-	MIN_MAP_BUFFER_ALIGNMENT                                   = gl.MIN_MAP_BUFFER_ALIGNMENT
-	MIN_PROGRAM_TEXEL_OFFSET                                   = gl.MIN_PROGRAM_TEXEL_OFFSET
-	MIN_PROGRAM_TEXTURE_GATHER_OFFSET_ARB                      = gl.MIN_PROGRAM_TEXTURE_GATHER_OFFSET_ARB
-	MIN_SAMPLE_SHADING_VALUE_ARB                               = gl.MIN_SAMPLE_SHADING_VALUE_ARB
-	MIN_SPARSE_LEVEL_ARB                                       = gl.MIN_SPARSE_LEVEL_ARB
-	MIPMAP                                                     = gl.MIPMAP
-	MIRRORED_REPEAT                                            = gl.MIRRORED_REPEAT
-	MIRROR_CLAMP_TO_EDGE                                       = gl.MIRROR_CLAMP_TO_EDGE
-	MULTIPLY_KHR                                               = gl.MULTIPLY_KHR
-	MULTIPLY_NV                                                = gl.MULTIPLY_NV
-	MULTISAMPLE                                                = gl.MULTISAMPLE
-	NAMED_STRING_LENGTH_ARB                                    = gl.NAMED_STRING_LENGTH_ARB
-	NAMED_STRING_TYPE_ARB                                      = gl.NAMED_STRING_TYPE_ARB
-	NAME_LENGTH                                                = gl.NAME_LENGTH
-	NAND                                                       = gl.NAND
-	NEAREST                                                    = gl.NEAREST
-	NEAREST_MIPMAP_LINEAR                                      = gl.NEAREST_MIPMAP_LINEAR
-	NEAREST_MIPMAP_NEAREST                                     = gl.NEAREST_MIPMAP_NEAREST
-	NEGATIVE_ONE_TO_ONE                                        = gl.NEGATIVE_ONE_TO_ONE
-	NEVER                                                      = gl.NEVER
-	NICEST                                                     = gl.NICEST
-	NONE                                                       = gl.NONE
-	NOOP                                                       = gl.NOOP
-	NOR                                                        = gl.NOR
-	NOTEQUAL                                                   = gl.NOTEQUAL
-	NO_ERROR                                                   = gl.NO_ERROR
-	NO_RESET_NOTIFICATION                                      = gl.NO_RESET_NOTIFICATION
-	NO_RESET_NOTIFICATION_ARB                                  = gl.NO_RESET_NOTIFICATION_ARB
-	NO_RESET_NOTIFICATION_KHR                                  = gl.NO_RESET_NOTIFICATION_KHR
-	NUM_ACTIVE_VARIABLES                                       = gl.NUM_ACTIVE_VARIABLES
-	NUM_COMPATIBLE_SUBROUTINES                                 = gl.NUM_COMPATIBLE_SUBROUTINES
-	NUM_COMPRESSED_TEXTURE_FORMATS                             = gl.NUM_COMPRESSED_TEXTURE_FORMATS
-	NUM_EXTENSIONS                                             = gl.NUM_EXTENSIONS
-	NUM_PROGRAM_BINARY_FORMATS                                 = gl.NUM_PROGRAM_BINARY_FORMATS
-	NUM_SAMPLE_COUNTS                                          = gl.NUM_SAMPLE_COUNTS
-	NUM_SHADER_BINARY_FORMATS                                  = gl.NUM_SHADER_BINARY_FORMATS
-	NUM_VIRTUAL_PAGE_SIZES_ARB                                 = gl.NUM_VIRTUAL_PAGE_SIZES_ARB
-	OBJECT_TYPE                                                = gl.OBJECT_TYPE
-	OFFSET                                                     = gl.OFFSET
-	ONE                                                        = gl.ONE
-	ONE_MINUS_CONSTANT_ALPHA                                   = gl.ONE_MINUS_CONSTANT_ALPHA
-	ONE_MINUS_CONSTANT_COLOR                                   = gl.ONE_MINUS_CONSTANT_COLOR
-	ONE_MINUS_DST_ALPHA                                        = gl.ONE_MINUS_DST_ALPHA
-	ONE_MINUS_DST_COLOR                                        = gl.ONE_MINUS_DST_COLOR
-	ONE_MINUS_SRC1_ALPHA                                       = gl.ONE_MINUS_SRC1_ALPHA
-	ONE_MINUS_SRC1_COLOR                                       = gl.ONE_MINUS_SRC1_COLOR
-	ONE_MINUS_SRC_ALPHA                                        = gl.ONE_MINUS_SRC_ALPHA
-	ONE_MINUS_SRC_COLOR                                        = gl.ONE_MINUS_SRC_COLOR
-	OR                                                         = gl.OR
-	OR_INVERTED                                                = gl.OR_INVERTED
-	OR_REVERSE                                                 = gl.OR_REVERSE
-	OUT_OF_MEMORY                                              = gl.OUT_OF_MEMORY
-	OVERLAY_KHR                                                = gl.OVERLAY_KHR
-	OVERLAY_NV                                                 = gl.OVERLAY_NV
-	PACK_ALIGNMENT                                             = gl.PACK_ALIGNMENT
-	PACK_COMPRESSED_BLOCK_DEPTH                                = gl.PACK_COMPRESSED_BLOCK_DEPTH
-	PACK_COMPRESSED_BLOCK_HEIGHT                               = gl.PACK_COMPRESSED_BLOCK_HEIGHT
-	PACK_COMPRESSED_BLOCK_SIZE                                 = gl.PACK_COMPRESSED_BLOCK_SIZE
-	PACK_COMPRESSED_BLOCK_WIDTH                                = gl.PACK_COMPRESSED_BLOCK_WIDTH
-	PACK_IMAGE_HEIGHT                                          = gl.PACK_IMAGE_HEIGHT
-	PACK_LSB_FIRST                                             = gl.PACK_LSB_FIRST
-	PACK_ROW_LENGTH                                            = gl.PACK_ROW_LENGTH
-	PACK_SKIP_IMAGES                                           = gl.PACK_SKIP_IMAGES
-	PACK_SKIP_PIXELS                                           = gl.PACK_SKIP_PIXELS
-	PACK_SKIP_ROWS                                             = gl.PACK_SKIP_ROWS
-	PACK_SWAP_BYTES                                            = gl.PACK_SWAP_BYTES
-	PALETTE4_R5_G6_B5_OES                                      = gl.PALETTE4_R5_G6_B5_OES
-	PALETTE4_RGB5_A1_OES                                       = gl.PALETTE4_RGB5_A1_OES
-	PALETTE4_RGB8_OES                                          = gl.PALETTE4_RGB8_OES
-	PALETTE4_RGBA4_OES                                         = gl.PALETTE4_RGBA4_OES
-	PALETTE4_RGBA8_OES                                         = gl.PALETTE4_RGBA8_OES
-	PALETTE8_R5_G6_B5_OES                                      = gl.PALETTE8_R5_G6_B5_OES
-	PALETTE8_RGB5_A1_OES                                       = gl.PALETTE8_RGB5_A1_OES
-	PALETTE8_RGB8_OES                                          = gl.PALETTE8_RGB8_OES
-	PALETTE8_RGBA4_OES                                         = gl.PALETTE8_RGBA4_OES
-	PALETTE8_RGBA8_OES                                         = gl.PALETTE8_RGBA8_OES
-	PARAMETER_BUFFER_ARB                                       = gl.PARAMETER_BUFFER_ARB
-	PARAMETER_BUFFER_BINDING_ARB                               = gl.PARAMETER_BUFFER_BINDING_ARB
-	PATCHES                                                    = gl.PATCHES
-	PATCH_DEFAULT_INNER_LEVEL                                  = gl.PATCH_DEFAULT_INNER_LEVEL
-	PATCH_DEFAULT_OUTER_LEVEL                                  = gl.PATCH_DEFAULT_OUTER_LEVEL
-	PATCH_VERTICES                                             = gl.PATCH_VERTICES
-	PERCENTAGE_AMD                                             = gl.PERCENTAGE_AMD
-	PERFMON_RESULT_AMD                                         = gl.PERFMON_RESULT_AMD
-	PERFMON_RESULT_AVAILABLE_AMD                               = gl.PERFMON_RESULT_AVAILABLE_AMD
-	PERFMON_RESULT_SIZE_AMD                                    = gl.PERFMON_RESULT_SIZE_AMD
-	PERFQUERY_COUNTER_DATA_BOOL32_INTEL                        = gl.PERFQUERY_COUNTER_DATA_BOOL32_INTEL
-	PERFQUERY_COUNTER_DATA_DOUBLE_INTEL                        = gl.PERFQUERY_COUNTER_DATA_DOUBLE_INTEL
-	PERFQUERY_COUNTER_DATA_FLOAT_INTEL                         = gl.PERFQUERY_COUNTER_DATA_FLOAT_INTEL
-	PERFQUERY_COUNTER_DATA_UINT32_INTEL                        = gl.PERFQUERY_COUNTER_DATA_UINT32_INTEL
-	PERFQUERY_COUNTER_DATA_UINT64_INTEL                        = gl.PERFQUERY_COUNTER_DATA_UINT64_INTEL
-	PERFQUERY_COUNTER_DESC_LENGTH_MAX_INTEL                    = gl.PERFQUERY_COUNTER_DESC_LENGTH_MAX_INTEL
-	PERFQUERY_COUNTER_DURATION_NORM_INTEL                      = gl.PERFQUERY_COUNTER_DURATION_NORM_INTEL
-	PERFQUERY_COUNTER_DURATION_RAW_INTEL                       = gl.PERFQUERY_COUNTER_DURATION_RAW_INTEL
-	PERFQUERY_COUNTER_EVENT_INTEL                              = gl.PERFQUERY_COUNTER_EVENT_INTEL
-	PERFQUERY_COUNTER_NAME_LENGTH_MAX_INTEL                    = gl.PERFQUERY_COUNTER_NAME_LENGTH_MAX_INTEL
-	PERFQUERY_COUNTER_RAW_INTEL                                = gl.PERFQUERY_COUNTER_RAW_INTEL
-	PERFQUERY_COUNTER_THROUGHPUT_INTEL                         = gl.PERFQUERY_COUNTER_THROUGHPUT_INTEL
-	PERFQUERY_COUNTER_TIMESTAMP_INTEL                          = gl.PERFQUERY_COUNTER_TIMESTAMP_INTEL
-	PERFQUERY_DONOT_FLUSH_INTEL                                = gl.PERFQUERY_DONOT_FLUSH_INTEL
-	PERFQUERY_FLUSH_INTEL                                      = gl.PERFQUERY_FLUSH_INTEL
-	PERFQUERY_GLOBAL_CONTEXT_INTEL                             = gl.PERFQUERY_GLOBAL_CONTEXT_INTEL
-	PERFQUERY_GPA_EXTENDED_COUNTERS_INTEL                      = gl.PERFQUERY_GPA_EXTENDED_COUNTERS_INTEL
-	PERFQUERY_QUERY_NAME_LENGTH_MAX_INTEL                      = gl.PERFQUERY_QUERY_NAME_LENGTH_MAX_INTEL
-	PERFQUERY_SINGLE_CONTEXT_INTEL                             = gl.PERFQUERY_SINGLE_CONTEXT_INTEL
-	PERFQUERY_WAIT_INTEL                                       = gl.PERFQUERY_WAIT_INTEL
-	PINLIGHT_NV                                                = gl.PINLIGHT_NV
-	PIXEL_BUFFER_BARRIER_BIT                                   = gl.PIXEL_BUFFER_BARRIER_BIT
-	PIXEL_PACK_BUFFER                                          = gl.PIXEL_PACK_BUFFER
-	PIXEL_PACK_BUFFER_BINDING                                  = gl.PIXEL_PACK_BUFFER_BINDING
-	PIXEL_UNPACK_BUFFER                                        = gl.PIXEL_UNPACK_BUFFER
-	PIXEL_UNPACK_BUFFER_BINDING                                = gl.PIXEL_UNPACK_BUFFER_BINDING
-	PLUS_CLAMPED_ALPHA_NV                                      = gl.PLUS_CLAMPED_ALPHA_NV
-	PLUS_CLAMPED_NV                                            = gl.PLUS_CLAMPED_NV
-	PLUS_DARKER_NV                                             = gl.PLUS_DARKER_NV
-	PLUS_NV                                                    = gl.PLUS_NV
-	POINT                                                      = gl.POINT
-	POINTS                                                     = gl.POINTS
-	POINT_FADE_THRESHOLD_SIZE                                  = gl.POINT_FADE_THRESHOLD_SIZE
-	POINT_SIZE                                                 = gl.POINT_SIZE
-	POINT_SIZE_GRANULARITY                                     = gl.POINT_SIZE_GRANULARITY
-	POINT_SIZE_RANGE                                           = gl.POINT_SIZE_RANGE
-	POINT_SPRITE_COORD_ORIGIN                                  = gl.POINT_SPRITE_COORD_ORIGIN
-	POLYGON_MODE                                               = gl.POLYGON_MODE
-	POLYGON_OFFSET_FACTOR                                      = gl.POLYGON_OFFSET_FACTOR
-	POLYGON_OFFSET_FILL                                        = gl.POLYGON_OFFSET_FILL
-	POLYGON_OFFSET_LINE                                        = gl.POLYGON_OFFSET_LINE
-	POLYGON_OFFSET_POINT                                       = gl.POLYGON_OFFSET_POINT
-	POLYGON_OFFSET_UNITS                                       = gl.POLYGON_OFFSET_UNITS
-	POLYGON_SMOOTH                                             = gl.POLYGON_SMOOTH
-	POLYGON_SMOOTH_HINT                                        = gl.POLYGON_SMOOTH_HINT
-	PRIMITIVES_GENERATED                                       = gl.PRIMITIVES_GENERATED
-	PRIMITIVES_SUBMITTED_ARB                                   = gl.PRIMITIVES_SUBMITTED_ARB
-	PRIMITIVE_RESTART                                          = gl.PRIMITIVE_RESTART
-	PRIMITIVE_RESTART_FIXED_INDEX                              = gl.PRIMITIVE_RESTART_FIXED_INDEX
-	PRIMITIVE_RESTART_INDEX                                    = gl.PRIMITIVE_RESTART_INDEX
-	PROGRAM                                                    = gl.PROGRAM
-	PROGRAM_BINARY_FORMATS                                     = gl.PROGRAM_BINARY_FORMATS
-	PROGRAM_BINARY_LENGTH                                      = gl.PROGRAM_BINARY_LENGTH
-	PROGRAM_BINARY_RETRIEVABLE_HINT                            = gl.PROGRAM_BINARY_RETRIEVABLE_HINT
-	PROGRAM_INPUT                                              = gl.PROGRAM_INPUT
-	PROGRAM_KHR                                                = gl.PROGRAM_KHR
-	PROGRAM_OBJECT_EXT                                         = gl.PROGRAM_OBJECT_EXT
-	PROGRAM_OUTPUT                                             = gl.PROGRAM_OUTPUT
-	PROGRAM_PIPELINE                                           = gl.PROGRAM_PIPELINE
-	PROGRAM_PIPELINE_BINDING                                   = gl.PROGRAM_PIPELINE_BINDING
-	PROGRAM_PIPELINE_BINDING_EXT                               = gl.PROGRAM_PIPELINE_BINDING_EXT
-	PROGRAM_PIPELINE_OBJECT_EXT                                = gl.PROGRAM_PIPELINE_OBJECT_EXT
-	PROGRAM_POINT_SIZE                                         = gl.PROGRAM_POINT_SIZE
-	PROGRAM_SEPARABLE                                          = gl.PROGRAM_SEPARABLE
-	PROGRAM_SEPARABLE_EXT                                      = gl.PROGRAM_SEPARABLE_EXT
-	PROVOKING_VERTEX                                           = gl.PROVOKING_VERTEX
-	PROXY_TEXTURE_1D                                           = gl.PROXY_TEXTURE_1D
-	PROXY_TEXTURE_1D_ARRAY                                     = gl.PROXY_TEXTURE_1D_ARRAY
-	PROXY_TEXTURE_2D                                           = gl.PROXY_TEXTURE_2D
-	PROXY_TEXTURE_2D_ARRAY                                     = gl.PROXY_TEXTURE_2D_ARRAY
-	PROXY_TEXTURE_2D_MULTISAMPLE                               = gl.PROXY_TEXTURE_2D_MULTISAMPLE
-	PROXY_TEXTURE_2D_MULTISAMPLE_ARRAY                         = gl.PROXY_TEXTURE_2D_MULTISAMPLE_ARRAY
-	PROXY_TEXTURE_3D                                           = gl.PROXY_TEXTURE_3D
-	PROXY_TEXTURE_CUBE_MAP                                     = gl.PROXY_TEXTURE_CUBE_MAP
-	PROXY_TEXTURE_CUBE_MAP_ARRAY_ARB                           = gl.PROXY_TEXTURE_CUBE_MAP_ARRAY_ARB
-	PROXY_TEXTURE_RECTANGLE                                    = gl.PROXY_TEXTURE_RECTANGLE
-	QUADS                                                      = gl.QUADS
-	QUADS_FOLLOW_PROVOKING_VERTEX_CONVENTION                   = gl.QUADS_FOLLOW_PROVOKING_VERTEX_CONVENTION
-	QUERY                                                      = gl.QUERY
-	QUERY_BUFFER                                               = gl.QUERY_BUFFER
-	QUERY_BUFFER_BARRIER_BIT                                   = gl.QUERY_BUFFER_BARRIER_BIT
-	QUERY_BUFFER_BINDING                                       = gl.QUERY_BUFFER_BINDING
-	QUERY_BY_REGION_NO_WAIT                                    = gl.QUERY_BY_REGION_NO_WAIT
-	QUERY_BY_REGION_NO_WAIT_INVERTED                           = gl.QUERY_BY_REGION_NO_WAIT_INVERTED
-	QUERY_BY_REGION_WAIT                                       = gl.QUERY_BY_REGION_WAIT
-	QUERY_BY_REGION_WAIT_INVERTED                              = gl.QUERY_BY_REGION_WAIT_INVERTED
-	QUERY_COUNTER_BITS                                         = gl.QUERY_COUNTER_BITS
-	QUERY_KHR                                                  = gl.QUERY_KHR
-	QUERY_NO_WAIT                                              = gl.QUERY_NO_WAIT
-	QUERY_NO_WAIT_INVERTED                                     = gl.QUERY_NO_WAIT_INVERTED
-	QUERY_OBJECT_EXT                                           = gl.QUERY_OBJECT_EXT
-	QUERY_RESULT                                               = gl.QUERY_RESULT
-	QUERY_RESULT_AVAILABLE                                     = gl.QUERY_RESULT_AVAILABLE
-	QUERY_RESULT_NO_WAIT                                       = gl.QUERY_RESULT_NO_WAIT
-	QUERY_TARGET                                               = gl.QUERY_TARGET
-	QUERY_WAIT                                                 = gl.QUERY_WAIT
-	QUERY_WAIT_INVERTED                                        = gl.QUERY_WAIT_INVERTED
-	R11F_G11F_B10F                                             = gl.R11F_G11F_B10F
-	R16                                                        = gl.R16
-	R16F                                                       = gl.R16F
-	R16I                                                       = gl.R16I
-	R16UI                                                      = gl.R16UI
-	R16_SNORM                                                  = gl.R16_SNORM
-	R32F                                                       = gl.R32F
-	R32I                                                       = gl.R32I
-	R32UI                                                      = gl.R32UI
-	R3_G3_B2                                                   = gl.R3_G3_B2
-	R8                                                         = gl.R8
-	R8I                                                        = gl.R8I
-	R8UI                                                       = gl.R8UI
-	R8_SNORM                                                   = gl.R8_SNORM
-	RASTERIZER_DISCARD                                         = gl.RASTERIZER_DISCARD
-	READ_BUFFER                                                = gl.READ_BUFFER
-	READ_FRAMEBUFFER                                           = gl.READ_FRAMEBUFFER
-	READ_FRAMEBUFFER_BINDING                                   = gl.READ_FRAMEBUFFER_BINDING
-	READ_ONLY                                                  = gl.READ_ONLY
-	READ_PIXELS                                                = gl.READ_PIXELS
-	READ_PIXELS_FORMAT                                         = gl.READ_PIXELS_FORMAT
-	READ_PIXELS_TYPE                                           = gl.READ_PIXELS_TYPE
-	READ_WRITE                                                 = gl.READ_WRITE
-	RED                                                        = gl.RED
-	RED_INTEGER                                                = gl.RED_INTEGER
-	RED_NV                                                     = gl.RED_NV
-	REFERENCED_BY_COMPUTE_SHADER                               = gl.REFERENCED_BY_COMPUTE_SHADER
-	REFERENCED_BY_FRAGMENT_SHADER                              = gl.REFERENCED_BY_FRAGMENT_SHADER
-	REFERENCED_BY_GEOMETRY_SHADER                              = gl.REFERENCED_BY_GEOMETRY_SHADER
-	REFERENCED_BY_TESS_CONTROL_SHADER                          = gl.REFERENCED_BY_TESS_CONTROL_SHADER
-	REFERENCED_BY_TESS_EVALUATION_SHADER                       = gl.REFERENCED_BY_TESS_EVALUATION_SHADER
-	REFERENCED_BY_VERTEX_SHADER                                = gl.REFERENCED_BY_VERTEX_SHADER
-	RENDERBUFFER                                               = gl.RENDERBUFFER
-	RENDERBUFFER_ALPHA_SIZE                                    = gl.RENDERBUFFER_ALPHA_SIZE
-	RENDERBUFFER_BINDING                                       = gl.RENDERBUFFER_BINDING
-	RENDERBUFFER_BLUE_SIZE                                     = gl.RENDERBUFFER_BLUE_SIZE
-	RENDERBUFFER_DEPTH_SIZE                                    = gl.RENDERBUFFER_DEPTH_SIZE
-	RENDERBUFFER_GREEN_SIZE                                    = gl.RENDERBUFFER_GREEN_SIZE
-	RENDERBUFFER_HEIGHT                                        = gl.RENDERBUFFER_HEIGHT
-	RENDERBUFFER_INTERNAL_FORMAT                               = gl.RENDERBUFFER_INTERNAL_FORMAT
-	RENDERBUFFER_RED_SIZE                                      = gl.RENDERBUFFER_RED_SIZE
-	RENDERBUFFER_SAMPLES                                       = gl.RENDERBUFFER_SAMPLES
-	RENDERBUFFER_STENCIL_SIZE                                  = gl.RENDERBUFFER_STENCIL_SIZE
-	RENDERBUFFER_WIDTH                                         = gl.RENDERBUFFER_WIDTH
-	RENDERER                                                   = gl.RENDERER
-	REPEAT                                                     = gl.REPEAT
-	REPLACE                                                    = gl.REPLACE
-	RESET_NOTIFICATION_STRATEGY                                = gl.RESET_NOTIFICATION_STRATEGY
-	RESET_NOTIFICATION_STRATEGY_ARB                            = gl.RESET_NOTIFICATION_STRATEGY_ARB
-	RESET_NOTIFICATION_STRATEGY_KHR                            = gl.RESET_NOTIFICATION_STRATEGY_KHR
-	RG                                                         = gl.RG
-	RG16                                                       = gl.RG16
-	RG16F                                                      = gl.RG16F
-	RG16I                                                      = gl.RG16I
-	RG16UI                                                     = gl.RG16UI
-	RG16_SNORM                                                 = gl.RG16_SNORM
-	RG32F                                                      = gl.RG32F
-	RG32I                                                      = gl.RG32I
-	RG32UI                                                     = gl.RG32UI
-	RG8                                                        = gl.RG8
-	RG8I                                                       = gl.RG8I
-	RG8UI                                                      = gl.RG8UI
-	RG8_SNORM                                                  = gl.RG8_SNORM
-	RGB                                                        = gl.RGB
-	RGB10                                                      = gl.RGB10
-	RGB10_A2                                                   = gl.RGB10_A2
-	RGB10_A2UI                                                 = gl.RGB10_A2UI
-	RGB12                                                      = gl.RGB12
-	RGB16                                                      = gl.RGB16
-	RGB16F                                                     = gl.RGB16F
-	RGB16I                                                     = gl.RGB16I
-	RGB16UI                                                    = gl.RGB16UI
-	RGB16_SNORM                                                = gl.RGB16_SNORM
-	RGB32F                                                     = gl.RGB32F
-	RGB32I                                                     = gl.RGB32I
-	RGB32UI                                                    = gl.RGB32UI
-	RGB4                                                       = gl.RGB4
-	RGB5                                                       = gl.RGB5
-	RGB565                                                     = gl.RGB565
-	RGB5_A1                                                    = gl.RGB5_A1
-	RGB8                                                       = gl.RGB8
-	RGB8I                                                      = gl.RGB8I
-	RGB8UI                                                     = gl.RGB8UI
-	RGB8_SNORM                                                 = gl.RGB8_SNORM
-	RGB9_E5                                                    = gl.RGB9_E5
-	RGBA                                                       = gl.RGBA
-	RGBA12                                                     = gl.RGBA12
-	RGBA16                                                     = gl.RGBA16
-	RGBA16F                                                    = gl.RGBA16F
-	RGBA16I                                                    = gl.RGBA16I
-	RGBA16UI                                                   = gl.RGBA16UI
-	RGBA16_SNORM                                               = gl.RGBA16_SNORM
-	RGBA2                                                      = gl.RGBA2
-	RGBA32F                                                    = gl.RGBA32F
-	RGBA32I                                                    = gl.RGBA32I
-	RGBA32UI                                                   = gl.RGBA32UI
-	RGBA4                                                      = gl.RGBA4
-	RGBA8                                                      = gl.RGBA8
-	RGBA8I                                                     = gl.RGBA8I
-	RGBA8UI                                                    = gl.RGBA8UI
-	RGBA8_SNORM                                                = gl.RGBA8_SNORM
-	RGBA_INTEGER                                               = gl.RGBA_INTEGER
-	RGB_422_APPLE                                              = gl.RGB_422_APPLE
-	RGB_INTEGER                                                = gl.RGB_INTEGER
-	RGB_RAW_422_APPLE                                          = gl.RGB_RAW_422_APPLE
-	RG_INTEGER                                                 = gl.RG_INTEGER
-	RIGHT                                                      = gl.RIGHT
-	SAMPLER                                                    = gl.SAMPLER
-	SAMPLER_1D                                                 = gl.SAMPLER_1D
-	SAMPLER_1D_ARRAY                                           = gl.SAMPLER_1D_ARRAY
-	SAMPLER_1D_ARRAY_SHADOW                                    = gl.SAMPLER_1D_ARRAY_SHADOW
-	SAMPLER_1D_SHADOW                                          = gl.SAMPLER_1D_SHADOW
-	SAMPLER_2D                                                 = gl.SAMPLER_2D
-	SAMPLER_2D_ARRAY                                           = gl.SAMPLER_2D_ARRAY
-	SAMPLER_2D_ARRAY_SHADOW                                    = gl.SAMPLER_2D_ARRAY_SHADOW
-	SAMPLER_2D_MULTISAMPLE                                     = gl.SAMPLER_2D_MULTISAMPLE
-	SAMPLER_2D_MULTISAMPLE_ARRAY                               = gl.SAMPLER_2D_MULTISAMPLE_ARRAY
-	SAMPLER_2D_RECT                                            = gl.SAMPLER_2D_RECT
-	SAMPLER_2D_RECT_SHADOW                                     = gl.SAMPLER_2D_RECT_SHADOW
-	SAMPLER_2D_SHADOW                                          = gl.SAMPLER_2D_SHADOW
-	SAMPLER_3D                                                 = gl.SAMPLER_3D
-	SAMPLER_BINDING                                            = gl.SAMPLER_BINDING
-	SAMPLER_BUFFER                                             = gl.SAMPLER_BUFFER
-	SAMPLER_CUBE                                               = gl.SAMPLER_CUBE
-	SAMPLER_CUBE_MAP_ARRAY_ARB                                 = gl.SAMPLER_CUBE_MAP_ARRAY_ARB
-	SAMPLER_CUBE_MAP_ARRAY_SHADOW_ARB                          = gl.SAMPLER_CUBE_MAP_ARRAY_SHADOW_ARB
-	SAMPLER_CUBE_SHADOW                                        = gl.SAMPLER_CUBE_SHADOW
-	SAMPLER_KHR                                                = gl.SAMPLER_KHR
-	SAMPLES                                                    = gl.SAMPLES
-	SAMPLES_PASSED                                             = gl.SAMPLES_PASSED
-	SAMPLE_ALPHA_TO_COVERAGE                                   = gl.SAMPLE_ALPHA_TO_COVERAGE
-	SAMPLE_ALPHA_TO_ONE                                        = gl.SAMPLE_ALPHA_TO_ONE
-	SAMPLE_BUFFERS                                             = gl.SAMPLE_BUFFERS
-	SAMPLE_COVERAGE                                            = gl.SAMPLE_COVERAGE
-	SAMPLE_COVERAGE_INVERT                                     = gl.SAMPLE_COVERAGE_INVERT
-	SAMPLE_COVERAGE_VALUE                                      = gl.SAMPLE_COVERAGE_VALUE
-	SAMPLE_MASK                                                = gl.SAMPLE_MASK
-	SAMPLE_MASK_VALUE                                          = gl.SAMPLE_MASK_VALUE
-	SAMPLE_POSITION                                            = gl.SAMPLE_POSITION
-	SAMPLE_SHADING_ARB                                         = gl.SAMPLE_SHADING_ARB
-	SCISSOR_BOX                                                = gl.SCISSOR_BOX
-	SCISSOR_TEST                                               = gl.SCISSOR_TEST
-	SCREEN_KHR                                                 = gl.SCREEN_KHR
-	SCREEN_NV                                                  = gl.SCREEN_NV
-	SEPARATE_ATTRIBS                                           = gl.SEPARATE_ATTRIBS
-	SET                                                        = gl.SET
-	SHADER                                                     = gl.SHADER
-	SHADER_BINARY_FORMATS                                      = gl.SHADER_BINARY_FORMATS
-	SHADER_COMPILER                                            = gl.SHADER_COMPILER
-	SHADER_IMAGE_ACCESS_BARRIER_BIT                            = gl.SHADER_IMAGE_ACCESS_BARRIER_BIT
-	SHADER_IMAGE_ATOMIC                                        = gl.SHADER_IMAGE_ATOMIC
-	SHADER_IMAGE_LOAD                                          = gl.SHADER_IMAGE_LOAD
-	SHADER_IMAGE_STORE                                         = gl.SHADER_IMAGE_STORE
-	SHADER_INCLUDE_ARB                                         = gl.SHADER_INCLUDE_ARB
-	SHADER_KHR                                                 = gl.SHADER_KHR
-	SHADER_OBJECT_EXT                                          = gl.SHADER_OBJECT_EXT
-	SHADER_SOURCE_LENGTH                                       = gl.SHADER_SOURCE_LENGTH
-	SHADER_STORAGE_BARRIER_BIT                                 = gl.SHADER_STORAGE_BARRIER_BIT
-	SHADER_STORAGE_BLOCK                                       = gl.SHADER_STORAGE_BLOCK
-	SHADER_STORAGE_BUFFER                                      = gl.SHADER_STORAGE_BUFFER
-	SHADER_STORAGE_BUFFER_BINDING                              = gl.SHADER_STORAGE_BUFFER_BINDING
-	SHADER_STORAGE_BUFFER_OFFSET_ALIGNMENT                     = gl.SHADER_STORAGE_BUFFER_OFFSET_ALIGNMENT
-	SHADER_STORAGE_BUFFER_SIZE                                 = gl.SHADER_STORAGE_BUFFER_SIZE
-	SHADER_STORAGE_BUFFER_START                                = gl.SHADER_STORAGE_BUFFER_START
-	SHADER_TYPE                                                = gl.SHADER_TYPE
-	SHADING_LANGUAGE_VERSION                                   = gl.SHADING_LANGUAGE_VERSION
-	SHORT                                                      = gl.SHORT
-	SIGNALED                                                   = gl.SIGNALED
-	SIGNED_NORMALIZED                                          = gl.SIGNED_NORMALIZED
-	SIMULTANEOUS_TEXTURE_AND_DEPTH_TEST                        = gl.SIMULTANEOUS_TEXTURE_AND_DEPTH_TEST
-	SIMULTANEOUS_TEXTURE_AND_DEPTH_WRITE                       = gl.SIMULTANEOUS_TEXTURE_AND_DEPTH_WRITE
-	SIMULTANEOUS_TEXTURE_AND_STENCIL_TEST                      = gl.SIMULTANEOUS_TEXTURE_AND_STENCIL_TEST
-	SIMULTANEOUS_TEXTURE_AND_STENCIL_WRITE                     = gl.SIMULTANEOUS_TEXTURE_AND_STENCIL_WRITE
-	SKIP_DECODE_EXT                                            = gl.SKIP_DECODE_EXT
-	SMOOTH_LINE_WIDTH_GRANULARITY                              = gl.SMOOTH_LINE_WIDTH_GRANULARITY
-	SMOOTH_LINE_WIDTH_RANGE                                    = gl.SMOOTH_LINE_WIDTH_RANGE
-	SMOOTH_POINT_SIZE_GRANULARITY                              = gl.SMOOTH_POINT_SIZE_GRANULARITY
-	SMOOTH_POINT_SIZE_RANGE                                    = gl.SMOOTH_POINT_SIZE_RANGE
-	SOFTLIGHT_KHR                                              = gl.SOFTLIGHT_KHR
-	SOFTLIGHT_NV                                               = gl.SOFTLIGHT_NV
-	SPARSE_BUFFER_PAGE_SIZE_ARB                                = gl.SPARSE_BUFFER_PAGE_SIZE_ARB
-	SPARSE_STORAGE_BIT_ARB                                     = gl.SPARSE_STORAGE_BIT_ARB
-	SPARSE_TEXTURE_FULL_ARRAY_CUBE_MIPMAPS_ARB                 = gl.SPARSE_TEXTURE_FULL_ARRAY_CUBE_MIPMAPS_ARB
-	SRC1_ALPHA                                                 = gl.SRC1_ALPHA
-	SRC1_COLOR                                                 = gl.SRC1_COLOR
-	SRC_ALPHA                                                  = gl.SRC_ALPHA
-	SRC_ALPHA_SATURATE                                         = gl.SRC_ALPHA_SATURATE
-	SRC_ATOP_NV                                                = gl.SRC_ATOP_NV
-	SRC_COLOR                                                  = gl.SRC_COLOR
-	SRC_IN_NV                                                  = gl.SRC_IN_NV
-	SRC_NV                                                     = gl.SRC_NV
-	SRC_OUT_NV                                                 = gl.SRC_OUT_NV
-	SRC_OVER_NV                                                = gl.SRC_OVER_NV
-	SRGB                                                       = gl.SRGB
-	SRGB8                                                      = gl.SRGB8
-	SRGB8_ALPHA8                                               = gl.SRGB8_ALPHA8
-	SRGB_ALPHA                                                 = gl.SRGB_ALPHA
-	SRGB_DECODE_ARB                                            = gl.SRGB_DECODE_ARB
-	SRGB_READ                                                  = gl.SRGB_READ
-	SRGB_WRITE                                                 = gl.SRGB_WRITE
-	STACK_OVERFLOW                                             = gl.STACK_OVERFLOW
-	STACK_OVERFLOW_KHR                                         = gl.STACK_OVERFLOW_KHR
-	STACK_UNDERFLOW                                            = gl.STACK_UNDERFLOW
-	STACK_UNDERFLOW_KHR                                        = gl.STACK_UNDERFLOW_KHR
-	STATIC_COPY                                                = gl.STATIC_COPY
-	STATIC_DRAW                                                = gl.STATIC_DRAW
-	STATIC_READ                                                = gl.STATIC_READ
-	STENCIL                                                    = gl.STENCIL
-	STENCIL_ATTACHMENT                                         = gl.STENCIL_ATTACHMENT
-	STENCIL_BACK_FAIL                                          = gl.STENCIL_BACK_FAIL
-	STENCIL_BACK_FUNC                                          = gl.STENCIL_BACK_FUNC
-	STENCIL_BACK_PASS_DEPTH_FAIL                               = gl.STENCIL_BACK_PASS_DEPTH_FAIL
-	STENCIL_BACK_PASS_DEPTH_PASS                               = gl.STENCIL_BACK_PASS_DEPTH_PASS
-	STENCIL_BACK_REF                                           = gl.STENCIL_BACK_REF
-	STENCIL_BACK_VALUE_MASK                                    = gl.STENCIL_BACK_VALUE_MASK
-	STENCIL_BACK_WRITEMASK                                     = gl.STENCIL_BACK_WRITEMASK
-	STENCIL_BUFFER_BIT                                         = gl.STENCIL_BUFFER_BIT
-	STENCIL_CLEAR_VALUE                                        = gl.STENCIL_CLEAR_VALUE
-	STENCIL_COMPONENTS                                         = gl.STENCIL_COMPONENTS
-	STENCIL_FAIL                                               = gl.STENCIL_FAIL
-	STENCIL_FUNC                                               = gl.STENCIL_FUNC
-	STENCIL_INDEX                                              = gl.STENCIL_INDEX
-	STENCIL_INDEX1                                             = gl.STENCIL_INDEX1
-	STENCIL_INDEX16                                            = gl.STENCIL_INDEX16
-	STENCIL_INDEX4                                             = gl.STENCIL_INDEX4
-	STENCIL_INDEX8                                             = gl.STENCIL_INDEX8
-	STENCIL_PASS_DEPTH_FAIL                                    = gl.STENCIL_PASS_DEPTH_FAIL
-	STENCIL_PASS_DEPTH_PASS                                    = gl.STENCIL_PASS_DEPTH_PASS
-	STENCIL_REF                                                = gl.STENCIL_REF
-	STENCIL_RENDERABLE                                         = gl.STENCIL_RENDERABLE
-	STENCIL_TEST                                               = gl.STENCIL_TEST
-	STENCIL_VALUE_MASK                                         = gl.STENCIL_VALUE_MASK
-	STENCIL_WRITEMASK                                          = gl.STENCIL_WRITEMASK
-	STEREO                                                     = gl.STEREO
-	STREAM_COPY                                                = gl.STREAM_COPY
-	STREAM_DRAW                                                = gl.STREAM_DRAW
-	STREAM_READ                                                = gl.STREAM_READ
-	SUBPIXEL_BITS                                              = gl.SUBPIXEL_BITS
-	SYNC_CL_EVENT_ARB                                          = gl.SYNC_CL_EVENT_ARB
-	SYNC_CL_EVENT_COMPLETE_ARB                                 = gl.SYNC_CL_EVENT_COMPLETE_ARB
-	SYNC_CONDITION                                             = gl.SYNC_CONDITION
-	SYNC_FENCE                                                 = gl.SYNC_FENCE
-	SYNC_FLAGS                                                 = gl.SYNC_FLAGS
-	SYNC_FLUSH_COMMANDS_BIT                                    = gl.SYNC_FLUSH_COMMANDS_BIT
-	SYNC_GPU_COMMANDS_COMPLETE                                 = gl.SYNC_GPU_COMMANDS_COMPLETE
-	SYNC_STATUS                                                = gl.SYNC_STATUS
-	TESS_CONTROL_OUTPUT_VERTICES                               = gl.TESS_CONTROL_OUTPUT_VERTICES
-	TESS_CONTROL_SHADER                                        = gl.TESS_CONTROL_SHADER
-	TESS_CONTROL_SHADER_BIT                                    = gl.TESS_CONTROL_SHADER_BIT
-	TESS_CONTROL_SHADER_PATCHES_ARB                            = gl.TESS_CONTROL_SHADER_PATCHES_ARB
-	TESS_CONTROL_SUBROUTINE                                    = gl.TESS_CONTROL_SUBROUTINE
-	TESS_CONTROL_SUBROUTINE_UNIFORM                            = gl.TESS_CONTROL_SUBROUTINE_UNIFORM
-	TESS_CONTROL_TEXTURE                                       = gl.TESS_CONTROL_TEXTURE
-	TESS_EVALUATION_SHADER                                     = gl.TESS_EVALUATION_SHADER
-	TESS_EVALUATION_SHADER_BIT                                 = gl.TESS_EVALUATION_SHADER_BIT
-	TESS_EVALUATION_SHADER_INVOCATIONS_ARB                     = gl.TESS_EVALUATION_SHADER_INVOCATIONS_ARB
-	TESS_EVALUATION_SUBROUTINE                                 = gl.TESS_EVALUATION_SUBROUTINE
-	TESS_EVALUATION_SUBROUTINE_UNIFORM                         = gl.TESS_EVALUATION_SUBROUTINE_UNIFORM
-	TESS_EVALUATION_TEXTURE                                    = gl.TESS_EVALUATION_TEXTURE
-	TESS_GEN_MODE                                              = gl.TESS_GEN_MODE
-	TESS_GEN_POINT_MODE                                        = gl.TESS_GEN_POINT_MODE
-	TESS_GEN_SPACING                                           = gl.TESS_GEN_SPACING
-	TESS_GEN_VERTEX_ORDER                                      = gl.TESS_GEN_VERTEX_ORDER
-	TEXTURE                                                    = gl.TEXTURE
-	TEXTURE0                                                   = gl.TEXTURE0
-	TEXTURE1                                                   = gl.TEXTURE1
-	TEXTURE10                                                  = gl.TEXTURE10
-	TEXTURE11                                                  = gl.TEXTURE11
-	TEXTURE12                                                  = gl.TEXTURE12
-	TEXTURE13                                                  = gl.TEXTURE13
-	TEXTURE14                                                  = gl.TEXTURE14
-	TEXTURE15                                                  = gl.TEXTURE15
-	TEXTURE16                                                  = gl.TEXTURE16
-	TEXTURE17                                                  = gl.TEXTURE17
-	TEXTURE18                                                  = gl.TEXTURE18
-	TEXTURE19                                                  = gl.TEXTURE19
-	TEXTURE2                                                   = gl.TEXTURE2
-	TEXTURE20                                                  = gl.TEXTURE20
-	TEXTURE21                                                  = gl.TEXTURE21
-	TEXTURE22                                                  = gl.TEXTURE22
-	TEXTURE23                                                  = gl.TEXTURE23
-	TEXTURE24                                                  = gl.TEXTURE24
-	TEXTURE25                                                  = gl.TEXTURE25
-	TEXTURE26                                                  = gl.TEXTURE26
-	TEXTURE27                                                  = gl.TEXTURE27
-	TEXTURE28                                                  = gl.TEXTURE28
-	TEXTURE29                                                  = gl.TEXTURE29
-	TEXTURE3                                                   = gl.TEXTURE3
-	TEXTURE30                                                  = gl.TEXTURE30
-	TEXTURE31                                                  = gl.TEXTURE31
-	TEXTURE4                                                   = gl.TEXTURE4
-	TEXTURE5                                                   = gl.TEXTURE5
-	TEXTURE6                                                   = gl.TEXTURE6
-	TEXTURE7                                                   = gl.TEXTURE7
-	TEXTURE8                                                   = gl.TEXTURE8
-	TEXTURE9                                                   = gl.TEXTURE9
-	TEXTURE_1D                                                 = gl.TEXTURE_1D
-	TEXTURE_1D_ARRAY                                           = gl.TEXTURE_1D_ARRAY
-	TEXTURE_2D                                                 = gl.TEXTURE_2D
-	TEXTURE_2D_ARRAY                                           = gl.TEXTURE_2D_ARRAY
-	TEXTURE_2D_MULTISAMPLE                                     = gl.TEXTURE_2D_MULTISAMPLE
-	TEXTURE_2D_MULTISAMPLE_ARRAY                               = gl.TEXTURE_2D_MULTISAMPLE_ARRAY
-	TEXTURE_3D                                                 = gl.TEXTURE_3D
-	TEXTURE_ALPHA_SIZE                                         = gl.TEXTURE_ALPHA_SIZE
-	TEXTURE_ALPHA_TYPE                                         = gl.TEXTURE_ALPHA_TYPE
-	TEXTURE_BASE_LEVEL                                         = gl.TEXTURE_BASE_LEVEL
-	TEXTURE_BINDING                                            = gl.TEXTURE_BINDING
-	TEXTURE_BINDING_1D                                         = gl.TEXTURE_BINDING_1D
-	TEXTURE_BINDING_1D_ARRAY                                   = gl.TEXTURE_BINDING_1D_ARRAY
-	TEXTURE_BINDING_2D                                         = gl.TEXTURE_BINDING_2D
-	TEXTURE_BINDING_2D_ARRAY                                   = gl.TEXTURE_BINDING_2D_ARRAY
-	TEXTURE_BINDING_2D_MULTISAMPLE                             = gl.TEXTURE_BINDING_2D_MULTISAMPLE
-	TEXTURE_BINDING_2D_MULTISAMPLE_ARRAY                       = gl.TEXTURE_BINDING_2D_MULTISAMPLE_ARRAY
-	TEXTURE_BINDING_3D                                         = gl.TEXTURE_BINDING_3D
-	TEXTURE_BINDING_BUFFER                                     = gl.TEXTURE_BINDING_BUFFER
-	TEXTURE_BINDING_CUBE_MAP                                   = gl.TEXTURE_BINDING_CUBE_MAP
-	TEXTURE_BINDING_CUBE_MAP_ARRAY                             = gl.TEXTURE_BINDING_CUBE_MAP_ARRAY
-	TEXTURE_BINDING_CUBE_MAP_ARRAY_ARB                         = gl.TEXTURE_BINDING_CUBE_MAP_ARRAY_ARB
-	TEXTURE_BINDING_RECTANGLE                                  = gl.TEXTURE_BINDING_RECTANGLE
-	TEXTURE_BLUE_SIZE                                          = gl.TEXTURE_BLUE_SIZE
-	TEXTURE_BLUE_TYPE                                          = gl.TEXTURE_BLUE_TYPE
-	TEXTURE_BORDER_COLOR                                       = gl.TEXTURE_BORDER_COLOR
-	TEXTURE_BUFFER                                             = gl.TEXTURE_BUFFER
-	TEXTURE_BUFFER_DATA_STORE_BINDING                          = gl.TEXTURE_BUFFER_DATA_STORE_BINDING
-	TEXTURE_BUFFER_OFFSET                                      = gl.TEXTURE_BUFFER_OFFSET
-	TEXTURE_BUFFER_OFFSET_ALIGNMENT                            = gl.TEXTURE_BUFFER_OFFSET_ALIGNMENT
-	TEXTURE_BUFFER_SIZE                                        = gl.TEXTURE_BUFFER_SIZE
-	TEXTURE_COMPARE_FUNC                                       = gl.TEXTURE_COMPARE_FUNC
-	TEXTURE_COMPARE_MODE                                       = gl.TEXTURE_COMPARE_MODE
-	TEXTURE_COMPRESSED                                         = gl.TEXTURE_COMPRESSED
-	TEXTURE_COMPRESSED_BLOCK_HEIGHT                            = gl.TEXTURE_COMPRESSED_BLOCK_HEIGHT
-	TEXTURE_COMPRESSED_BLOCK_SIZE                              = gl.TEXTURE_COMPRESSED_BLOCK_SIZE
-	TEXTURE_COMPRESSED_BLOCK_WIDTH                             = gl.TEXTURE_COMPRESSED_BLOCK_WIDTH
-	TEXTURE_COMPRESSED_IMAGE_SIZE                              = gl.TEXTURE_COMPRESSED_IMAGE_SIZE
-	TEXTURE_COMPRESSION_HINT                                   = gl.TEXTURE_COMPRESSION_HINT
-	TEXTURE_CUBE_MAP                                           = gl.TEXTURE_CUBE_MAP
-	TEXTURE_CUBE_MAP_ARRAY                                     = gl.TEXTURE_CUBE_MAP_ARRAY
-	TEXTURE_CUBE_MAP_ARRAY_ARB                                 = gl.TEXTURE_CUBE_MAP_ARRAY_ARB
-	TEXTURE_CUBE_MAP_NEGATIVE_X                                = gl.TEXTURE_CUBE_MAP_NEGATIVE_X
-	TEXTURE_CUBE_MAP_NEGATIVE_Y                                = gl.TEXTURE_CUBE_MAP_NEGATIVE_Y
-	TEXTURE_CUBE_MAP_NEGATIVE_Z                                = gl.TEXTURE_CUBE_MAP_NEGATIVE_Z
-	TEXTURE_CUBE_MAP_POSITIVE_X                                = gl.TEXTURE_CUBE_MAP_POSITIVE_X
-	TEXTURE_CUBE_MAP_POSITIVE_Y                                = gl.TEXTURE_CUBE_MAP_POSITIVE_Y
-	TEXTURE_CUBE_MAP_POSITIVE_Z                                = gl.TEXTURE_CUBE_MAP_POSITIVE_Z
-	TEXTURE_CUBE_MAP_SEAMLESS                                  = gl.TEXTURE_CUBE_MAP_SEAMLESS
-	TEXTURE_DEPTH                                              = gl.TEXTURE_DEPTH
-	TEXTURE_DEPTH_SIZE                                         = gl.TEXTURE_DEPTH_SIZE
-	TEXTURE_DEPTH_TYPE                                         = gl.TEXTURE_DEPTH_TYPE
-	TEXTURE_FETCH_BARRIER_BIT                                  = gl.TEXTURE_FETCH_BARRIER_BIT
-	TEXTURE_FILTER_CONTROL_EXT                                 = gl.TEXTURE_FILTER_CONTROL_EXT
-	TEXTURE_FIXED_SAMPLE_LOCATIONS                             = gl.TEXTURE_FIXED_SAMPLE_LOCATIONS
-	TEXTURE_GATHER                                             = gl.TEXTURE_GATHER
-	TEXTURE_GATHER_SHADOW                                      = gl.TEXTURE_GATHER_SHADOW
-	TEXTURE_GREEN_SIZE                                         = gl.TEXTURE_GREEN_SIZE
-	TEXTURE_GREEN_TYPE                                         = gl.TEXTURE_GREEN_TYPE
-	TEXTURE_HEIGHT                                             = gl.TEXTURE_HEIGHT
-	TEXTURE_IMAGE_FORMAT                                       = gl.TEXTURE_IMAGE_FORMAT
-	TEXTURE_IMAGE_TYPE                                         = gl.TEXTURE_IMAGE_TYPE
-	TEXTURE_IMMUTABLE_FORMAT                                   = gl.TEXTURE_IMMUTABLE_FORMAT
-	TEXTURE_IMMUTABLE_LEVELS                                   = gl.TEXTURE_IMMUTABLE_LEVELS
-	TEXTURE_INTERNAL_FORMAT                                    = gl.TEXTURE_INTERNAL_FORMAT
-	TEXTURE_LOD_BIAS                                           = gl.TEXTURE_LOD_BIAS
-	TEXTURE_LOD_BIAS_EXT                                       = gl.TEXTURE_LOD_BIAS_EXT
-	TEXTURE_MAG_FILTER                                         = gl.TEXTURE_MAG_FILTER
-	TEXTURE_MAX_ANISOTROPY_EXT                                 = gl.TEXTURE_MAX_ANISOTROPY_EXT
-	TEXTURE_MAX_LEVEL                                          = gl.TEXTURE_MAX_LEVEL
-	TEXTURE_MAX_LOD                                            = gl.TEXTURE_MAX_LOD
-	TEXTURE_MIN_FILTER                                         = gl.TEXTURE_MIN_FILTER
-	TEXTURE_MIN_LOD                                            = gl.TEXTURE_MIN_LOD
-	TEXTURE_RECTANGLE                                          = gl.TEXTURE_RECTANGLE
-	TEXTURE_RED_SIZE                                           = gl.TEXTURE_RED_SIZE
-	TEXTURE_RED_TYPE                                           = gl.TEXTURE_RED_TYPE
-	TEXTURE_SAMPLES                                            = gl.TEXTURE_SAMPLES
-	TEXTURE_SHADOW                                             = gl.TEXTURE_SHADOW
-	TEXTURE_SHARED_SIZE                                        = gl.TEXTURE_SHARED_SIZE
-	TEXTURE_SPARSE_ARB                                         = gl.TEXTURE_SPARSE_ARB
-	TEXTURE_SRGB_DECODE_EXT                                    = gl.TEXTURE_SRGB_DECODE_EXT
-	TEXTURE_STENCIL_SIZE                                       = gl.TEXTURE_STENCIL_SIZE
-	TEXTURE_SWIZZLE_A                                          = gl.TEXTURE_SWIZZLE_A
-	TEXTURE_SWIZZLE_B                                          = gl.TEXTURE_SWIZZLE_B
-	TEXTURE_SWIZZLE_G                                          = gl.TEXTURE_SWIZZLE_G
-	TEXTURE_SWIZZLE_R                                          = gl.TEXTURE_SWIZZLE_R
-	TEXTURE_SWIZZLE_RGBA                                       = gl.TEXTURE_SWIZZLE_RGBA
-	TEXTURE_TARGET                                             = gl.TEXTURE_TARGET
-	TEXTURE_UPDATE_BARRIER_BIT                                 = gl.TEXTURE_UPDATE_BARRIER_BIT
-	TEXTURE_VIEW                                               = gl.TEXTURE_VIEW
-	TEXTURE_VIEW_MIN_LAYER                                     = gl.TEXTURE_VIEW_MIN_LAYER
-	TEXTURE_VIEW_MIN_LEVEL                                     = gl.TEXTURE_VIEW_MIN_LEVEL
-	TEXTURE_VIEW_NUM_LAYERS                                    = gl.TEXTURE_VIEW_NUM_LAYERS
-	TEXTURE_VIEW_NUM_LEVELS                                    = gl.TEXTURE_VIEW_NUM_LEVELS
-	TEXTURE_WIDTH                                              = gl.TEXTURE_WIDTH
-	TEXTURE_WRAP_R                                             = gl.TEXTURE_WRAP_R
-	TEXTURE_WRAP_S                                             = gl.TEXTURE_WRAP_S
-	TEXTURE_WRAP_T                                             = gl.TEXTURE_WRAP_T
-	TIMEOUT_EXPIRED                                            = gl.TIMEOUT_EXPIRED
-	TIMEOUT_IGNORED                                            = gl.TIMEOUT_IGNORED
-	TIMESTAMP                                                  = gl.TIMESTAMP
-	TIME_ELAPSED                                               = gl.TIME_ELAPSED
-	TOP_LEVEL_ARRAY_SIZE                                       = gl.TOP_LEVEL_ARRAY_SIZE
-	TOP_LEVEL_ARRAY_STRIDE                                     = gl.TOP_LEVEL_ARRAY_STRIDE
-	TRANSFORM_FEEDBACK                                         = gl.TRANSFORM_FEEDBACK
-	TRANSFORM_FEEDBACK_ACTIVE                                  = gl.TRANSFORM_FEEDBACK_ACTIVE
-	TRANSFORM_FEEDBACK_BARRIER_BIT                             = gl.TRANSFORM_FEEDBACK_BARRIER_BIT
-	TRANSFORM_FEEDBACK_BINDING                                 = gl.TRANSFORM_FEEDBACK_BINDING
-	TRANSFORM_FEEDBACK_BUFFER                                  = gl.TRANSFORM_FEEDBACK_BUFFER
-	TRANSFORM_FEEDBACK_BUFFER_ACTIVE                           = gl.TRANSFORM_FEEDBACK_BUFFER_ACTIVE
-	TRANSFORM_FEEDBACK_BUFFER_BINDING                          = gl.TRANSFORM_FEEDBACK_BUFFER_BINDING
-	TRANSFORM_FEEDBACK_BUFFER_INDEX                            = gl.TRANSFORM_FEEDBACK_BUFFER_INDEX
-	TRANSFORM_FEEDBACK_BUFFER_MODE                             = gl.TRANSFORM_FEEDBACK_BUFFER_MODE
-	TRANSFORM_FEEDBACK_BUFFER_PAUSED                           = gl.TRANSFORM_FEEDBACK_BUFFER_PAUSED
-	TRANSFORM_FEEDBACK_BUFFER_SIZE                             = gl.TRANSFORM_FEEDBACK_BUFFER_SIZE
-	TRANSFORM_FEEDBACK_BUFFER_START                            = gl.TRANSFORM_FEEDBACK_BUFFER_START
-	TRANSFORM_FEEDBACK_BUFFER_STRIDE                           = gl.TRANSFORM_FEEDBACK_BUFFER_STRIDE
-	TRANSFORM_FEEDBACK_OVERFLOW_ARB                            = gl.TRANSFORM_FEEDBACK_OVERFLOW_ARB
-	TRANSFORM_FEEDBACK_PAUSED                                  = gl.TRANSFORM_FEEDBACK_PAUSED
-	TRANSFORM_FEEDBACK_PRIMITIVES_WRITTEN                      = gl.TRANSFORM_FEEDBACK_PRIMITIVES_WRITTEN
-	TRANSFORM_FEEDBACK_STREAM_OVERFLOW_ARB                     = gl.TRANSFORM_FEEDBACK_STREAM_OVERFLOW_ARB
-	TRANSFORM_FEEDBACK_VARYING                                 = gl.TRANSFORM_FEEDBACK_VARYING
-	TRANSFORM_FEEDBACK_VARYINGS                                = gl.TRANSFORM_FEEDBACK_VARYINGS
-	TRANSFORM_FEEDBACK_VARYING_MAX_LENGTH                      = gl.TRANSFORM_FEEDBACK_VARYING_MAX_LENGTH
-	TRIANGLES                                                  = gl.TRIANGLES
-	TRIANGLES_ADJACENCY                                        = gl.TRIANGLES_ADJACENCY
-	TRIANGLE_FAN                                               = gl.TRIANGLE_FAN
-	TRIANGLE_STRIP                                             = gl.TRIANGLE_STRIP
-	TRIANGLE_STRIP_ADJACENCY                                   = gl.TRIANGLE_STRIP_ADJACENCY
-	TRUE                                                       = gl.TRUE
-	TYPE                                                       = gl.TYPE
-	UNCORRELATED_NV                                            = gl.UNCORRELATED_NV
-	UNDEFINED_VERTEX                                           = gl.UNDEFINED_VERTEX
-	UNIFORM                                                    = gl.UNIFORM
-	UNIFORM_ARRAY_STRIDE                                       = gl.UNIFORM_ARRAY_STRIDE
-	UNIFORM_ATOMIC_COUNTER_BUFFER_INDEX                        = gl.UNIFORM_ATOMIC_COUNTER_BUFFER_INDEX
-	UNIFORM_BARRIER_BIT                                        = gl.UNIFORM_BARRIER_BIT
-	UNIFORM_BLOCK                                              = gl.UNIFORM_BLOCK
-	UNIFORM_BLOCK_ACTIVE_UNIFORMS                              = gl.UNIFORM_BLOCK_ACTIVE_UNIFORMS
-	UNIFORM_BLOCK_ACTIVE_UNIFORM_INDICES                       = gl.UNIFORM_BLOCK_ACTIVE_UNIFORM_INDICES
-	UNIFORM_BLOCK_BINDING                                      = gl.UNIFORM_BLOCK_BINDING
-	UNIFORM_BLOCK_DATA_SIZE                                    = gl.UNIFORM_BLOCK_DATA_SIZE
-	UNIFORM_BLOCK_INDEX                                        = gl.UNIFORM_BLOCK_INDEX
-	UNIFORM_BLOCK_NAME_LENGTH                                  = gl.UNIFORM_BLOCK_NAME_LENGTH
-	UNIFORM_BLOCK_REFERENCED_BY_COMPUTE_SHADER                 = gl.UNIFORM_BLOCK_REFERENCED_BY_COMPUTE_SHADER
-	UNIFORM_BLOCK_REFERENCED_BY_FRAGMENT_SHADER                = gl.UNIFORM_BLOCK_REFERENCED_BY_FRAGMENT_SHADER
-	UNIFORM_BLOCK_REFERENCED_BY_GEOMETRY_SHADER                = gl.UNIFORM_BLOCK_REFERENCED_BY_GEOMETRY_SHADER
-	UNIFORM_BLOCK_REFERENCED_BY_TESS_CONTROL_SHADER            = gl.UNIFORM_BLOCK_REFERENCED_BY_TESS_CONTROL_SHADER
-	UNIFORM_BLOCK_REFERENCED_BY_TESS_EVALUATION_SHADER         = gl.UNIFORM_BLOCK_REFERENCED_BY_TESS_EVALUATION_SHADER
-	UNIFORM_BLOCK_REFERENCED_BY_VERTEX_SHADER                  = gl.UNIFORM_BLOCK_REFERENCED_BY_VERTEX_SHADER
-	UNIFORM_BUFFER                                             = gl.UNIFORM_BUFFER
-	UNIFORM_BUFFER_BINDING                                     = gl.UNIFORM_BUFFER_BINDING
-	UNIFORM_BUFFER_OFFSET_ALIGNMENT                            = gl.UNIFORM_BUFFER_OFFSET_ALIGNMENT
-	UNIFORM_BUFFER_SIZE                                        = gl.UNIFORM_BUFFER_SIZE
-	UNIFORM_BUFFER_START                                       = gl.UNIFORM_BUFFER_START
-	UNIFORM_IS_ROW_MAJOR                                       = gl.UNIFORM_IS_ROW_MAJOR
-	UNIFORM_MATRIX_STRIDE                                      = gl.UNIFORM_MATRIX_STRIDE
-	UNIFORM_NAME_LENGTH                                        = gl.UNIFORM_NAME_LENGTH
-	UNIFORM_OFFSET                                             = gl.UNIFORM_OFFSET
-	UNIFORM_SIZE                                               = gl.UNIFORM_SIZE
-	UNIFORM_TYPE                                               = gl.UNIFORM_TYPE
-	UNKNOWN_CONTEXT_RESET                                      = gl.UNKNOWN_CONTEXT_RESET
-	UNKNOWN_CONTEXT_RESET_ARB                                  = gl.UNKNOWN_CONTEXT_RESET_ARB
-	UNKNOWN_CONTEXT_RESET_KHR                                  = gl.UNKNOWN_CONTEXT_RESET_KHR
-	UNPACK_ALIGNMENT                                           = gl.UNPACK_ALIGNMENT
-	UNPACK_COMPRESSED_BLOCK_DEPTH                              = gl.UNPACK_COMPRESSED_BLOCK_DEPTH
-	UNPACK_COMPRESSED_BLOCK_HEIGHT                             = gl.UNPACK_COMPRESSED_BLOCK_HEIGHT
-	UNPACK_COMPRESSED_BLOCK_SIZE                               = gl.UNPACK_COMPRESSED_BLOCK_SIZE
-	UNPACK_COMPRESSED_BLOCK_WIDTH                              = gl.UNPACK_COMPRESSED_BLOCK_WIDTH
-	UNPACK_IMAGE_HEIGHT                                        = gl.UNPACK_IMAGE_HEIGHT
-	UNPACK_LSB_FIRST                                           = gl.UNPACK_LSB_FIRST
-	UNPACK_ROW_LENGTH                                          = gl.UNPACK_ROW_LENGTH
-	UNPACK_SKIP_IMAGES                                         = gl.UNPACK_SKIP_IMAGES
-	UNPACK_SKIP_PIXELS                                         = gl.UNPACK_SKIP_PIXELS
-	UNPACK_SKIP_ROWS                                           = gl.UNPACK_SKIP_ROWS
-	UNPACK_SWAP_BYTES                                          = gl.UNPACK_SWAP_BYTES
-	UNSIGNALED                                                 = gl.UNSIGNALED
-	UNSIGNED_BYTE                                              = gl.UNSIGNED_BYTE
-	UNSIGNED_BYTE_2_3_3_REV                                    = gl.UNSIGNED_BYTE_2_3_3_REV
-	UNSIGNED_BYTE_3_3_2                                        = gl.UNSIGNED_BYTE_3_3_2
-	UNSIGNED_INT                                               = gl.UNSIGNED_INT
-	UNSIGNED_INT64_AMD                                         = gl.UNSIGNED_INT64_AMD
-	UNSIGNED_INT64_ARB                                         = gl.UNSIGNED_INT64_ARB
-	UNSIGNED_INT_10F_11F_11F_REV                               = gl.UNSIGNED_INT_10F_11F_11F_REV
-	UNSIGNED_INT_10_10_10_2                                    = gl.UNSIGNED_INT_10_10_10_2
-	UNSIGNED_INT_24_8                                          = gl.UNSIGNED_INT_24_8
-	UNSIGNED_INT_2_10_10_10_REV                                = gl.UNSIGNED_INT_2_10_10_10_REV
-	UNSIGNED_INT_5_9_9_9_REV                                   = gl.UNSIGNED_INT_5_9_9_9_REV
-	UNSIGNED_INT_8_8_8_8                                       = gl.UNSIGNED_INT_8_8_8_8
-	UNSIGNED_INT_8_8_8_8_REV                                   = gl.UNSIGNED_INT_8_8_8_8_REV
-	UNSIGNED_INT_ATOMIC_COUNTER                                = gl.UNSIGNED_INT_ATOMIC_COUNTER
-	UNSIGNED_INT_IMAGE_1D                                      = gl.UNSIGNED_INT_IMAGE_1D
-	UNSIGNED_INT_IMAGE_1D_ARRAY                                = gl.UNSIGNED_INT_IMAGE_1D_ARRAY
-	UNSIGNED_INT_IMAGE_2D                                      = gl.UNSIGNED_INT_IMAGE_2D
-	UNSIGNED_INT_IMAGE_2D_ARRAY                                = gl.UNSIGNED_INT_IMAGE_2D_ARRAY
-	UNSIGNED_INT_IMAGE_2D_MULTISAMPLE                          = gl.UNSIGNED_INT_IMAGE_2D_MULTISAMPLE
-	UNSIGNED_INT_IMAGE_2D_MULTISAMPLE_ARRAY                    = gl.UNSIGNED_INT_IMAGE_2D_MULTISAMPLE_ARRAY
-	UNSIGNED_INT_IMAGE_2D_RECT                                 = gl.UNSIGNED_INT_IMAGE_2D_RECT
-	UNSIGNED_INT_IMAGE_3D                                      = gl.UNSIGNED_INT_IMAGE_3D
-	UNSIGNED_INT_IMAGE_BUFFER                                  = gl.UNSIGNED_INT_IMAGE_BUFFER
-	UNSIGNED_INT_IMAGE_CUBE                                    = gl.UNSIGNED_INT_IMAGE_CUBE
-	UNSIGNED_INT_IMAGE_CUBE_MAP_ARRAY                          = gl.UNSIGNED_INT_IMAGE_CUBE_MAP_ARRAY
-	UNSIGNED_INT_SAMPLER_1D                                    = gl.UNSIGNED_INT_SAMPLER_1D
-	UNSIGNED_INT_SAMPLER_1D_ARRAY                              = gl.UNSIGNED_INT_SAMPLER_1D_ARRAY
-	UNSIGNED_INT_SAMPLER_2D                                    = gl.UNSIGNED_INT_SAMPLER_2D
-	UNSIGNED_INT_SAMPLER_2D_ARRAY                              = gl.UNSIGNED_INT_SAMPLER_2D_ARRAY
-	UNSIGNED_INT_SAMPLER_2D_MULTISAMPLE                        = gl.UNSIGNED_INT_SAMPLER_2D_MULTISAMPLE
-	UNSIGNED_INT_SAMPLER_2D_MULTISAMPLE_ARRAY                  = gl.UNSIGNED_INT_SAMPLER_2D_MULTISAMPLE_ARRAY
-	UNSIGNED_INT_SAMPLER_2D_RECT                               = gl.UNSIGNED_INT_SAMPLER_2D_RECT
-	UNSIGNED_INT_SAMPLER_3D                                    = gl.UNSIGNED_INT_SAMPLER_3D
-	UNSIGNED_INT_SAMPLER_BUFFER                                = gl.UNSIGNED_INT_SAMPLER_BUFFER
-	UNSIGNED_INT_SAMPLER_CUBE                                  = gl.UNSIGNED_INT_SAMPLER_CUBE
-	UNSIGNED_INT_SAMPLER_CUBE_MAP_ARRAY_ARB                    = gl.UNSIGNED_INT_SAMPLER_CUBE_MAP_ARRAY_ARB
-	UNSIGNED_INT_VEC2                                          = gl.UNSIGNED_INT_VEC2
-	UNSIGNED_INT_VEC3                                          = gl.UNSIGNED_INT_VEC3
-	UNSIGNED_INT_VEC4                                          = gl.UNSIGNED_INT_VEC4
-	UNSIGNED_NORMALIZED                                        = gl.UNSIGNED_NORMALIZED
-	UNSIGNED_SHORT                                             = gl.UNSIGNED_SHORT
-	UNSIGNED_SHORT_1_5_5_5_REV                                 = gl.UNSIGNED_SHORT_1_5_5_5_REV
-	UNSIGNED_SHORT_4_4_4_4                                     = gl.UNSIGNED_SHORT_4_4_4_4
-	UNSIGNED_SHORT_4_4_4_4_REV                                 = gl.UNSIGNED_SHORT_4_4_4_4_REV
-	UNSIGNED_SHORT_5_5_5_1                                     = gl.UNSIGNED_SHORT_5_5_5_1
-	UNSIGNED_SHORT_5_6_5                                       = gl.UNSIGNED_SHORT_5_6_5
-	UNSIGNED_SHORT_5_6_5_REV                                   = gl.UNSIGNED_SHORT_5_6_5_REV
-	UNSIGNED_SHORT_8_8_APPLE                                   = gl.UNSIGNED_SHORT_8_8_APPLE
-	UNSIGNED_SHORT_8_8_REV_APPLE                               = gl.UNSIGNED_SHORT_8_8_REV_APPLE
-	UPPER_LEFT                                                 = gl.UPPER_LEFT
-	VALIDATE_STATUS                                            = gl.VALIDATE_STATUS
-	VENDOR                                                     = gl.VENDOR
-	VERSION                                                    = gl.VERSION
-	VERTEX_ARRAY                                               = gl.VERTEX_ARRAY
-	VERTEX_ARRAY_BINDING                                       = gl.VERTEX_ARRAY_BINDING
-	VERTEX_ARRAY_KHR                                           = gl.VERTEX_ARRAY_KHR
-	VERTEX_ARRAY_OBJECT_EXT                                    = gl.VERTEX_ARRAY_OBJECT_EXT
-	VERTEX_ATTRIB_ARRAY_BARRIER_BIT                            = gl.VERTEX_ATTRIB_ARRAY_BARRIER_BIT
-	VERTEX_ATTRIB_ARRAY_BUFFER_BINDING                         = gl.VERTEX_ATTRIB_ARRAY_BUFFER_BINDING
-	VERTEX_ATTRIB_ARRAY_DIVISOR                                = gl.VERTEX_ATTRIB_ARRAY_DIVISOR
-	VERTEX_ATTRIB_ARRAY_ENABLED                                = gl.VERTEX_ATTRIB_ARRAY_ENABLED
-	VERTEX_ATTRIB_ARRAY_INTEGER                                = gl.VERTEX_ATTRIB_ARRAY_INTEGER
-	VERTEX_ATTRIB_ARRAY_NORMALIZED                             = gl.VERTEX_ATTRIB_ARRAY_NORMALIZED
-	VERTEX_ATTRIB_ARRAY_POINTER                                = gl.VERTEX_ATTRIB_ARRAY_POINTER
-	VERTEX_ATTRIB_ARRAY_SIZE                                   = gl.VERTEX_ATTRIB_ARRAY_SIZE
-	VERTEX_ATTRIB_ARRAY_STRIDE                                 = gl.VERTEX_ATTRIB_ARRAY_STRIDE
-	VERTEX_ATTRIB_ARRAY_TYPE                                   = gl.VERTEX_ATTRIB_ARRAY_TYPE
-	VERTEX_ATTRIB_BINDING                                      = gl.VERTEX_ATTRIB_BINDING
-	VERTEX_ATTRIB_RELATIVE_OFFSET                              = gl.VERTEX_ATTRIB_RELATIVE_OFFSET
-	VERTEX_BINDING_DIVISOR                                     = gl.VERTEX_BINDING_DIVISOR
-	VERTEX_BINDING_OFFSET                                      = gl.VERTEX_BINDING_OFFSET
-	VERTEX_BINDING_STRIDE                                      = gl.VERTEX_BINDING_STRIDE
-	VERTEX_PROGRAM_POINT_SIZE                                  = gl.VERTEX_PROGRAM_POINT_SIZE
-	VERTEX_SHADER                                              = gl.VERTEX_SHADER
-	VERTEX_SHADER_BIT                                          = gl.VERTEX_SHADER_BIT
-	VERTEX_SHADER_BIT_EXT                                      = gl.VERTEX_SHADER_BIT_EXT
-	VERTEX_SHADER_INVOCATIONS_ARB                              = gl.VERTEX_SHADER_INVOCATIONS_ARB
-	VERTEX_SUBROUTINE                                          = gl.VERTEX_SUBROUTINE
-	VERTEX_SUBROUTINE_UNIFORM                                  = gl.VERTEX_SUBROUTINE_UNIFORM
-	VERTEX_TEXTURE                                             = gl.VERTEX_TEXTURE
-	VERTICES_SUBMITTED_ARB                                     = gl.VERTICES_SUBMITTED_ARB
-	VIEWPORT                                                   = gl.VIEWPORT
-	VIEWPORT_BOUNDS_RANGE                                      = gl.VIEWPORT_BOUNDS_RANGE
-	VIEWPORT_INDEX_PROVOKING_VERTEX                            = gl.VIEWPORT_INDEX_PROVOKING_VERTEX
-	VIEWPORT_SUBPIXEL_BITS                                     = gl.VIEWPORT_SUBPIXEL_BITS
-	VIEW_CLASS_128_BITS                                        = gl.VIEW_CLASS_128_BITS
-	VIEW_CLASS_16_BITS                                         = gl.VIEW_CLASS_16_BITS
-	VIEW_CLASS_24_BITS                                         = gl.VIEW_CLASS_24_BITS
-	VIEW_CLASS_32_BITS                                         = gl.VIEW_CLASS_32_BITS
-	VIEW_CLASS_48_BITS                                         = gl.VIEW_CLASS_48_BITS
-	VIEW_CLASS_64_BITS                                         = gl.VIEW_CLASS_64_BITS
-	VIEW_CLASS_8_BITS                                          = gl.VIEW_CLASS_8_BITS
-	VIEW_CLASS_96_BITS                                         = gl.VIEW_CLASS_96_BITS
-	VIEW_CLASS_BPTC_FLOAT                                      = gl.VIEW_CLASS_BPTC_FLOAT
-	VIEW_CLASS_BPTC_UNORM                                      = gl.VIEW_CLASS_BPTC_UNORM
-	VIEW_CLASS_RGTC1_RED                                       = gl.VIEW_CLASS_RGTC1_RED
-	VIEW_CLASS_RGTC2_RG                                        = gl.VIEW_CLASS_RGTC2_RG
-	VIEW_CLASS_S3TC_DXT1_RGB                                   = gl.VIEW_CLASS_S3TC_DXT1_RGB
-	VIEW_CLASS_S3TC_DXT1_RGBA                                  = gl.VIEW_CLASS_S3TC_DXT1_RGBA
-	VIEW_CLASS_S3TC_DXT3_RGBA                                  = gl.VIEW_CLASS_S3TC_DXT3_RGBA
-	VIEW_CLASS_S3TC_DXT5_RGBA                                  = gl.VIEW_CLASS_S3TC_DXT5_RGBA
-	VIEW_COMPATIBILITY_CLASS                                   = gl.VIEW_COMPATIBILITY_CLASS
-	VIRTUAL_PAGE_SIZE_INDEX_ARB                                = gl.VIRTUAL_PAGE_SIZE_INDEX_ARB
-	VIRTUAL_PAGE_SIZE_X_ARB                                    = gl.VIRTUAL_PAGE_SIZE_X_ARB
-	VIRTUAL_PAGE_SIZE_Y_ARB                                    = gl.VIRTUAL_PAGE_SIZE_Y_ARB
-	VIRTUAL_PAGE_SIZE_Z_ARB                                    = gl.VIRTUAL_PAGE_SIZE_Z_ARB
-	VIVIDLIGHT_NV                                              = gl.VIVIDLIGHT_NV
-	WAIT_FAILED                                                = gl.WAIT_FAILED
-	WRITE_ONLY                                                 = gl.WRITE_ONLY
-	XOR                                                        = gl.XOR
-	XOR_NV                                                     = gl.XOR_NV
-	ZERO                                                       = gl.ZERO
-	ZERO_TO_ONE                                                = gl.ZERO_TO_ONE
+	ACTIVE_ATOMIC_COUNTER_BUFFERS                                                    = 0x92D9
+	ACTIVE_ATTRIBUTES                                                                = 0x8B89
+	ACTIVE_ATTRIBUTE_MAX_LENGTH                                                      = 0x8B8A
+	ACTIVE_PROGRAM                                                                   = 0x8259
+	ACTIVE_PROGRAM_EXT                                                               = 0x8B8D
+	ACTIVE_RESOURCES                                                                 = 0x92F5
+	ACTIVE_SUBROUTINES                                                               = 0x8DE5
+	ACTIVE_SUBROUTINE_MAX_LENGTH                                                     = 0x8E48
+	ACTIVE_SUBROUTINE_UNIFORMS                                                       = 0x8DE6
+	ACTIVE_SUBROUTINE_UNIFORM_LOCATIONS                                              = 0x8E47
+	ACTIVE_SUBROUTINE_UNIFORM_MAX_LENGTH                                             = 0x8E49
+	ACTIVE_TEXTURE                                                                   = 0x84E0
+	ACTIVE_UNIFORMS                                                                  = 0x8B86
+	ACTIVE_UNIFORM_BLOCKS                                                            = 0x8A36
+	ACTIVE_UNIFORM_BLOCK_MAX_NAME_LENGTH                                             = 0x8A35
+	ACTIVE_UNIFORM_MAX_LENGTH                                                        = 0x8B87
+	ACTIVE_VARIABLES                                                                 = 0x9305
+	ALIASED_LINE_WIDTH_RANGE                                                         = 0x846E
+	ALL_BARRIER_BITS                                                                 = 0xFFFFFFFF
+	ALL_COMPLETED_NV                                                                 = 0x84F2
+	ALL_SHADER_BITS                                                                  = 0xFFFFFFFF
+	ALL_SHADER_BITS_EXT                                                              = 0xFFFFFFFF
+	ALPHA                                                      PixelFormat           = 0x1906
+	ALREADY_SIGNALED                                           SyncStatus            = 0x911A
+	ALWAYS                                                                           = 0x0207
+	AND                                                                              = 0x1501
+	AND_INVERTED                                                                     = 0x1504
+	AND_REVERSE                                                                      = 0x1502
+	ANY_SAMPLES_PASSED                                                               = 0x8C2F
+	ANY_SAMPLES_PASSED_CONSERVATIVE                                                  = 0x8D6A
+	ARRAY_BUFFER                                               BufferTarget          = 0x8892
+	ARRAY_BUFFER_BINDING                                                             = 0x8894
+	ARRAY_SIZE                                                                       = 0x92FB
+	ARRAY_STRIDE                                                                     = 0x92FE
+	ATOMIC_COUNTER_BARRIER_BIT                                                       = 0x00001000
+	ATOMIC_COUNTER_BUFFER                                      BufferTarget          = 0x92C0
+	ATOMIC_COUNTER_BUFFER_ACTIVE_ATOMIC_COUNTERS                                     = 0x92C5
+	ATOMIC_COUNTER_BUFFER_ACTIVE_ATOMIC_COUNTER_INDICES                              = 0x92C6
+	ATOMIC_COUNTER_BUFFER_BINDING                                                    = 0x92C1
+	ATOMIC_COUNTER_BUFFER_DATA_SIZE                                                  = 0x92C4
+	ATOMIC_COUNTER_BUFFER_INDEX                                                      = 0x9301
+	ATOMIC_COUNTER_BUFFER_REFERENCED_BY_COMPUTE_SHADER                               = 0x90ED
+	ATOMIC_COUNTER_BUFFER_REFERENCED_BY_FRAGMENT_SHADER                              = 0x92CB
+	ATOMIC_COUNTER_BUFFER_REFERENCED_BY_GEOMETRY_SHADER                              = 0x92CA
+	ATOMIC_COUNTER_BUFFER_REFERENCED_BY_TESS_CONTROL_SHADER                          = 0x92C8
+	ATOMIC_COUNTER_BUFFER_REFERENCED_BY_TESS_EVALUATION_SHADER                       = 0x92C9
+	ATOMIC_COUNTER_BUFFER_REFERENCED_BY_VERTEX_SHADER                                = 0x92C7
+	ATOMIC_COUNTER_BUFFER_SIZE                                                       = 0x92C3
+	ATOMIC_COUNTER_BUFFER_START                                                      = 0x92C2
+	ATTACHED_SHADERS                                                                 = 0x8B85
+	AUTO_GENERATE_MIPMAP                                                             = 0x8295
+	BACK                                                                             = 0x0405
+	BACK_LEFT                                                  ColorBuffer           = 0x0402
+	BACK_RIGHT                                                 ColorBuffer           = 0x0403
+	BGR                                                        PixelFormat           = 0x80E0
+	BGRA                                                       PixelFormat           = 0x80E1
+	BGRA_INTEGER                                               PixelFormat           = 0x8D9B
+	BGR_INTEGER                                                PixelFormat           = 0x8D9A
+	BLEND                                                      Capability            = 0x0BE2
+	BLEND_ADVANCED_COHERENT_KHR                                                      = 0x9285
+	BLEND_ADVANCED_COHERENT_NV                                                       = 0x9285
+	BLEND_COLOR                                                                      = 0x8005
+	BLEND_DST                                                                        = 0x0BE0
+	BLEND_DST_ALPHA                                                                  = 0x80CA
+	BLEND_DST_RGB                                                                    = 0x80C8
+	BLEND_EQUATION                                                                   = 0x8009
+	BLEND_EQUATION_ALPHA                                                             = 0x883D
+	BLEND_EQUATION_EXT                                                               = 0x8009
+	BLEND_EQUATION_RGB                                                               = 0x8009
+	BLEND_OVERLAP_NV                                                                 = 0x9281
+	BLEND_PREMULTIPLIED_SRC_NV                                                       = 0x9280
+	BLEND_SRC                                                                        = 0x0BE1
+	BLEND_SRC_ALPHA                                                                  = 0x80CB
+	BLEND_SRC_RGB                                                                    = 0x80C9
+	BLOCK_INDEX                                                                      = 0x92FD
+	BLUE                                                       PixelFormat           = 0x1905
+	BLUE_INTEGER                                               PixelFormat           = 0x8D96
+	BLUE_NV                                                                          = 0x1905
+	BOOL                                                                             = 0x8B56
+	BOOL_VEC2                                                                        = 0x8B57
+	BOOL_VEC3                                                                        = 0x8B58
+	BOOL_VEC4                                                                        = 0x8B59
+	BUFFER                                                                           = 0x82E0
+	BUFFER_ACCESS                                                                    = 0x88BB
+	BUFFER_ACCESS_FLAGS                                                              = 0x911F
+	BUFFER_BINDING                                                                   = 0x9302
+	BUFFER_DATA_SIZE                                                                 = 0x9303
+	BUFFER_IMMUTABLE_STORAGE                                                         = 0x821F
+	BUFFER_KHR                                                                       = 0x82E0
+	BUFFER_MAPPED                                                                    = 0x88BC
+	BUFFER_MAP_LENGTH                                                                = 0x9120
+	BUFFER_MAP_OFFSET                                                                = 0x9121
+	BUFFER_MAP_POINTER                                                               = 0x88BD
+	BUFFER_OBJECT_EXT                                                                = 0x9151
+	BUFFER_SIZE                                                                      = 0x8764
+	BUFFER_STORAGE_FLAGS                                                             = 0x8220
+	BUFFER_UPDATE_BARRIER_BIT                                                        = 0x00000200
+	BUFFER_USAGE                                                                     = 0x8765
+	BUFFER_VARIABLE                                                                  = 0x92E5
+	BYTE                                                                             = 0x1400
+	CAVEAT_SUPPORT                                                                   = 0x82B8
+	CCW                                                        Winding               = 0x0901
+	CLAMP_READ_COLOR                                                                 = 0x891C
+	CLAMP_TO_BORDER                                                                  = 0x812D
+	CLAMP_TO_EDGE                                                                    = 0x812F
+	CLEAR                                                                            = 0x1500
+	CLEAR_BUFFER                                                                     = 0x82B4
+	CLEAR_TEXTURE                                                                    = 0x9365
+	CLIENT_MAPPED_BUFFER_BARRIER_BIT                                                 = 0x00004000
+	CLIENT_STORAGE_BIT                                                               = 0x0200
+	CLIPPING_INPUT_PRIMITIVES_ARB                                                    = 0x82F6
+	CLIPPING_OUTPUT_PRIMITIVES_ARB                                                   = 0x82F7
+	CLIP_DEPTH_MODE                                                                  = 0x935D
+	CLIP_DISTANCE0                                             Capability            = 0x3000
+	CLIP_DISTANCE1                                             Capability            = 0x3001
+	CLIP_DISTANCE2                                             Capability            = 0x3002
+	CLIP_DISTANCE3                                             Capability            = 0x3003
+	CLIP_DISTANCE4                                             Capability            = 0x3004
+	CLIP_DISTANCE5                                             Capability            = 0x3005
+	CLIP_DISTANCE6                                             Capability            = 0x3006
+	CLIP_DISTANCE7                                             Capability            = 0x3007
+	CLIP_ORIGIN                                                                      = 0x935C
+	COLOR                                                                            = 0x1800
+	COLORBURN_KHR                                                                    = 0x929A
+	COLORBURN_NV                                                                     = 0x929A
+	COLORDODGE_KHR                                                                   = 0x9299
+	COLORDODGE_NV                                                                    = 0x9299
+	COLOR_ATTACHMENT0                                                                = 0x8CE0
+	COLOR_ATTACHMENT1                                                                = 0x8CE1
+	COLOR_ATTACHMENT10                                                               = 0x8CEA
+	COLOR_ATTACHMENT11                                                               = 0x8CEB
+	COLOR_ATTACHMENT12                                                               = 0x8CEC
+	COLOR_ATTACHMENT13                                                               = 0x8CED
+	COLOR_ATTACHMENT14                                                               = 0x8CEE
+	COLOR_ATTACHMENT15                                                               = 0x8CEF
+	COLOR_ATTACHMENT2                                                                = 0x8CE2
+	COLOR_ATTACHMENT3                                                                = 0x8CE3
+	COLOR_ATTACHMENT4                                                                = 0x8CE4
+	COLOR_ATTACHMENT5                                                                = 0x8CE5
+	COLOR_ATTACHMENT6                                                                = 0x8CE6
+	COLOR_ATTACHMENT7                                                                = 0x8CE7
+	COLOR_ATTACHMENT8                                                                = 0x8CE8
+	COLOR_ATTACHMENT9                                                                = 0x8CE9
+	COLOR_BUFFER_BIT                                                                 = 0x00004000
+	COLOR_CLEAR_VALUE                                                                = 0x0C22
+	COLOR_COMPONENTS                                                                 = 0x8283
+	COLOR_ENCODING                                                                   = 0x8296
+	COLOR_LOGIC_OP                                             Capability            = 0x0BF2
+	COLOR_RENDERABLE                                                                 = 0x8286
+	COLOR_WRITEMASK                                                                  = 0x0C23
+	COMMAND_BARRIER_BIT                                                              = 0x00000040
+	COMPARE_REF_TO_TEXTURE                                                           = 0x884E
+	COMPATIBLE_SUBROUTINES                                                           = 0x8E4B
+	COMPILE_STATUS                                                                   = 0x8B81
+	COMPRESSED_R11_EAC                                         InternalFormat        = 0x9270
+	COMPRESSED_RED                                             InternalFormat        = 0x8225
+	COMPRESSED_RED_RGTC1                                       InternalFormat        = 0x8DBB
+	COMPRESSED_RG                                              InternalFormat        = 0x8226
+	COMPRESSED_RG11_EAC                                        InternalFormat        = 0x9272
+	COMPRESSED_RGB                                             InternalFormat        = 0x84ED
+	COMPRESSED_RGB8_ETC2                                       InternalFormat        = 0x9274
+	COMPRESSED_RGB8_PUNCHTHROUGH_ALPHA1_ETC2                   InternalFormat        = 0x9276
+	COMPRESSED_RGBA                                            InternalFormat        = 0x84EE
+	COMPRESSED_RGBA8_ETC2_EAC                                  InternalFormat        = 0x9278
+	COMPRESSED_RGBA_ASTC_10x10_KHR                                                   = 0x93BB
+	COMPRESSED_RGBA_ASTC_10x5_KHR                                                    = 0x93B8
+	COMPRESSED_RGBA_ASTC_10x6_KHR                                                    = 0x93B9
+	COMPRESSED_RGBA_ASTC_10x8_KHR                                                    = 0x93BA
+	COMPRESSED_RGBA_ASTC_12x10_KHR                                                   = 0x93BC
+	COMPRESSED_RGBA_ASTC_12x12_KHR                                                   = 0x93BD
+	COMPRESSED_RGBA_ASTC_4x4_KHR                                                     = 0x93B0
+	COMPRESSED_RGBA_ASTC_5x4_KHR                                                     = 0x93B1
+	COMPRESSED_RGBA_ASTC_5x5_KHR                                                     = 0x93B2
+	COMPRESSED_RGBA_ASTC_6x5_KHR                                                     = 0x93B3
+	COMPRESSED_RGBA_ASTC_6x6_KHR                                                     = 0x93B4
+	COMPRESSED_RGBA_ASTC_8x5_KHR                                                     = 0x93B5
+	COMPRESSED_RGBA_ASTC_8x6_KHR                                                     = 0x93B6
+	COMPRESSED_RGBA_ASTC_8x8_KHR                                                     = 0x93B7
+	COMPRESSED_RGBA_BPTC_UNORM_ARB                             InternalFormat        = 0x8E8C
+	COMPRESSED_RGBA_S3TC_DXT1_EXT                              InternalFormat        = 0x83F1
+	COMPRESSED_RGBA_S3TC_DXT3_EXT                              InternalFormat        = 0x83F2
+	COMPRESSED_RGBA_S3TC_DXT5_EXT                              InternalFormat        = 0x83F3
+	COMPRESSED_RGB_BPTC_SIGNED_FLOAT_ARB                       InternalFormat        = 0x8E8E
+	COMPRESSED_RGB_BPTC_UNSIGNED_FLOAT_ARB                     InternalFormat        = 0x8E8F
+	COMPRESSED_RGB_S3TC_DXT1_EXT                               InternalFormat        = 0x83F0
+	COMPRESSED_RG_RGTC2                                        InternalFormat        = 0x8DBD
+	COMPRESSED_SIGNED_R11_EAC                                  InternalFormat        = 0x9271
+	COMPRESSED_SIGNED_RED_RGTC1                                InternalFormat        = 0x8DBC
+	COMPRESSED_SIGNED_RG11_EAC                                 InternalFormat        = 0x9273
+	COMPRESSED_SIGNED_RG_RGTC2                                 InternalFormat        = 0x8DBE
+	COMPRESSED_SRGB                                            InternalFormat        = 0x8C48
+	COMPRESSED_SRGB8_ALPHA8_ASTC_10x10_KHR                                           = 0x93DB
+	COMPRESSED_SRGB8_ALPHA8_ASTC_10x5_KHR                                            = 0x93D8
+	COMPRESSED_SRGB8_ALPHA8_ASTC_10x6_KHR                                            = 0x93D9
+	COMPRESSED_SRGB8_ALPHA8_ASTC_10x8_KHR                                            = 0x93DA
+	COMPRESSED_SRGB8_ALPHA8_ASTC_12x10_KHR                                           = 0x93DC
+	COMPRESSED_SRGB8_ALPHA8_ASTC_12x12_KHR                                           = 0x93DD
+	COMPRESSED_SRGB8_ALPHA8_ASTC_4x4_KHR                                             = 0x93D0
+	COMPRESSED_SRGB8_ALPHA8_ASTC_5x4_KHR                                             = 0x93D1
+	COMPRESSED_SRGB8_ALPHA8_ASTC_5x5_KHR                                             = 0x93D2
+	COMPRESSED_SRGB8_ALPHA8_ASTC_6x5_KHR                                             = 0x93D3
+	COMPRESSED_SRGB8_ALPHA8_ASTC_6x6_KHR                                             = 0x93D4
+	COMPRESSED_SRGB8_ALPHA8_ASTC_8x5_KHR                                             = 0x93D5
+	COMPRESSED_SRGB8_ALPHA8_ASTC_8x6_KHR                                             = 0x93D6
+	COMPRESSED_SRGB8_ALPHA8_ASTC_8x8_KHR                                             = 0x93D7
+	COMPRESSED_SRGB8_ALPHA8_ETC2_EAC                           InternalFormat        = 0x9279
+	COMPRESSED_SRGB8_ETC2                                      InternalFormat        = 0x9275
+	COMPRESSED_SRGB8_PUNCHTHROUGH_ALPHA1_ETC2                  InternalFormat        = 0x9277
+	COMPRESSED_SRGB_ALPHA                                      InternalFormat        = 0x8C49
+	COMPRESSED_SRGB_ALPHA_BPTC_UNORM_ARB                       InternalFormat        = 0x8E8D
+	COMPRESSED_TEXTURE_FORMATS                                                       = 0x86A3
+	COMPUTE_SHADER                                             ShaderType            = 0x91B9
+	COMPUTE_SHADER_BIT                                                               = 0x00000020
+	COMPUTE_SHADER_INVOCATIONS_ARB                                                   = 0x82F5
+	COMPUTE_SUBROUTINE                                                               = 0x92ED
+	COMPUTE_SUBROUTINE_UNIFORM                                                       = 0x92F3
+	COMPUTE_TEXTURE                                                                  = 0x82A0
+	COMPUTE_WORK_GROUP_SIZE                                                          = 0x8267
+	CONDITION_SATISFIED                                        SyncStatus            = 0x911C
+	CONJOINT_NV                                                                      = 0x9284
+	CONSTANT_ALPHA                                             BlendFactor           = 0x8003
+	CONSTANT_COLOR                                             BlendFactor           = 0x8001
+	CONTEXT_COMPATIBILITY_PROFILE_BIT                                                = 0x00000002
+	CONTEXT_CORE_PROFILE_BIT                                                         = 0x00000001
+	CONTEXT_FLAGS                                                                    = 0x821E
+	CONTEXT_FLAG_DEBUG_BIT                                                           = 0x00000002
+	CONTEXT_FLAG_DEBUG_BIT_KHR                                                       = 0x00000002
+	CONTEXT_FLAG_FORWARD_COMPATIBLE_BIT                                              = 0x00000001
+	CONTEXT_FLAG_ROBUST_ACCESS_BIT_ARB                                               = 0x00000004
+	CONTEXT_LOST                                                                     = 0x0507
+	CONTEXT_LOST_KHR                                                                 = 0x0507
+	CONTEXT_PROFILE_MASK                                                             = 0x9126
+	CONTEXT_RELEASE_BEHAVIOR                                                         = 0x82FB
+	CONTEXT_RELEASE_BEHAVIOR_FLUSH                                                   = 0x82FC
+	CONTEXT_RELEASE_BEHAVIOR_FLUSH_KHR                                               = 0x82FC
+	CONTEXT_RELEASE_BEHAVIOR_KHR                                                     = 0x82FB
+	CONTEXT_ROBUST_ACCESS                                                            = 0x90F3
+	CONTEXT_ROBUST_ACCESS_KHR                                                        = 0x90F3
+	CONTRAST_NV                                                                      = 0x92A1
+	COPY                                                                             = 0x1503
+	COPY_INVERTED                                                                    = 0x150C
+	COPY_READ_BUFFER                                           BufferTarget          = 0x8F36
+	COPY_READ_BUFFER_BINDING                                                         = 0x8F36
+	COPY_WRITE_BUFFER                                          BufferTarget          = 0x8F37
+	COPY_WRITE_BUFFER_BINDING                                                        = 0x8F37
+	COUNTER_RANGE_AMD                                                                = 0x8BC1
+	COUNTER_TYPE_AMD                                                                 = 0x8BC0
+	CULL_FACE                                                  Capability            = 0x0B44
+	CULL_FACE_MODE                                                                   = 0x0B45
+	CURRENT_PROGRAM                                                                  = 0x8B8D
+	CURRENT_QUERY                                                                    = 0x8865
+	CURRENT_VERTEX_ATTRIB                                                            = 0x8626
+	CW                                                         Winding               = 0x0900
+	DARKEN_KHR                                                                       = 0x9297
+	DARKEN_NV                                                                        = 0x9297
+	DEBUG_CALLBACK_FUNCTION                                                          = 0x8244
+	DEBUG_CALLBACK_FUNCTION_ARB                                                      = 0x8244
+	DEBUG_CALLBACK_FUNCTION_KHR                                                      = 0x8244
+	DEBUG_CALLBACK_USER_PARAM                                                        = 0x8245
+	DEBUG_CALLBACK_USER_PARAM_ARB                                                    = 0x8245
+	DEBUG_CALLBACK_USER_PARAM_KHR                                                    = 0x8245
+	DEBUG_GROUP_STACK_DEPTH                                                          = 0x826D
+	DEBUG_GROUP_STACK_DEPTH_KHR                                                      = 0x826D
+	DEBUG_LOGGED_MESSAGES                                                            = 0x9145
+	DEBUG_LOGGED_MESSAGES_ARB                                                        = 0x9145
+	DEBUG_LOGGED_MESSAGES_KHR                                                        = 0x9145
+	DEBUG_NEXT_LOGGED_MESSAGE_LENGTH                                                 = 0x8243
+	DEBUG_NEXT_LOGGED_MESSAGE_LENGTH_ARB                                             = 0x8243
+	DEBUG_NEXT_LOGGED_MESSAGE_LENGTH_KHR                                             = 0x8243
+	DEBUG_OUTPUT                                               Capability            = 0x92E0
+	DEBUG_OUTPUT_KHR                                                                 = 0x92E0
+	DEBUG_OUTPUT_SYNCHRONOUS                                   Capability            = 0x8242
+	DEBUG_OUTPUT_SYNCHRONOUS_ARB                                                     = 0x8242
+	DEBUG_OUTPUT_SYNCHRONOUS_KHR                                                     = 0x8242
+	DEBUG_SEVERITY_HIGH                                                              = 0x9146
+	DEBUG_SEVERITY_HIGH_ARB                                                          = 0x9146
+	DEBUG_SEVERITY_HIGH_KHR                                                          = 0x9146
+	DEBUG_SEVERITY_LOW                                                               = 0x9148
+	DEBUG_SEVERITY_LOW_ARB                                                           = 0x9148
+	DEBUG_SEVERITY_LOW_KHR                                                           = 0x9148
+	DEBUG_SEVERITY_MEDIUM                                                            = 0x9147
+	DEBUG_SEVERITY_MEDIUM_ARB                                                        = 0x9147
+	DEBUG_SEVERITY_MEDIUM_KHR                                                        = 0x9147
+	DEBUG_SEVERITY_NOTIFICATION                                                      = 0x826B
+	DEBUG_SEVERITY_NOTIFICATION_KHR                                                  = 0x826B
+	DEBUG_SOURCE_API                                                                 = 0x8246
+	DEBUG_SOURCE_API_ARB                                                             = 0x8246
+	DEBUG_SOURCE_API_KHR                                                             = 0x8246
+	DEBUG_SOURCE_APPLICATION                                                         = 0x824A
+	DEBUG_SOURCE_APPLICATION_ARB                                                     = 0x824A
+	DEBUG_SOURCE_APPLICATION_KHR                                                     = 0x824A
+	DEBUG_SOURCE_OTHER                                                               = 0x824B
+	DEBUG_SOURCE_OTHER_ARB                                                           = 0x824B
+	DEBUG_SOURCE_OTHER_KHR                                                           = 0x824B
+	DEBUG_SOURCE_SHADER_COMPILER                                                     = 0x8248
+	DEBUG_SOURCE_SHADER_COMPILER_ARB                                                 = 0x8248
+	DEBUG_SOURCE_SHADER_COMPILER_KHR                                                 = 0x8248
+	DEBUG_SOURCE_THIRD_PARTY                                                         = 0x8249
+	DEBUG_SOURCE_THIRD_PARTY_ARB                                                     = 0x8249
+	DEBUG_SOURCE_THIRD_PARTY_KHR                                                     = 0x8249
+	DEBUG_SOURCE_WINDOW_SYSTEM                                                       = 0x8247
+	DEBUG_SOURCE_WINDOW_SYSTEM_ARB                                                   = 0x8247
+	DEBUG_SOURCE_WINDOW_SYSTEM_KHR                                                   = 0x8247
+	DEBUG_TYPE_DEPRECATED_BEHAVIOR                                                   = 0x824D
+	DEBUG_TYPE_DEPRECATED_BEHAVIOR_ARB                                               = 0x824D
+	DEBUG_TYPE_DEPRECATED_BEHAVIOR_KHR                                               = 0x824D
+	DEBUG_TYPE_ERROR                                                                 = 0x824C
+	DEBUG_TYPE_ERROR_ARB                                                             = 0x824C
+	DEBUG_TYPE_ERROR_KHR                                                             = 0x824C
+	DEBUG_TYPE_MARKER                                                                = 0x8268
+	DEBUG_TYPE_MARKER_KHR                                                            = 0x8268
+	DEBUG_TYPE_OTHER                                                                 = 0x8251
+	DEBUG_TYPE_OTHER_ARB                                                             = 0x8251
+	DEBUG_TYPE_OTHER_KHR                                                             = 0x8251
+	DEBUG_TYPE_PERFORMANCE                                                           = 0x8250
+	DEBUG_TYPE_PERFORMANCE_ARB                                                       = 0x8250
+	DEBUG_TYPE_PERFORMANCE_KHR                                                       = 0x8250
+	DEBUG_TYPE_POP_GROUP                                                             = 0x826A
+	DEBUG_TYPE_POP_GROUP_KHR                                                         = 0x826A
+	DEBUG_TYPE_PORTABILITY                                                           = 0x824F
+	DEBUG_TYPE_PORTABILITY_ARB                                                       = 0x824F
+	DEBUG_TYPE_PORTABILITY_KHR                                                       = 0x824F
+	DEBUG_TYPE_PUSH_GROUP                                                            = 0x8269
+	DEBUG_TYPE_PUSH_GROUP_KHR                                                        = 0x8269
+	DEBUG_TYPE_UNDEFINED_BEHAVIOR                                                    = 0x824E
+	DEBUG_TYPE_UNDEFINED_BEHAVIOR_ARB                                                = 0x824E
+	DEBUG_TYPE_UNDEFINED_BEHAVIOR_KHR                                                = 0x824E
+	DECODE_EXT                                                                       = 0x8A49
+	DECR                                                       StencilOp             = 0x1E03
+	DECR_WRAP                                                  StencilOp             = 0x8508
+	DELETE_STATUS                                                                    = 0x8B80
+	DEPTH                                                                            = 0x1801
+	DEPTH24_STENCIL8                                           InternalFormat        = 0x88F0
+	DEPTH32F_STENCIL8                                          InternalFormat        = 0x8CAD
+	DEPTH_ATTACHMENT                                           FramebufferAttachment = 0x8D00
+	DEPTH_BUFFER_BIT                                                                 = 0x00000100
+	DEPTH_CLAMP                                                Capability            = 0x864F
+	DEPTH_CLEAR_VALUE                                                                = 0x0B73
+	DEPTH_COMPONENT                                                                  = 0x1902
+	DEPTH_COMPONENT16                                          InternalFormat        = 0x81A5
+	DEPTH_COMPONENT24                                          InternalFormat        = 0x81A6
+	DEPTH_COMPONENT32                                          InternalFormat        = 0x81A7
+	DEPTH_COMPONENT32F                                         InternalFormat        = 0x8CAC
+	DEPTH_COMPONENTS                                                                 = 0x8284
+	DEPTH_FUNC                                                                       = 0x0B74
+	DEPTH_RANGE                                                                      = 0x0B70
+	DEPTH_RENDERABLE                                                                 = 0x8287
+	DEPTH_STENCIL                                                                    = 0x84F9
+	DEPTH_STENCIL_ATTACHMENT                                   FramebufferAttachment = 0x821A
+	DEPTH_STENCIL_TEXTURE_MODE                                 TextureParameter      = 0x90EA
+	DEPTH_TEST                                                 Capability            = 0x0B71
+	DEPTH_WRITEMASK                                                                  = 0x0B72
+	DIFFERENCE_KHR                                                                   = 0x929E
+	DIFFERENCE_NV                                                                    = 0x929E
+	DISJOINT_NV                                                                      = 0x9283
+	DISPATCH_INDIRECT_BUFFER                                   BufferTarget          = 0x90EE
+	DISPATCH_INDIRECT_BUFFER_BINDING                                                 = 0x90EF
+	DITHER                                                     Capability            = 0x0BD0
+	DONT_CARE                                                                        = 0x1100
+	DOUBLE                                                                           = 0x140A
+	DOUBLEBUFFER                                                                     = 0x0C32
+	DOUBLE_MAT2                                                AttributeType         = 0x8F46
+	DOUBLE_MAT2x3                                              AttributeType         = 0x8F49
+	DOUBLE_MAT2x4                                              AttributeType         = 0x8F4A
+	DOUBLE_MAT3                                                AttributeType         = 0x8F47
+	DOUBLE_MAT3x2                                              AttributeType         = 0x8F4B
+	DOUBLE_MAT3x4                                              AttributeType         = 0x8F4C
+	DOUBLE_MAT4                                                AttributeType         = 0x8F48
+	DOUBLE_MAT4x2                                              AttributeType         = 0x8F4D
+	DOUBLE_MAT4x3                                              AttributeType         = 0x8F4E
+	DOUBLE_VEC2                                                AttributeType         = 0x8FFC
+	DOUBLE_VEC3                                                AttributeType         = 0x8FFD
+	DOUBLE_VEC4                                                AttributeType         = 0x8FFE
+	DRAW_BUFFER                                                                      = 0x0C01
+	DRAW_BUFFER0                                                                     = 0x8825
+	DRAW_BUFFER1                                                                     = 0x8826
+	DRAW_BUFFER10                                                                    = 0x882F
+	DRAW_BUFFER11                                                                    = 0x8830
+	DRAW_BUFFER12                                                                    = 0x8831
+	DRAW_BUFFER13                                                                    = 0x8832
+	DRAW_BUFFER14                                                                    = 0x8833
+	DRAW_BUFFER15                                                                    = 0x8834
+	DRAW_BUFFER2                                                                     = 0x8827
+	DRAW_BUFFER3                                                                     = 0x8828
+	DRAW_BUFFER4                                                                     = 0x8829
+	DRAW_BUFFER5                                                                     = 0x882A
+	DRAW_BUFFER6                                                                     = 0x882B
+	DRAW_BUFFER7                                                                     = 0x882C
+	DRAW_BUFFER8                                                                     = 0x882D
+	DRAW_BUFFER9                                                                     = 0x882E
+	DRAW_FRAMEBUFFER                                           FramebufferTarget     = 0x8CA9
+	DRAW_FRAMEBUFFER_BINDING                                                         = 0x8CA6
+	DRAW_INDIRECT_BUFFER                                       BufferTarget          = 0x8F3F
+	DRAW_INDIRECT_BUFFER_BINDING                                                     = 0x8F43
+	DST_ALPHA                                                  BlendFactor           = 0x0304
+	DST_ATOP_NV                                                                      = 0x928F
+	DST_COLOR                                                  BlendFactor           = 0x0306
+	DST_IN_NV                                                                        = 0x928B
+	DST_NV                                                                           = 0x9287
+	DST_OUT_NV                                                                       = 0x928D
+	DST_OVER_NV                                                                      = 0x9289
+	DYNAMIC_COPY                                               BufferUsage           = 0x88EA
+	DYNAMIC_DRAW                                               BufferUsage           = 0x88E8
+	DYNAMIC_READ                                               BufferUsage           = 0x88E9
+	DYNAMIC_STORAGE_BIT                                                              = 0x0100
+	ELEMENT_ARRAY_BARRIER_BIT                                                        = 0x00000002
+	ELEMENT_ARRAY_BUFFER                                       BufferTarget          = 0x8893
+	ELEMENT_ARRAY_BUFFER_BINDING                                                     = 0x8895
+	EQUAL                                                                            = 0x0202
+	EQUIV                                                                            = 0x1509
+	EXCLUSION_KHR                                                                    = 0x92A0
+	EXCLUSION_NV                                                                     = 0x92A0
+	EXTENSIONS                                                                       = 0x1F03
+	FALSE                                                                            = 0
+	FASTEST                                                                          = 0x1101
+	FENCE_CONDITION_NV                                                               = 0x84F4
+	FENCE_STATUS_NV                                                                  = 0x84F3
+	FILL                                                       PolygonMode           = 0x1B02
+	FILTER                                                                           = 0x829A
+	FIRST_VERTEX_CONVENTION                                                          = 0x8E4D
+	FIXED                                                      VertexAttribType      = 0x140C
+	FIXED_OES                                                                        = 0x140C
+	FIXED_ONLY                                                                       = 0x891D
+	FLOAT                                                                            = 0x1406
+	FLOAT_32_UNSIGNED_INT_24_8_REV                             PixelType             = 0x8DAD
+	FLOAT_MAT2                                                 AttributeType         = 0x8B5A
+	FLOAT_MAT2x3                                               AttributeType         = 0x8B65
+	FLOAT_MAT2x4                                               AttributeType         = 0x8B66
+	FLOAT_MAT3                                                 AttributeType         = 0x8B5B
+	FLOAT_MAT3x2                                               AttributeType         = 0x8B67
+	FLOAT_MAT3x4                                               AttributeType         = 0x8B68
+	FLOAT_MAT4                                                 AttributeType         = 0x8B5C
+	FLOAT_MAT4x2                                               AttributeType         = 0x8B69
+	FLOAT_MAT4x3                                               AttributeType         = 0x8B6A
+	FLOAT_VEC2                                                 AttributeType         = 0x8B50
+	FLOAT_VEC3                                                 AttributeType         = 0x8B51
+	FLOAT_VEC4                                                 AttributeType         = 0x8B52
+	FRACTIONAL_EVEN                                                                  = 0x8E7C
+	FRACTIONAL_ODD                                                                   = 0x8E7B
+	FRAGMENT_INTERPOLATION_OFFSET_BITS                                               = 0x8E5D
+	FRAGMENT_SHADER                                            ShaderType            = 0x8B30
+	FRAGMENT_SHADER_BIT                                                              = 0x00000002
+	FRAGMENT_SHADER_BIT_EXT                                                          = 0x00000002
+	FRAGMENT_SHADER_DERIVATIVE_HINT                                                  = 0x8B8B
+	FRAGMENT_SHADER_INVOCATIONS_ARB                                                  = 0x82F4
+	FRAGMENT_SUBROUTINE                                                              = 0x92EC
+	FRAGMENT_SUBROUTINE_UNIFORM                                                      = 0x92F2
+	FRAGMENT_TEXTURE                                                                 = 0x829F
+	FRAMEBUFFER                                                FramebufferTarget     = 0x8D40
+	FRAMEBUFFER_ATTACHMENT_ALPHA_SIZE                                                = 0x8215
+	FRAMEBUFFER_ATTACHMENT_BLUE_SIZE                                                 = 0x8214
+	FRAMEBUFFER_ATTACHMENT_COLOR_ENCODING                                            = 0x8210
+	FRAMEBUFFER_ATTACHMENT_COMPONENT_TYPE                                            = 0x8211
+	FRAMEBUFFER_ATTACHMENT_DEPTH_SIZE                                                = 0x8216
+	FRAMEBUFFER_ATTACHMENT_GREEN_SIZE                                                = 0x8213
+	FRAMEBUFFER_ATTACHMENT_LAYERED                                                   = 0x8DA7
+	FRAMEBUFFER_ATTACHMENT_OBJECT_NAME                                               = 0x8CD1
+	FRAMEBUFFER_ATTACHMENT_OBJECT_TYPE                                               = 0x8CD0
+	FRAMEBUFFER_ATTACHMENT_RED_SIZE                                                  = 0x8212
+	FRAMEBUFFER_ATTACHMENT_STENCIL_SIZE                                              = 0x8217
+	FRAMEBUFFER_ATTACHMENT_TEXTURE_CUBE_MAP_FACE                                     = 0x8CD3
+	FRAMEBUFFER_ATTACHMENT_TEXTURE_LAYER                                             = 0x8CD4
+	FRAMEBUFFER_ATTACHMENT_TEXTURE_LEVEL                                             = 0x8CD2
+	FRAMEBUFFER_BARRIER_BIT                                                          = 0x00000400
+	FRAMEBUFFER_BINDING                                                              = 0x8CA6
+	FRAMEBUFFER_BLEND                                                                = 0x828B
+	FRAMEBUFFER_COMPLETE                                                             = 0x8CD5
+	FRAMEBUFFER_DEFAULT                                                              = 0x8218
+	FRAMEBUFFER_DEFAULT_FIXED_SAMPLE_LOCATIONS                                       = 0x9314
+	FRAMEBUFFER_DEFAULT_HEIGHT                                                       = 0x9311
+	FRAMEBUFFER_DEFAULT_LAYERS                                                       = 0x9312
+	FRAMEBUFFER_DEFAULT_SAMPLES                                                      = 0x9313
+	FRAMEBUFFER_DEFAULT_WIDTH                                                        = 0x9310
+	FRAMEBUFFER_INCOMPLETE_ATTACHMENT                                                = 0x8CD6
+	FRAMEBUFFER_INCOMPLETE_DRAW_BUFFER                                               = 0x8CDB
+	FRAMEBUFFER_INCOMPLETE_LAYER_TARGETS                                             = 0x8DA8
+	FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT                                        = 0x8CD7
+	FRAMEBUFFER_INCOMPLETE_MULTISAMPLE                                               = 0x8D56
+	FRAMEBUFFER_INCOMPLETE_READ_BUFFER                                               = 0x8CDC
+	FRAMEBUFFER_RENDERABLE                                                           = 0x8289
+	FRAMEBUFFER_RENDERABLE_LAYERED                                                   = 0x828A
+	FRAMEBUFFER_SRGB                                           Capability            = 0x8DB9
+	FRAMEBUFFER_UNDEFINED                                                            = 0x8219
+	FRAMEBUFFER_UNSUPPORTED                                                          = 0x8CDD
+	FRONT                                                                            = 0x0404
+	FRONT_AND_BACK                                                                   = 0x0408
+	FRONT_FACE                                                                       = 0x0B46
+	FRONT_LEFT                                                 ColorBuffer           = 0x0400
+	FRONT_RIGHT                                                ColorBuffer           = 0x0401
+	FULL_SUPPORT                                                                     = 0x82B7
+	FUNC_ADD                                                   BlendEquation         = 0x8006
+	FUNC_ADD_EXT                                                                     = 0x8006
+	FUNC_REVERSE_SUBTRACT                                      BlendEquation         = 0x800B
+	FUNC_SUBTRACT                                              BlendEquation         = 0x800A
+	GEOMETRY_INPUT_TYPE                                                              = 0x8917
+	GEOMETRY_OUTPUT_TYPE                                                             = 0x8918
+	GEOMETRY_SHADER                                            ShaderType            = 0x8DD9
+	GEOMETRY_SHADER_BIT                                                              = 0x00000004
+	GEOMETRY_SHADER_INVOCATIONS                                                      = 0x887F
+	GEOMETRY_SHADER_PRIMITIVES_EMITTED_ARB                                           = 0x82F3
+	GEOMETRY_SUBROUTINE                                                              = 0x92EB
+	GEOMETRY_SUBROUTINE_UNIFORM                                                      = 0x92F1
+	GEOMETRY_TEXTURE                                                                 = 0x829E
+	GEOMETRY_VERTICES_OUT                                                            = 0x8916
+	GEQUAL                                                                           = 0x0206
+	GET_TEXTURE_IMAGE_FORMAT                                                         = 0x8291
+	GET_TEXTURE_IMAGE_TYPE                                                           = 0x8292
+	GREATER                                                                          = 0x0204
+	GREEN                                                      PixelFormat           = 0x1904
+	GREEN_INTEGER                                              PixelFormat           = 0x8D95
+	GREEN_NV                                                                         = 0x1904
+	GUILTY_CONTEXT_RESET                                                             = 0x8253
+	GUILTY_CONTEXT_RESET_ARB                                                         = 0x8253
+	GUILTY_CONTEXT_RESET_KHR                                                         = 0x8253
+	HALF_FLOAT                                                                       = 0x140B
+	HARDLIGHT_KHR                                                                    = 0x929B
+	HARDLIGHT_NV                                                                     = 0x929B
+	HARDMIX_NV                                                                       = 0x92A9
+	HIGH_FLOAT                                                                       = 0x8DF2
+	HIGH_INT                                                                         = 0x8DF5
+	HSL_COLOR_KHR                                                                    = 0x92AF
+	HSL_COLOR_NV                                                                     = 0x92AF
+	HSL_HUE_KHR                                                                      = 0x92AD
+	HSL_HUE_NV                                                                       = 0x92AD
+	HSL_LUMINOSITY_KHR                                                               = 0x92B0
+	HSL_LUMINOSITY_NV                                                                = 0x92B0
+	HSL_SATURATION_KHR                                                               = 0x92AE
+	HSL_SATURATION_NV                                                                = 0x92AE
+	IMAGE_1D                                                                         = 0x904C
+	IMAGE_1D_ARRAY                                                                   = 0x9052
+	IMAGE_2D                                                                         = 0x904D
+	IMAGE_2D_ARRAY                                                                   = 0x9053
+	IMAGE_2D_MULTISAMPLE                                                             = 0x9055
+	IMAGE_2D_MULTISAMPLE_ARRAY                                                       = 0x9056
+	IMAGE_2D_RECT                                                                    = 0x904F
+	IMAGE_3D                                                                         = 0x904E
+	IMAGE_BINDING_ACCESS                                                             = 0x8F3E
+	IMAGE_BINDING_FORMAT                                                             = 0x906E
+	IMAGE_BINDING_LAYER                                                              = 0x8F3D
+	IMAGE_BINDING_LAYERED                                                            = 0x8F3C
+	IMAGE_BINDING_LEVEL                                                              = 0x8F3B
+	IMAGE_BINDING_NAME                                                               = 0x8F3A
+	IMAGE_BUFFER                                                                     = 0x9051
+	IMAGE_CLASS_10_10_10_2                                                           = 0x82C3
+	IMAGE_CLASS_11_11_10                                                             = 0x82C2
+	IMAGE_CLASS_1_X_16                                                               = 0x82BE
+	IMAGE_CLASS_1_X_32                                                               = 0x82BB
+	IMAGE_CLASS_1_X_8                                                                = 0x82C1
+	IMAGE_CLASS_2_X_16                                                               = 0x82BD
+	IMAGE_CLASS_2_X_32                                                               = 0x82BA
+	IMAGE_CLASS_2_X_8                                                                = 0x82C0
+	IMAGE_CLASS_4_X_16                                                               = 0x82BC
+	IMAGE_CLASS_4_X_32                                                               = 0x82B9
+	IMAGE_CLASS_4_X_8                                                                = 0x82BF
+	IMAGE_COMPATIBILITY_CLASS                                                        = 0x82A8
+	IMAGE_CUBE                                                                       = 0x9050
+	IMAGE_CUBE_MAP_ARRAY                                                             = 0x9054
+	IMAGE_FORMAT_COMPATIBILITY_BY_CLASS                                              = 0x90C9
+	IMAGE_FORMAT_COMPATIBILITY_BY_SIZE                                               = 0x90C8
+	IMAGE_FORMAT_COMPATIBILITY_TYPE                                                  = 0x90C7
+	IMAGE_PIXEL_FORMAT                                                               = 0x82A9
+	IMAGE_PIXEL_TYPE                                                                 = 0x82AA
+	IMAGE_TEXEL_SIZE                                                                 = 0x82A7
+	IMPLEMENTATION_COLOR_READ_FORMAT                                                 = 0x8B9B
+	IMPLEMENTATION_COLOR_READ_FORMAT_OES                                             = 0x8B9B
+	IMPLEMENTATION_COLOR_READ_TYPE                                                   = 0x8B9A
+	IMPLEMENTATION_COLOR_READ_TYPE_OES                                               = 0x8B9A
+	INCR                                                       StencilOp             = 0x1E02
+	INCR_WRAP                                                  StencilOp             = 0x8507
+	INFO_LOG_LENGTH                                                                  = 0x8B84
+	INNOCENT_CONTEXT_RESET                                                           = 0x8254
+	INNOCENT_CONTEXT_RESET_ARB                                                       = 0x8254
+	INNOCENT_CONTEXT_RESET_KHR                                                       = 0x8254
+	INT                                                                              = 0x1404
+	INTERLEAVED_ATTRIBS                                                              = 0x8C8C
+	INTERNALFORMAT_ALPHA_SIZE                                                        = 0x8274
+	INTERNALFORMAT_ALPHA_TYPE                                                        = 0x827B
+	INTERNALFORMAT_BLUE_SIZE                                                         = 0x8273
+	INTERNALFORMAT_BLUE_TYPE                                                         = 0x827A
+	INTERNALFORMAT_DEPTH_SIZE                                                        = 0x8275
+	INTERNALFORMAT_DEPTH_TYPE                                                        = 0x827C
+	INTERNALFORMAT_GREEN_SIZE                                                        = 0x8272
+	INTERNALFORMAT_GREEN_TYPE                                                        = 0x8279
+	INTERNALFORMAT_PREFERRED                                                         = 0x8270
+	INTERNALFORMAT_RED_SIZE                                                          = 0x8271
+	INTERNALFORMAT_RED_TYPE                                                          = 0x8278
+	INTERNALFORMAT_SHARED_SIZE                                                       = 0x8277
+	INTERNALFORMAT_STENCIL_SIZE                                                      = 0x8276
+	INTERNALFORMAT_STENCIL_TYPE                                                      = 0x827D
+	INTERNALFORMAT_SUPPORTED                                                         = 0x826F
+	INT_2_10_10_10_REV                                         VertexAttribType      = 0x8D9F
+	INT_IMAGE_1D                                                                     = 0x9057
+	INT_IMAGE_1D_ARRAY                                                               = 0x905D
+	INT_IMAGE_2D                                                                     = 0x9058
+	INT_IMAGE_2D_ARRAY                                                               = 0x905E
+	INT_IMAGE_2D_MULTISAMPLE                                                         = 0x9060
+	INT_IMAGE_2D_MULTISAMPLE_ARRAY                                                   = 0x9061
+	INT_IMAGE_2D_RECT                                                                = 0x905A
+	INT_IMAGE_3D                                                                     = 0x9059
+	INT_IMAGE_BUFFER                                                                 = 0x905C
+	INT_IMAGE_CUBE                                                                   = 0x905B
+	INT_IMAGE_CUBE_MAP_ARRAY                                                         = 0x905F
+	INT_SAMPLER_1D                                                                   = 0x8DC9
+	INT_SAMPLER_1D_ARRAY                                                             = 0x8DCE
+	INT_SAMPLER_2D                                                                   = 0x8DCA
+	INT_SAMPLER_2D_ARRAY                                                             = 0x8DCF
+	INT_SAMPLER_2D_MULTISAMPLE                                                       = 0x9109
+	INT_SAMPLER_2D_MULTISAMPLE_ARRAY                                                 = 0x910C
+	INT_SAMPLER_2D_RECT                                                              = 0x8DCD
+	INT_SAMPLER_3D                                                                   = 0x8DCB
+	INT_SAMPLER_BUFFER                                                               = 0x8DD0
+	INT_SAMPLER_CUBE                                                                 = 0x8DCC
+	INT_SAMPLER_CUBE_MAP_ARRAY_ARB                                                   = 0x900E
+	INT_VEC2                                                   AttributeType         = 0x8B53
+	INT_VEC3                                                   AttributeType         = 0x8B54
+	INT_VEC4                                                   AttributeType         = 0x8B55
+	INVALID_ENUM                                                                     = 0x0500
+	INVALID_FRAMEBUFFER_OPERATION                                                    = 0x0506
+	INVALID_INDEX                                                                    = 0xFFFFFFFF
+	INVALID_OPERATION                                                                = 0x0502
+	INVALID_VALUE                                                                    = 0x0501
+	INVERT                                                     StencilOp             = 0x150A
+	INVERT_OVG_NV                                                                    = 0x92B4
+	INVERT_RGB_NV                                                                    = 0x92A3
+	ISOLINES                                                                         = 0x8E7A
+	IS_PER_PATCH                                                                     = 0x92E7
+	IS_ROW_MAJOR                                                                     = 0x9300
+	KEEP                                                       StencilOp             = 0x1E00
+	LAST_VERTEX_CONVENTION                                                           = 0x8E4E
+	LAYER_PROVOKING_VERTEX                                                           = 0x825E
+	LEFT                                                       ColorBuffer           = 0x0406
+	LEQUAL                                                                           = 0x0203
+	LESS                                                                             = 0x0201
+	LIGHTEN_KHR                                                                      = 0x9298
+	LIGHTEN_NV                                                                       = 0x9298
+	LINE                                                       PolygonMode           = 0x1B01
+	LINEAR                                                                           = 0x2601
+	LINEARBURN_NV                                                                    = 0x92A5
+	LINEARDODGE_NV                                                                   = 0x92A4
+	LINEARLIGHT_NV                                                                   = 0x92A7
+	LINEAR_MIPMAP_LINEAR                                                             = 0x2703
+	LINEAR_MIPMAP_NEAREST                                                            = 0x2701
+	LINES                                                      PrimitiveType         = 0x0001
+	LINES_ADJACENCY                                            PrimitiveType         = 0x000A
+	LINE_LOOP                                                  PrimitiveType         = 0x0002
+	LINE_SMOOTH                                                Capability            = 0x0B20
+	LINE_SMOOTH_HINT                                                                 = 0x0C52
+	LINE_STRIP                                                 PrimitiveType         = 0x0003
+	LINE_STRIP_ADJACENCY                                       PrimitiveType         = 0x000B
+	LINE_WIDTH                                                                       = 0x0B21
+	LINE_WIDTH_GRANULARITY                                                           = 0x0B23
+	LINE_WIDTH_RANGE                                                                 = 0x0B22
+	LINK_STATUS                                                                      = 0x8B82
+	LOCATION                                                                         = 0x930E
+	LOCATION_COMPONENT                                                               = 0x934A
+	LOCATION_INDEX                                                                   = 0x930F
+	LOGIC_OP_MODE                                                                    = 0x0BF0
+	LOSE_CONTEXT_ON_RESET                                                            = 0x8252
+	LOSE_CONTEXT_ON_RESET_ARB                                                        = 0x8252
+	LOSE_CONTEXT_ON_RESET_KHR                                                        = 0x8252
+	LOWER_LEFT                                                                       = 0x8CA1
+	LOW_FLOAT                                                                        = 0x8DF0
+	LOW_INT                                                                          = 0x8DF3
+	MAJOR_VERSION                                                                    = 0x821B
+	MANUAL_GENERATE_MIPMAP                                                           = 0x8294
+	MAP_COHERENT_BIT                                                                 = 0x0080
+	MAP_FLUSH_EXPLICIT_BIT                                                           = 0x0010
+	MAP_INVALIDATE_BUFFER_BIT                                                        = 0x0008
+	MAP_INVALIDATE_RANGE_BIT                                                         = 0x0004
+	MAP_PERSISTENT_BIT                                                               = 0x0040
+	MAP_READ_BIT                                                                     = 0x0001
+	MAP_UNSYNCHRONIZED_BIT                                                           = 0x0020
+	MAP_WRITE_BIT                                                                    = 0x0002
+	MATRIX_STRIDE                                                                    = 0x92FF
+	MAX                                                        BlendEquation         = 0x8008
+	MAX_3D_TEXTURE_SIZE                                                              = 0x8073
+	MAX_ARRAY_TEXTURE_LAYERS                                                         = 0x88FF
+	MAX_ATOMIC_COUNTER_BUFFER_BINDINGS                                               = 0x92DC
+	MAX_ATOMIC_COUNTER_BUFFER_SIZE                                                   = 0x92D8
+	MAX_CLIP_DISTANCES                                                               = 0x0D32
+	MAX_COLOR_ATTACHMENTS                                                            = 0x8CDF
+	MAX_COLOR_TEXTURE_SAMPLES                                                        = 0x910E
+	MAX_COMBINED_ATOMIC_COUNTERS                                                     = 0x92D7
+	MAX_COMBINED_ATOMIC_COUNTER_BUFFERS                                              = 0x92D1
+	MAX_COMBINED_CLIP_AND_CULL_DISTANCES                                             = 0x82FA
+	MAX_COMBINED_COMPUTE_UNIFORM_COMPONENTS                                          = 0x8266
+	MAX_COMBINED_DIMENSIONS                                                          = 0x8282
+	MAX_COMBINED_FRAGMENT_UNIFORM_COMPONENTS                                         = 0x8A33
+	MAX_COMBINED_GEOMETRY_UNIFORM_COMPONENTS                                         = 0x8A32
+	MAX_COMBINED_IMAGE_UNIFORMS                                                      = 0x90CF
+	MAX_COMBINED_IMAGE_UNITS_AND_FRAGMENT_OUTPUTS                                    = 0x8F39
+	MAX_COMBINED_SHADER_OUTPUT_RESOURCES                                             = 0x8F39
+	MAX_COMBINED_SHADER_STORAGE_BLOCKS                                               = 0x90DC
+	MAX_COMBINED_TESS_CONTROL_UNIFORM_COMPONENTS                                     = 0x8E1E
+	MAX_COMBINED_TESS_EVALUATION_UNIFORM_COMPONENTS                                  = 0x8E1F
+	MAX_COMBINED_TEXTURE_IMAGE_UNITS                                                 = 0x8B4D
+	MAX_COMBINED_UNIFORM_BLOCKS                                                      = 0x8A2E
+	MAX_COMBINED_VERTEX_UNIFORM_COMPONENTS                                           = 0x8A31
+	MAX_COMPUTE_ATOMIC_COUNTERS                                                      = 0x8265
+	MAX_COMPUTE_ATOMIC_COUNTER_BUFFERS                                               = 0x8264
+	MAX_COMPUTE_FIXED_GROUP_INVOCATIONS_ARB                                          = 0x90EB
+	MAX_COMPUTE_FIXED_GROUP_SIZE_ARB                                                 = 0x91BF
+	MAX_COMPUTE_IMAGE_UNIFORMS                                                       = 0x91BD
+	MAX_COMPUTE_SHADER_STORAGE_BLOCKS                                                = 0x90DB
+	MAX_COMPUTE_SHARED_MEMORY_SIZE                                                   = 0x8262
+	MAX_COMPUTE_TEXTURE_IMAGE_UNITS                                                  = 0x91BC
+	MAX_COMPUTE_UNIFORM_BLOCKS                                                       = 0x91BB
+	MAX_COMPUTE_UNIFORM_COMPONENTS                                                   = 0x8263
+	MAX_COMPUTE_VARIABLE_GROUP_INVOCATIONS_ARB                                       = 0x9344
+	MAX_COMPUTE_VARIABLE_GROUP_SIZE_ARB                                              = 0x9345
+	MAX_COMPUTE_WORK_GROUP_COUNT                                                     = 0x91BE
+	MAX_COMPUTE_WORK_GROUP_INVOCATIONS                                               = 0x90EB
+	MAX_COMPUTE_WORK_GROUP_SIZE                                                      = 0x91BF
+	MAX_CUBE_MAP_TEXTURE_SIZE                                                        = 0x851C
+	MAX_CULL_DISTANCES                                                               = 0x82F9
+	MAX_DEBUG_GROUP_STACK_DEPTH                                                      = 0x826C
+	MAX_DEBUG_GROUP_STACK_DEPTH_KHR                                                  = 0x826C
+	MAX_DEBUG_LOGGED_MESSAGES                                                        = 0x9144
+	MAX_DEBUG_LOGGED_MESSAGES_ARB                                                    = 0x9144
+	MAX_DEBUG_LOGGED_MESSAGES_KHR                                                    = 0x9144
+	MAX_DEBUG_MESSAGE_LENGTH                                                         = 0x9143
+	MAX_DEBUG_MESSAGE_LENGTH_ARB                                                     = 0x9143
+	MAX_DEBUG_MESSAGE_LENGTH_KHR                                                     = 0x9143
+	MAX_DEPTH                                                                        = 0x8280
+	MAX_DEPTH_TEXTURE_SAMPLES                                                        = 0x910F
+	MAX_DRAW_BUFFERS                                                                 = 0x8824
+	MAX_DUAL_SOURCE_DRAW_BUFFERS                                                     = 0x88FC
+	MAX_ELEMENTS_INDICES                                                             = 0x80E9
+	MAX_ELEMENTS_VERTICES                                                            = 0x80E8
+	MAX_ELEMENT_INDEX                                                                = 0x8D6B
+	MAX_EXT                                                                          = 0x8008
+	MAX_FRAGMENT_ATOMIC_COUNTERS                                                     = 0x92D6
+	MAX_FRAGMENT_ATOMIC_COUNTER_BUFFERS                                              = 0x92D0
+	MAX_FRAGMENT_IMAGE_UNIFORMS                                                      = 0x90CE
+	MAX_FRAGMENT_INPUT_COMPONENTS                                                    = 0x9125
+	MAX_FRAGMENT_INTERPOLATION_OFFSET                                                = 0x8E5C
+	MAX_FRAGMENT_SHADER_STORAGE_BLOCKS                                               = 0x90DA
+	MAX_FRAGMENT_UNIFORM_BLOCKS                                                      = 0x8A2D
+	MAX_FRAGMENT_UNIFORM_COMPONENTS                                                  = 0x8B49
+	MAX_FRAGMENT_UNIFORM_VECTORS                                                     = 0x8DFD
+	MAX_FRAMEBUFFER_HEIGHT                                                           = 0x9316
+	MAX_FRAMEBUFFER_LAYERS                                                           = 0x9317
+	MAX_FRAMEBUFFER_SAMPLES                                                          = 0x9318
+	MAX_FRAMEBUFFER_WIDTH                                                            = 0x9315
+	MAX_GEOMETRY_ATOMIC_COUNTERS                                                     = 0x92D5
+	MAX_GEOMETRY_ATOMIC_COUNTER_BUFFERS                                              = 0x92CF
+	MAX_GEOMETRY_IMAGE_UNIFORMS                                                      = 0x90CD
+	MAX_GEOMETRY_INPUT_COMPONENTS                                                    = 0x9123
+	MAX_GEOMETRY_OUTPUT_COMPONENTS                                                   = 0x9124
+	MAX_GEOMETRY_OUTPUT_VERTICES                                                     = 0x8DE0
+	MAX_GEOMETRY_SHADER_INVOCATIONS                                                  = 0x8E5A
+	MAX_GEOMETRY_SHADER_STORAGE_BLOCKS                                               = 0x90D7
+	MAX_GEOMETRY_TEXTURE_IMAGE_UNITS                                                 = 0x8C29
+	MAX_GEOMETRY_TOTAL_OUTPUT_COMPONENTS                                             = 0x8DE1
+	MAX_GEOMETRY_UNIFORM_BLOCKS                                                      = 0x8A2C
+	MAX_GEOMETRY_UNIFORM_COMPONENTS                                                  = 0x8DDF
+	MAX_HEIGHT                                                                       = 0x827F
+	MAX_IMAGE_SAMPLES                                                                = 0x906D
+	MAX_IMAGE_UNITS                                                                  = 0x8F38
+	MAX_INTEGER_SAMPLES                                                              = 0x9110
+	MAX_LABEL_LENGTH                                                                 = 0x82E8
+	MAX_LABEL_LENGTH_KHR                                                             = 0x82E8
+	MAX_LAYERS                                                                       = 0x8281
+	MAX_NAME_LENGTH                                                                  = 0x92F6
+	MAX_NUM_ACTIVE_VARIABLES                                                         = 0x92F7
+	MAX_NUM_COMPATIBLE_SUBROUTINES                                                   = 0x92F8
+	MAX_PATCH_VERTICES                                                               = 0x8E7D
+	MAX_PROGRAM_TEXEL_OFFSET                                                         = 0x8905
+	MAX_PROGRAM_TEXTURE_GATHER_COMPONENTS_ARB                                        = 0x8F9F
+	MAX_PROGRAM_TEXTURE_GATHER_OFFSET_ARB                                            = 0x8E5F
+	MAX_RECTANGLE_TEXTURE_SIZE                                                       = 0x84F8
+	MAX_RENDERBUFFER_SIZE                                                            = 0x84E8
+	MAX_SAMPLES                                                                      = 0x8D57
+	MAX_SAMPLE_MASK_WORDS                                                            = 0x8E59
+	MAX_SERVER_WAIT_TIMEOUT                                                          = 0x9111
+	MAX_SHADER_STORAGE_BLOCK_SIZE                                                    = 0x90DE
+	MAX_SHADER_STORAGE_BUFFER_BINDINGS                                               = 0x90DD
+	MAX_SPARSE_3D_TEXTURE_SIZE_ARB                                                   = 0x9199
+	MAX_SPARSE_ARRAY_TEXTURE_LAYERS_ARB                                              = 0x919A
+	MAX_SPARSE_TEXTURE_SIZE_ARB                                                      = 0x9198
+	MAX_SUBROUTINES                                                                  = 0x8DE7
+	MAX_SUBROUTINE_UNIFORM_LOCATIONS                                                 = 0x8DE8
+	MAX_TESS_CONTROL_ATOMIC_COUNTERS                                                 = 0x92D3
+	MAX_TESS_CONTROL_ATOMIC_COUNTER_BUFFERS                                          = 0x92CD
+	MAX_TESS_CONTROL_IMAGE_UNIFORMS                                                  = 0x90CB
+	MAX_TESS_CONTROL_INPUT_COMPONENTS                                                = 0x886C
+	MAX_TESS_CONTROL_OUTPUT_COMPONENTS                                               = 0x8E83
+	MAX_TESS_CONTROL_SHADER_STORAGE_BLOCKS                                           = 0x90D8
+	MAX_TESS_CONTROL_TEXTURE_IMAGE_UNITS                                             = 0x8E81
+	MAX_TESS_CONTROL_TOTAL_OUTPUT_COMPONENTS                                         = 0x8E85
+	MAX_TESS_CONTROL_UNIFORM_BLOCKS                                                  = 0x8E89
+	MAX_TESS_CONTROL_UNIFORM_COMPONENTS                                              = 0x8E7F
+	MAX_TESS_EVALUATION_ATOMIC_COUNTERS                                              = 0x92D4
+	MAX_TESS_EVALUATION_ATOMIC_COUNTER_BUFFERS                                       = 0x92CE
+	MAX_TESS_EVALUATION_IMAGE_UNIFORMS                                               = 0x90CC
+	MAX_TESS_EVALUATION_INPUT_COMPONENTS                                             = 0x886D
+	MAX_TESS_EVALUATION_OUTPUT_COMPONENTS                                            = 0x8E86
+	MAX_TESS_EVALUATION_SHADER_STORAGE_BLOCKS                                        = 0x90D9
+	MAX_TESS_EVALUATION_TEXTURE_IMAGE_UNITS                                          = 0x8E82
+	MAX_TESS_EVALUATION_UNIFORM_BLOCKS                                               = 0x8E8A
+	MAX_TESS_EVALUATION_UNIFORM_COMPONENTS                                           = 0x8E80
+	MAX_TESS_GEN_LEVEL                                                               = 0x8E7E
+	MAX_TESS_PATCH_COMPONENTS                                                        = 0x8E84
+	MAX_TEXTURE_BUFFER_SIZE                                                          = 0x8C2B
+	MAX_TEXTURE_IMAGE_UNITS                                                          = 0x8872
+	MAX_TEXTURE_LOD_BIAS                                                             = 0x84FD
+	MAX_TEXTURE_LOD_BIAS_EXT                                                         = 0x84FD
+	MAX_TEXTURE_MAX_ANISOTROPY                                                       = 0x84FF
+	MAX_TEXTURE_MAX_ANISOTROPY_EXT                                                   = 0x84FF
+	MAX_TEXTURE_SIZE                                                                 = 0x0D33
+	MAX_TRANSFORM_FEEDBACK_BUFFERS                                                   = 0x8E70
+	MAX_TRANSFORM_FEEDBACK_INTERLEAVED_COMPONENTS                                    = 0x8C8A
+	MAX_TRANSFORM_FEEDBACK_SEPARATE_ATTRIBS                                          = 0x8C8B
+	MAX_TRANSFORM_FEEDBACK_SEPARATE_COMPONENTS                                       = 0x8C80
+	MAX_UNIFORM_BLOCK_SIZE                                                           = 0x8A30
+	MAX_UNIFORM_BUFFER_BINDINGS                                                      = 0x8A2F
+	MAX_UNIFORM_LOCATIONS                                                            = 0x826E
+	MAX_VARYING_COMPONENTS                                                           = 0x8B4B
+	MAX_VARYING_FLOATS                                                               = 0x8B4B
+	MAX_VARYING_VECTORS                                                              = 0x8DFC
+	MAX_VERTEX_ATOMIC_COUNTERS                                                       = 0x92D2
+	MAX_VERTEX_ATOMIC_COUNTER_BUFFERS                                                = 0x92CC
+	MAX_VERTEX_ATTRIBS                                                               = 0x8869
+	MAX_VERTEX_ATTRIB_BINDINGS                                                       = 0x82DA
+	MAX_VERTEX_ATTRIB_RELATIVE_OFFSET                                                = 0x82D9
+	MAX_VERTEX_ATTRIB_STRIDE                                                         = 0x82E5
+	MAX_VERTEX_IMAGE_UNIFORMS                                                        = 0x90CA
+	MAX_VERTEX_OUTPUT_COMPONENTS                                                     = 0x9122
+	MAX_VERTEX_SHADER_STORAGE_BLOCKS                                                 = 0x90D6
+	MAX_VERTEX_STREAMS                                                               = 0x8E71
+	MAX_VERTEX_TEXTURE_IMAGE_UNITS                                                   = 0x8B4C
+	MAX_VERTEX_UNIFORM_BLOCKS                                                        = 0x8A2B
+	MAX_VERTEX_UNIFORM_COMPONENTS                                                    = 0x8B4A
+	MAX_VERTEX_UNIFORM_VECTORS                                                       = 0x8DFB
+	MAX_VIEWPORTS                                                                    = 0x825B
+	MAX_VIEWPORT_DIMS                                                                = 0x0D3A
+	MAX_WIDTH                                                                        = 0x827E
+	MEDIUM_FLOAT                                                                     = 0x8DF1
+	MEDIUM_INT                                                                       = 0x8DF4
+	MIN                                                        BlendEquation         = 0x8007
+	MINOR_VERSION                                                                    = 0x821C
+	MINUS_CLAMPED_NV                                                                 = 0x92B3
+	MINUS_NV                                                                         = 0x929F
+	MIN_EXT                                                                          = 0x8007
+	MIN_FRAGMENT_INTERPOLATION_OFFSET                                                = 0x8E5B
+	MIN_MAP_BUFFER_ALIGNMENT                                                         = 0x90BC
+	MIN_PROGRAM_TEXEL_OFFSET                                                         = 0x8904
+	MIN_PROGRAM_TEXTURE_GATHER_OFFSET_ARB                                            = 0x8E5E
+	MIN_SAMPLE_SHADING_VALUE_ARB                                                     = 0x8C37
+	MIN_SPARSE_LEVEL_ARB                                                             = 0x919B
+	MIPMAP                                                                           = 0x8293
+	MIRRORED_REPEAT                                                                  = 0x8370
+	MIRROR_CLAMP_TO_EDGE                                                             = 0x8743
+	MULTIPLY_KHR                                                                     = 0x9294
+	MULTIPLY_NV                                                                      = 0x9294
+	MULTISAMPLE                                                Capability            = 0x809D
+	NAMED_STRING_LENGTH_ARB                                                          = 0x8DE9
+	NAMED_STRING_TYPE_ARB                                                            = 0x8DEA
+	NAME_LENGTH                                                                      = 0x92F9
+	NAND                                                                             = 0x150E
+	NEAREST                                                                          = 0x2600
+	NEAREST_MIPMAP_LINEAR                                                            = 0x2702
+	NEAREST_MIPMAP_NEAREST                                                           = 0x2700
+	NEGATIVE_ONE_TO_ONE                                                              = 0x935E
+	NEVER                                                                            = 0x0200
+	NICEST                                                                           = 0x1102
+	NONE                                                                             = 0
+	NOOP                                                                             = 0x1505
+	NOR                                                                              = 0x1508
+	NOTEQUAL                                                                         = 0x0205
+	NO_ERROR                                                                         = 0
+	NO_RESET_NOTIFICATION                                                            = 0x8261
+	NO_RESET_NOTIFICATION_ARB                                                        = 0x8261
+	NO_RESET_NOTIFICATION_KHR                                                        = 0x8261
+	NUM_ACTIVE_VARIABLES                                                             = 0x9304
+	NUM_COMPATIBLE_SUBROUTINES                                                       = 0x8E4A
+	NUM_COMPRESSED_TEXTURE_FORMATS                                                   = 0x86A2
+	NUM_EXTENSIONS                                                                   = 0x821D
+	NUM_PROGRAM_BINARY_FORMATS                                                       = 0x87FE
+	NUM_SAMPLE_COUNTS                                                                = 0x9380
+	NUM_SHADER_BINARY_FORMATS                                                        = 0x8DF9
+	NUM_VIRTUAL_PAGE_SIZES_ARB                                                       = 0x91A8
+	OBJECT_TYPE                                                                      = 0x9112
+	OFFSET                                                                           = 0x92FC
+	ONE                                                                              = 1
+	ONE_MINUS_CONSTANT_ALPHA                                   BlendFactor           = 0x8004
+	ONE_MINUS_CONSTANT_COLOR                                   BlendFactor           = 0x8002
+	ONE_MINUS_DST_ALPHA                                        BlendFactor           = 0x0305
+	ONE_MINUS_DST_COLOR                                        BlendFactor           = 0x0307
+	ONE_MINUS_SRC1_ALPHA                                       BlendFactor           = 0x88FB
+	ONE_MINUS_SRC1_COLOR                                       BlendFactor           = 0x88FA
+	ONE_MINUS_SRC_ALPHA                                        BlendFactor           = 0x0303
+	ONE_MINUS_SRC_COLOR                                        BlendFactor           = 0x0301
+	OR                                                                               = 0x1507
+	OR_INVERTED                                                                      = 0x150D
+	OR_REVERSE                                                                       = 0x150B
+	OUT_OF_MEMORY                                                                    = 0x0505
+	OVERLAY_KHR                                                                      = 0x9296
+	OVERLAY_NV                                                                       = 0x9296
+	PACK_ALIGNMENT                                                                   = 0x0D05
+	PACK_COMPRESSED_BLOCK_DEPTH                                                      = 0x912D
+	PACK_COMPRESSED_BLOCK_HEIGHT                                                     = 0x912C
+	PACK_COMPRESSED_BLOCK_SIZE                                                       = 0x912E
+	PACK_COMPRESSED_BLOCK_WIDTH                                                      = 0x912B
+	PACK_IMAGE_HEIGHT                                                                = 0x806C
+	PACK_LSB_FIRST                                                                   = 0x0D01
+	PACK_ROW_LENGTH                                                                  = 0x0D02
+	PACK_SKIP_IMAGES                                                                 = 0x806B
+	PACK_SKIP_PIXELS                                                                 = 0x0D04
+	PACK_SKIP_ROWS                                                                   = 0x0D03
+	PACK_SWAP_BYTES                                                                  = 0x0D00
+	PALETTE4_R5_G6_B5_OES                                                            = 0x8B92
+	PALETTE4_RGB5_A1_OES                                                             = 0x8B94
+	PALETTE4_RGB8_OES                                                                = 0x8B90
+	PALETTE4_RGBA4_OES                                                               = 0x8B93
+	PALETTE4_RGBA8_OES                                                               = 0x8B91
+	PALETTE8_R5_G6_B5_OES                                                            = 0x8B97
+	PALETTE8_RGB5_A1_OES                                                             = 0x8B99
+	PALETTE8_RGB8_OES                                                                = 0x8B95
+	PALETTE8_RGBA4_OES                                                               = 0x8B98
+	PALETTE8_RGBA8_OES                                                               = 0x8B96
+	PARAMETER_BUFFER_ARB                                                             = 0x80EE
+	PARAMETER_BUFFER_BINDING_ARB                                                     = 0x80EF
+	PATCHES                                                    PrimitiveType         = 0x000E
+	PATCH_DEFAULT_INNER_LEVEL                                                        = 0x8E73
+	PATCH_DEFAULT_OUTER_LEVEL                                                        = 0x8E74
+	PATCH_VERTICES                                                                   = 0x8E72
+	PERCENTAGE_AMD                                                                   = 0x8BC3
+	PERFMON_RESULT_AMD                                                               = 0x8BC6
+	PERFMON_RESULT_AVAILABLE_AMD                                                     = 0x8BC4
+	PERFMON_RESULT_SIZE_AMD                                                          = 0x8BC5
+	PERFQUERY_COUNTER_DATA_BOOL32_INTEL                                              = 0x94FC
+	PERFQUERY_COUNTER_DATA_DOUBLE_INTEL                                              = 0x94FB
+	PERFQUERY_COUNTER_DATA_FLOAT_INTEL                                               = 0x94FA
+	PERFQUERY_COUNTER_DATA_UINT32_INTEL                                              = 0x94F8
+	PERFQUERY_COUNTER_DATA_UINT64_INTEL                                              = 0x94F9
+	PERFQUERY_COUNTER_DESC_LENGTH_MAX_INTEL                                          = 0x94FF
+	PERFQUERY_COUNTER_DURATION_NORM_INTEL                                            = 0x94F1
+	PERFQUERY_COUNTER_DURATION_RAW_INTEL                                             = 0x94F2
+	PERFQUERY_COUNTER_EVENT_INTEL                                                    = 0x94F0
+	PERFQUERY_COUNTER_NAME_LENGTH_MAX_INTEL                                          = 0x94FE
+	PERFQUERY_COUNTER_RAW_INTEL                                                      = 0x94F4
+	PERFQUERY_COUNTER_THROUGHPUT_INTEL                                               = 0x94F3
+	PERFQUERY_COUNTER_TIMESTAMP_INTEL                                                = 0x94F5
+	PERFQUERY_DONOT_FLUSH_INTEL                                                      = 0x83F9
+	PERFQUERY_FLUSH_INTEL                                                            = 0x83FA
+	PERFQUERY_GLOBAL_CONTEXT_INTEL                                                   = 0x00000001
+	PERFQUERY_GPA_EXTENDED_COUNTERS_INTEL                                            = 0x9500
+	PERFQUERY_QUERY_NAME_LENGTH_MAX_INTEL                                            = 0x94FD
+	PERFQUERY_SINGLE_CONTEXT_INTEL                                                   = 0x00000000
+	PERFQUERY_WAIT_INTEL                                                             = 0x83FB
+	PINLIGHT_NV                                                                      = 0x92A8
+	PIXEL_BUFFER_BARRIER_BIT                                                         = 0x00000080
+	PIXEL_PACK_BUFFER                                          BufferTarget          = 0x88EB
+	PIXEL_PACK_BUFFER_BINDING                                                        = 0x88ED
+	PIXEL_UNPACK_BUFFER                                        BufferTarget          = 0x88EC
+	PIXEL_UNPACK_BUFFER_BINDING                                                      = 0x88EF
+	PLUS_CLAMPED_ALPHA_NV                                                            = 0x92B2
+	PLUS_CLAMPED_NV                                                                  = 0x92B1
+	PLUS_DARKER_NV                                                                   = 0x9292
+	PLUS_NV                                                                          = 0x9291
+	POINT                                                      PolygonMode           = 0x1B00
+	POINTS                                                     PrimitiveType         = 0x0000
+	POINT_FADE_THRESHOLD_SIZE                                                        = 0x8128
+	POINT_SIZE                                                                       = 0x0B11
+	POINT_SIZE_GRANULARITY                                                           = 0x0B13
+	POINT_SIZE_RANGE                                                                 = 0x0B12
+	POINT_SPRITE_COORD_ORIGIN                                                        = 0x8CA0
+	POLYGON_MODE                                                                     = 0x0B40
+	POLYGON_OFFSET_FACTOR                                                            = 0x8038
+	POLYGON_OFFSET_FILL                                        Capability            = 0x8037
+	POLYGON_OFFSET_LINE                                        Capability            = 0x2A02
+	POLYGON_OFFSET_POINT                                       Capability            = 0x2A01
+	POLYGON_OFFSET_UNITS                                                             = 0x2A00
+	POLYGON_SMOOTH                                             Capability            = 0x0B41
+	POLYGON_SMOOTH_HINT                                                              = 0x0C53
+	PRIMITIVES_GENERATED                                                             = 0x8C87
+	PRIMITIVES_SUBMITTED_ARB                                                         = 0x82EF
+	PRIMITIVE_RESTART                                          Capability            = 0x8F9D
+	PRIMITIVE_RESTART_FIXED_INDEX                              Capability            = 0x8D69
+	PRIMITIVE_RESTART_INDEX                                                          = 0x8F9E
+	PROGRAM                                                                          = 0x82E2
+	PROGRAM_BINARY_FORMATS                                                           = 0x87FF
+	PROGRAM_BINARY_LENGTH                                                            = 0x8741
+	PROGRAM_BINARY_RETRIEVABLE_HINT                                                  = 0x8257
+	PROGRAM_INPUT                                                                    = 0x92E3
+	PROGRAM_KHR                                                                      = 0x82E2
+	PROGRAM_OBJECT_EXT                                                               = 0x8B40
+	PROGRAM_OUTPUT                                                                   = 0x92E4
+	PROGRAM_PIPELINE                                                                 = 0x82E4
+	PROGRAM_PIPELINE_BINDING                                                         = 0x825A
+	PROGRAM_PIPELINE_BINDING_EXT                                                     = 0x825A
+	PROGRAM_PIPELINE_OBJECT_EXT                                                      = 0x8A4F
+	PROGRAM_POINT_SIZE                                         Capability            = 0x8642
+	PROGRAM_SEPARABLE                                                                = 0x8258
+	PROGRAM_SEPARABLE_EXT                                                            = 0x8258
+	PROVOKING_VERTEX                                                                 = 0x8E4F
+	PROXY_TEXTURE_1D                                           TextureTarget         = 0x8063
+	PROXY_TEXTURE_1D_ARRAY                                     TextureTarget         = 0x8C19
+	PROXY_TEXTURE_2D                                           TextureTarget         = 0x8064
+	PROXY_TEXTURE_2D_ARRAY                                     TextureTarget         = 0x8C1B
+	PROXY_TEXTURE_2D_MULTISAMPLE                               TextureTarget         = 0x9101
+	PROXY_TEXTURE_2D_MULTISAMPLE_ARRAY                         TextureTarget         = 0x9103
+	PROXY_TEXTURE_3D                                           TextureTarget         = 0x8070
+	PROXY_TEXTURE_CUBE_MAP                                     TextureTarget         = 0x851B
+	PROXY_TEXTURE_CUBE_MAP_ARRAY_ARB                                                 = 0x900B
+	PROXY_TEXTURE_RECTANGLE                                    TextureTarget         = 0x84F7
+	QUADS                                                      PrimitiveType         = 0x0007
+	QUADS_FOLLOW_PROVOKING_VERTEX_CONVENTION                                         = 0x8E4C
+	QUERY                                                                            = 0x82E3
+	QUERY_BUFFER                                               BufferTarget          = 0x9192
+	QUERY_BUFFER_BARRIER_BIT                                                         = 0x00008000
+	QUERY_BUFFER_BINDING                                                             = 0x9193
+	QUERY_BY_REGION_NO_WAIT                                                          = 0x8E16
+	QUERY_BY_REGION_NO_WAIT_INVERTED                                                 = 0x8E1A
+	QUERY_BY_REGION_WAIT                                                             = 0x8E15
+	QUERY_BY_REGION_WAIT_INVERTED                                                    = 0x8E19
+	QUERY_COUNTER_BITS                                                               = 0x8864
+	QUERY_KHR                                                                        = 0x82E3
+	QUERY_NO_WAIT                                                                    = 0x8E14
+	QUERY_NO_WAIT_INVERTED                                                           = 0x8E18
+	QUERY_OBJECT_EXT                                                                 = 0x9153
+	QUERY_RESULT                                                                     = 0x8866
+	QUERY_RESULT_AVAILABLE                                                           = 0x8867
+	QUERY_RESULT_NO_WAIT                                                             = 0x9194
+	QUERY_TARGET                                                                     = 0x82EA
+	QUERY_WAIT                                                                       = 0x8E13
+	QUERY_WAIT_INVERTED                                                              = 0x8E17
+	R11F_G11F_B10F                                             InternalFormat        = 0x8C3A
+	R16                                                        InternalFormat        = 0x822A
+	R16F                                                       InternalFormat        = 0x822D
+	R16I                                                       InternalFormat        = 0x8233
+	R16UI                                                      InternalFormat        = 0x8234
+	R16_SNORM                                                  InternalFormat        = 0x8F98
+	R32F                                                       InternalFormat        = 0x822E
+	R32I                                                       InternalFormat        = 0x8235
+	R32UI                                                      InternalFormat        = 0x8236
+	R3_G3_B2                                                   InternalFormat        = 0x2A10
+	R8                                                         InternalFormat        = 0x8229
+	R8I                                                        InternalFormat        = 0x8231
+	R8UI                                                       InternalFormat        = 0x8232
+	R8_SNORM                                                   InternalFormat        = 0x8F94
+	RASTERIZER_DISCARD                                         Capability            = 0x8C89
+	READ_BUFFER                                                                      = 0x0C02
+	READ_FRAMEBUFFER                                           FramebufferTarget     = 0x8CA8
+	READ_FRAMEBUFFER_BINDING                                                         = 0x8CAA
+	READ_ONLY                                                                        = 0x88B8
+	READ_PIXELS                                                                      = 0x828C
+	READ_PIXELS_FORMAT                                                               = 0x828D
+	READ_PIXELS_TYPE                                                                 = 0x828E
+	READ_WRITE                                                                       = 0x88BA
+	RED                                                                              = 0x1903
+	RED_INTEGER                                                PixelFormat           = 0x8D94
+	RED_NV                                                                           = 0x1903
+	REFERENCED_BY_COMPUTE_SHADER                                                     = 0x930B
+	REFERENCED_BY_FRAGMENT_SHADER                                                    = 0x930A
+	REFERENCED_BY_GEOMETRY_SHADER                                                    = 0x9309
+	REFERENCED_BY_TESS_CONTROL_SHADER                                                = 0x9307
+	REFERENCED_BY_TESS_EVALUATION_SHADER                                             = 0x9308
+	REFERENCED_BY_VERTEX_SHADER                                                      = 0x9306
+	RENDERBUFFER                                                                     = 0x8D41
+	RENDERBUFFER_ALPHA_SIZE                                                          = 0x8D53
+	RENDERBUFFER_BINDING                                                             = 0x8CA7
+	RENDERBUFFER_BLUE_SIZE                                                           = 0x8D52
+	RENDERBUFFER_DEPTH_SIZE                                                          = 0x8D54
+	RENDERBUFFER_GREEN_SIZE                                                          = 0x8D51
+	RENDERBUFFER_HEIGHT                                                              = 0x8D43
+	RENDERBUFFER_INTERNAL_FORMAT                                                     = 0x8D44
+	RENDERBUFFER_RED_SIZE                                                            = 0x8D50
+	RENDERBUFFER_SAMPLES                                                             = 0x8CAB
+	RENDERBUFFER_STENCIL_SIZE                                                        = 0x8D55
+	RENDERBUFFER_WIDTH                                                               = 0x8D42
+	RENDERER                                                                         = 0x1F01
+	REPEAT                                                                           = 0x2901
+	REPLACE                                                    StencilOp             = 0x1E01
+	RESET_NOTIFICATION_STRATEGY                                                      = 0x8256
+	RESET_NOTIFICATION_STRATEGY_ARB                                                  = 0x8256
+	RESET_NOTIFICATION_STRATEGY_KHR                                                  = 0x8256
+	RG                                                                               = 0x8227
+	RG16                                                       InternalFormat        = 0x822C
+	RG16F                                                      InternalFormat        = 0x822F
+	RG16I                                                      InternalFormat        = 0x8239
+	RG16UI                                                     InternalFormat        = 0x823A
+	RG16_SNORM                                                 InternalFormat        = 0x8F99
+	RG32F                                                      InternalFormat        = 0x8230
+	RG32I                                                      InternalFormat        = 0x823B
+	RG32UI                                                     InternalFormat        = 0x823C
+	RG8                                                        InternalFormat        = 0x822B
+	RG8I                                                       InternalFormat        = 0x8237
+	RG8UI                                                      InternalFormat        = 0x8238
+	RG8_SNORM                                                  InternalFormat        = 0x8F95
+	RGB                                                                              = 0x1907
+	RGB10                                                      InternalFormat        = 0x8052
+	RGB10_A2                                                   InternalFormat        = 0x8059
+	RGB10_A2UI                                                 InternalFormat        = 0x906F
+	RGB12                                                      InternalFormat        = 0x8053
+	RGB16                                                      InternalFormat        = 0x8054
+	RGB16F                                                     InternalFormat        = 0x881B
+	RGB16I                                                     InternalFormat        = 0x8D89
+	RGB16UI                                                    InternalFormat        = 0x8D77
+	RGB16_SNORM                                                InternalFormat        = 0x8F9A
+	RGB32F                                                     InternalFormat        = 0x8815
+	RGB32I                                                     InternalFormat        = 0x8D83
+	RGB32UI                                                    InternalFormat        = 0x8D71
+	RGB4                                                       InternalFormat        = 0x804F
+	RGB5                                                       InternalFormat        = 0x8050
+	RGB565                                                     InternalFormat        = 0x8D62
+	RGB5_A1                                                    InternalFormat        = 0x8057
+	RGB8                                                       InternalFormat        = 0x8051
+	RGB8I                                                      InternalFormat        = 0x8D8F
+	RGB8UI                                                     InternalFormat        = 0x8D7D
+	RGB8_SNORM                                                 InternalFormat        = 0x8F96
+	RGB9_E5                                                    InternalFormat        = 0x8C3D
+	RGBA                                                                             = 0x1908
+	RGBA12                                                     InternalFormat        = 0x805A
+	RGBA16                                                     InternalFormat        = 0x805B
+	RGBA16F                                                    InternalFormat        = 0x881A
+	RGBA16I                                                    InternalFormat        = 0x8D88
+	RGBA16UI                                                   InternalFormat        = 0x8D76
+	RGBA16_SNORM                                               InternalFormat        = 0x8F9B
+	RGBA2                                                      InternalFormat        = 0x8055
+	RGBA32F                                                    InternalFormat        = 0x8814
+	RGBA32I                                                    InternalFormat        = 0x8D82
+	RGBA32UI                                                   InternalFormat        = 0x8D70
+	RGBA4                                                      InternalFormat        = 0x8056
+	RGBA8                                                      InternalFormat        = 0x8058
+	RGBA8I                                                     InternalFormat        = 0x8D8E
+	RGBA8UI                                                    InternalFormat        = 0x8D7C
+	RGBA8_SNORM                                                InternalFormat        = 0x8F97
+	RGBA_INTEGER                                               PixelFormat           = 0x8D99
+	RGB_422_APPLE                                                                    = 0x8A1F
+	RGB_INTEGER                                                PixelFormat           = 0x8D98
+	RGB_RAW_422_APPLE                                                                = 0x8A51
+	RG_INTEGER                                                 PixelFormat           = 0x8228
+	RIGHT                                                      ColorBuffer           = 0x0407
+	SAMPLER                                                                          = 0x82E6
+	SAMPLER_1D                                                                       = 0x8B5D
+	SAMPLER_1D_ARRAY                                                                 = 0x8DC0
+	SAMPLER_1D_ARRAY_SHADOW                                                          = 0x8DC3
+	SAMPLER_1D_SHADOW                                                                = 0x8B61
+	SAMPLER_2D                                                                       = 0x8B5E
+	SAMPLER_2D_ARRAY                                                                 = 0x8DC1
+	SAMPLER_2D_ARRAY_SHADOW                                                          = 0x8DC4
+	SAMPLER_2D_MULTISAMPLE                                                           = 0x9108
+	SAMPLER_2D_MULTISAMPLE_ARRAY                                                     = 0x910B
+	SAMPLER_2D_RECT                                                                  = 0x8B63
+	SAMPLER_2D_RECT_SHADOW                                                           = 0x8B64
+	SAMPLER_2D_SHADOW                                                                = 0x8B62
+	SAMPLER_3D                                                                       = 0x8B5F
+	SAMPLER_BINDING                                                                  = 0x8919
+	SAMPLER_BUFFER                                                                   = 0x8DC2
+	SAMPLER_CUBE                                                                     = 0x8B60
+	SAMPLER_CUBE_MAP_ARRAY_ARB                                                       = 0x900C
+	SAMPLER_CUBE_MAP_ARRAY_SHADOW_ARB                                                = 0x900D
+	SAMPLER_CUBE_SHADOW                                                              = 0x8DC5
+	SAMPLER_KHR                                                                      = 0x82E6
+	SAMPLES                                                                          = 0x80A9
+	SAMPLES_PASSED                                                                   = 0x8914
+	SAMPLE_ALPHA_TO_COVERAGE                                   Capability            = 0x809E
+	SAMPLE_ALPHA_TO_ONE                                        Capability            = 0x809F
+	SAMPLE_BUFFERS                                                                   = 0x80A8
+	SAMPLE_COVERAGE                                            Capability            = 0x80A0
+	SAMPLE_COVERAGE_INVERT                                                           = 0x80AB
+	SAMPLE_COVERAGE_VALUE                                                            = 0x80AA
+	SAMPLE_MASK                                                Capability            = 0x8E51
+	SAMPLE_MASK_VALUE                                                                = 0x8E52
+	SAMPLE_POSITION                                                                  = 0x8E50
+	SAMPLE_SHADING_ARB                                                               = 0x8C36
+	SCISSOR_BOX                                                                      = 0x0C10
+	SCISSOR_TEST                                               Capability            = 0x0C11
+	SCREEN_KHR                                                                       = 0x9295
+	SCREEN_NV                                                                        = 0x9295
+	SEPARATE_ATTRIBS                                                                 = 0x8C8D
+	SET                                                                              = 0x150F
+	SHADER                                                                           = 0x82E1
+	SHADER_BINARY_FORMATS                                                            = 0x8DF8
+	SHADER_COMPILER                                                                  = 0x8DFA
+	SHADER_IMAGE_ACCESS_BARRIER_BIT                                                  = 0x00000020
+	SHADER_IMAGE_ATOMIC                                                              = 0x82A6
+	SHADER_IMAGE_LOAD                                                                = 0x82A4
+	SHADER_IMAGE_STORE                                                               = 0x82A5
+	SHADER_INCLUDE_ARB                                                               = 0x8DAE
+	SHADER_KHR                                                                       = 0x82E1
+	SHADER_OBJECT_EXT                                                                = 0x8B48
+	SHADER_SOURCE_LENGTH                                                             = 0x8B88
+	SHADER_STORAGE_BARRIER_BIT                                                       = 0x00002000
+	SHADER_STORAGE_BLOCK                                                             = 0x92E6
+	SHADER_STORAGE_BUFFER                                      BufferTarget          = 0x90D2
+	SHADER_STORAGE_BUFFER_BINDING                                                    = 0x90D3
+	SHADER_STORAGE_BUFFER_OFFSET_ALIGNMENT                                           = 0x90DF
+	SHADER_STORAGE_BUFFER_SIZE                                                       = 0x90D5
+	SHADER_STORAGE_BUFFER_START                                                      = 0x90D4
+	SHADER_TYPE                                                                      = 0x8B4F
+	SHADING_LANGUAGE_VERSION                                                         = 0x8B8C
+	SHORT                                                                            = 0x1402
+	SIGNALED                                                                         = 0x9119
+	SIGNED_NORMALIZED                                                                = 0x8F9C
+	SIMULTANEOUS_TEXTURE_AND_DEPTH_TEST                                              = 0x82AC
+	SIMULTANEOUS_TEXTURE_AND_DEPTH_WRITE                                             = 0x82AE
+	SIMULTANEOUS_TEXTURE_AND_STENCIL_TEST                                            = 0x82AD
+	SIMULTANEOUS_TEXTURE_AND_STENCIL_WRITE                                           = 0x82AF
+	SKIP_DECODE_EXT                                                                  = 0x8A4A
+	SMOOTH_LINE_WIDTH_GRANULARITY                                                    = 0x0B23
+	SMOOTH_LINE_WIDTH_RANGE                                                          = 0x0B22
+	SMOOTH_POINT_SIZE_GRANULARITY                                                    = 0x0B13
+	SMOOTH_POINT_SIZE_RANGE                                                          = 0x0B12
+	SOFTLIGHT_KHR                                                                    = 0x929C
+	SOFTLIGHT_NV                                                                     = 0x929C
+	SPARSE_BUFFER_PAGE_SIZE_ARB                                                      = 0x82F8
+	SPARSE_STORAGE_BIT_ARB                                                           = 0x0400
+	SPARSE_TEXTURE_FULL_ARRAY_CUBE_MIPMAPS_ARB                                       = 0x91A9
+	SRC1_ALPHA                                                 BlendFactor           = 0x8589
+	SRC1_COLOR                                                 BlendFactor           = 0x88F9
+	SRC_ALPHA                                                  BlendFactor           = 0x0302
+	SRC_ALPHA_SATURATE                                         BlendFactor           = 0x0308
+	SRC_ATOP_NV                                                                      = 0x928E
+	SRC_COLOR                                                  BlendFactor           = 0x0300
+	SRC_IN_NV                                                                        = 0x928A
+	SRC_NV                                                                           = 0x9286
+	SRC_OUT_NV                                                                       = 0x928C
+	SRC_OVER_NV                                                                      = 0x9288
+	SRGB                                                       InternalFormat        = 0x8C40
+	SRGB8                                                      InternalFormat        = 0x8C41
+	SRGB8_ALPHA8                                               InternalFormat        = 0x8C43
+	SRGB_ALPHA                                                 InternalFormat        = 0x8C42
+	SRGB_DECODE_ARB                                                                  = 0x8299
+	SRGB_READ                                                                        = 0x8297
+	SRGB_WRITE                                                                       = 0x8298
+	STACK_OVERFLOW                                                                   = 0x0503
+	STACK_OVERFLOW_KHR                                                               = 0x0503
+	STACK_UNDERFLOW                                                                  = 0x0504
+	STACK_UNDERFLOW_KHR                                                              = 0x0504
+	STATIC_COPY                                                BufferUsage           = 0x88E6
+	STATIC_DRAW                                                BufferUsage           = 0x88E4
+	STATIC_READ                                                BufferUsage           = 0x88E5
+	STENCIL                                                                          = 0x1802
+	STENCIL_ATTACHMENT                                         FramebufferAttachment = 0x8D20
+	STENCIL_BACK_FAIL                                                                = 0x8801
+	STENCIL_BACK_FUNC                                                                = 0x8800
+	STENCIL_BACK_PASS_DEPTH_FAIL                                                     = 0x8802
+	STENCIL_BACK_PASS_DEPTH_PASS                                                     = 0x8803
+	STENCIL_BACK_REF                                                                 = 0x8CA3
+	STENCIL_BACK_VALUE_MASK                                                          = 0x8CA4
+	STENCIL_BACK_WRITEMASK                                                           = 0x8CA5
+	STENCIL_BUFFER_BIT                                                               = 0x00000400
+	STENCIL_CLEAR_VALUE                                                              = 0x0B91
+	STENCIL_COMPONENTS                                                               = 0x8285
+	STENCIL_FAIL                                                                     = 0x0B94
+	STENCIL_FUNC                                                                     = 0x0B92
+	STENCIL_INDEX                                                                    = 0x1901
+	STENCIL_INDEX1                                             InternalFormat        = 0x8D46
+	STENCIL_INDEX16                                            InternalFormat        = 0x8D49
+	STENCIL_INDEX4                                             InternalFormat        = 0x8D47
+	STENCIL_INDEX8                                             InternalFormat        = 0x8D48
+	STENCIL_PASS_DEPTH_FAIL                                                          = 0x0B95
+	STENCIL_PASS_DEPTH_PASS                                                          = 0x0B96
+	STENCIL_REF                                                                      = 0x0B97
+	STENCIL_RENDERABLE                                                               = 0x8288
+	STENCIL_TEST                                               Capability            = 0x0B90
+	STENCIL_VALUE_MASK                                                               = 0x0B93
+	STENCIL_WRITEMASK                                                                = 0x0B98
+	STEREO                                                                           = 0x0C33
+	STREAM_COPY                                                BufferUsage           = 0x88E2
+	STREAM_DRAW                                                BufferUsage           = 0x88E0
+	STREAM_READ                                                BufferUsage           = 0x88E1
+	SUBPIXEL_BITS                                                                    = 0x0D50
+	SYNC_CL_EVENT_ARB                                                                = 0x8240
+	SYNC_CL_EVENT_COMPLETE_ARB                                                       = 0x8241
+	SYNC_CONDITION                                                                   = 0x9113
+	SYNC_FENCE                                                                       = 0x9116
+	SYNC_FLAGS                                                                       = 0x9115
+	SYNC_FLUSH_COMMANDS_BIT                                                          = 0x00000001
+	SYNC_GPU_COMMANDS_COMPLETE                                                       = 0x9117
+	SYNC_STATUS                                                                      = 0x9114
+	TESS_CONTROL_OUTPUT_VERTICES                                                     = 0x8E75
+	TESS_CONTROL_SHADER                                        ShaderType            = 0x8E88
+	TESS_CONTROL_SHADER_BIT                                                          = 0x00000008
+	TESS_CONTROL_SHADER_PATCHES_ARB                                                  = 0x82F1
+	TESS_CONTROL_SUBROUTINE                                                          = 0x92E9
+	TESS_CONTROL_SUBROUTINE_UNIFORM                                                  = 0x92EF
+	TESS_CONTROL_TEXTURE                                                             = 0x829C
+	TESS_EVALUATION_SHADER                                     ShaderType            = 0x8E87
+	TESS_EVALUATION_SHADER_BIT                                                       = 0x00000010
+	TESS_EVALUATION_SHADER_INVOCATIONS_ARB                                           = 0x82F2
+	TESS_EVALUATION_SUBROUTINE                                                       = 0x92EA
+	TESS_EVALUATION_SUBROUTINE_UNIFORM                                               = 0x92F0
+	TESS_EVALUATION_TEXTURE                                                          = 0x829D
+	TESS_GEN_MODE                                                                    = 0x8E76
+	TESS_GEN_POINT_MODE                                                              = 0x8E79
+	TESS_GEN_SPACING                                                                 = 0x8E77
+	TESS_GEN_VERTEX_ORDER                                                            = 0x8E78
+	TEXTURE                                                                          = 0x1702
+	TEXTURE0                                                                         = 0x84C0
+	TEXTURE1                                                                         = 0x84C1
+	TEXTURE10                                                                        = 0x84CA
+	TEXTURE11                                                                        = 0x84CB
+	TEXTURE12                                                                        = 0x84CC
+	TEXTURE13                                                                        = 0x84CD
+	TEXTURE14                                                                        = 0x84CE
+	TEXTURE15                                                                        = 0x84CF
+	TEXTURE16                                                                        = 0x84D0
+	TEXTURE17                                                                        = 0x84D1
+	TEXTURE18                                                                        = 0x84D2
+	TEXTURE19                                                                        = 0x84D3
+	TEXTURE2                                                                         = 0x84C2
+	TEXTURE20                                                                        = 0x84D4
+	TEXTURE21                                                                        = 0x84D5
+	TEXTURE22                                                                        = 0x84D6
+	TEXTURE23                                                                        = 0x84D7
+	TEXTURE24                                                                        = 0x84D8
+	TEXTURE25                                                                        = 0x84D9
+	TEXTURE26                                                                        = 0x84DA
+	TEXTURE27                                                                        = 0x84DB
+	TEXTURE28                                                                        = 0x84DC
+	TEXTURE29                                                                        = 0x84DD
+	TEXTURE3                                                                         = 0x84C3
+	TEXTURE30                                                                        = 0x84DE
+	TEXTURE31                                                                        = 0x84DF
+	TEXTURE4                                                                         = 0x84C4
+	TEXTURE5                                                                         = 0x84C5
+	TEXTURE6                                                                         = 0x84C6
+	TEXTURE7                                                                         = 0x84C7
+	TEXTURE8                                                                         = 0x84C8
+	TEXTURE9                                                                         = 0x84C9
+	TEXTURE_1D                                                 TextureTarget         = 0x0DE0
+	TEXTURE_1D_ARRAY                                           TextureTarget         = 0x8C18
+	TEXTURE_2D                                                 TextureTarget         = 0x0DE1
+	TEXTURE_2D_ARRAY                                           TextureTarget         = 0x8C1A
+	TEXTURE_2D_MULTISAMPLE                                     TextureTarget         = 0x9100
+	TEXTURE_2D_MULTISAMPLE_ARRAY                               TextureTarget         = 0x9102
+	TEXTURE_3D                                                 TextureTarget         = 0x806F
+	TEXTURE_ALPHA_SIZE                                         TextureParameter      = 0x805F
+	TEXTURE_ALPHA_TYPE                                                               = 0x8C13
+	TEXTURE_BASE_LEVEL                                         TextureParameter      = 0x813C
+	TEXTURE_BINDING                                                                  = 0x82EB
+	TEXTURE_BINDING_1D                                                               = 0x8068
+	TEXTURE_BINDING_1D_ARRAY                                                         = 0x8C1C
+	TEXTURE_BINDING_2D                                                               = 0x8069
+	TEXTURE_BINDING_2D_ARRAY                                                         = 0x8C1D
+	TEXTURE_BINDING_2D_MULTISAMPLE                                                   = 0x9104
+	TEXTURE_BINDING_2D_MULTISAMPLE_ARRAY                                             = 0x9105
+	TEXTURE_BINDING_3D                                                               = 0x806A
+	TEXTURE_BINDING_BUFFER                                                           = 0x8C2C
+	TEXTURE_BINDING_CUBE_MAP                                                         = 0x8514
+	TEXTURE_BINDING_CUBE_MAP_ARRAY                                                   = 0x900A
+	TEXTURE_BINDING_CUBE_MAP_ARRAY_ARB                                               = 0x900A
+	TEXTURE_BINDING_RECTANGLE                                                        = 0x84F6
+	TEXTURE_BLUE_SIZE                                          TextureParameter      = 0x805E
+	TEXTURE_BLUE_TYPE                                                                = 0x8C12
+	TEXTURE_BORDER_COLOR                                       TextureParameter      = 0x1004
+	TEXTURE_BUFFER                                                                   = 0x8C2A
+	TEXTURE_BUFFER_DATA_STORE_BINDING                                                = 0x8C2D
+	TEXTURE_BUFFER_OFFSET                                                            = 0x919D
+	TEXTURE_BUFFER_OFFSET_ALIGNMENT                                                  = 0x919F
+	TEXTURE_BUFFER_SIZE                                                              = 0x919E
+	TEXTURE_COMPARE_FUNC                                       TextureParameter      = 0x884D
+	TEXTURE_COMPARE_MODE                                       TextureParameter      = 0x884C
+	TEXTURE_COMPRESSED                                                               = 0x86A1
+	TEXTURE_COMPRESSED_BLOCK_HEIGHT                                                  = 0x82B2
+	TEXTURE_COMPRESSED_BLOCK_SIZE                                                    = 0x82B3
+	TEXTURE_COMPRESSED_BLOCK_WIDTH                                                   = 0x82B1
+	TEXTURE_COMPRESSED_IMAGE_SIZE                                                    = 0x86A0
+	TEXTURE_COMPRESSION_HINT                                                         = 0x84EF
+	TEXTURE_CUBE_MAP                                           TextureTarget         = 0x8513
+	TEXTURE_CUBE_MAP_ARRAY                                     TextureTarget         = 0x9009
+	TEXTURE_CUBE_MAP_ARRAY_ARB                                                       = 0x9009
+	TEXTURE_CUBE_MAP_NEGATIVE_X                                TextureTarget         = 0x8516
+	TEXTURE_CUBE_MAP_NEGATIVE_Y                                TextureTarget         = 0x8518
+	TEXTURE_CUBE_MAP_NEGATIVE_Z                                TextureTarget         = 0x851A
+	TEXTURE_CUBE_MAP_POSITIVE_X                                TextureTarget         = 0x8515
+	TEXTURE_CUBE_MAP_POSITIVE_Y                                TextureTarget         = 0x8517
+	TEXTURE_CUBE_MAP_POSITIVE_Z                                TextureTarget         = 0x8519
+	TEXTURE_CUBE_MAP_SEAMLESS                                  Capability            = 0x884F
+	TEXTURE_DEPTH                                              TextureParameter      = 0x8071
+	TEXTURE_DEPTH_SIZE                                                               = 0x884A
+	TEXTURE_DEPTH_TYPE                                                               = 0x8C16
+	TEXTURE_FETCH_BARRIER_BIT                                                        = 0x00000008
+	TEXTURE_FILTER_CONTROL_EXT                                                       = 0x8500
+	TEXTURE_FIXED_SAMPLE_LOCATIONS                                                   = 0x9107
+	TEXTURE_GATHER                                                                   = 0x82A2
+	TEXTURE_GATHER_SHADOW                                                            = 0x82A3
+	TEXTURE_GREEN_SIZE                                         TextureParameter      = 0x805D
+	TEXTURE_GREEN_TYPE                                                               = 0x8C11
+	TEXTURE_HEIGHT                                             TextureParameter      = 0x1001
+	TEXTURE_IMAGE_FORMAT                                                             = 0x828F
+	TEXTURE_IMAGE_TYPE                                                               = 0x8290
+	TEXTURE_IMMUTABLE_FORMAT                                                         = 0x912F
+	TEXTURE_IMMUTABLE_LEVELS                                                         = 0x82DF
+	TEXTURE_INTERNAL_FORMAT                                    TextureParameter      = 0x1003
+	TEXTURE_LOD_BIAS                                           TextureParameter      = 0x8501
+	TEXTURE_LOD_BIAS_EXT                                                             = 0x8501
+	TEXTURE_MAG_FILTER                                         TextureParameter      = 0x2800
+	TEXTURE_MAX_ANISOTROPY_EXT                                                       = 0x84FE
+	TEXTURE_MAX_LEVEL                                          TextureParameter      = 0x813D
+	TEXTURE_MAX_LOD                                            TextureParameter      = 0x813B
+	TEXTURE_MIN_FILTER                                         TextureParameter      = 0x2801
+	TEXTURE_MIN_LOD                                            TextureParameter      = 0x813A
+	TEXTURE_RECTANGLE                                          TextureTarget         = 0x84F5
+	TEXTURE_RED_SIZE                                           TextureParameter      = 0x805C
+	TEXTURE_RED_TYPE                                                                 = 0x8C10
+	TEXTURE_SAMPLES                                                                  = 0x9106
+	TEXTURE_SHADOW                                                                   = 0x82A1
+	TEXTURE_SHARED_SIZE                                                              = 0x8C3F
+	TEXTURE_SPARSE_ARB                                                               = 0x91A6
+	TEXTURE_SRGB_DECODE_EXT                                                          = 0x8A48
+	TEXTURE_STENCIL_SIZE                                                             = 0x88F1
+	TEXTURE_SWIZZLE_A                                          TextureParameter      = 0x8E45
+	TEXTURE_SWIZZLE_B                                          TextureParameter      = 0x8E44
+	TEXTURE_SWIZZLE_G                                          TextureParameter      = 0x8E43
+	TEXTURE_SWIZZLE_R                                          TextureParameter      = 0x8E42
+	TEXTURE_SWIZZLE_RGBA                                       TextureParameter      = 0x8E46
+	TEXTURE_TARGET                                                                   = 0x1006
+	TEXTURE_UPDATE_BARRIER_BIT                                                       = 0x00000100
+	TEXTURE_VIEW                                                                     = 0x82B5
+	TEXTURE_VIEW_MIN_LAYER                                                           = 0x82DD
+	TEXTURE_VIEW_MIN_LEVEL                                                           = 0x82DB
+	TEXTURE_VIEW_NUM_LAYERS                                                          = 0x82DE
+	TEXTURE_VIEW_NUM_LEVELS                                                          = 0x82DC
+	TEXTURE_WIDTH                                              TextureParameter      = 0x1000
+	TEXTURE_WRAP_R                                             TextureParameter      = 0x8072
+	TEXTURE_WRAP_S                                             TextureParameter      = 0x2802
+	TEXTURE_WRAP_T                                             TextureParameter      = 0x2803
+	TIMEOUT_EXPIRED                                            SyncStatus            = 0x911B
+	TIMEOUT_IGNORED                                                                  = 0xFFFFFFFFFFFFFFFF
+	TIMESTAMP                                                                        = 0x8E28
+	TIME_ELAPSED                                                                     = 0x88BF
+	TOP_LEVEL_ARRAY_SIZE                                                             = 0x930C
+	TOP_LEVEL_ARRAY_STRIDE                                                           = 0x930D
+	TRANSFORM_FEEDBACK                                                               = 0x8E22
+	TRANSFORM_FEEDBACK_ACTIVE                                                        = 0x8E24
+	TRANSFORM_FEEDBACK_BARRIER_BIT                                                   = 0x00000800
+	TRANSFORM_FEEDBACK_BINDING                                                       = 0x8E25
+	TRANSFORM_FEEDBACK_BUFFER                                  BufferTarget          = 0x8C8E
+	TRANSFORM_FEEDBACK_BUFFER_ACTIVE                                                 = 0x8E24
+	TRANSFORM_FEEDBACK_BUFFER_BINDING                                                = 0x8C8F
+	TRANSFORM_FEEDBACK_BUFFER_INDEX                                                  = 0x934B
+	TRANSFORM_FEEDBACK_BUFFER_MODE                                                   = 0x8C7F
+	TRANSFORM_FEEDBACK_BUFFER_PAUSED                                                 = 0x8E23
+	TRANSFORM_FEEDBACK_BUFFER_SIZE                                                   = 0x8C85
+	TRANSFORM_FEEDBACK_BUFFER_START                                                  = 0x8C84
+	TRANSFORM_FEEDBACK_BUFFER_STRIDE                                                 = 0x934C
+	TRANSFORM_FEEDBACK_OVERFLOW_ARB                                                  = 0x82EC
+	TRANSFORM_FEEDBACK_PAUSED                                                        = 0x8E23
+	TRANSFORM_FEEDBACK_PRIMITIVES_WRITTEN                                            = 0x8C88
+	TRANSFORM_FEEDBACK_STREAM_OVERFLOW_ARB                                           = 0x82ED
+	TRANSFORM_FEEDBACK_VARYING                                                       = 0x92F4
+	TRANSFORM_FEEDBACK_VARYINGS                                                      = 0x8C83
+	TRANSFORM_FEEDBACK_VARYING_MAX_LENGTH                                            = 0x8C76
+	TRIANGLES                                                  PrimitiveType         = 0x0004
+	TRIANGLES_ADJACENCY                                        PrimitiveType         = 0x000C
+	TRIANGLE_FAN                                               PrimitiveType         = 0x0006
+	TRIANGLE_STRIP                                             PrimitiveType         = 0x0005
+	TRIANGLE_STRIP_ADJACENCY                                   PrimitiveType         = 0x000D
+	TRUE                                                                             = 1
+	TYPE                                                                             = 0x92FA
+	UNCORRELATED_NV                                                                  = 0x9282
+	UNDEFINED_VERTEX                                                                 = 0x8260
+	UNIFORM                                                                          = 0x92E1
+	UNIFORM_ARRAY_STRIDE                                                             = 0x8A3C
+	UNIFORM_ATOMIC_COUNTER_BUFFER_INDEX                                              = 0x92DA
+	UNIFORM_BARRIER_BIT                                                              = 0x00000004
+	UNIFORM_BLOCK                                                                    = 0x92E2
+	UNIFORM_BLOCK_ACTIVE_UNIFORMS                                                    = 0x8A42
+	UNIFORM_BLOCK_ACTIVE_UNIFORM_INDICES                                             = 0x8A43
+	UNIFORM_BLOCK_BINDING                                                            = 0x8A3F
+	UNIFORM_BLOCK_DATA_SIZE                                                          = 0x8A40
+	UNIFORM_BLOCK_INDEX                                                              = 0x8A3A
+	UNIFORM_BLOCK_NAME_LENGTH                                                        = 0x8A41
+	UNIFORM_BLOCK_REFERENCED_BY_COMPUTE_SHADER                                       = 0x90EC
+	UNIFORM_BLOCK_REFERENCED_BY_FRAGMENT_SHADER                                      = 0x8A46
+	UNIFORM_BLOCK_REFERENCED_BY_GEOMETRY_SHADER                                      = 0x8A45
+	UNIFORM_BLOCK_REFERENCED_BY_TESS_CONTROL_SHADER                                  = 0x84F0
+	UNIFORM_BLOCK_REFERENCED_BY_TESS_EVALUATION_SHADER                               = 0x84F1
+	UNIFORM_BLOCK_REFERENCED_BY_VERTEX_SHADER                                        = 0x8A44
+	UNIFORM_BUFFER                                             BufferTarget          = 0x8A11
+	UNIFORM_BUFFER_BINDING                                                           = 0x8A28
+	UNIFORM_BUFFER_OFFSET_ALIGNMENT                                                  = 0x8A34
+	UNIFORM_BUFFER_SIZE                                                              = 0x8A2A
+	UNIFORM_BUFFER_START                                                             = 0x8A29
+	UNIFORM_IS_ROW_MAJOR                                                             = 0x8A3E
+	UNIFORM_MATRIX_STRIDE                                                            = 0x8A3D
+	UNIFORM_NAME_LENGTH                                                              = 0x8A39
+	UNIFORM_OFFSET                                                                   = 0x8A3B
+	UNIFORM_SIZE                                                                     = 0x8A38
+	UNIFORM_TYPE                                                                     = 0x8A37
+	UNKNOWN_CONTEXT_RESET                                                            = 0x8255
+	UNKNOWN_CONTEXT_RESET_ARB                                                        = 0x8255
+	UNKNOWN_CONTEXT_RESET_KHR                                                        = 0x8255
+	UNPACK_ALIGNMENT                                                                 = 0x0CF5
+	UNPACK_COMPRESSED_BLOCK_DEPTH                                                    = 0x9129
+	UNPACK_COMPRESSED_BLOCK_HEIGHT                                                   = 0x9128
+	UNPACK_COMPRESSED_BLOCK_SIZE                                                     = 0x912A
+	UNPACK_COMPRESSED_BLOCK_WIDTH                                                    = 0x9127
+	UNPACK_IMAGE_HEIGHT                                                              = 0x806E
+	UNPACK_LSB_FIRST                                                                 = 0x0CF1
+	UNPACK_ROW_LENGTH                                                                = 0x0CF2
+	UNPACK_SKIP_IMAGES                                                               = 0x806D
+	UNPACK_SKIP_PIXELS                                                               = 0x0CF4
+	UNPACK_SKIP_ROWS                                                                 = 0x0CF3
+	UNPACK_SWAP_BYTES                                                                = 0x0CF0
+	UNSIGNALED                                                                       = 0x9118
+	UNSIGNED_BYTE                                                                    = 0x1401
+	UNSIGNED_BYTE_2_3_3_REV                                    PixelType             = 0x8362
+	UNSIGNED_BYTE_3_3_2                                        PixelType             = 0x8032
+	UNSIGNED_INT                                                                     = 0x1405
+	UNSIGNED_INT64_AMD                                                               = 0x8BC2
+	UNSIGNED_INT64_ARB                                                               = 0x140F
+	UNSIGNED_INT_10F_11F_11F_REV                                                     = 0x8C3B
+	UNSIGNED_INT_10_10_10_2                                    PixelType             = 0x8036
+	UNSIGNED_INT_24_8                                          PixelType             = 0x84FA
+	UNSIGNED_INT_2_10_10_10_REV                                                      = 0x8368
+	UNSIGNED_INT_5_9_9_9_REV                                   PixelType             = 0x8C3E
+	UNSIGNED_INT_8_8_8_8                                       PixelType             = 0x8035
+	UNSIGNED_INT_8_8_8_8_REV                                   PixelType             = 0x8367
+	UNSIGNED_INT_ATOMIC_COUNTER                                                      = 0x92DB
+	UNSIGNED_INT_IMAGE_1D                                                            = 0x9062
+	UNSIGNED_INT_IMAGE_1D_ARRAY                                                      = 0x9068
+	UNSIGNED_INT_IMAGE_2D                                                            = 0x9063
+	UNSIGNED_INT_IMAGE_2D_ARRAY                                                      = 0x9069
+	UNSIGNED_INT_IMAGE_2D_MULTISAMPLE                                                = 0x906B
+	UNSIGNED_INT_IMAGE_2D_MULTISAMPLE_ARRAY                                          = 0x906C
+	UNSIGNED_INT_IMAGE_2D_RECT                                                       = 0x9065
+	UNSIGNED_INT_IMAGE_3D                                                            = 0x9064
+	UNSIGNED_INT_IMAGE_BUFFER                                                        = 0x9067
+	UNSIGNED_INT_IMAGE_CUBE                                                          = 0x9066
+	UNSIGNED_INT_IMAGE_CUBE_MAP_ARRAY                                                = 0x906A
+	UNSIGNED_INT_SAMPLER_1D                                                          = 0x8DD1
+	UNSIGNED_INT_SAMPLER_1D_ARRAY                                                    = 0x8DD6
+	UNSIGNED_INT_SAMPLER_2D                                                          = 0x8DD2
+	UNSIGNED_INT_SAMPLER_2D_ARRAY                                                    = 0x8DD7
+	UNSIGNED_INT_SAMPLER_2D_MULTISAMPLE                                              = 0x910A
+	UNSIGNED_INT_SAMPLER_2D_MULTISAMPLE_ARRAY                                        = 0x910D
+	UNSIGNED_INT_SAMPLER_2D_RECT                                                     = 0x8DD5
+	UNSIGNED_INT_SAMPLER_3D                                                          = 0x8DD3
+	UNSIGNED_INT_SAMPLER_BUFFER                                                      = 0x8DD8
+	UNSIGNED_INT_SAMPLER_CUBE                                                        = 0x8DD4
+	UNSIGNED_INT_SAMPLER_CUBE_MAP_ARRAY_ARB                                          = 0x900F
+	UNSIGNED_INT_VEC2                                          AttributeType         = 0x8DC6
+	UNSIGNED_INT_VEC3                                          AttributeType         = 0x8DC7
+	UNSIGNED_INT_VEC4                                          AttributeType         = 0x8DC8
+	UNSIGNED_NORMALIZED                                                              = 0x8C17
+	UNSIGNED_SHORT                                                                   = 0x1403
+	UNSIGNED_SHORT_1_5_5_5_REV                                 PixelType             = 0x8366
+	UNSIGNED_SHORT_4_4_4_4                                     PixelType             = 0x8033
+	UNSIGNED_SHORT_4_4_4_4_REV                                 PixelType             = 0x8365
+	UNSIGNED_SHORT_5_5_5_1                                     PixelType             = 0x8034
+	UNSIGNED_SHORT_5_6_5                                       PixelType             = 0x8363
+	UNSIGNED_SHORT_5_6_5_REV                                   PixelType             = 0x8364
+	UNSIGNED_SHORT_8_8_APPLE                                                         = 0x85BA
+	UNSIGNED_SHORT_8_8_REV_APPLE                                                     = 0x85BB
+	UPPER_LEFT                                                                       = 0x8CA2
+	VALIDATE_STATUS                                                                  = 0x8B83
+	VENDOR                                                                           = 0x1F00
+	VERSION                                                                          = 0x1F02
+	VERTEX_ARRAY                                                                     = 0x8074
+	VERTEX_ARRAY_BINDING                                                             = 0x85B5
+	VERTEX_ARRAY_KHR                                                                 = 0x8074
+	VERTEX_ARRAY_OBJECT_EXT                                                          = 0x9154
+	VERTEX_ATTRIB_ARRAY_BARRIER_BIT                                                  = 0x00000001
+	VERTEX_ATTRIB_ARRAY_BUFFER_BINDING                                               = 0x889F
+	VERTEX_ATTRIB_ARRAY_DIVISOR                                                      = 0x88FE
+	VERTEX_ATTRIB_ARRAY_ENABLED                                                      = 0x8622
+	VERTEX_ATTRIB_ARRAY_INTEGER                                                      = 0x88FD
+	VERTEX_ATTRIB_ARRAY_LONG                                                         = 0x874E
+	VERTEX_ATTRIB_ARRAY_NORMALIZED                                                   = 0x886A
+	VERTEX_ATTRIB_ARRAY_POINTER                                                      = 0x8645
+	VERTEX_ATTRIB_ARRAY_SIZE                                                         = 0x8623
+	VERTEX_ATTRIB_ARRAY_STRIDE                                                       = 0x8624
+	VERTEX_ATTRIB_ARRAY_TYPE                                                         = 0x8625
+	VERTEX_ATTRIB_BINDING                                                            = 0x82D4
+	VERTEX_ATTRIB_RELATIVE_OFFSET                                                    = 0x82D5
+	VERTEX_BINDING_DIVISOR                                                           = 0x82D6
+	VERTEX_BINDING_OFFSET                                                            = 0x82D7
+	VERTEX_BINDING_STRIDE                                                            = 0x82D8
+	VERTEX_PROGRAM_POINT_SIZE                                                        = 0x8642
+	VERTEX_SHADER                                              ShaderType            = 0x8B31
+	VERTEX_SHADER_BIT                                                                = 0x00000001
+	VERTEX_SHADER_BIT_EXT                                                            = 0x00000001
+	VERTEX_SHADER_INVOCATIONS_ARB                                                    = 0x82F0
+	VERTEX_SUBROUTINE                                                                = 0x92E8
+	VERTEX_SUBROUTINE_UNIFORM                                                        = 0x92EE
+	VERTEX_TEXTURE                                                                   = 0x829B
+	VERTICES_SUBMITTED_ARB                                                           = 0x82EE
+	VIEWPORT                                                                         = 0x0BA2
+	VIEWPORT_BOUNDS_RANGE                                                            = 0x825D
+	VIEWPORT_INDEX_PROVOKING_VERTEX                                                  = 0x825F
+	VIEWPORT_SUBPIXEL_BITS                                                           = 0x825C
+	VIEW_CLASS_128_BITS                                                              = 0x82C4
+	VIEW_CLASS_16_BITS                                                               = 0x82CA
+	VIEW_CLASS_24_BITS                                                               = 0x82C9
+	VIEW_CLASS_32_BITS                                                               = 0x82C8
+	VIEW_CLASS_48_BITS                                                               = 0x82C7
+	VIEW_CLASS_64_BITS                                                               = 0x82C6
+	VIEW_CLASS_8_BITS                                                                = 0x82CB
+	VIEW_CLASS_96_BITS                                                               = 0x82C5
+	VIEW_CLASS_BPTC_FLOAT                                                            = 0x82D3
+	VIEW_CLASS_BPTC_UNORM                                                            = 0x82D2
+	VIEW_CLASS_RGTC1_RED                                                             = 0x82D0
+	VIEW_CLASS_RGTC2_RG                                                              = 0x82D1
+	VIEW_CLASS_S3TC_DXT1_RGB                                                         = 0x82CC
+	VIEW_CLASS_S3TC_DXT1_RGBA                                                        = 0x82CD
+	VIEW_CLASS_S3TC_DXT3_RGBA                                                        = 0x82CE
+	VIEW_CLASS_S3TC_DXT5_RGBA                                                        = 0x82CF
+	VIEW_COMPATIBILITY_CLASS                                                         = 0x82B6
+	VIRTUAL_PAGE_SIZE_INDEX_ARB                                                      = 0x91A7
+	VIRTUAL_PAGE_SIZE_X_ARB                                                          = 0x9195
+	VIRTUAL_PAGE_SIZE_Y_ARB                                                          = 0x9196
+	VIRTUAL_PAGE_SIZE_Z_ARB                                                          = 0x9197
+	VIVIDLIGHT_NV                                                                    = 0x92A6
+	WAIT_FAILED                                                SyncStatus            = 0x911D
+	WRITE_ONLY                                                                       = 0x88B9
+	XOR                                                                              = 0x1506
+	XOR_NV                                                                           = 0x1506
+	ZERO                                                                             = 0
+	ZERO_TO_ONE                                                                      = 0x935F
 )
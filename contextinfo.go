@@ -0,0 +1,178 @@
+package gl
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ContextInfo describes the current context, parsed from GL_VERSION,
+// GL_SHADING_LANGUAGE_VERSION, GL_CONTEXT_PROFILE_MASK and GL_CONTEXT_FLAGS.
+type ContextInfo struct {
+	// Version and GLSLVersion are the raw version strings.
+	Version     string
+	GLSLVersion string
+
+	// Major and Minor are the version of OpenGL, or of OpenGL ES if ES is
+	// true. Release is the optional third number, 0 if there is none.
+	Major, Minor, Release int
+	ES                    bool
+
+	// GLSLMajor and GLSLMinor are the version of the shading language,
+	// 4.60 is 4 and 60.
+	GLSLMajor, GLSLMinor int
+
+	// Driver is the vendor-specific suffix of GL_VERSION, like
+	// "NVIDIA 535.54.03" or "Mesa 23.1.4".
+	Driver string
+
+	// Core and Compatibility are the profile of a desktop context of
+	// version 3.2 or later, both are false otherwise.
+	Core, Compatibility bool
+
+	// Debug, ForwardCompatible and Robust are the context flags.
+	Debug, ForwardCompatible, Robust bool
+}
+
+// QueryContextInfo returns the ContextInfo of the current context. It must be
+// called on the context thread, after Init. It only returns an error if the
+// version strings are malformed.
+func QueryContextInfo() (ContextInfo, error) {
+	info := ContextInfo{
+		Version:     glGoStr(backend.GetString(VERSION)),
+		GLSLVersion: glGoStr(backend.GetString(SHADING_LANGUAGE_VERSION)),
+	}
+	if err := info.parseVersion(); err != nil {
+		return info, err
+	}
+	if err := info.parseGLSLVersion(); err != nil {
+		return info, err
+	}
+	if !info.ES && info.AtLeast(3, 2) {
+		mask := getInteger(CONTEXT_PROFILE_MASK)
+		info.Core = mask&CONTEXT_CORE_PROFILE_BIT != 0
+		info.Compatibility = mask&CONTEXT_COMPATIBILITY_PROFILE_BIT != 0
+	}
+	// CONTEXT_FLAGS is desktop 3.0 and OpenGL ES 3.2.
+	if !info.ES && info.AtLeast(3, 0) || info.ES && info.AtLeast(3, 2) {
+		flags := getInteger(CONTEXT_FLAGS)
+		info.Debug = flags&CONTEXT_FLAG_DEBUG_BIT != 0
+		info.ForwardCompatible = flags&CONTEXT_FLAG_FORWARD_COMPATIBLE_BIT != 0
+		info.Robust = flags&CONTEXT_FLAG_ROBUST_ACCESS_BIT_ARB != 0
+	}
+	return info, nil
+}
+
+// cachedContextInfo is the ContextInfo of the current context, queried by
+// the first contextInfo call after InitBackend.
+var cachedContextInfo *ContextInfo
+
+// contextInfo returns the ContextInfo of the current context, queried once
+// per context for the checks made on every call. A malformed version leaves
+// the numbers zero.
+func contextInfo() ContextInfo {
+	if cachedContextInfo == nil {
+		info, _ := QueryContextInfo()
+		cachedContextInfo = &info
+	}
+	return *cachedContextInfo
+}
+
+// AtLeast returns true if the version of the context is at least
+// major.minor. The versions of OpenGL and OpenGL ES aren't comparable, check
+// ES too.
+func (info ContextInfo) AtLeast(major, minor int) bool {
+	return info.Major > major || info.Major == major && info.Minor >= minor
+}
+
+// GLSLAtLeast returns true if the shading language version is at least
+// major.minor, like GLSLAtLeast(4, 30).
+func (info ContextInfo) GLSLAtLeast(major, minor int) bool {
+	return info.GLSLMajor > major || info.GLSLMajor == major && info.GLSLMinor >= minor
+}
+
+// String returns a summary of info, like "OpenGL 4.6 core debug, GLSL 4.60,
+// NVIDIA 535.54.03".
+func (info ContextInfo) String() string {
+	api := "OpenGL"
+	if info.ES {
+		api = "OpenGL ES"
+	}
+	s := fmt.Sprintf("%s %d.%d", api, info.Major, info.Minor)
+	if info.Core {
+		s += " core"
+	}
+	if info.Compatibility {
+		s += " compatibility"
+	}
+	if info.ForwardCompatible {
+		s += " forward-compatible"
+	}
+	if info.Debug {
+		s += " debug"
+	}
+	if info.Robust {
+		s += " robust"
+	}
+	s += fmt.Sprintf(", GLSL %d.%02d", info.GLSLMajor, info.GLSLMinor)
+	if info.Driver != "" {
+		s += ", " + info.Driver
+	}
+	return s
+}
+
+// parseVersion parses GL_VERSION, "<major>.<minor>[.<release>] [driver]" on
+// desktop and "OpenGL ES <major>.<minor> [driver]" on OpenGL ES.
+func (info *ContextInfo) parseVersion() error {
+	s := info.Version
+	// OpenGL ES 1 has a profile too, "OpenGL ES-CM 1.1".
+	if strings.HasPrefix(s, "OpenGL ES ") || strings.HasPrefix(s, "OpenGL ES-") {
+		info.ES = true
+		s = strings.TrimPrefix(s[len("OpenGL ES"):], " ")
+		if strings.HasPrefix(s, "-") {
+			_, s, _ = strings.Cut(s, " ")
+		}
+	}
+	number, driver, _ := strings.Cut(s, " ")
+	info.Driver = strings.TrimSpace(driver)
+	nums, err := parseVersionNumbers(number, 2, 3)
+	if err != nil {
+		return fmt.Errorf("gl: malformed GL_VERSION %q", info.Version)
+	}
+	info.Major, info.Minor = nums[0], nums[1]
+	if len(nums) == 3 {
+		info.Release = nums[2]
+	}
+	return nil
+}
+
+// parseGLSLVersion parses GL_SHADING_LANGUAGE_VERSION, "<major>.<minor>
+// [vendor]" on desktop and "OpenGL ES GLSL ES <major>.<minor> [vendor]" on
+// OpenGL ES.
+func (info *ContextInfo) parseGLSLVersion() error {
+	s := strings.TrimPrefix(info.GLSLVersion, "OpenGL ES GLSL ES ")
+	number, _, _ := strings.Cut(s, " ")
+	nums, err := parseVersionNumbers(number, 2, 3)
+	if err != nil {
+		return fmt.Errorf("gl: malformed GL_SHADING_LANGUAGE_VERSION %q", info.GLSLVersion)
+	}
+	info.GLSLMajor, info.GLSLMinor = nums[0], nums[1]
+	return nil
+}
+
+// parseVersionNumbers parses the min to max dot separated numbers of s.
+func parseVersionNumbers(s string, min, max int) ([]int, error) {
+	parts := strings.Split(s, ".")
+	if len(parts) < min || len(parts) > max {
+		return nil, fmt.Errorf("want %d to %d numbers", min, max)
+	}
+	nums := make([]int, len(parts))
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			return nil, err
+		}
+		nums[i] = n
+	}
+	return nums, nil
+}
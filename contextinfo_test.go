@@ -0,0 +1,59 @@
+package gl
+
+import "testing"
+
+func TestQueryContextInfo(t *testing.T) {
+	tests := []struct {
+		version, glsl string
+		integers      map[uint32]int32
+		want          string
+		wantErr       bool
+	}{
+		{"3.3.0 FakeBackend", "3.30 FakeBackend", nil, "OpenGL 3.3, GLSL 3.30, FakeBackend", false},
+		{"4.6.0 NVIDIA 535.54.03", "4.60 NVIDIA", map[uint32]int32{CONTEXT_PROFILE_MASK: CONTEXT_CORE_PROFILE_BIT, CONTEXT_FLAGS: CONTEXT_FLAG_DEBUG_BIT}, "OpenGL 4.6 core debug, GLSL 4.60, NVIDIA 535.54.03", false},
+		{"4.5 (Compatibility Profile) Mesa 23.1.4", "4.50", map[uint32]int32{CONTEXT_PROFILE_MASK: CONTEXT_COMPATIBILITY_PROFILE_BIT}, "OpenGL 4.5 compatibility, GLSL 4.50, (Compatibility Profile) Mesa 23.1.4", false},
+		{"OpenGL ES 3.2 Mesa 23.1.4", "OpenGL ES GLSL ES 3.20", map[uint32]int32{CONTEXT_FLAGS: CONTEXT_FLAG_ROBUST_ACCESS_BIT_ARB}, "OpenGL ES 3.2 robust, GLSL 3.20, Mesa 23.1.4", false},
+		{"OpenGL ES-CM 1.1", "OpenGL ES GLSL ES 1.00", nil, "OpenGL ES 1.1, GLSL 1.00", false},
+		{"four point six", "4.60", nil, "", true},
+		{"4.6", "", nil, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			f := newFake(t)
+			f.Strings[VERSION] = tt.version
+			f.Strings[SHADING_LANGUAGE_VERSION] = tt.glsl
+			for pname, v := range tt.integers {
+				f.Integers[pname] = []int32{v}
+			}
+			info, err := QueryContextInfo()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("QueryContextInfo() = %v, want an error: %v", err, tt.wantErr)
+			}
+			if err == nil && info.String() != tt.want {
+				t.Errorf("QueryContextInfo() = %q, want %q", info, tt.want)
+			}
+		})
+	}
+}
+
+func TestContextInfoAtLeast(t *testing.T) {
+	info := ContextInfo{Major: 4, Minor: 3, GLSLMajor: 4, GLSLMinor: 30}
+	tests := []struct {
+		major, minor int
+		want         bool
+	}{
+		{3, 3, true},
+		{4, 0, true},
+		{4, 3, true},
+		{4, 4, false},
+		{5, 0, false},
+	}
+	for _, tt := range tests {
+		if got := info.AtLeast(tt.major, tt.minor); got != tt.want {
+			t.Errorf("AtLeast(%d, %d) = %v, want %v", tt.major, tt.minor, got, tt.want)
+		}
+		if got := info.GLSLAtLeast(tt.major, tt.minor*10); got != tt.want {
+			t.Errorf("GLSLAtLeast(%d, %d) = %v, want %v", tt.major, tt.minor*10, got, tt.want)
+		}
+	}
+}
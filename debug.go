@@ -0,0 +1,277 @@
+package gl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"time"
+	"unsafe"
+)
+
+// DebugSource is the source of a debug message.
+type DebugSource uint32
+
+// All the debug message sources.
+const (
+	DebugSourceAPI            DebugSource = DEBUG_SOURCE_API
+	DebugSourceWindowSystem   DebugSource = DEBUG_SOURCE_WINDOW_SYSTEM
+	DebugSourceShaderCompiler DebugSource = DEBUG_SOURCE_SHADER_COMPILER
+	DebugSourceThirdParty     DebugSource = DEBUG_SOURCE_THIRD_PARTY
+	DebugSourceApplication    DebugSource = DEBUG_SOURCE_APPLICATION
+	DebugSourceOther          DebugSource = DEBUG_SOURCE_OTHER
+)
+
+func (s DebugSource) String() string {
+	switch s {
+	case DebugSourceAPI:
+		return "API"
+	case DebugSourceWindowSystem:
+		return "WindowSystem"
+	case DebugSourceShaderCompiler:
+		return "ShaderCompiler"
+	case DebugSourceThirdParty:
+		return "ThirdParty"
+	case DebugSourceApplication:
+		return "Application"
+	case DebugSourceOther:
+		return "Other"
+	case DebugDontCare:
+		return "DontCare"
+	default:
+		return fmt.Sprintf("DebugSource(0x%X)", uint32(s))
+	}
+}
+
+// DebugType is the type of a debug message.
+type DebugType uint32
+
+// All the debug message types.
+const (
+	DebugTypeError              DebugType = DEBUG_TYPE_ERROR
+	DebugTypeDeprecatedBehavior DebugType = DEBUG_TYPE_DEPRECATED_BEHAVIOR
+	DebugTypeUndefinedBehavior  DebugType = DEBUG_TYPE_UNDEFINED_BEHAVIOR
+	DebugTypePortability        DebugType = DEBUG_TYPE_PORTABILITY
+	DebugTypePerformance        DebugType = DEBUG_TYPE_PERFORMANCE
+	DebugTypeMarker             DebugType = DEBUG_TYPE_MARKER
+	DebugTypePushGroup          DebugType = DEBUG_TYPE_PUSH_GROUP
+	DebugTypePopGroup           DebugType = DEBUG_TYPE_POP_GROUP
+	DebugTypeOther              DebugType = DEBUG_TYPE_OTHER
+)
+
+func (t DebugType) String() string {
+	switch t {
+	case DebugTypeError:
+		return "Error"
+	case DebugTypeDeprecatedBehavior:
+		return "DeprecatedBehavior"
+	case DebugTypeUndefinedBehavior:
+		return "UndefinedBehavior"
+	case DebugTypePortability:
+		return "Portability"
+	case DebugTypePerformance:
+		return "Performance"
+	case DebugTypeMarker:
+		return "Marker"
+	case DebugTypePushGroup:
+		return "PushGroup"
+	case DebugTypePopGroup:
+		return "PopGroup"
+	case DebugTypeOther:
+		return "Other"
+	case DebugDontCare:
+		return "DontCare"
+	default:
+		return fmt.Sprintf("DebugType(0x%X)", uint32(t))
+	}
+}
+
+// DebugSeverity is the severity of a debug message.
+type DebugSeverity uint32
+
+// All the debug message severities.
+const (
+	DebugSeverityHigh         DebugSeverity = DEBUG_SEVERITY_HIGH
+	DebugSeverityMedium       DebugSeverity = DEBUG_SEVERITY_MEDIUM
+	DebugSeverityLow          DebugSeverity = DEBUG_SEVERITY_LOW
+	DebugSeverityNotification DebugSeverity = DEBUG_SEVERITY_NOTIFICATION
+)
+
+func (s DebugSeverity) String() string {
+	switch s {
+	case DebugSeverityHigh:
+		return "High"
+	case DebugSeverityMedium:
+		return "Medium"
+	case DebugSeverityLow:
+		return "Low"
+	case DebugSeverityNotification:
+		return "Notification"
+	case DebugDontCare:
+		return "DontCare"
+	default:
+		return fmt.Sprintf("DebugSeverity(0x%X)", uint32(s))
+	}
+}
+
+// Level returns the slog level matching the severity.
+func (s DebugSeverity) Level() slog.Level {
+	switch s {
+	case DebugSeverityHigh:
+		return slog.LevelError
+	case DebugSeverityMedium:
+		return slog.LevelWarn
+	case DebugSeverityLow:
+		return slog.LevelInfo
+	default:
+		return slog.LevelDebug
+	}
+}
+
+// DebugDontCare matches every source, type or severity in DebugMessageControl.
+const DebugDontCare = DONT_CARE
+
+// DebugMessage is a message generated by the OpenGL debug output.
+type DebugMessage struct {
+	Source   DebugSource
+	Type     DebugType
+	ID       uint32
+	Severity DebugSeverity
+	Message  string
+
+	// Stack is the goroutine stack at the time the message was generated. It
+	// is only captured in synchronous mode, where it points at the call that
+	// generated the message.
+	Stack []byte
+}
+
+func (m DebugMessage) String() string {
+	return fmt.Sprintf("%s %s %s (%d): %s", m.Severity, m.Source, m.Type, m.ID, m.Message)
+}
+
+// DebugHandler receives the messages of the OpenGL debug output.
+type DebugHandler func(DebugMessage)
+
+// ErrDebugOutputUnavailable is returned by EnableDebugOutput when the context
+// supports neither OpenGL 4.3, KHR_debug nor ARB_debug_output.
+var ErrDebugOutputUnavailable = errors.New("gl: neither KHR_debug nor ARB_debug_output is available")
+
+var (
+	debugHandler     DebugHandler
+	debugARB         bool
+	debugSynchronous bool
+)
+
+// EnableDebugOutput installs a glDebugMessageCallback delivering every message
+// of the debug output to handler. It uses KHR_debug when the context is at
+// least OpenGL 4.3 or exposes the extension, and falls back to
+// ARB_debug_output. Most drivers only generate messages for debug contexts.
+func EnableDebugOutput(handler DebugHandler) error {
+	// A malformed version leaves info zero, only the extensions count then.
+	info := contextInfo()
+	switch {
+	case !info.ES && info.AtLeast(4, 3) || AvailableExtensions().Has(KHR_debug):
+		debugARB = false
+	case arbDebugOutputAvailable():
+		debugARB = true
+	default:
+		return ErrDebugOutputUnavailable
+	}
+	debugHandler = handler
+	if debugARB {
+		debugMessageCallbackARB(debugCallback)
+		return nil
+	}
+	backend.Enable(uint32(DEBUG_OUTPUT))
+	backend.DebugMessageCallback(debugCallback, nil)
+	return nil
+}
+
+// DisableDebugOutput stops delivering the debug output messages.
+func DisableDebugOutput() {
+	debugHandler = nil
+	if !debugARB {
+		backend.Disable(uint32(DEBUG_OUTPUT))
+	}
+}
+
+// DebugOutputSynchronous turns the synchronous debug output on or off. In
+// synchronous mode messages are delivered during the call that generated
+// them, on the same thread, so DebugMessage.Stack points at the offending
+// call. It slows down the driver and should only be used while debugging.
+func DebugOutputSynchronous(enabled bool) {
+	debugSynchronous = enabled
+	if enabled {
+		backend.Enable(uint32(DEBUG_OUTPUT_SYNCHRONOUS))
+	} else {
+		backend.Disable(uint32(DEBUG_OUTPUT_SYNCHRONOUS))
+	}
+}
+
+// DebugMessageControl enables or disables the messages matching source, xtype
+// and severity, any of them can be DebugDontCare. If ids isn't empty only the
+// messages with those ids are affected, in which case severity must be
+// DebugDontCare.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man/html/glDebugMessageControl.xhtml
+func DebugMessageControl(source DebugSource, xtype DebugType, severity DebugSeverity, ids []uint32, enabled bool) {
+	if debugARB {
+		debugMessageControlARB(uint32(source), uint32(xtype), uint32(severity), ids, enabled)
+		return
+	}
+	backend.DebugMessageControl(uint32(source), uint32(xtype), uint32(severity), int32(len(ids)), first(ids), enabled)
+}
+
+// DebugMessageInsert inserts a message in the debug output, typically with
+// DebugSourceApplication, to annotate it.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man/html/glDebugMessageInsert.xhtml
+func DebugMessageInsert(source DebugSource, xtype DebugType, id uint32, severity DebugSeverity, message string) {
+	if debugARB {
+		debugMessageInsertARB(uint32(source), uint32(xtype), id, uint32(severity), message)
+		return
+	}
+	backend.DebugMessageInsert(uint32(source), uint32(xtype), id, uint32(severity), int32(len(message)), glStr(message+"\x00"))
+}
+
+func debugCallback(source, xtype, id, severity uint32, length int32, message string, userParam unsafe.Pointer) {
+	h := debugHandler
+	if h == nil {
+		return
+	}
+	m := DebugMessage{
+		Source:   DebugSource(source),
+		Type:     DebugType(xtype),
+		ID:       id,
+		Severity: DebugSeverity(severity),
+		Message:  message,
+	}
+	if debugSynchronous {
+		m.Stack = debug.Stack()
+	}
+	h(m)
+}
+
+// SlogDebugHandler returns a DebugHandler logging every message to h, at the
+// level matching its severity.
+func SlogDebugHandler(h slog.Handler) DebugHandler {
+	return func(m DebugMessage) {
+		ctx := context.Background()
+		level := m.Severity.Level()
+		if !h.Enabled(ctx, level) {
+			return
+		}
+		r := slog.NewRecord(time.Now(), level, m.Message, 0)
+		r.AddAttrs(
+			slog.String("source", m.Source.String()),
+			slog.String("type", m.Type.String()),
+			slog.Uint64("id", uint64(m.ID)),
+			slog.String("severity", m.Severity.String()),
+		)
+		if m.Stack != nil {
+			r.AddAttrs(slog.String("stack", string(m.Stack)))
+		}
+		h.Handle(ctx, r)
+	}
+}
@@ -0,0 +1,22 @@
+//go:build !gles30
+
+package gl
+
+// ARB_debug_output is the fallback of the debug output for contexts older than
+// OpenGL 4.3 without KHR_debug. OpenGL ES only has KHR_debug.
+
+func arbDebugOutputAvailable() bool {
+	return AvailableExtensions().Has(ARB_debug_output)
+}
+
+func debugMessageCallbackARB(callback debugProc) {
+	backend.DebugMessageCallbackARB(callback, nil)
+}
+
+func debugMessageControlARB(source, xtype, severity uint32, ids []uint32, enabled bool) {
+	backend.DebugMessageControlARB(source, xtype, severity, int32(len(ids)), first(ids), enabled)
+}
+
+func debugMessageInsertARB(source, xtype, id, severity uint32, message string) {
+	backend.DebugMessageInsertARB(source, xtype, id, severity, int32(len(message)), glStr(message+"\x00"))
+}
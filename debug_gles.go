@@ -0,0 +1,15 @@
+//go:build gles30
+
+package gl
+
+// OpenGL ES only has KHR_debug, these are never called.
+
+func arbDebugOutputAvailable() bool {
+	return false
+}
+
+func debugMessageCallbackARB(callback debugProc) {}
+
+func debugMessageControlARB(source, xtype, severity uint32, ids []uint32, enabled bool) {}
+
+func debugMessageInsertARB(source, xtype, id, severity uint32, message string) {}
@@ -0,0 +1,134 @@
+package gl
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestEnableDebugOutput(t *testing.T) {
+	tests := []struct {
+		name       string
+		version    string
+		extensions []string
+		want       string // call installing the callback, "" for none
+	}{
+		{"core", "4.3.0", nil, "DebugMessageCallback"},
+		{"KHR_debug", "3.3.0", []string{string(KHR_debug)}, "DebugMessageCallback"},
+		{"ARB_debug_output", "3.3.0", []string{string(ARB_debug_output)}, "DebugMessageCallbackARB"},
+		{"unavailable", "3.3.0", nil, ""},
+		{"OpenGL ES", "OpenGL ES 3.2", nil, ""},
+		{"malformed version", "four point three", []string{string(KHR_debug)}, "DebugMessageCallback"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFake(t)
+			f.Strings[VERSION] = tt.version
+			f.Extensions = tt.extensions
+			resetExtensions()
+			defer resetExtensions()
+			want := tt.want
+			if Profile == "3.0-es" && want == "DebugMessageCallbackARB" {
+				want = ""
+			}
+			err := EnableDebugOutput(func(DebugMessage) {})
+			defer DisableDebugOutput()
+			if want == "" {
+				if err != ErrDebugOutputUnavailable {
+					t.Errorf("EnableDebugOutput() = %v, want ErrDebugOutputUnavailable", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := ""
+			for _, c := range f.Calls {
+				if c.Name == "DebugMessageCallback" || c.Name == "DebugMessageCallbackARB" {
+					got = c.Name
+				}
+			}
+			if got != want {
+				t.Errorf("EnableDebugOutput() called %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestDebugOutputRouting(t *testing.T) {
+	tests := []struct {
+		name        string
+		synchronous bool
+		disabled    bool
+	}{
+		{"asynchronous", false, false},
+		{"synchronous", true, false},
+		{"disabled", false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFake(t)
+			f.Extensions = []string{string(KHR_debug)}
+			resetExtensions()
+			defer resetExtensions()
+			var got []DebugMessage
+			if err := EnableDebugOutput(func(m DebugMessage) { got = append(got, m) }); err != nil {
+				t.Fatal(err)
+			}
+			defer DisableDebugOutput()
+			DebugOutputSynchronous(tt.synchronous)
+			defer DebugOutputSynchronous(false)
+			if tt.disabled {
+				DisableDebugOutput()
+			}
+			DebugMessageInsert(DebugSourceApplication, DebugTypeMarker, 7, DebugSeverityNotification, "frame")
+			if tt.disabled {
+				if got != nil {
+					t.Errorf("handler got %v after DisableDebugOutput", got)
+				}
+				return
+			}
+			want := DebugMessage{Source: DebugSourceApplication, Type: DebugTypeMarker, ID: 7, Severity: DebugSeverityNotification, Message: "frame"}
+			if len(got) != 1 {
+				t.Fatalf("handler got %v, want %v", got, want)
+			}
+			if (got[0].Stack != nil) != tt.synchronous {
+				t.Errorf("message has a stack: %v, want one: %v", got[0].Stack != nil, tt.synchronous)
+			}
+			got[0].Stack = nil
+			if got[0].String() != want.String() {
+				t.Errorf("handler got %v, want %v", got[0], want)
+			}
+		})
+	}
+}
+
+func TestSlogDebugHandler(t *testing.T) {
+	tests := []struct {
+		severity DebugSeverity
+		want     string // logged level, "" for none
+	}{
+		{DebugSeverityHigh, "ERROR"},
+		{DebugSeverityMedium, "WARN"},
+		{DebugSeverityLow, "INFO"},
+		{DebugSeverityNotification, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.severity.String(), func(t *testing.T) {
+			var buf bytes.Buffer
+			h := SlogDebugHandler(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo}))
+			h(DebugMessage{Source: DebugSourceAPI, Type: DebugTypeError, ID: 1, Severity: tt.severity, Message: "bad"})
+			got := buf.String()
+			if tt.want == "" {
+				if got != "" {
+					t.Errorf("logged %q, want nothing", got)
+				}
+				return
+			}
+			if !strings.Contains(got, "level="+tt.want) || !strings.Contains(got, "msg=bad") || !strings.Contains(got, "id=1") {
+				t.Errorf("logged %q, want level %s", got, tt.want)
+			}
+		})
+	}
+}
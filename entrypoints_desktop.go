@@ -0,0 +1,29 @@
+// Code generated by glgen from gl.xml. DO NOT EDIT.
+
+//go:build !gles30
+
+package gl
+
+import "unsafe"
+
+// desktopEntryPoints are the methods of Backend in the 3.3-core, 4.1-core, 4.5-core profiles.
+type desktopEntryPoints interface {
+	BindFragDataLocation(program, color uint32, name *uint8)
+	CopyTexImage1D(target uint32, level int32, internalformat uint32, x, y, width, border int32)
+	DebugMessageCallbackARB(callback debugProc, userParam unsafe.Pointer)
+	DebugMessageControlARB(source, xtype, severity uint32, count int32, ids *uint32, enabled bool)
+	DebugMessageInsertARB(source, xtype, id, severity uint32, length int32, buf *uint8)
+	DrawBuffer(buf uint32)
+	FramebufferTexture(target, attachment, texture uint32, level int32)
+	GetBooleani_v(target, index uint32, data *bool)
+	GetBufferSubData(target uint32, offset, size int, data unsafe.Pointer)
+	GetDoublev(pname uint32, data *float64)
+	GetTexImage(target uint32, level int32, format, xtype uint32, pixels unsafe.Pointer)
+	GetTexLevelParameteriv(target uint32, level int32, pname uint32, params *int32)
+	GetTexParameterIiv(target, pname uint32, params *int32)
+	GetTexParameterIuiv(target, pname uint32, params *uint32)
+	PolygonMode(face, mode uint32)
+	TexImage1D(target uint32, level, internalformat, width, border int32, format, xtype uint32, pixels unsafe.Pointer)
+	TexParameterIiv(target, pname uint32, params *int32)
+	TexParameterIuiv(target, pname uint32, params *uint32)
+}
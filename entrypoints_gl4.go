@@ -0,0 +1,12 @@
+// Code generated by glgen from gl.xml. DO NOT EDIT.
+
+//go:build gl41 || gl45
+
+package gl
+
+import "unsafe"
+
+// gl4EntryPoints are the methods of Backend in the 4.1-core, 4.5-core profiles.
+type gl4EntryPoints interface {
+	VertexAttribLPointer(index uint32, size int32, xtype uint32, stride int32, pointer unsafe.Pointer)
+}
@@ -0,0 +1,14 @@
+// Code generated by glgen from gl.xml. DO NOT EDIT.
+
+//go:build gl45
+
+package gl
+
+import "unsafe"
+
+// gl45EntryPoints are the methods of Backend in the 4.5-core profile.
+type gl45EntryPoints interface {
+	BufferStorage(target uint32, size int, data unsafe.Pointer, flags uint32)
+	InvalidateBufferData(buffer uint32)
+	InvalidateBufferSubData(buffer uint32, offset, length int)
+}
@@ -0,0 +1,1143 @@
+// Code generated by glgen from gl.xml. DO NOT EDIT.
+
+package gl
+
+import "fmt"
+
+// BufferTarget is a binding point of buffers.
+type BufferTarget uint32
+
+func (e BufferTarget) String() string {
+	switch e {
+	case ARRAY_BUFFER:
+		return "GL_ARRAY_BUFFER"
+	case ELEMENT_ARRAY_BUFFER:
+		return "GL_ELEMENT_ARRAY_BUFFER"
+	case PIXEL_PACK_BUFFER:
+		return "GL_PIXEL_PACK_BUFFER"
+	case PIXEL_UNPACK_BUFFER:
+		return "GL_PIXEL_UNPACK_BUFFER"
+	case UNIFORM_BUFFER:
+		return "GL_UNIFORM_BUFFER"
+	case TEXTURE_BUFFER:
+		return "GL_TEXTURE_BUFFER"
+	case TRANSFORM_FEEDBACK_BUFFER:
+		return "GL_TRANSFORM_FEEDBACK_BUFFER"
+	case COPY_READ_BUFFER:
+		return "GL_COPY_READ_BUFFER"
+	case COPY_WRITE_BUFFER:
+		return "GL_COPY_WRITE_BUFFER"
+	case DRAW_INDIRECT_BUFFER:
+		return "GL_DRAW_INDIRECT_BUFFER"
+	case SHADER_STORAGE_BUFFER:
+		return "GL_SHADER_STORAGE_BUFFER"
+	case DISPATCH_INDIRECT_BUFFER:
+		return "GL_DISPATCH_INDIRECT_BUFFER"
+	case QUERY_BUFFER:
+		return "GL_QUERY_BUFFER"
+	case ATOMIC_COUNTER_BUFFER:
+		return "GL_ATOMIC_COUNTER_BUFFER"
+	default:
+		return fmt.Sprintf("BufferTarget(0x%X)", uint32(e))
+	}
+}
+
+// BufferUsage is the expected usage pattern of the data store of a buffer.
+type BufferUsage uint32
+
+func (e BufferUsage) String() string {
+	switch e {
+	case STREAM_DRAW:
+		return "GL_STREAM_DRAW"
+	case STREAM_READ:
+		return "GL_STREAM_READ"
+	case STREAM_COPY:
+		return "GL_STREAM_COPY"
+	case STATIC_DRAW:
+		return "GL_STATIC_DRAW"
+	case STATIC_READ:
+		return "GL_STATIC_READ"
+	case STATIC_COPY:
+		return "GL_STATIC_COPY"
+	case DYNAMIC_DRAW:
+		return "GL_DYNAMIC_DRAW"
+	case DYNAMIC_READ:
+		return "GL_DYNAMIC_READ"
+	case DYNAMIC_COPY:
+		return "GL_DYNAMIC_COPY"
+	default:
+		return fmt.Sprintf("BufferUsage(0x%X)", uint32(e))
+	}
+}
+
+// TextureTarget is a binding point of textures, or a face of a cube map.
+type TextureTarget uint32
+
+func (e TextureTarget) String() string {
+	switch e {
+	case TEXTURE_1D:
+		return "GL_TEXTURE_1D"
+	case TEXTURE_2D:
+		return "GL_TEXTURE_2D"
+	case PROXY_TEXTURE_1D:
+		return "GL_PROXY_TEXTURE_1D"
+	case PROXY_TEXTURE_2D:
+		return "GL_PROXY_TEXTURE_2D"
+	case TEXTURE_3D:
+		return "GL_TEXTURE_3D"
+	case PROXY_TEXTURE_3D:
+		return "GL_PROXY_TEXTURE_3D"
+	case TEXTURE_RECTANGLE:
+		return "GL_TEXTURE_RECTANGLE"
+	case PROXY_TEXTURE_RECTANGLE:
+		return "GL_PROXY_TEXTURE_RECTANGLE"
+	case TEXTURE_CUBE_MAP:
+		return "GL_TEXTURE_CUBE_MAP"
+	case TEXTURE_CUBE_MAP_POSITIVE_X:
+		return "GL_TEXTURE_CUBE_MAP_POSITIVE_X"
+	case TEXTURE_CUBE_MAP_NEGATIVE_X:
+		return "GL_TEXTURE_CUBE_MAP_NEGATIVE_X"
+	case TEXTURE_CUBE_MAP_POSITIVE_Y:
+		return "GL_TEXTURE_CUBE_MAP_POSITIVE_Y"
+	case TEXTURE_CUBE_MAP_NEGATIVE_Y:
+		return "GL_TEXTURE_CUBE_MAP_NEGATIVE_Y"
+	case TEXTURE_CUBE_MAP_POSITIVE_Z:
+		return "GL_TEXTURE_CUBE_MAP_POSITIVE_Z"
+	case TEXTURE_CUBE_MAP_NEGATIVE_Z:
+		return "GL_TEXTURE_CUBE_MAP_NEGATIVE_Z"
+	case PROXY_TEXTURE_CUBE_MAP:
+		return "GL_PROXY_TEXTURE_CUBE_MAP"
+	case TEXTURE_1D_ARRAY:
+		return "GL_TEXTURE_1D_ARRAY"
+	case PROXY_TEXTURE_1D_ARRAY:
+		return "GL_PROXY_TEXTURE_1D_ARRAY"
+	case TEXTURE_2D_ARRAY:
+		return "GL_TEXTURE_2D_ARRAY"
+	case PROXY_TEXTURE_2D_ARRAY:
+		return "GL_PROXY_TEXTURE_2D_ARRAY"
+	case TEXTURE_BUFFER:
+		return "GL_TEXTURE_BUFFER"
+	case TEXTURE_CUBE_MAP_ARRAY:
+		return "GL_TEXTURE_CUBE_MAP_ARRAY"
+	case TEXTURE_2D_MULTISAMPLE:
+		return "GL_TEXTURE_2D_MULTISAMPLE"
+	case PROXY_TEXTURE_2D_MULTISAMPLE:
+		return "GL_PROXY_TEXTURE_2D_MULTISAMPLE"
+	case TEXTURE_2D_MULTISAMPLE_ARRAY:
+		return "GL_TEXTURE_2D_MULTISAMPLE_ARRAY"
+	case PROXY_TEXTURE_2D_MULTISAMPLE_ARRAY:
+		return "GL_PROXY_TEXTURE_2D_MULTISAMPLE_ARRAY"
+	default:
+		return fmt.Sprintf("TextureTarget(0x%X)", uint32(e))
+	}
+}
+
+// TextureParameter is a parameter of a texture.
+type TextureParameter uint32
+
+func (e TextureParameter) String() string {
+	switch e {
+	case TEXTURE_WIDTH:
+		return "GL_TEXTURE_WIDTH"
+	case TEXTURE_HEIGHT:
+		return "GL_TEXTURE_HEIGHT"
+	case TEXTURE_INTERNAL_FORMAT:
+		return "GL_TEXTURE_INTERNAL_FORMAT"
+	case TEXTURE_BORDER_COLOR:
+		return "GL_TEXTURE_BORDER_COLOR"
+	case TEXTURE_MAG_FILTER:
+		return "GL_TEXTURE_MAG_FILTER"
+	case TEXTURE_MIN_FILTER:
+		return "GL_TEXTURE_MIN_FILTER"
+	case TEXTURE_WRAP_S:
+		return "GL_TEXTURE_WRAP_S"
+	case TEXTURE_WRAP_T:
+		return "GL_TEXTURE_WRAP_T"
+	case TEXTURE_RED_SIZE:
+		return "GL_TEXTURE_RED_SIZE"
+	case TEXTURE_GREEN_SIZE:
+		return "GL_TEXTURE_GREEN_SIZE"
+	case TEXTURE_BLUE_SIZE:
+		return "GL_TEXTURE_BLUE_SIZE"
+	case TEXTURE_ALPHA_SIZE:
+		return "GL_TEXTURE_ALPHA_SIZE"
+	case TEXTURE_DEPTH:
+		return "GL_TEXTURE_DEPTH"
+	case TEXTURE_WRAP_R:
+		return "GL_TEXTURE_WRAP_R"
+	case TEXTURE_MIN_LOD:
+		return "GL_TEXTURE_MIN_LOD"
+	case TEXTURE_MAX_LOD:
+		return "GL_TEXTURE_MAX_LOD"
+	case TEXTURE_BASE_LEVEL:
+		return "GL_TEXTURE_BASE_LEVEL"
+	case TEXTURE_MAX_LEVEL:
+		return "GL_TEXTURE_MAX_LEVEL"
+	case TEXTURE_LOD_BIAS:
+		return "GL_TEXTURE_LOD_BIAS"
+	case TEXTURE_COMPARE_MODE:
+		return "GL_TEXTURE_COMPARE_MODE"
+	case TEXTURE_COMPARE_FUNC:
+		return "GL_TEXTURE_COMPARE_FUNC"
+	case TEXTURE_SWIZZLE_R:
+		return "GL_TEXTURE_SWIZZLE_R"
+	case TEXTURE_SWIZZLE_G:
+		return "GL_TEXTURE_SWIZZLE_G"
+	case TEXTURE_SWIZZLE_B:
+		return "GL_TEXTURE_SWIZZLE_B"
+	case TEXTURE_SWIZZLE_A:
+		return "GL_TEXTURE_SWIZZLE_A"
+	case TEXTURE_SWIZZLE_RGBA:
+		return "GL_TEXTURE_SWIZZLE_RGBA"
+	case DEPTH_STENCIL_TEXTURE_MODE:
+		return "GL_DEPTH_STENCIL_TEXTURE_MODE"
+	default:
+		return fmt.Sprintf("TextureParameter(0x%X)", uint32(e))
+	}
+}
+
+// ShaderType is the stage a shader is compiled for.
+type ShaderType uint32
+
+func (e ShaderType) String() string {
+	switch e {
+	case FRAGMENT_SHADER:
+		return "GL_FRAGMENT_SHADER"
+	case VERTEX_SHADER:
+		return "GL_VERTEX_SHADER"
+	case GEOMETRY_SHADER:
+		return "GL_GEOMETRY_SHADER"
+	case TESS_EVALUATION_SHADER:
+		return "GL_TESS_EVALUATION_SHADER"
+	case TESS_CONTROL_SHADER:
+		return "GL_TESS_CONTROL_SHADER"
+	case COMPUTE_SHADER:
+		return "GL_COMPUTE_SHADER"
+	default:
+		return fmt.Sprintf("ShaderType(0x%X)", uint32(e))
+	}
+}
+
+// Capability is a server-side capability, toggled by Enable and Disable.
+type Capability uint32
+
+func (e Capability) String() string {
+	switch e {
+	case LINE_SMOOTH:
+		return "GL_LINE_SMOOTH"
+	case POLYGON_SMOOTH:
+		return "GL_POLYGON_SMOOTH"
+	case CULL_FACE:
+		return "GL_CULL_FACE"
+	case DEPTH_TEST:
+		return "GL_DEPTH_TEST"
+	case STENCIL_TEST:
+		return "GL_STENCIL_TEST"
+	case DITHER:
+		return "GL_DITHER"
+	case BLEND:
+		return "GL_BLEND"
+	case COLOR_LOGIC_OP:
+		return "GL_COLOR_LOGIC_OP"
+	case SCISSOR_TEST:
+		return "GL_SCISSOR_TEST"
+	case POLYGON_OFFSET_POINT:
+		return "GL_POLYGON_OFFSET_POINT"
+	case POLYGON_OFFSET_LINE:
+		return "GL_POLYGON_OFFSET_LINE"
+	case CLIP_DISTANCE0:
+		return "GL_CLIP_DISTANCE0"
+	case CLIP_DISTANCE1:
+		return "GL_CLIP_DISTANCE1"
+	case CLIP_DISTANCE2:
+		return "GL_CLIP_DISTANCE2"
+	case CLIP_DISTANCE3:
+		return "GL_CLIP_DISTANCE3"
+	case CLIP_DISTANCE4:
+		return "GL_CLIP_DISTANCE4"
+	case CLIP_DISTANCE5:
+		return "GL_CLIP_DISTANCE5"
+	case CLIP_DISTANCE6:
+		return "GL_CLIP_DISTANCE6"
+	case CLIP_DISTANCE7:
+		return "GL_CLIP_DISTANCE7"
+	case POLYGON_OFFSET_FILL:
+		return "GL_POLYGON_OFFSET_FILL"
+	case MULTISAMPLE:
+		return "GL_MULTISAMPLE"
+	case SAMPLE_ALPHA_TO_COVERAGE:
+		return "GL_SAMPLE_ALPHA_TO_COVERAGE"
+	case SAMPLE_ALPHA_TO_ONE:
+		return "GL_SAMPLE_ALPHA_TO_ONE"
+	case SAMPLE_COVERAGE:
+		return "GL_SAMPLE_COVERAGE"
+	case DEBUG_OUTPUT_SYNCHRONOUS:
+		return "GL_DEBUG_OUTPUT_SYNCHRONOUS"
+	case PROGRAM_POINT_SIZE:
+		return "GL_PROGRAM_POINT_SIZE"
+	case DEPTH_CLAMP:
+		return "GL_DEPTH_CLAMP"
+	case TEXTURE_CUBE_MAP_SEAMLESS:
+		return "GL_TEXTURE_CUBE_MAP_SEAMLESS"
+	case RASTERIZER_DISCARD:
+		return "GL_RASTERIZER_DISCARD"
+	case PRIMITIVE_RESTART_FIXED_INDEX:
+		return "GL_PRIMITIVE_RESTART_FIXED_INDEX"
+	case FRAMEBUFFER_SRGB:
+		return "GL_FRAMEBUFFER_SRGB"
+	case SAMPLE_MASK:
+		return "GL_SAMPLE_MASK"
+	case PRIMITIVE_RESTART:
+		return "GL_PRIMITIVE_RESTART"
+	case DEBUG_OUTPUT:
+		return "GL_DEBUG_OUTPUT"
+	default:
+		return fmt.Sprintf("Capability(0x%X)", uint32(e))
+	}
+}
+
+// StencilOp is an action taken on the stencil buffer.
+type StencilOp uint32
+
+func (e StencilOp) String() string {
+	switch e {
+	case ZERO:
+		return "GL_ZERO"
+	case INVERT:
+		return "GL_INVERT"
+	case KEEP:
+		return "GL_KEEP"
+	case REPLACE:
+		return "GL_REPLACE"
+	case INCR:
+		return "GL_INCR"
+	case DECR:
+		return "GL_DECR"
+	case INCR_WRAP:
+		return "GL_INCR_WRAP"
+	case DECR_WRAP:
+		return "GL_DECR_WRAP"
+	default:
+		return fmt.Sprintf("StencilOp(0x%X)", uint32(e))
+	}
+}
+
+// StencilFunc is a comparison of the stencil test.
+type StencilFunc uint32
+
+func (e StencilFunc) String() string {
+	switch e {
+	case NEVER:
+		return "GL_NEVER"
+	case LESS:
+		return "GL_LESS"
+	case EQUAL:
+		return "GL_EQUAL"
+	case LEQUAL:
+		return "GL_LEQUAL"
+	case GREATER:
+		return "GL_GREATER"
+	case NOTEQUAL:
+		return "GL_NOTEQUAL"
+	case GEQUAL:
+		return "GL_GEQUAL"
+	case ALWAYS:
+		return "GL_ALWAYS"
+	default:
+		return fmt.Sprintf("StencilFunc(0x%X)", uint32(e))
+	}
+}
+
+// DepthFunc is a comparison of the depth test.
+type DepthFunc uint32
+
+func (e DepthFunc) String() string {
+	switch e {
+	case NEVER:
+		return "GL_NEVER"
+	case LESS:
+		return "GL_LESS"
+	case EQUAL:
+		return "GL_EQUAL"
+	case LEQUAL:
+		return "GL_LEQUAL"
+	case GREATER:
+		return "GL_GREATER"
+	case NOTEQUAL:
+		return "GL_NOTEQUAL"
+	case GEQUAL:
+		return "GL_GEQUAL"
+	case ALWAYS:
+		return "GL_ALWAYS"
+	default:
+		return fmt.Sprintf("DepthFunc(0x%X)", uint32(e))
+	}
+}
+
+// InternalFormat is the format a texture or render buffer stores its pixels in.
+type InternalFormat uint32
+
+func (e InternalFormat) String() string {
+	switch e {
+	case STENCIL_INDEX:
+		return "GL_STENCIL_INDEX"
+	case DEPTH_COMPONENT:
+		return "GL_DEPTH_COMPONENT"
+	case RED:
+		return "GL_RED"
+	case RGB:
+		return "GL_RGB"
+	case RGBA:
+		return "GL_RGBA"
+	case R3_G3_B2:
+		return "GL_R3_G3_B2"
+	case RGB4:
+		return "GL_RGB4"
+	case RGB5:
+		return "GL_RGB5"
+	case RGB8:
+		return "GL_RGB8"
+	case RGB10:
+		return "GL_RGB10"
+	case RGB12:
+		return "GL_RGB12"
+	case RGB16:
+		return "GL_RGB16"
+	case RGBA2:
+		return "GL_RGBA2"
+	case RGBA4:
+		return "GL_RGBA4"
+	case RGB5_A1:
+		return "GL_RGB5_A1"
+	case RGBA8:
+		return "GL_RGBA8"
+	case RGB10_A2:
+		return "GL_RGB10_A2"
+	case RGBA12:
+		return "GL_RGBA12"
+	case RGBA16:
+		return "GL_RGBA16"
+	case DEPTH_COMPONENT16:
+		return "GL_DEPTH_COMPONENT16"
+	case DEPTH_COMPONENT24:
+		return "GL_DEPTH_COMPONENT24"
+	case DEPTH_COMPONENT32:
+		return "GL_DEPTH_COMPONENT32"
+	case COMPRESSED_RED:
+		return "GL_COMPRESSED_RED"
+	case COMPRESSED_RG:
+		return "GL_COMPRESSED_RG"
+	case RG:
+		return "GL_RG"
+	case R8:
+		return "GL_R8"
+	case R16:
+		return "GL_R16"
+	case RG8:
+		return "GL_RG8"
+	case RG16:
+		return "GL_RG16"
+	case R16F:
+		return "GL_R16F"
+	case R32F:
+		return "GL_R32F"
+	case RG16F:
+		return "GL_RG16F"
+	case RG32F:
+		return "GL_RG32F"
+	case R8I:
+		return "GL_R8I"
+	case R8UI:
+		return "GL_R8UI"
+	case R16I:
+		return "GL_R16I"
+	case R16UI:
+		return "GL_R16UI"
+	case R32I:
+		return "GL_R32I"
+	case R32UI:
+		return "GL_R32UI"
+	case RG8I:
+		return "GL_RG8I"
+	case RG8UI:
+		return "GL_RG8UI"
+	case RG16I:
+		return "GL_RG16I"
+	case RG16UI:
+		return "GL_RG16UI"
+	case RG32I:
+		return "GL_RG32I"
+	case RG32UI:
+		return "GL_RG32UI"
+	case COMPRESSED_RGB_S3TC_DXT1_EXT:
+		return "GL_COMPRESSED_RGB_S3TC_DXT1_EXT"
+	case COMPRESSED_RGBA_S3TC_DXT1_EXT:
+		return "GL_COMPRESSED_RGBA_S3TC_DXT1_EXT"
+	case COMPRESSED_RGBA_S3TC_DXT3_EXT:
+		return "GL_COMPRESSED_RGBA_S3TC_DXT3_EXT"
+	case COMPRESSED_RGBA_S3TC_DXT5_EXT:
+		return "GL_COMPRESSED_RGBA_S3TC_DXT5_EXT"
+	case COMPRESSED_RGB:
+		return "GL_COMPRESSED_RGB"
+	case COMPRESSED_RGBA:
+		return "GL_COMPRESSED_RGBA"
+	case DEPTH_STENCIL:
+		return "GL_DEPTH_STENCIL"
+	case RGBA32F:
+		return "GL_RGBA32F"
+	case RGB32F:
+		return "GL_RGB32F"
+	case RGBA16F:
+		return "GL_RGBA16F"
+	case RGB16F:
+		return "GL_RGB16F"
+	case DEPTH24_STENCIL8:
+		return "GL_DEPTH24_STENCIL8"
+	case R11F_G11F_B10F:
+		return "GL_R11F_G11F_B10F"
+	case RGB9_E5:
+		return "GL_RGB9_E5"
+	case SRGB:
+		return "GL_SRGB"
+	case SRGB8:
+		return "GL_SRGB8"
+	case SRGB_ALPHA:
+		return "GL_SRGB_ALPHA"
+	case SRGB8_ALPHA8:
+		return "GL_SRGB8_ALPHA8"
+	case COMPRESSED_SRGB:
+		return "GL_COMPRESSED_SRGB"
+	case COMPRESSED_SRGB_ALPHA:
+		return "GL_COMPRESSED_SRGB_ALPHA"
+	case DEPTH_COMPONENT32F:
+		return "GL_DEPTH_COMPONENT32F"
+	case DEPTH32F_STENCIL8:
+		return "GL_DEPTH32F_STENCIL8"
+	case STENCIL_INDEX1:
+		return "GL_STENCIL_INDEX1"
+	case STENCIL_INDEX4:
+		return "GL_STENCIL_INDEX4"
+	case STENCIL_INDEX8:
+		return "GL_STENCIL_INDEX8"
+	case STENCIL_INDEX16:
+		return "GL_STENCIL_INDEX16"
+	case RGB565:
+		return "GL_RGB565"
+	case RGBA32UI:
+		return "GL_RGBA32UI"
+	case RGB32UI:
+		return "GL_RGB32UI"
+	case RGBA16UI:
+		return "GL_RGBA16UI"
+	case RGB16UI:
+		return "GL_RGB16UI"
+	case RGBA8UI:
+		return "GL_RGBA8UI"
+	case RGB8UI:
+		return "GL_RGB8UI"
+	case RGBA32I:
+		return "GL_RGBA32I"
+	case RGB32I:
+		return "GL_RGB32I"
+	case RGBA16I:
+		return "GL_RGBA16I"
+	case RGB16I:
+		return "GL_RGB16I"
+	case RGBA8I:
+		return "GL_RGBA8I"
+	case RGB8I:
+		return "GL_RGB8I"
+	case COMPRESSED_RED_RGTC1:
+		return "GL_COMPRESSED_RED_RGTC1"
+	case COMPRESSED_SIGNED_RED_RGTC1:
+		return "GL_COMPRESSED_SIGNED_RED_RGTC1"
+	case COMPRESSED_RG_RGTC2:
+		return "GL_COMPRESSED_RG_RGTC2"
+	case COMPRESSED_SIGNED_RG_RGTC2:
+		return "GL_COMPRESSED_SIGNED_RG_RGTC2"
+	case COMPRESSED_RGBA_BPTC_UNORM_ARB:
+		return "GL_COMPRESSED_RGBA_BPTC_UNORM_ARB"
+	case COMPRESSED_SRGB_ALPHA_BPTC_UNORM_ARB:
+		return "GL_COMPRESSED_SRGB_ALPHA_BPTC_UNORM_ARB"
+	case COMPRESSED_RGB_BPTC_SIGNED_FLOAT_ARB:
+		return "GL_COMPRESSED_RGB_BPTC_SIGNED_FLOAT_ARB"
+	case COMPRESSED_RGB_BPTC_UNSIGNED_FLOAT_ARB:
+		return "GL_COMPRESSED_RGB_BPTC_UNSIGNED_FLOAT_ARB"
+	case R8_SNORM:
+		return "GL_R8_SNORM"
+	case RG8_SNORM:
+		return "GL_RG8_SNORM"
+	case RGB8_SNORM:
+		return "GL_RGB8_SNORM"
+	case RGBA8_SNORM:
+		return "GL_RGBA8_SNORM"
+	case R16_SNORM:
+		return "GL_R16_SNORM"
+	case RG16_SNORM:
+		return "GL_RG16_SNORM"
+	case RGB16_SNORM:
+		return "GL_RGB16_SNORM"
+	case RGBA16_SNORM:
+		return "GL_RGBA16_SNORM"
+	case RGB10_A2UI:
+		return "GL_RGB10_A2UI"
+	case COMPRESSED_R11_EAC:
+		return "GL_COMPRESSED_R11_EAC"
+	case COMPRESSED_SIGNED_R11_EAC:
+		return "GL_COMPRESSED_SIGNED_R11_EAC"
+	case COMPRESSED_RG11_EAC:
+		return "GL_COMPRESSED_RG11_EAC"
+	case COMPRESSED_SIGNED_RG11_EAC:
+		return "GL_COMPRESSED_SIGNED_RG11_EAC"
+	case COMPRESSED_RGB8_ETC2:
+		return "GL_COMPRESSED_RGB8_ETC2"
+	case COMPRESSED_SRGB8_ETC2:
+		return "GL_COMPRESSED_SRGB8_ETC2"
+	case COMPRESSED_RGB8_PUNCHTHROUGH_ALPHA1_ETC2:
+		return "GL_COMPRESSED_RGB8_PUNCHTHROUGH_ALPHA1_ETC2"
+	case COMPRESSED_SRGB8_PUNCHTHROUGH_ALPHA1_ETC2:
+		return "GL_COMPRESSED_SRGB8_PUNCHTHROUGH_ALPHA1_ETC2"
+	case COMPRESSED_RGBA8_ETC2_EAC:
+		return "GL_COMPRESSED_RGBA8_ETC2_EAC"
+	case COMPRESSED_SRGB8_ALPHA8_ETC2_EAC:
+		return "GL_COMPRESSED_SRGB8_ALPHA8_ETC2_EAC"
+	default:
+		return fmt.Sprintf("InternalFormat(0x%X)", uint32(e))
+	}
+}
+
+// PixelFormat is the format of pixel data in client memory.
+type PixelFormat uint32
+
+func (e PixelFormat) String() string {
+	switch e {
+	case UNSIGNED_SHORT:
+		return "GL_UNSIGNED_SHORT"
+	case UNSIGNED_INT:
+		return "GL_UNSIGNED_INT"
+	case STENCIL_INDEX:
+		return "GL_STENCIL_INDEX"
+	case DEPTH_COMPONENT:
+		return "GL_DEPTH_COMPONENT"
+	case RED:
+		return "GL_RED"
+	case GREEN:
+		return "GL_GREEN"
+	case BLUE:
+		return "GL_BLUE"
+	case ALPHA:
+		return "GL_ALPHA"
+	case RGB:
+		return "GL_RGB"
+	case RGBA:
+		return "GL_RGBA"
+	case BGR:
+		return "GL_BGR"
+	case BGRA:
+		return "GL_BGRA"
+	case RG:
+		return "GL_RG"
+	case RG_INTEGER:
+		return "GL_RG_INTEGER"
+	case DEPTH_STENCIL:
+		return "GL_DEPTH_STENCIL"
+	case RED_INTEGER:
+		return "GL_RED_INTEGER"
+	case GREEN_INTEGER:
+		return "GL_GREEN_INTEGER"
+	case BLUE_INTEGER:
+		return "GL_BLUE_INTEGER"
+	case RGB_INTEGER:
+		return "GL_RGB_INTEGER"
+	case RGBA_INTEGER:
+		return "GL_RGBA_INTEGER"
+	case BGR_INTEGER:
+		return "GL_BGR_INTEGER"
+	case BGRA_INTEGER:
+		return "GL_BGRA_INTEGER"
+	default:
+		return fmt.Sprintf("PixelFormat(0x%X)", uint32(e))
+	}
+}
+
+// PixelType is the data type of pixel data in client memory.
+type PixelType uint32
+
+func (e PixelType) String() string {
+	switch e {
+	case BYTE:
+		return "GL_BYTE"
+	case UNSIGNED_BYTE:
+		return "GL_UNSIGNED_BYTE"
+	case SHORT:
+		return "GL_SHORT"
+	case UNSIGNED_SHORT:
+		return "GL_UNSIGNED_SHORT"
+	case INT:
+		return "GL_INT"
+	case UNSIGNED_INT:
+		return "GL_UNSIGNED_INT"
+	case FLOAT:
+		return "GL_FLOAT"
+	case HALF_FLOAT:
+		return "GL_HALF_FLOAT"
+	case UNSIGNED_BYTE_3_3_2:
+		return "GL_UNSIGNED_BYTE_3_3_2"
+	case UNSIGNED_SHORT_4_4_4_4:
+		return "GL_UNSIGNED_SHORT_4_4_4_4"
+	case UNSIGNED_SHORT_5_5_5_1:
+		return "GL_UNSIGNED_SHORT_5_5_5_1"
+	case UNSIGNED_INT_8_8_8_8:
+		return "GL_UNSIGNED_INT_8_8_8_8"
+	case UNSIGNED_INT_10_10_10_2:
+		return "GL_UNSIGNED_INT_10_10_10_2"
+	case UNSIGNED_BYTE_2_3_3_REV:
+		return "GL_UNSIGNED_BYTE_2_3_3_REV"
+	case UNSIGNED_SHORT_5_6_5:
+		return "GL_UNSIGNED_SHORT_5_6_5"
+	case UNSIGNED_SHORT_5_6_5_REV:
+		return "GL_UNSIGNED_SHORT_5_6_5_REV"
+	case UNSIGNED_SHORT_4_4_4_4_REV:
+		return "GL_UNSIGNED_SHORT_4_4_4_4_REV"
+	case UNSIGNED_SHORT_1_5_5_5_REV:
+		return "GL_UNSIGNED_SHORT_1_5_5_5_REV"
+	case UNSIGNED_INT_8_8_8_8_REV:
+		return "GL_UNSIGNED_INT_8_8_8_8_REV"
+	case UNSIGNED_INT_2_10_10_10_REV:
+		return "GL_UNSIGNED_INT_2_10_10_10_REV"
+	case UNSIGNED_INT_24_8:
+		return "GL_UNSIGNED_INT_24_8"
+	case UNSIGNED_INT_10F_11F_11F_REV:
+		return "GL_UNSIGNED_INT_10F_11F_11F_REV"
+	case UNSIGNED_INT_5_9_9_9_REV:
+		return "GL_UNSIGNED_INT_5_9_9_9_REV"
+	case FLOAT_32_UNSIGNED_INT_24_8_REV:
+		return "GL_FLOAT_32_UNSIGNED_INT_24_8_REV"
+	default:
+		return fmt.Sprintf("PixelType(0x%X)", uint32(e))
+	}
+}
+
+// VertexAttribType is the data type of the components of a vertex attribute.
+type VertexAttribType uint32
+
+func (e VertexAttribType) String() string {
+	switch e {
+	case BYTE:
+		return "GL_BYTE"
+	case UNSIGNED_BYTE:
+		return "GL_UNSIGNED_BYTE"
+	case SHORT:
+		return "GL_SHORT"
+	case UNSIGNED_SHORT:
+		return "GL_UNSIGNED_SHORT"
+	case INT:
+		return "GL_INT"
+	case UNSIGNED_INT:
+		return "GL_UNSIGNED_INT"
+	case FLOAT:
+		return "GL_FLOAT"
+	case DOUBLE:
+		return "GL_DOUBLE"
+	case HALF_FLOAT:
+		return "GL_HALF_FLOAT"
+	case FIXED:
+		return "GL_FIXED"
+	case UNSIGNED_INT_2_10_10_10_REV:
+		return "GL_UNSIGNED_INT_2_10_10_10_REV"
+	case UNSIGNED_INT_10F_11F_11F_REV:
+		return "GL_UNSIGNED_INT_10F_11F_11F_REV"
+	case INT_2_10_10_10_REV:
+		return "GL_INT_2_10_10_10_REV"
+	default:
+		return fmt.Sprintf("VertexAttribType(0x%X)", uint32(e))
+	}
+}
+
+// PrimitiveType is a kind of primitive to render.
+type PrimitiveType uint32
+
+func (e PrimitiveType) String() string {
+	switch e {
+	case POINTS:
+		return "GL_POINTS"
+	case LINES:
+		return "GL_LINES"
+	case LINE_LOOP:
+		return "GL_LINE_LOOP"
+	case LINE_STRIP:
+		return "GL_LINE_STRIP"
+	case TRIANGLES:
+		return "GL_TRIANGLES"
+	case TRIANGLE_STRIP:
+		return "GL_TRIANGLE_STRIP"
+	case TRIANGLE_FAN:
+		return "GL_TRIANGLE_FAN"
+	case QUADS:
+		return "GL_QUADS"
+	case LINES_ADJACENCY:
+		return "GL_LINES_ADJACENCY"
+	case LINE_STRIP_ADJACENCY:
+		return "GL_LINE_STRIP_ADJACENCY"
+	case TRIANGLES_ADJACENCY:
+		return "GL_TRIANGLES_ADJACENCY"
+	case TRIANGLE_STRIP_ADJACENCY:
+		return "GL_TRIANGLE_STRIP_ADJACENCY"
+	case PATCHES:
+		return "GL_PATCHES"
+	default:
+		return fmt.Sprintf("PrimitiveType(0x%X)", uint32(e))
+	}
+}
+
+// FramebufferTarget is a binding point of framebuffers.
+type FramebufferTarget uint32
+
+func (e FramebufferTarget) String() string {
+	switch e {
+	case READ_FRAMEBUFFER:
+		return "GL_READ_FRAMEBUFFER"
+	case DRAW_FRAMEBUFFER:
+		return "GL_DRAW_FRAMEBUFFER"
+	case FRAMEBUFFER:
+		return "GL_FRAMEBUFFER"
+	default:
+		return fmt.Sprintf("FramebufferTarget(0x%X)", uint32(e))
+	}
+}
+
+// FramebufferAttachment is an attachment point of a framebuffer.
+type FramebufferAttachment uint32
+
+func (e FramebufferAttachment) String() string {
+	switch e {
+	case DEPTH_STENCIL_ATTACHMENT:
+		return "GL_DEPTH_STENCIL_ATTACHMENT"
+	case COLOR_ATTACHMENT0:
+		return "GL_COLOR_ATTACHMENT0"
+	case COLOR_ATTACHMENT1:
+		return "GL_COLOR_ATTACHMENT1"
+	case COLOR_ATTACHMENT2:
+		return "GL_COLOR_ATTACHMENT2"
+	case COLOR_ATTACHMENT3:
+		return "GL_COLOR_ATTACHMENT3"
+	case COLOR_ATTACHMENT4:
+		return "GL_COLOR_ATTACHMENT4"
+	case COLOR_ATTACHMENT5:
+		return "GL_COLOR_ATTACHMENT5"
+	case COLOR_ATTACHMENT6:
+		return "GL_COLOR_ATTACHMENT6"
+	case COLOR_ATTACHMENT7:
+		return "GL_COLOR_ATTACHMENT7"
+	case COLOR_ATTACHMENT8:
+		return "GL_COLOR_ATTACHMENT8"
+	case COLOR_ATTACHMENT9:
+		return "GL_COLOR_ATTACHMENT9"
+	case COLOR_ATTACHMENT10:
+		return "GL_COLOR_ATTACHMENT10"
+	case COLOR_ATTACHMENT11:
+		return "GL_COLOR_ATTACHMENT11"
+	case COLOR_ATTACHMENT12:
+		return "GL_COLOR_ATTACHMENT12"
+	case COLOR_ATTACHMENT13:
+		return "GL_COLOR_ATTACHMENT13"
+	case COLOR_ATTACHMENT14:
+		return "GL_COLOR_ATTACHMENT14"
+	case COLOR_ATTACHMENT15:
+		return "GL_COLOR_ATTACHMENT15"
+	case DEPTH_ATTACHMENT:
+		return "GL_DEPTH_ATTACHMENT"
+	case STENCIL_ATTACHMENT:
+		return "GL_STENCIL_ATTACHMENT"
+	default:
+		return fmt.Sprintf("FramebufferAttachment(0x%X)", uint32(e))
+	}
+}
+
+// ColorBuffer is a color buffer to draw into or read from.
+type ColorBuffer uint32
+
+func (e ColorBuffer) String() string {
+	switch e {
+	case NONE:
+		return "GL_NONE"
+	case FRONT_LEFT:
+		return "GL_FRONT_LEFT"
+	case FRONT_RIGHT:
+		return "GL_FRONT_RIGHT"
+	case BACK_LEFT:
+		return "GL_BACK_LEFT"
+	case BACK_RIGHT:
+		return "GL_BACK_RIGHT"
+	case FRONT:
+		return "GL_FRONT"
+	case BACK:
+		return "GL_BACK"
+	case LEFT:
+		return "GL_LEFT"
+	case RIGHT:
+		return "GL_RIGHT"
+	case FRONT_AND_BACK:
+		return "GL_FRONT_AND_BACK"
+	case COLOR_ATTACHMENT0:
+		return "GL_COLOR_ATTACHMENT0"
+	case COLOR_ATTACHMENT1:
+		return "GL_COLOR_ATTACHMENT1"
+	case COLOR_ATTACHMENT2:
+		return "GL_COLOR_ATTACHMENT2"
+	case COLOR_ATTACHMENT3:
+		return "GL_COLOR_ATTACHMENT3"
+	case COLOR_ATTACHMENT4:
+		return "GL_COLOR_ATTACHMENT4"
+	case COLOR_ATTACHMENT5:
+		return "GL_COLOR_ATTACHMENT5"
+	case COLOR_ATTACHMENT6:
+		return "GL_COLOR_ATTACHMENT6"
+	case COLOR_ATTACHMENT7:
+		return "GL_COLOR_ATTACHMENT7"
+	case COLOR_ATTACHMENT8:
+		return "GL_COLOR_ATTACHMENT8"
+	case COLOR_ATTACHMENT9:
+		return "GL_COLOR_ATTACHMENT9"
+	case COLOR_ATTACHMENT10:
+		return "GL_COLOR_ATTACHMENT10"
+	case COLOR_ATTACHMENT11:
+		return "GL_COLOR_ATTACHMENT11"
+	case COLOR_ATTACHMENT12:
+		return "GL_COLOR_ATTACHMENT12"
+	case COLOR_ATTACHMENT13:
+		return "GL_COLOR_ATTACHMENT13"
+	case COLOR_ATTACHMENT14:
+		return "GL_COLOR_ATTACHMENT14"
+	case COLOR_ATTACHMENT15:
+		return "GL_COLOR_ATTACHMENT15"
+	default:
+		return fmt.Sprintf("ColorBuffer(0x%X)", uint32(e))
+	}
+}
+
+// BlendEquation is how the blended source and destination colors are combined.
+type BlendEquation uint32
+
+func (e BlendEquation) String() string {
+	switch e {
+	case FUNC_ADD:
+		return "GL_FUNC_ADD"
+	case MIN:
+		return "GL_MIN"
+	case MAX:
+		return "GL_MAX"
+	case FUNC_SUBTRACT:
+		return "GL_FUNC_SUBTRACT"
+	case FUNC_REVERSE_SUBTRACT:
+		return "GL_FUNC_REVERSE_SUBTRACT"
+	default:
+		return fmt.Sprintf("BlendEquation(0x%X)", uint32(e))
+	}
+}
+
+// BlendFactor is a factor the source or destination color is multiplied by before blending.
+type BlendFactor uint32
+
+func (e BlendFactor) String() string {
+	switch e {
+	case ZERO:
+		return "GL_ZERO"
+	case ONE:
+		return "GL_ONE"
+	case SRC_COLOR:
+		return "GL_SRC_COLOR"
+	case ONE_MINUS_SRC_COLOR:
+		return "GL_ONE_MINUS_SRC_COLOR"
+	case SRC_ALPHA:
+		return "GL_SRC_ALPHA"
+	case ONE_MINUS_SRC_ALPHA:
+		return "GL_ONE_MINUS_SRC_ALPHA"
+	case DST_ALPHA:
+		return "GL_DST_ALPHA"
+	case ONE_MINUS_DST_ALPHA:
+		return "GL_ONE_MINUS_DST_ALPHA"
+	case DST_COLOR:
+		return "GL_DST_COLOR"
+	case ONE_MINUS_DST_COLOR:
+		return "GL_ONE_MINUS_DST_COLOR"
+	case SRC_ALPHA_SATURATE:
+		return "GL_SRC_ALPHA_SATURATE"
+	case CONSTANT_COLOR:
+		return "GL_CONSTANT_COLOR"
+	case ONE_MINUS_CONSTANT_COLOR:
+		return "GL_ONE_MINUS_CONSTANT_COLOR"
+	case CONSTANT_ALPHA:
+		return "GL_CONSTANT_ALPHA"
+	case ONE_MINUS_CONSTANT_ALPHA:
+		return "GL_ONE_MINUS_CONSTANT_ALPHA"
+	case SRC1_ALPHA:
+		return "GL_SRC1_ALPHA"
+	case SRC1_COLOR:
+		return "GL_SRC1_COLOR"
+	case ONE_MINUS_SRC1_COLOR:
+		return "GL_ONE_MINUS_SRC1_COLOR"
+	case ONE_MINUS_SRC1_ALPHA:
+		return "GL_ONE_MINUS_SRC1_ALPHA"
+	default:
+		return fmt.Sprintf("BlendFactor(0x%X)", uint32(e))
+	}
+}
+
+// Face is a face of polygons, for culling and the stencil test.
+type Face uint32
+
+func (e Face) String() string {
+	switch e {
+	case FRONT:
+		return "GL_FRONT"
+	case BACK:
+		return "GL_BACK"
+	case FRONT_AND_BACK:
+		return "GL_FRONT_AND_BACK"
+	default:
+		return fmt.Sprintf("Face(0x%X)", uint32(e))
+	}
+}
+
+// Winding is the orientation of front-facing polygons.
+type Winding uint32
+
+func (e Winding) String() string {
+	switch e {
+	case CW:
+		return "GL_CW"
+	case CCW:
+		return "GL_CCW"
+	default:
+		return fmt.Sprintf("Winding(0x%X)", uint32(e))
+	}
+}
+
+// PolygonMode is how polygons are rasterized.
+type PolygonMode uint32
+
+func (e PolygonMode) String() string {
+	switch e {
+	case POINT:
+		return "GL_POINT"
+	case LINE:
+		return "GL_LINE"
+	case FILL:
+		return "GL_FILL"
+	default:
+		return fmt.Sprintf("PolygonMode(0x%X)", uint32(e))
+	}
+}
+
+// IndexType is the type of the indices of an element array.
+type IndexType uint32
+
+func (e IndexType) String() string {
+	switch e {
+	case UNSIGNED_BYTE:
+		return "GL_UNSIGNED_BYTE"
+	case UNSIGNED_SHORT:
+		return "GL_UNSIGNED_SHORT"
+	case UNSIGNED_INT:
+		return "GL_UNSIGNED_INT"
+	default:
+		return fmt.Sprintf("IndexType(0x%X)", uint32(e))
+	}
+}
+
+// SyncStatus is the result of waiting on a sync object.
+type SyncStatus uint32
+
+func (e SyncStatus) String() string {
+	switch e {
+	case ALREADY_SIGNALED:
+		return "GL_ALREADY_SIGNALED"
+	case TIMEOUT_EXPIRED:
+		return "GL_TIMEOUT_EXPIRED"
+	case CONDITION_SATISFIED:
+		return "GL_CONDITION_SATISFIED"
+	case WAIT_FAILED:
+		return "GL_WAIT_FAILED"
+	default:
+		return fmt.Sprintf("SyncStatus(0x%X)", uint32(e))
+	}
+}
+
+// AttributeType is the type of an active attribute of a program, as declared in the shaders.
+type AttributeType uint32
+
+func (e AttributeType) String() string {
+	switch e {
+	case INT:
+		return "GL_INT"
+	case UNSIGNED_INT:
+		return "GL_UNSIGNED_INT"
+	case FLOAT:
+		return "GL_FLOAT"
+	case DOUBLE:
+		return "GL_DOUBLE"
+	case FLOAT_VEC2:
+		return "GL_FLOAT_VEC2"
+	case FLOAT_VEC3:
+		return "GL_FLOAT_VEC3"
+	case FLOAT_VEC4:
+		return "GL_FLOAT_VEC4"
+	case INT_VEC2:
+		return "GL_INT_VEC2"
+	case INT_VEC3:
+		return "GL_INT_VEC3"
+	case INT_VEC4:
+		return "GL_INT_VEC4"
+	case FLOAT_MAT2:
+		return "GL_FLOAT_MAT2"
+	case FLOAT_MAT3:
+		return "GL_FLOAT_MAT3"
+	case FLOAT_MAT4:
+		return "GL_FLOAT_MAT4"
+	case FLOAT_MAT2x3:
+		return "GL_FLOAT_MAT2x3"
+	case FLOAT_MAT2x4:
+		return "GL_FLOAT_MAT2x4"
+	case FLOAT_MAT3x2:
+		return "GL_FLOAT_MAT3x2"
+	case FLOAT_MAT3x4:
+		return "GL_FLOAT_MAT3x4"
+	case FLOAT_MAT4x2:
+		return "GL_FLOAT_MAT4x2"
+	case FLOAT_MAT4x3:
+		return "GL_FLOAT_MAT4x3"
+	case UNSIGNED_INT_VEC2:
+		return "GL_UNSIGNED_INT_VEC2"
+	case UNSIGNED_INT_VEC3:
+		return "GL_UNSIGNED_INT_VEC3"
+	case UNSIGNED_INT_VEC4:
+		return "GL_UNSIGNED_INT_VEC4"
+	case DOUBLE_MAT2:
+		return "GL_DOUBLE_MAT2"
+	case DOUBLE_MAT3:
+		return "GL_DOUBLE_MAT3"
+	case DOUBLE_MAT4:
+		return "GL_DOUBLE_MAT4"
+	case DOUBLE_MAT2x3:
+		return "GL_DOUBLE_MAT2x3"
+	case DOUBLE_MAT2x4:
+		return "GL_DOUBLE_MAT2x4"
+	case DOUBLE_MAT3x2:
+		return "GL_DOUBLE_MAT3x2"
+	case DOUBLE_MAT3x4:
+		return "GL_DOUBLE_MAT3x4"
+	case DOUBLE_MAT4x2:
+		return "GL_DOUBLE_MAT4x2"
+	case DOUBLE_MAT4x3:
+		return "GL_DOUBLE_MAT4x3"
+	case DOUBLE_VEC2:
+		return "GL_DOUBLE_VEC2"
+	case DOUBLE_VEC3:
+		return "GL_DOUBLE_VEC3"
+	case DOUBLE_VEC4:
+		return "GL_DOUBLE_VEC4"
+	default:
+		return fmt.Sprintf("AttributeType(0x%X)", uint32(e))
+	}
+}
@@ -2,15 +2,145 @@ package gl
 
 import (
 	"errors"
-	"github.com/go-gl/gl/v3.3-core/gl"
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
 )
 
-// GetError returns a go error representing the OpenGL error, if any.
+// Error is an OpenGL error along with where it came from. It matches the
+// sentinel errors of every code it holds with errors.Is.
+type Error struct {
+	// Codes are all the error codes drained from the error queue, oldest
+	// first. OpenGL may keep one code per error flag so there can be several.
+	Codes []uint32
+
+	// Func is the function of this package that was called, like
+	// "Buffer.Data", and Caller the file:line it was called from. Func is
+	// empty when the error was only found by GetError.
+	Func   string
+	Caller string
+
+	// Call is the OpenGL entry point that generated the error, like
+	// "glBufferData", and Args its arguments. They are only known to
+	// CheckErrors.
+	Call string
+	Args []interface{}
+}
+
+func (e *Error) Error() string {
+	codes := make([]string, len(e.Codes))
+	for i, code := range e.Codes {
+		codes[i] = glErrorToerror(code).Error()
+	}
+	msg := strings.Join(codes, ", ")
+	if e.Func != "" {
+		msg += " in " + e.Func
+	}
+	if e.Call != "" {
+		args := make([]string, len(e.Args))
+		for i, arg := range e.Args {
+			args[i] = fmt.Sprint(arg)
+		}
+		msg += fmt.Sprintf(" (%s(%s))", e.Call, strings.Join(args, ", "))
+	}
+	if e.Caller != "" {
+		msg += " at " + e.Caller
+	}
+	return msg
+}
+
+// Unwrap returns the sentinel error of every code, for errors.Is.
+func (e *Error) Unwrap() []error {
+	errs := make([]error, len(e.Codes))
+	for i, code := range e.Codes {
+		errs[i] = glErrorToerror(code)
+	}
+	return errs
+}
+
+// GetError drains the OpenGL error queue and returns an *Error holding every
+// code in it, nil if there was none.
 func GetError() error {
-	if err := gl.GetError(); err != gl.NO_ERROR {
-		return glErrorToerror(err)
+	codes := drainErrors(backend)
+	if len(codes) == 0 {
+		return nil
+	}
+	_, caller := callerInfo()
+	return &Error{Codes: codes, Caller: caller}
+}
+
+// CheckErrors runs fn and checks for an error after every OpenGL call it
+// makes. It returns an *Error describing the first call that failed, nil if
+// none did. Errors pending before fn runs are discarded. Checking every call
+// stalls the pipeline, use it to narrow down a failure, not every frame.
+func CheckErrors(fn func()) error {
+	drainErrors(backend)
+	var err *Error
+	c := &checkedBackend{Backend: backend, before: noCheckBefore}
+	c.after = func(call string, args ...interface{}) {
+		codes := drainErrors(c.Backend)
+		if len(codes) == 0 || err != nil {
+			return
+		}
+		fn, caller := callerInfo()
+		err = &Error{Codes: codes, Func: fn, Caller: caller, Call: call, Args: args}
+	}
+	backend = c
+	defer func() {
+		// A Backend from outside of the package may keep c in the chain,
+		// keep it going but stop checking.
+		c.after = noCheckAfter
+		unlinkLayer(c)
+	}()
+	fn()
+	if err == nil {
+		return nil
+	}
+	return err
+}
+
+// maxErrors bounds the number of codes drained at once, a lost context may
+// never stop reporting errors.
+const maxErrors = 16
+
+// drainErrors calls glGetError until the queue is empty.
+func drainErrors(b Backend) []uint32 {
+	var codes []uint32
+	for len(codes) < maxErrors {
+		code := b.GetError()
+		if code == NO_ERROR {
+			break
+		}
+		codes = append(codes, code)
+		if code == CONTEXT_LOST {
+			break
+		}
+	}
+	return codes
+}
+
+// pkgPath is the import path of this package, used to tell its frames apart
+// from the user's.
+var pkgPath = reflect.TypeOf(Error{}).PkgPath()
+
+// callerInfo returns the outermost function of this package on the stack and
+// the file:line of the code that called it. The tests of the package count as
+// code calling it.
+func callerInfo() (fn, caller string) {
+	var pcs [32]uintptr
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs[:])])
+	for {
+		frame, more := frames.Next()
+		name, ok := strings.CutPrefix(frame.Function, pkgPath+".")
+		if !ok || strings.HasSuffix(frame.File, "_test.go") {
+			return fn, fmt.Sprintf("%s:%d", frame.File, frame.Line)
+		}
+		fn = name
+		if !more {
+			return fn, ""
+		}
 	}
-	return nil
 }
 
 // These errors represent all the error OpenGL can generate. Seriously there are
@@ -23,27 +153,30 @@ var (
 	ErrOutOfMemory                 = errors.New("GL_OUT_OF_MEMORY")
 	ErrStackUnderflow              = errors.New("GL_STACK_UNDERFLOW")
 	ErrStackOverflow               = errors.New("GL_STACK_OVERFLOW")
+	ErrContextLost                 = errors.New("GL_CONTEXT_LOST")
 	ErrUnknown                     = errors.New("Unknown error code")
 )
 
 func glErrorToerror(err uint32) error {
 	switch err {
-	case gl.NO_ERROR:
+	case NO_ERROR:
 		return nil
-	case gl.INVALID_ENUM:
+	case INVALID_ENUM:
 		return ErrInvalidEnum
-	case gl.INVALID_VALUE:
+	case INVALID_VALUE:
 		return ErrInvalidValue
-	case gl.INVALID_OPERATION:
+	case INVALID_OPERATION:
 		return ErrInvalidOperation
-	case gl.INVALID_FRAMEBUFFER_OPERATION:
+	case INVALID_FRAMEBUFFER_OPERATION:
 		return ErrInvalidFramebufferOperation
-	case gl.OUT_OF_MEMORY:
+	case OUT_OF_MEMORY:
 		return ErrOutOfMemory
-	case gl.STACK_UNDERFLOW:
+	case STACK_UNDERFLOW:
 		return ErrStackUnderflow
-	case gl.STACK_OVERFLOW:
+	case STACK_OVERFLOW:
 		return ErrStackOverflow
+	case CONTEXT_LOST:
+		return ErrContextLost
 	default:
 		return ErrUnknown
 	}
@@ -0,0 +1,111 @@
+package gl
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestGetError(t *testing.T) {
+	tests := []struct {
+		name   string
+		queue  []uint32
+		want   []uint32
+		wantIs []error
+	}{
+		{"none", nil, nil, nil},
+		{"one", []uint32{INVALID_ENUM}, []uint32{INVALID_ENUM}, []error{ErrInvalidEnum}},
+		{"several", []uint32{INVALID_VALUE, OUT_OF_MEMORY}, []uint32{INVALID_VALUE, OUT_OF_MEMORY}, []error{ErrInvalidValue, ErrOutOfMemory}},
+		{"context lost", []uint32{CONTEXT_LOST, INVALID_OPERATION}, []uint32{CONTEXT_LOST}, []error{ErrContextLost}},
+		{"unknown", []uint32{0x1234}, []uint32{0x1234}, []error{ErrUnknown}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFake(t)
+			f.Errors = tt.queue
+			err := GetError()
+			if tt.want == nil {
+				if err != nil {
+					t.Errorf("GetError() = %v, want nil", err)
+				}
+				return
+			}
+			var glErr *Error
+			if !errors.As(err, &glErr) || !equalCodes(glErr.Codes, tt.want) {
+				t.Fatalf("GetError() = %v, want codes %#x", err, tt.want)
+			}
+			for _, target := range tt.wantIs {
+				if !errors.Is(err, target) {
+					t.Errorf("errors.Is(%v, %v) = false", err, target)
+				}
+			}
+		})
+	}
+}
+
+func TestGetErrorDrainsAtMost(t *testing.T) {
+	f := newFake(t)
+	for i := 0; i < maxErrors+4; i++ {
+		f.Errors = append(f.Errors, OUT_OF_MEMORY)
+	}
+	var glErr *Error
+	if !errors.As(GetError(), &glErr) || len(glErr.Codes) != maxErrors {
+		t.Errorf("GetError() drained %d codes, want %d", len(glErr.Codes), maxErrors)
+	}
+}
+
+func TestCheckErrors(t *testing.T) {
+	f := newFake(t)
+	f.Errors = []uint32{INVALID_VALUE}
+	err := CheckErrors(func() {
+		ClearColor.Set(0, 0, 0, 1)
+		f.Errors = append(f.Errors, INVALID_ENUM)
+		Enable(DEPTH_TEST)
+		f.Errors = append(f.Errors, INVALID_OPERATION)
+		ClearColor.Set(1, 1, 1, 1)
+	})
+	var glErr *Error
+	if !errors.As(err, &glErr) {
+		t.Fatalf("CheckErrors() = %v, want an *Error", err)
+	}
+	// The tests are in the package, Func and Caller point to the testing
+	// package.
+	if !equalCodes(glErr.Codes, []uint32{INVALID_ENUM}) || glErr.Call != "glEnable" || len(glErr.Args) != 1 || glErr.Args[0] != uint32(DEPTH_TEST) {
+		t.Errorf("CheckErrors() = %+v, want INVALID_ENUM in glEnable(DEPTH_TEST)", glErr)
+	}
+	if _, ok := backend.(*checkedBackend); ok {
+		t.Error("CheckErrors left its backend installed")
+	}
+	if err := CheckErrors(func() { ClearColor.Set(0, 0, 0, 1) }); err != nil {
+		t.Errorf("CheckErrors() without errors = %v, want nil", err)
+	}
+}
+
+func TestCheckErrorsBelowLayers(t *testing.T) {
+	f := newFake(t)
+	err := CheckErrors(func() {
+		EnableStateCache()
+		f.Errors = append(f.Errors, INVALID_ENUM)
+		Enable(BLEND)
+	})
+	defer DisableStateCache()
+	if err == nil {
+		t.Error("CheckErrors() = nil through the state cache, want an error")
+	}
+	for b := backend; b != Backend(f); b = *b.(backendLayer).next() {
+		if _, ok := b.(*checkedBackend); ok {
+			t.Fatal("CheckErrors left its backend below the state cache")
+		}
+	}
+}
+
+func equalCodes(a, b []uint32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
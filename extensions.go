@@ -0,0 +1,165 @@
+package gl
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Extension is the name of an OpenGL extension, like "GL_ARB_buffer_storage".
+type Extension string
+
+// Well-known extensions, any other name can be converted to Extension.
+const (
+	ARB_bindless_texture             Extension = "GL_ARB_bindless_texture"
+	ARB_buffer_storage               Extension = "GL_ARB_buffer_storage"
+	ARB_clip_control                 Extension = "GL_ARB_clip_control"
+	ARB_compute_shader               Extension = "GL_ARB_compute_shader"
+	ARB_copy_image                   Extension = "GL_ARB_copy_image"
+	ARB_debug_output                 Extension = "GL_ARB_debug_output"
+	ARB_direct_state_access          Extension = "GL_ARB_direct_state_access"
+	ARB_get_program_binary           Extension = "GL_ARB_get_program_binary"
+	ARB_gl_spirv                     Extension = "GL_ARB_gl_spirv"
+	ARB_invalidate_subdata           Extension = "GL_ARB_invalidate_subdata"
+	ARB_multi_draw_indirect          Extension = "GL_ARB_multi_draw_indirect"
+	ARB_parallel_shader_compile      Extension = "GL_ARB_parallel_shader_compile"
+	ARB_robustness                   Extension = "GL_ARB_robustness"
+	ARB_separate_shader_objects      Extension = "GL_ARB_separate_shader_objects"
+	ARB_shader_storage_buffer_object Extension = "GL_ARB_shader_storage_buffer_object"
+	ARB_sparse_texture               Extension = "GL_ARB_sparse_texture"
+	ARB_texture_compression_bptc     Extension = "GL_ARB_texture_compression_bptc"
+	ARB_texture_filter_anisotropic   Extension = "GL_ARB_texture_filter_anisotropic"
+	ARB_texture_storage              Extension = "GL_ARB_texture_storage"
+	ARB_timer_query                  Extension = "GL_ARB_timer_query"
+	ARB_transform_feedback2          Extension = "GL_ARB_transform_feedback2"
+	ARB_vertex_attrib_binding        Extension = "GL_ARB_vertex_attrib_binding"
+	EXT_buffer_storage               Extension = "GL_EXT_buffer_storage"
+	EXT_color_buffer_float           Extension = "GL_EXT_color_buffer_float"
+	EXT_disjoint_timer_query         Extension = "GL_EXT_disjoint_timer_query"
+	EXT_texture_compression_s3tc     Extension = "GL_EXT_texture_compression_s3tc"
+	EXT_texture_filter_anisotropic   Extension = "GL_EXT_texture_filter_anisotropic"
+	KHR_debug                        Extension = "GL_KHR_debug"
+	KHR_parallel_shader_compile      Extension = "GL_KHR_parallel_shader_compile"
+	KHR_robustness                   Extension = "GL_KHR_robustness"
+	KHR_texture_compression_astc_ldr Extension = "GL_KHR_texture_compression_astc_ldr"
+	OES_texture_float_linear         Extension = "GL_OES_texture_float_linear"
+)
+
+// Extensions is a set of extensions.
+type Extensions map[Extension]struct{}
+
+// Has returns true if ext is in the set.
+func (e Extensions) Has(ext Extension) bool {
+	_, ok := e[ext]
+	return ok
+}
+
+// List returns the extensions of the set, sorted.
+func (e Extensions) List() []Extension {
+	list := make([]Extension, 0, len(e))
+	for ext := range e {
+		list = append(list, ext)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
+	return list
+}
+
+// Require returns a *MissingExtensionsError listing every extension of exts
+// that isn't in the set, nil if they all are.
+func (e Extensions) Require(exts ...Extension) error {
+	var missing []Extension
+	for _, ext := range exts {
+		if !e.Has(ext) {
+			missing = append(missing, ext)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	return &MissingExtensionsError{Missing: missing}
+}
+
+// MissingExtensionsError is returned by Require when some of the required
+// extensions aren't available.
+type MissingExtensionsError struct {
+	Missing []Extension
+}
+
+func (e *MissingExtensionsError) Error() string {
+	names := make([]string, len(e.Missing))
+	for i, ext := range e.Missing {
+		names[i] = string(ext)
+	}
+	return "gl: missing extensions " + strings.Join(names, ", ")
+}
+
+// extensionCache holds the extensions of the context, queried once.
+type extensionCache struct {
+	sync.Mutex
+	loaded Extensions // nil until the first query
+	masked Extensions
+	set    Extensions // loaded without masked
+}
+
+var cachedExtensions extensionCache
+
+// updateSet rebuilds set from loaded and masked. c must be locked.
+func (c *extensionCache) updateSet() {
+	if c.loaded == nil {
+		return
+	}
+	c.set = make(Extensions, len(c.loaded))
+	for ext := range c.loaded {
+		if !c.masked.Has(ext) {
+			c.set[ext] = struct{}{}
+		}
+	}
+}
+
+// AvailableExtensions returns the extensions of the context, without the
+// masked ones. They are queried once, on the first call after Init, which
+// must be on the context thread. The set must not be modified.
+func AvailableExtensions() Extensions {
+	c := &cachedExtensions
+	c.Lock()
+	defer c.Unlock()
+	if c.loaded == nil {
+		c.loaded = Extensions{}
+		for _, ext := range GetExtensions() {
+			c.loaded[Extension(ext)] = struct{}{}
+		}
+		c.updateSet()
+	}
+	return c.set
+}
+
+// Require returns a *MissingExtensionsError listing every extension of exts
+// the context doesn't have, nil if it has them all.
+func Require(exts ...Extension) error {
+	return AvailableExtensions().Require(exts...)
+}
+
+// MaskExtensions makes the context look like it doesn't have exts, to test
+// the fallback paths of an application. It replaces the previous mask,
+// MaskExtensions() with no arguments unmasks every extension. It also affects
+// IsExtensionAvailable and the extensions this package picks, like KHR_debug
+// for EnableDebugOutput.
+func MaskExtensions(exts ...Extension) {
+	c := &cachedExtensions
+	c.Lock()
+	defer c.Unlock()
+	c.masked = Extensions{}
+	for _, ext := range exts {
+		c.masked[ext] = struct{}{}
+	}
+	c.updateSet()
+}
+
+// resetExtensions forgets the queried extensions, the next query reloads
+// them. The mask is kept.
+func resetExtensions() {
+	c := &cachedExtensions
+	c.Lock()
+	c.loaded, c.set = nil, nil
+	c.Unlock()
+}
@@ -0,0 +1,79 @@
+package gl
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRequire(t *testing.T) {
+	tests := []struct {
+		name        string
+		available   []string
+		masked      []Extension
+		required    []Extension
+		wantMissing []Extension
+	}{
+		{"nothing required", nil, nil, nil, nil},
+		{"available", []string{string(KHR_debug), string(ARB_timer_query)}, nil, []Extension{KHR_debug}, nil},
+		{"missing", []string{string(KHR_debug)}, nil, []Extension{ARB_timer_query, KHR_debug, ARB_buffer_storage}, []Extension{ARB_timer_query, ARB_buffer_storage}},
+		{"masked", []string{string(KHR_debug), string(ARB_timer_query)}, []Extension{KHR_debug}, []Extension{KHR_debug, ARB_timer_query}, []Extension{KHR_debug}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFake(t)
+			f.Extensions = tt.available
+			resetExtensions()
+			defer resetExtensions()
+			MaskExtensions(tt.masked...)
+			defer MaskExtensions()
+
+			err := Require(tt.required...)
+			var missing *MissingExtensionsError
+			switch {
+			case tt.wantMissing == nil && err != nil:
+				t.Errorf("Require() = %v, want nil", err)
+			case tt.wantMissing != nil && (!errors.As(err, &missing) || !equalExtensions(missing.Missing, tt.wantMissing)):
+				t.Errorf("Require() = %v, want %v missing", err, tt.wantMissing)
+			}
+			for _, ext := range tt.masked {
+				if IsExtensionAvailable(string(ext)) {
+					t.Errorf("IsExtensionAvailable(%s) = true for a masked extension", ext)
+				}
+			}
+		})
+	}
+}
+
+func TestAvailableExtensionsCached(t *testing.T) {
+	f := newFake(t)
+	f.Extensions = []string{string(KHR_debug), string(ARB_buffer_storage)}
+	resetExtensions()
+	defer resetExtensions()
+
+	if got, want := AvailableExtensions().List(), []Extension{ARB_buffer_storage, KHR_debug}; !equalExtensions(got, want) {
+		t.Errorf("List() = %v, want %v", got, want)
+	}
+	f.Reset()
+	MaskExtensions(KHR_debug)
+	defer MaskExtensions()
+	if AvailableExtensions().Has(KHR_debug) || !AvailableExtensions().Has(ARB_buffer_storage) {
+		t.Errorf("AvailableExtensions() = %v with KHR_debug masked", AvailableExtensions().List())
+	}
+	MaskExtensions()
+	if !AvailableExtensions().Has(KHR_debug) {
+		t.Error("KHR_debug still masked after MaskExtensions()")
+	}
+	checkCalls(t, f)
+}
+
+func equalExtensions(a, b []Extension) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,1121 @@
+package gl
+
+import "unsafe"
+
+// FakeBackend is an in-memory Backend that doesn't need an OpenGL context. It
+// allocates object names, tracks bindings and records every call issued to it
+// so code using this package can be exercised with a plain go test:
+//
+//	fake := gl.NewFakeBackend()
+//	gl.InitBackend(fake)
+//	buf := gl.GenBuffer()
+//	buf.Bind(gl.ARRAY_BUFFER)
+//	// fake.Calls now holds GenBuffers and BindBuffer
+//
+// It does not render anything nor validate arguments. Queries that aren't
+// derived from the tracked state are answered from Integers, Floats and
+// Strings.
+type FakeBackend struct {
+	// Calls is every call issued to the backend, in order.
+	Calls []Call
+
+	// Errors is the queue of error codes returned by GetError, first in first
+	// out. GetError returns gl.NO_ERROR once it is empty.
+	Errors []uint32
+
+	// Integers answers the integer and boolean queries (glGet*, glGetShaderiv,
+	// glGetProgramiv) that aren't bindings.
+	Integers map[uint32][]int32
+
+	// Floats answers the floating point queries.
+	Floats map[uint32][]float32
+
+	// Strings answers glGetString.
+	Strings map[uint32]string
+
+	// Extensions answers glGetStringi(gl.EXTENSIONS, i) and
+	// glGetIntegerv(gl.NUM_EXTENSIONS).
+	Extensions []string
+
+	// Attributes are the active attributes by program name, they answer
+	// glGetActiveAttrib, glGetAttribLocation and the gl.ACTIVE_ATTRIBUTES
+	// and gl.ACTIVE_ATTRIBUTE_MAX_LENGTH queries of glGetProgramiv.
+	Attributes map[uint32][]ActiveAttribute
+
+	// InfoLogs are the info logs by shader or program name, they answer
+	// glGetShaderInfoLog, glGetProgramInfoLog and their gl.INFO_LOG_LENGTH
+	// queries.
+	InfoLogs map[uint32]string
+
+	debug debugProc
+
+	names    map[objectKind]uint32
+	live     map[objectKind]map[uint32]bool
+	bound    map[fakeBinding]uint32
+	enabled  map[uint32]bool
+	uniforms map[string]int32
+	strings  map[string][]byte
+	buffers  map[uint32][]byte
+	usages   map[uint32]uint32
+	attribs  map[fakeVertexAttrib]map[uint32]int32
+	syncs    uintptr
+}
+
+// Call is a single call recorded by the FakeBackend. Name is the name of the
+// go-gl function and Args its arguments, pointers are recorded as is.
+type Call struct {
+	Name string
+	Args []interface{}
+}
+
+// fakeBinding identifies a binding point of the FakeBackend. unit is only used
+// by textures.
+type fakeBinding struct {
+	kind   objectKind
+	unit   uint32
+	target uint32
+}
+
+// fakeVertexAttrib identifies a vertex attribute of a vertex array.
+type fakeVertexAttrib struct {
+	vao, index uint32
+}
+
+// fakeBindingQueries maps the glGet pnames to the binding point they read.
+var fakeBindingQueries = map[uint32]fakeBinding{
+	ARRAY_BUFFER_BINDING:              {kind: kindBuffer, target: uint32(ARRAY_BUFFER)},
+	COPY_READ_BUFFER_BINDING:          {kind: kindBuffer, target: uint32(COPY_READ_BUFFER)},
+	COPY_WRITE_BUFFER_BINDING:         {kind: kindBuffer, target: uint32(COPY_WRITE_BUFFER)},
+	ELEMENT_ARRAY_BUFFER_BINDING:      {kind: kindBuffer, target: uint32(ELEMENT_ARRAY_BUFFER)},
+	PIXEL_PACK_BUFFER_BINDING:         {kind: kindBuffer, target: uint32(PIXEL_PACK_BUFFER)},
+	PIXEL_UNPACK_BUFFER_BINDING:       {kind: kindBuffer, target: uint32(PIXEL_UNPACK_BUFFER)},
+	TRANSFORM_FEEDBACK_BUFFER_BINDING: {kind: kindBuffer, target: uint32(TRANSFORM_FEEDBACK_BUFFER)},
+	UNIFORM_BUFFER_BINDING:            {kind: kindBuffer, target: uint32(UNIFORM_BUFFER)},
+	TEXTURE_BINDING_1D:                {kind: kindTexture, target: uint32(TEXTURE_1D)},
+	TEXTURE_BINDING_1D_ARRAY:          {kind: kindTexture, target: uint32(TEXTURE_1D_ARRAY)},
+	TEXTURE_BINDING_2D:                {kind: kindTexture, target: uint32(TEXTURE_2D)},
+	TEXTURE_BINDING_2D_ARRAY:          {kind: kindTexture, target: uint32(TEXTURE_2D_ARRAY)},
+	TEXTURE_BINDING_2D_MULTISAMPLE:    {kind: kindTexture, target: uint32(TEXTURE_2D_MULTISAMPLE)},
+	TEXTURE_BINDING_3D:                {kind: kindTexture, target: uint32(TEXTURE_3D)},
+	TEXTURE_BINDING_BUFFER:            {kind: kindTexture, target: TEXTURE_BUFFER},
+	TEXTURE_BINDING_CUBE_MAP:          {kind: kindTexture, target: uint32(TEXTURE_CUBE_MAP)},
+	TEXTURE_BINDING_RECTANGLE:         {kind: kindTexture, target: uint32(TEXTURE_RECTANGLE)},
+	DRAW_FRAMEBUFFER_BINDING:          {kind: kindFramebuffer, target: uint32(DRAW_FRAMEBUFFER)},
+	READ_FRAMEBUFFER_BINDING:          {kind: kindFramebuffer, target: uint32(READ_FRAMEBUFFER)},
+	RENDERBUFFER_BINDING:              {kind: kindRenderBuffer, target: RENDERBUFFER},
+	VERTEX_ARRAY_BINDING:              {kind: kindVertexArray},
+	CURRENT_PROGRAM:                   {kind: kindProgram},
+	TRANSFORM_FEEDBACK_BINDING:        {kind: kindTransformFeedback, target: TRANSFORM_FEEDBACK},
+}
+
+// NewFakeBackend returns a FakeBackend answering like a freshly created
+// OpenGL 3.3 core context where every shader compiles and every program
+// links.
+func NewFakeBackend() *FakeBackend {
+	return &FakeBackend{
+		Integers: map[uint32][]int32{
+			ACTIVE_TEXTURE:  {TEXTURE0},
+			COMPILE_STATUS:  {TRUE},
+			LINK_STATUS:     {TRUE},
+			VALIDATE_STATUS: {TRUE},
+			MAJOR_VERSION:   {3},
+			MINOR_VERSION:   {3},
+		},
+		Floats: map[uint32][]float32{},
+		Strings: map[uint32]string{
+			VENDOR:                   "lux",
+			RENDERER:                 "FakeBackend",
+			VERSION:                  "3.3.0 FakeBackend",
+			SHADING_LANGUAGE_VERSION: "3.30 FakeBackend",
+		},
+		Attributes: map[uint32][]ActiveAttribute{},
+		InfoLogs:   map[uint32]string{},
+		names:      map[objectKind]uint32{},
+		live:       map[objectKind]map[uint32]bool{},
+		bound:      map[fakeBinding]uint32{},
+		enabled:    map[uint32]bool{},
+		uniforms:   map[string]int32{},
+		strings:    map[string][]byte{},
+		buffers:    map[uint32][]byte{},
+		usages:     map[uint32]uint32{},
+		attribs:    map[fakeVertexAttrib]map[uint32]int32{},
+	}
+}
+
+// LiveObjects returns the number of objects allocated through the backend and
+// not deleted yet.
+func (f *FakeBackend) LiveObjects() int {
+	n := 0
+	for _, names := range f.live {
+		n += len(names)
+	}
+	return n
+}
+
+// Reset forgets the recorded calls.
+func (f *FakeBackend) Reset() {
+	f.Calls = f.Calls[:0]
+}
+
+func (f *FakeBackend) record(name string, args ...interface{}) {
+	f.Calls = append(f.Calls, Call{Name: name, Args: args})
+}
+
+// binding returns the binding point of kind on target, on the active texture
+// unit for textures.
+func (f *FakeBackend) binding(kind objectKind, target uint32) fakeBinding {
+	b := fakeBinding{kind: kind, target: target}
+	if kind == kindTexture {
+		if v := f.Integers[ACTIVE_TEXTURE]; len(v) > 0 {
+			b.unit = uint32(v[0])
+		}
+	}
+	return b
+}
+
+func (f *FakeBackend) bind(kind objectKind, target, name uint32) {
+	if kind == kindFramebuffer && target == uint32(FRAMEBUFFER) {
+		f.bound[f.binding(kind, uint32(DRAW_FRAMEBUFFER))] = name
+		f.bound[f.binding(kind, uint32(READ_FRAMEBUFFER))] = name
+		return
+	}
+	f.bound[f.binding(kind, target)] = name
+}
+
+// gen allocates n names of the given kind into names.
+func (f *FakeBackend) gen(kind objectKind, n int32, names *uint32) {
+	if n <= 0 {
+		return
+	}
+	for i, out := 0, unsafe.Slice(names, n); i < len(out); i++ {
+		out[i] = f.create(kind)
+	}
+}
+
+func (f *FakeBackend) create(kind objectKind) uint32 {
+	// Shaders and programs share the same namespace.
+	ns := kind
+	if ns == kindShader {
+		ns = kindProgram
+	}
+	f.names[ns]++
+	if f.live[kind] == nil {
+		f.live[kind] = map[uint32]bool{}
+	}
+	f.live[kind][f.names[ns]] = true
+	return f.names[ns]
+}
+
+// del deletes n names of the given kind and unbinds them.
+func (f *FakeBackend) del(kind objectKind, n int32, names *uint32) {
+	if n <= 0 {
+		return
+	}
+	for _, name := range unsafe.Slice(names, n) {
+		delete(f.live[kind], name)
+		for b, v := range f.bound {
+			if b.kind == kind && v == name {
+				delete(f.bound, b)
+			}
+		}
+	}
+}
+
+// cstr returns a pointer to a NUL terminated copy of s that stays valid for the
+// lifetime of the backend.
+func (f *FakeBackend) cstr(s string) *uint8 {
+	b, ok := f.strings[s]
+	if !ok {
+		b = append([]byte(s), 0)
+		f.strings[s] = b
+	}
+	return &b[0]
+}
+
+// copyStr copies s to the buffer of bufSize bytes at out, truncated and NUL
+// terminated, and sets length to the bytes copied, NUL excluded.
+func copyStr(s string, bufSize int32, length *int32, out *uint8) {
+	n := 0
+	if bufSize > 0 {
+		buf := unsafe.Slice(out, bufSize)
+		n = copy(buf[:bufSize-1], s)
+		buf[n] = 0
+	}
+	if length != nil {
+		*length = int32(n)
+	}
+}
+
+// infoLogLength answers gl.INFO_LOG_LENGTH, which counts the NUL.
+func (f *FakeBackend) infoLogLength(name uint32) int32 {
+	if l := f.InfoLogs[name]; l != "" {
+		return int32(len(l) + 1)
+	}
+	return 0
+}
+
+func (f *FakeBackend) integers(pname uint32) []int32 {
+	switch pname {
+	case NUM_EXTENSIONS:
+		return []int32{int32(len(f.Extensions))}
+	}
+	if b, ok := fakeBindingQueries[pname]; ok {
+		return []int32{int32(f.bound[f.binding(b.kind, b.target)])}
+	}
+	if v, ok := f.enabled[pname]; ok && v {
+		return []int32{TRUE}
+	}
+	return f.Integers[pname]
+}
+
+// bufferData replaces the data store of the buffer bound to target with a
+// copy of data, zeroed if data is nil.
+func (f *FakeBackend) bufferData(target uint32, size int, data unsafe.Pointer, usage uint32) {
+	store := make([]byte, size)
+	if data != nil {
+		copy(store, unsafe.Slice((*byte)(data), size))
+	}
+	name := f.bound[f.binding(kindBuffer, target)]
+	f.buffers[name] = store
+	f.usages[name] = usage
+}
+
+// bufferRange returns size bytes at offset of the data store of the buffer
+// bound to target, nil if they are out of its bounds.
+func (f *FakeBackend) bufferRange(target uint32, offset, size int) []byte {
+	store := f.buffers[f.bound[f.binding(kindBuffer, target)]]
+	if offset < 0 || size < 0 || offset+size > len(store) {
+		return nil
+	}
+	return store[offset : offset+size]
+}
+
+// bufferParameter answers glGetBufferParameter* for the buffer bound to
+// target. Buffers are never mapped as far as queries go.
+func (f *FakeBackend) bufferParameter(target, pname uint32) int32 {
+	name := f.bound[f.binding(kindBuffer, target)]
+	switch pname {
+	case BUFFER_SIZE:
+		return int32(len(f.buffers[name]))
+	case BUFFER_USAGE:
+		return int32(f.usages[name])
+	}
+	return 0
+}
+
+// vertexAttrib returns the state of the attribute index of the bound vertex
+// array by glGetVertexAttribiv pname.
+func (f *FakeBackend) vertexAttrib(index uint32) map[uint32]int32 {
+	key := fakeVertexAttrib{vao: f.bound[f.binding(kindVertexArray, 0)], index: index}
+	a, ok := f.attribs[key]
+	if !ok {
+		a = map[uint32]int32{VERTEX_ATTRIB_ARRAY_SIZE: 4, VERTEX_ATTRIB_ARRAY_TYPE: FLOAT}
+		f.attribs[key] = a
+	}
+	return a
+}
+
+// vertexAttribPointer sets the state of glVertexAttrib*Pointer.
+func (f *FakeBackend) vertexAttribPointer(index uint32, size int32, xtype uint32, normalized, integer, long bool, stride int32) {
+	a := f.vertexAttrib(index)
+	a[VERTEX_ATTRIB_ARRAY_SIZE] = size
+	a[VERTEX_ATTRIB_ARRAY_TYPE] = int32(xtype)
+	a[VERTEX_ATTRIB_ARRAY_NORMALIZED] = fakeBool(normalized)
+	a[VERTEX_ATTRIB_ARRAY_INTEGER] = fakeBool(integer)
+	a[VERTEX_ATTRIB_ARRAY_LONG] = fakeBool(long)
+	a[VERTEX_ATTRIB_ARRAY_STRIDE] = stride
+	a[VERTEX_ATTRIB_ARRAY_BUFFER_BINDING] = int32(f.bound[f.binding(kindBuffer, uint32(ARRAY_BUFFER))])
+}
+
+// stencilFunc sets the state of glStencilFuncSeparate for the faces of face.
+func (f *FakeBackend) stencilFunc(face, xfunc uint32, ref int32, mask uint32) {
+	if face != BACK {
+		f.Integers[STENCIL_FUNC] = []int32{int32(xfunc)}
+		f.Integers[STENCIL_REF] = []int32{ref}
+		f.Integers[STENCIL_VALUE_MASK] = []int32{int32(mask)}
+	}
+	if face != FRONT {
+		f.Integers[STENCIL_BACK_FUNC] = []int32{int32(xfunc)}
+		f.Integers[STENCIL_BACK_REF] = []int32{ref}
+		f.Integers[STENCIL_BACK_VALUE_MASK] = []int32{int32(mask)}
+	}
+}
+
+// stencilMask sets the state of glStencilMaskSeparate for the faces of face.
+func (f *FakeBackend) stencilMask(face, mask uint32) {
+	if face != BACK {
+		f.Integers[STENCIL_WRITEMASK] = []int32{int32(mask)}
+	}
+	if face != FRONT {
+		f.Integers[STENCIL_BACK_WRITEMASK] = []int32{int32(mask)}
+	}
+}
+
+// stencilOp sets the state of glStencilOpSeparate for the faces of face.
+func (f *FakeBackend) stencilOp(face, sfail, dpfail, dppass uint32) {
+	if face != BACK {
+		f.Integers[STENCIL_FAIL] = []int32{int32(sfail)}
+		f.Integers[STENCIL_PASS_DEPTH_FAIL] = []int32{int32(dpfail)}
+		f.Integers[STENCIL_PASS_DEPTH_PASS] = []int32{int32(dppass)}
+	}
+	if face != FRONT {
+		f.Integers[STENCIL_BACK_FAIL] = []int32{int32(sfail)}
+		f.Integers[STENCIL_BACK_PASS_DEPTH_FAIL] = []int32{int32(dpfail)}
+		f.Integers[STENCIL_BACK_PASS_DEPTH_PASS] = []int32{int32(dppass)}
+	}
+}
+
+// fakeBool is the value of b in Integers.
+func fakeBool(b bool) int32 {
+	if b {
+		return TRUE
+	}
+	return FALSE
+}
+
+func (f *FakeBackend) Init() error {
+	f.record("Init")
+	return nil
+}
+
+func (f *FakeBackend) ActiveTexture(texture uint32) {
+	f.record("ActiveTexture", texture)
+	f.Integers[ACTIVE_TEXTURE] = []int32{int32(texture)}
+}
+
+func (f *FakeBackend) BindBuffer(target, buffer uint32) {
+	f.record("BindBuffer", target, buffer)
+	f.bind(kindBuffer, target, buffer)
+}
+
+func (f *FakeBackend) BindBufferBase(target, index, buffer uint32) {
+	f.record("BindBufferBase", target, index, buffer)
+	f.bind(kindBuffer, target, buffer)
+}
+
+func (f *FakeBackend) BindBufferRange(target, index, buffer uint32, offset, size int) {
+	f.record("BindBufferRange", target, index, buffer, offset, size)
+	f.bind(kindBuffer, target, buffer)
+}
+
+func (f *FakeBackend) BindFramebuffer(target, framebuffer uint32) {
+	f.record("BindFramebuffer", target, framebuffer)
+	f.bind(kindFramebuffer, target, framebuffer)
+}
+
+func (f *FakeBackend) BindRenderbuffer(target, renderbuffer uint32) {
+	f.record("BindRenderbuffer", target, renderbuffer)
+	f.bind(kindRenderBuffer, target, renderbuffer)
+}
+
+func (f *FakeBackend) BindTexture(target, texture uint32) {
+	f.record("BindTexture", target, texture)
+	f.bind(kindTexture, target, texture)
+}
+
+func (f *FakeBackend) BindTransformFeedback(target, id uint32) {
+	f.record("BindTransformFeedback", target, id)
+	f.bind(kindTransformFeedback, target, id)
+}
+
+func (f *FakeBackend) BindVertexArray(array uint32) {
+	f.record("BindVertexArray", array)
+	f.bind(kindVertexArray, 0, array)
+}
+
+func (f *FakeBackend) BlendColor(red, green, blue, alpha float32) {
+	f.record("BlendColor", red, green, blue, alpha)
+	f.Floats[BLEND_COLOR] = []float32{red, green, blue, alpha}
+}
+
+func (f *FakeBackend) BlendEquationSeparate(modeRGB, modeAlpha uint32) {
+	f.record("BlendEquationSeparate", modeRGB, modeAlpha)
+	f.Integers[BLEND_EQUATION_RGB] = []int32{int32(modeRGB)}
+	f.Integers[BLEND_EQUATION_ALPHA] = []int32{int32(modeAlpha)}
+}
+
+func (f *FakeBackend) BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha uint32) {
+	f.record("BlendFuncSeparate", srcRGB, dstRGB, srcAlpha, dstAlpha)
+	f.Integers[BLEND_SRC_RGB] = []int32{int32(srcRGB)}
+	f.Integers[BLEND_DST_RGB] = []int32{int32(dstRGB)}
+	f.Integers[BLEND_SRC_ALPHA] = []int32{int32(srcAlpha)}
+	f.Integers[BLEND_DST_ALPHA] = []int32{int32(dstAlpha)}
+}
+
+func (f *FakeBackend) BufferSubData(target uint32, offset, size int, data unsafe.Pointer) {
+	f.record("BufferSubData", target, offset, size, data)
+	copy(f.bufferRange(target, offset, size), unsafe.Slice((*byte)(data), size))
+}
+
+func (f *FakeBackend) ClearDepthf(d float32) {
+	f.record("ClearDepthf", d)
+	f.Floats[DEPTH_CLEAR_VALUE] = []float32{d}
+}
+
+func (f *FakeBackend) ClearStencil(s int32) {
+	f.record("ClearStencil", s)
+	f.Integers[STENCIL_CLEAR_VALUE] = []int32{s}
+}
+
+func (f *FakeBackend) ClientWaitSync(sync uintptr, flags uint32, timeout uint64) uint32 {
+	f.record("ClientWaitSync", sync, flags, timeout)
+	return uint32(ALREADY_SIGNALED)
+}
+
+func (f *FakeBackend) CopyBufferSubData(readTarget, writeTarget uint32, readOffset, writeOffset, size int) {
+	f.record("CopyBufferSubData", readTarget, writeTarget, readOffset, writeOffset, size)
+	copy(f.bufferRange(writeTarget, writeOffset, size), f.bufferRange(readTarget, readOffset, size))
+}
+
+func (f *FakeBackend) DeleteSync(sync uintptr) {
+	f.record("DeleteSync", sync)
+}
+
+func (f *FakeBackend) DepthFunc(xfunc uint32) {
+	f.record("DepthFunc", xfunc)
+	f.Integers[DEPTH_FUNC] = []int32{int32(xfunc)}
+}
+
+func (f *FakeBackend) DepthRangef(near, far float32) {
+	f.record("DepthRangef", near, far)
+	f.Floats[DEPTH_RANGE] = []float32{near, far}
+}
+
+func (f *FakeBackend) FenceSync(condition, flags uint32) uintptr {
+	f.record("FenceSync", condition, flags)
+	f.syncs++
+	return f.syncs
+}
+
+func (f *FakeBackend) FlushMappedBufferRange(target uint32, offset, length int) {
+	f.record("FlushMappedBufferRange", target, offset, length)
+}
+
+func (f *FakeBackend) FrontFace(mode uint32) {
+	f.record("FrontFace", mode)
+	f.Integers[FRONT_FACE] = []int32{int32(mode)}
+}
+
+func (f *FakeBackend) GetActiveAttrib(program, index uint32, bufSize int32, length, size *int32, xtype *uint32, name *uint8) {
+	f.record("GetActiveAttrib", program, index, bufSize, length, size, xtype, name)
+	attribs := f.Attributes[program]
+	if int(index) >= len(attribs) {
+		return
+	}
+	a := attribs[index]
+	*size, *xtype = a.Size, uint32(a.Type)
+	copyStr(a.Name, bufSize, length, name)
+}
+
+func (f *FakeBackend) GetAttribLocation(program uint32, name *uint8) int32 {
+	f.record("GetAttribLocation", program, name)
+	n := glGoStr(name)
+	for _, a := range f.Attributes[program] {
+		if a.Name == n {
+			return a.Location
+		}
+	}
+	return -1
+}
+
+func (f *FakeBackend) GetBufferParameteri64v(target, pname uint32, params *int64) {
+	f.record("GetBufferParameteri64v", target, pname, params)
+	*params = int64(f.bufferParameter(target, pname))
+}
+
+func (f *FakeBackend) GetBufferParameteriv(target, pname uint32, params *int32) {
+	f.record("GetBufferParameteriv", target, pname, params)
+	*params = f.bufferParameter(target, pname)
+}
+
+func (f *FakeBackend) GetVertexAttribiv(index, pname uint32, params *int32) {
+	f.record("GetVertexAttribiv", index, pname, params)
+	*params = f.vertexAttrib(index)[pname]
+}
+
+func (f *FakeBackend) LineWidth(width float32) {
+	f.record("LineWidth", width)
+	f.Floats[LINE_WIDTH] = []float32{width}
+}
+
+func (f *FakeBackend) MapBufferRange(target uint32, offset, length int, access uint32) unsafe.Pointer {
+	f.record("MapBufferRange", target, offset, length, access)
+	if b := f.bufferRange(target, offset, length); len(b) > 0 {
+		return unsafe.Pointer(&b[0])
+	}
+	return nil
+}
+
+func (f *FakeBackend) PixelStorei(pname uint32, param int32) {
+	f.record("PixelStorei", pname, param)
+	f.Integers[pname] = []int32{param}
+}
+
+func (f *FakeBackend) PolygonOffset(factor, units float32) {
+	f.record("PolygonOffset", factor, units)
+	f.Floats[POLYGON_OFFSET_FACTOR] = []float32{factor}
+	f.Floats[POLYGON_OFFSET_UNITS] = []float32{units}
+}
+
+func (f *FakeBackend) SampleCoverage(value float32, invert bool) {
+	f.record("SampleCoverage", value, invert)
+	f.Floats[SAMPLE_COVERAGE_VALUE] = []float32{value}
+	f.Integers[SAMPLE_COVERAGE_INVERT] = []int32{fakeBool(invert)}
+}
+
+func (f *FakeBackend) Scissor(x, y, width, height int32) {
+	f.record("Scissor", x, y, width, height)
+	f.Integers[SCISSOR_BOX] = []int32{x, y, width, height}
+}
+
+func (f *FakeBackend) StencilFuncSeparate(face, xfunc uint32, ref int32, mask uint32) {
+	f.record("StencilFuncSeparate", face, xfunc, ref, mask)
+	f.stencilFunc(face, xfunc, ref, mask)
+}
+
+func (f *FakeBackend) StencilMaskSeparate(face, mask uint32) {
+	f.record("StencilMaskSeparate", face, mask)
+	f.stencilMask(face, mask)
+}
+
+func (f *FakeBackend) StencilOpSeparate(face, sfail, dpfail, dppass uint32) {
+	f.record("StencilOpSeparate", face, sfail, dpfail, dppass)
+	f.stencilOp(face, sfail, dpfail, dppass)
+}
+
+func (f *FakeBackend) UnmapBuffer(target uint32) bool {
+	f.record("UnmapBuffer", target)
+	return true
+}
+
+func (f *FakeBackend) UseProgram(program uint32) {
+	f.record("UseProgram", program)
+	f.bind(kindProgram, 0, program)
+}
+
+func (f *FakeBackend) CheckFramebufferStatus(target uint32) uint32 {
+	f.record("CheckFramebufferStatus", target)
+	return FRAMEBUFFER_COMPLETE
+}
+
+func (f *FakeBackend) CreateProgram() uint32 {
+	f.record("CreateProgram")
+	return f.create(kindProgram)
+}
+
+func (f *FakeBackend) CreateShader(xtype uint32) uint32 {
+	f.record("CreateShader", xtype)
+	return f.create(kindShader)
+}
+
+func (f *FakeBackend) DeleteBuffers(n int32, buffers *uint32) {
+	f.record("DeleteBuffers", n, buffers)
+	f.del(kindBuffer, n, buffers)
+}
+
+func (f *FakeBackend) DeleteFramebuffers(n int32, framebuffers *uint32) {
+	f.record("DeleteFramebuffers", n, framebuffers)
+	f.del(kindFramebuffer, n, framebuffers)
+}
+
+func (f *FakeBackend) DeleteProgram(program uint32) {
+	f.record("DeleteProgram", program)
+	f.del(kindProgram, 1, &program)
+}
+
+func (f *FakeBackend) DeleteRenderbuffers(n int32, renderbuffers *uint32) {
+	f.record("DeleteRenderbuffers", n, renderbuffers)
+	f.del(kindRenderBuffer, n, renderbuffers)
+}
+
+func (f *FakeBackend) DeleteShader(shader uint32) {
+	f.record("DeleteShader", shader)
+	f.del(kindShader, 1, &shader)
+}
+
+func (f *FakeBackend) DeleteTextures(n int32, textures *uint32) {
+	f.record("DeleteTextures", n, textures)
+	f.del(kindTexture, n, textures)
+}
+
+func (f *FakeBackend) DeleteTransformFeedbacks(n int32, ids *uint32) {
+	f.record("DeleteTransformFeedbacks", n, ids)
+	f.del(kindTransformFeedback, n, ids)
+}
+
+func (f *FakeBackend) DeleteVertexArrays(n int32, arrays *uint32) {
+	f.record("DeleteVertexArrays", n, arrays)
+	f.del(kindVertexArray, n, arrays)
+}
+
+func (f *FakeBackend) DebugMessageCallback(callback debugProc, userParam unsafe.Pointer) {
+	f.record("DebugMessageCallback", callback, userParam)
+	f.debug = callback
+}
+
+func (f *FakeBackend) DebugMessageControl(source, xtype, severity uint32, count int32, ids *uint32, enabled bool) {
+	f.record("DebugMessageControl", source, xtype, severity, count, ids, enabled)
+}
+
+// DebugMessageInsert delivers the message to the debug callback, if any, like
+// a driver would.
+func (f *FakeBackend) DebugMessageInsert(source, xtype, id, severity uint32, length int32, buf *uint8) {
+	f.record("DebugMessageInsert", source, xtype, id, severity, length, buf)
+	if f.debug != nil {
+		f.debug(source, xtype, id, severity, length, string(unsafe.Slice(buf, length)), nil)
+	}
+}
+
+func (f *FakeBackend) Disable(cap uint32) {
+	f.record("Disable", cap)
+	f.enabled[cap] = false
+}
+
+func (f *FakeBackend) Enable(cap uint32) {
+	f.record("Enable", cap)
+	f.enabled[cap] = true
+}
+
+func (f *FakeBackend) GenBuffers(n int32, buffers *uint32) {
+	f.record("GenBuffers", n, buffers)
+	f.gen(kindBuffer, n, buffers)
+}
+
+func (f *FakeBackend) GenFramebuffers(n int32, framebuffers *uint32) {
+	f.record("GenFramebuffers", n, framebuffers)
+	f.gen(kindFramebuffer, n, framebuffers)
+}
+
+func (f *FakeBackend) GenRenderbuffers(n int32, renderbuffers *uint32) {
+	f.record("GenRenderbuffers", n, renderbuffers)
+	f.gen(kindRenderBuffer, n, renderbuffers)
+}
+
+func (f *FakeBackend) GenTextures(n int32, textures *uint32) {
+	f.record("GenTextures", n, textures)
+	f.gen(kindTexture, n, textures)
+}
+
+func (f *FakeBackend) GenTransformFeedbacks(n int32, ids *uint32) {
+	f.record("GenTransformFeedbacks", n, ids)
+	f.gen(kindTransformFeedback, n, ids)
+}
+
+func (f *FakeBackend) GenVertexArrays(n int32, arrays *uint32) {
+	f.record("GenVertexArrays", n, arrays)
+	f.gen(kindVertexArray, n, arrays)
+}
+
+func (f *FakeBackend) GetBooleanv(pname uint32, data *bool) {
+	f.record("GetBooleanv", pname, data)
+	v := f.integers(pname)
+	for i, out := 0, unsafe.Slice(data, len(v)); i < len(v); i++ {
+		out[i] = v[i] != 0
+	}
+}
+
+func (f *FakeBackend) GetError() uint32 {
+	f.record("GetError")
+	if len(f.Errors) == 0 {
+		return NO_ERROR
+	}
+	err := f.Errors[0]
+	f.Errors = f.Errors[1:]
+	return err
+}
+
+func (f *FakeBackend) GetFloatv(pname uint32, data *float32) {
+	f.record("GetFloatv", pname, data)
+	copy(unsafe.Slice(data, len(f.Floats[pname])), f.Floats[pname])
+}
+
+func (f *FakeBackend) GetInteger64v(pname uint32, data *int64) {
+	f.record("GetInteger64v", pname, data)
+	v := f.integers(pname)
+	for i, out := 0, unsafe.Slice(data, len(v)); i < len(v); i++ {
+		out[i] = int64(v[i])
+	}
+}
+
+func (f *FakeBackend) GetIntegerv(pname uint32, data *int32) {
+	f.record("GetIntegerv", pname, data)
+	v := f.integers(pname)
+	copy(unsafe.Slice(data, len(v)), v)
+}
+
+func (f *FakeBackend) GetProgramiv(program, pname uint32, params *int32) {
+	f.record("GetProgramiv", program, pname, params)
+	switch pname {
+	case ACTIVE_ATTRIBUTES:
+		*params = int32(len(f.Attributes[program]))
+		return
+	case ACTIVE_ATTRIBUTE_MAX_LENGTH:
+		*params = 0
+		for _, a := range f.Attributes[program] {
+			*params = max(*params, int32(len(a.Name)+1))
+		}
+		return
+	case INFO_LOG_LENGTH:
+		*params = f.infoLogLength(program)
+		return
+	}
+	if v := f.Integers[pname]; len(v) > 0 {
+		*params = v[0]
+	}
+}
+
+func (f *FakeBackend) GetShaderiv(shader, pname uint32, params *int32) {
+	f.record("GetShaderiv", shader, pname, params)
+	if pname == INFO_LOG_LENGTH {
+		*params = f.infoLogLength(shader)
+		return
+	}
+	if v := f.Integers[pname]; len(v) > 0 {
+		*params = v[0]
+	}
+}
+
+func (f *FakeBackend) GetString(name uint32) *uint8 {
+	f.record("GetString", name)
+	return f.cstr(f.Strings[name])
+}
+
+func (f *FakeBackend) GetStringi(name, index uint32) *uint8 {
+	f.record("GetStringi", name, index)
+	if name != EXTENSIONS || int(index) >= len(f.Extensions) {
+		return f.cstr("")
+	}
+	return f.cstr(f.Extensions[index])
+}
+
+func (f *FakeBackend) GetUniformLocation(program uint32, name *uint8) int32 {
+	f.record("GetUniformLocation", program, name)
+	n := glGoStr(name)
+	loc, ok := f.uniforms[n]
+	if !ok {
+		loc = int32(len(f.uniforms))
+		f.uniforms[n] = loc
+	}
+	return loc
+}
+
+func (f *FakeBackend) IsTexture(texture uint32) bool {
+	f.record("IsTexture", texture)
+	return f.live[kindTexture][texture]
+}
+
+func (f *FakeBackend) AttachShader(program, shader uint32) {
+	f.record("AttachShader", program, shader)
+}
+
+func (f *FakeBackend) BeginTransformFeedback(primitiveMode uint32) {
+	f.record("BeginTransformFeedback", primitiveMode)
+}
+
+func (f *FakeBackend) BufferData(target uint32, size int, data unsafe.Pointer, usage uint32) {
+	f.record("BufferData", target, size, data, usage)
+	f.bufferData(target, size, data, usage)
+}
+
+func (f *FakeBackend) ClearColor(red, green, blue, alpha float32) {
+	f.record("ClearColor", red, green, blue, alpha)
+	f.Floats[COLOR_CLEAR_VALUE] = []float32{red, green, blue, alpha}
+}
+
+func (f *FakeBackend) ColorMask(red, green, blue, alpha bool) {
+	f.record("ColorMask", red, green, blue, alpha)
+	f.Integers[COLOR_WRITEMASK] = []int32{fakeBool(red), fakeBool(green), fakeBool(blue), fakeBool(alpha)}
+}
+
+func (f *FakeBackend) CompileShader(shader uint32) {
+	f.record("CompileShader", shader)
+}
+
+func (f *FakeBackend) CopyTexImage2D(target uint32, level int32, internalformat uint32, x, y, width, height, border int32) {
+	f.record("CopyTexImage2D", target, level, internalformat, x, y, width, height, border)
+}
+
+func (f *FakeBackend) CullFace(mode uint32) {
+	f.record("CullFace", mode)
+	f.Integers[CULL_FACE_MODE] = []int32{int32(mode)}
+}
+
+func (f *FakeBackend) DepthMask(flag bool) {
+	f.record("DepthMask", flag)
+	f.Integers[DEPTH_WRITEMASK] = []int32{fakeBool(flag)}
+}
+
+func (f *FakeBackend) DisableVertexAttribArray(index uint32) {
+	f.record("DisableVertexAttribArray", index)
+	f.vertexAttrib(index)[VERTEX_ATTRIB_ARRAY_ENABLED] = FALSE
+}
+
+func (f *FakeBackend) DrawBuffers(n int32, bufs *uint32) {
+	f.record("DrawBuffers", n, bufs)
+}
+
+func (f *FakeBackend) EnableVertexAttribArray(index uint32) {
+	f.record("EnableVertexAttribArray", index)
+	f.vertexAttrib(index)[VERTEX_ATTRIB_ARRAY_ENABLED] = TRUE
+}
+
+func (f *FakeBackend) EndTransformFeedback() {
+	f.record("EndTransformFeedback")
+}
+
+func (f *FakeBackend) FramebufferRenderbuffer(target, attachment, renderbuffertarget, renderbuffer uint32) {
+	f.record("FramebufferRenderbuffer", target, attachment, renderbuffertarget, renderbuffer)
+}
+
+func (f *FakeBackend) GetAttachedShaders(program uint32, maxCount int32, count *int32, shaders *uint32) {
+	f.record("GetAttachedShaders", program, maxCount, count, shaders)
+}
+
+func (f *FakeBackend) GetInteger64i_v(target, index uint32, data *int64) {
+	f.record("GetInteger64i_v", target, index, data)
+}
+
+func (f *FakeBackend) GetIntegeri_v(target, index uint32, data *int32) {
+	f.record("GetIntegeri_v", target, index, data)
+}
+
+func (f *FakeBackend) GetProgramInfoLog(program uint32, bufSize int32, length *int32, infoLog *uint8) {
+	f.record("GetProgramInfoLog", program, bufSize, length, infoLog)
+	copyStr(f.InfoLogs[program], bufSize, length, infoLog)
+}
+
+func (f *FakeBackend) GetShaderInfoLog(shader uint32, bufSize int32, length *int32, infoLog *uint8) {
+	f.record("GetShaderInfoLog", shader, bufSize, length, infoLog)
+	copyStr(f.InfoLogs[shader], bufSize, length, infoLog)
+}
+
+func (f *FakeBackend) GetShaderSource(shader uint32, bufSize int32, length *int32, source *uint8) {
+	f.record("GetShaderSource", shader, bufSize, length, source)
+}
+
+func (f *FakeBackend) GetTexParameterfv(target, pname uint32, params *float32) {
+	f.record("GetTexParameterfv", target, pname, params)
+}
+
+func (f *FakeBackend) GetTexParameteriv(target, pname uint32, params *int32) {
+	f.record("GetTexParameteriv", target, pname, params)
+}
+
+func (f *FakeBackend) LinkProgram(program uint32) {
+	f.record("LinkProgram", program)
+}
+
+func (f *FakeBackend) PauseTransformFeedback() {
+	f.record("PauseTransformFeedback")
+}
+
+func (f *FakeBackend) ReadBuffer(src uint32) {
+	f.record("ReadBuffer", src)
+}
+
+func (f *FakeBackend) ReadPixels(x, y, width, height int32, format, xtype uint32, pixels unsafe.Pointer) {
+	f.record("ReadPixels", x, y, width, height, format, xtype, pixels)
+}
+
+func (f *FakeBackend) RenderbufferStorage(target, internalformat uint32, width, height int32) {
+	f.record("RenderbufferStorage", target, internalformat, width, height)
+}
+
+func (f *FakeBackend) ResumeTransformFeedback() {
+	f.record("ResumeTransformFeedback")
+}
+
+func (f *FakeBackend) ShaderSource(shader uint32, count int32, xstring **uint8, length *int32) {
+	f.record("ShaderSource", shader, count, xstring, length)
+}
+
+func (f *FakeBackend) StencilFunc(xfunc uint32, ref int32, mask uint32) {
+	f.record("StencilFunc", xfunc, ref, mask)
+	f.stencilFunc(FRONT_AND_BACK, xfunc, ref, mask)
+}
+
+func (f *FakeBackend) StencilMask(mask uint32) {
+	f.record("StencilMask", mask)
+	f.stencilMask(FRONT_AND_BACK, mask)
+}
+
+func (f *FakeBackend) StencilOp(fail, zfail, zpass uint32) {
+	f.record("StencilOp", fail, zfail, zpass)
+	f.stencilOp(FRONT_AND_BACK, fail, zfail, zpass)
+}
+
+func (f *FakeBackend) TexImage2D(target uint32, level, internalformat, width, height, border int32, format, xtype uint32, pixels unsafe.Pointer) {
+	f.record("TexImage2D", target, level, internalformat, width, height, border, format, xtype, pixels)
+}
+
+func (f *FakeBackend) TexImage3D(target uint32, level, internalformat, width, height, depth, border int32, format, xtype uint32, pixels unsafe.Pointer) {
+	f.record("TexImage3D", target, level, internalformat, width, height, depth, border, format, xtype, pixels)
+}
+
+func (f *FakeBackend) TexParameterf(target, pname uint32, param float32) {
+	f.record("TexParameterf", target, pname, param)
+}
+
+func (f *FakeBackend) TexParameterfv(target, pname uint32, params *float32) {
+	f.record("TexParameterfv", target, pname, params)
+}
+
+func (f *FakeBackend) TexParameteri(target, pname uint32, param int32) {
+	f.record("TexParameteri", target, pname, param)
+}
+
+func (f *FakeBackend) TexParameteriv(target, pname uint32, params *int32) {
+	f.record("TexParameteriv", target, pname, params)
+}
+
+func (f *FakeBackend) Uniform1f(location int32, v0 float32) {
+	f.record("Uniform1f", location, v0)
+}
+
+func (f *FakeBackend) Uniform1fv(location, count int32, value *float32) {
+	f.record("Uniform1fv", location, count, value)
+}
+
+func (f *FakeBackend) Uniform1i(location, v0 int32) {
+	f.record("Uniform1i", location, v0)
+}
+
+func (f *FakeBackend) Uniform1iv(location, count int32, value *int32) {
+	f.record("Uniform1iv", location, count, value)
+}
+
+func (f *FakeBackend) Uniform1ui(location int32, v0 uint32) {
+	f.record("Uniform1ui", location, v0)
+}
+
+func (f *FakeBackend) Uniform1uiv(location, count int32, value *uint32) {
+	f.record("Uniform1uiv", location, count, value)
+}
+
+func (f *FakeBackend) Uniform2f(location int32, v0, v1 float32) {
+	f.record("Uniform2f", location, v0, v1)
+}
+
+func (f *FakeBackend) Uniform2fv(location, count int32, value *float32) {
+	f.record("Uniform2fv", location, count, value)
+}
+
+func (f *FakeBackend) Uniform2i(location, v0, v1 int32) {
+	f.record("Uniform2i", location, v0, v1)
+}
+
+func (f *FakeBackend) Uniform2iv(location, count int32, value *int32) {
+	f.record("Uniform2iv", location, count, value)
+}
+
+func (f *FakeBackend) Uniform2ui(location int32, v0, v1 uint32) {
+	f.record("Uniform2ui", location, v0, v1)
+}
+
+func (f *FakeBackend) Uniform2uiv(location, count int32, value *uint32) {
+	f.record("Uniform2uiv", location, count, value)
+}
+
+func (f *FakeBackend) Uniform3f(location int32, v0, v1, v2 float32) {
+	f.record("Uniform3f", location, v0, v1, v2)
+}
+
+func (f *FakeBackend) Uniform3fv(location, count int32, value *float32) {
+	f.record("Uniform3fv", location, count, value)
+}
+
+func (f *FakeBackend) Uniform3i(location, v0, v1, v2 int32) {
+	f.record("Uniform3i", location, v0, v1, v2)
+}
+
+func (f *FakeBackend) Uniform3iv(location, count int32, value *int32) {
+	f.record("Uniform3iv", location, count, value)
+}
+
+func (f *FakeBackend) Uniform3ui(location int32, v0, v1, v2 uint32) {
+	f.record("Uniform3ui", location, v0, v1, v2)
+}
+
+func (f *FakeBackend) Uniform3uiv(location, count int32, value *uint32) {
+	f.record("Uniform3uiv", location, count, value)
+}
+
+func (f *FakeBackend) Uniform4f(location int32, v0, v1, v2, v3 float32) {
+	f.record("Uniform4f", location, v0, v1, v2, v3)
+}
+
+func (f *FakeBackend) Uniform4fv(location, count int32, value *float32) {
+	f.record("Uniform4fv", location, count, value)
+}
+
+func (f *FakeBackend) Uniform4i(location, v0, v1, v2, v3 int32) {
+	f.record("Uniform4i", location, v0, v1, v2, v3)
+}
+
+func (f *FakeBackend) Uniform4iv(location, count int32, value *int32) {
+	f.record("Uniform4iv", location, count, value)
+}
+
+func (f *FakeBackend) Uniform4ui(location int32, v0, v1, v2, v3 uint32) {
+	f.record("Uniform4ui", location, v0, v1, v2, v3)
+}
+
+func (f *FakeBackend) Uniform4uiv(location, count int32, value *uint32) {
+	f.record("Uniform4uiv", location, count, value)
+}
+
+func (f *FakeBackend) UniformMatrix2fv(location, count int32, transpose bool, value *float32) {
+	f.record("UniformMatrix2fv", location, count, transpose, value)
+}
+
+func (f *FakeBackend) UniformMatrix2x3fv(location, count int32, transpose bool, value *float32) {
+	f.record("UniformMatrix2x3fv", location, count, transpose, value)
+}
+
+func (f *FakeBackend) UniformMatrix2x4fv(location, count int32, transpose bool, value *float32) {
+	f.record("UniformMatrix2x4fv", location, count, transpose, value)
+}
+
+func (f *FakeBackend) UniformMatrix3fv(location, count int32, transpose bool, value *float32) {
+	f.record("UniformMatrix3fv", location, count, transpose, value)
+}
+
+func (f *FakeBackend) UniformMatrix3x2fv(location, count int32, transpose bool, value *float32) {
+	f.record("UniformMatrix3x2fv", location, count, transpose, value)
+}
+
+func (f *FakeBackend) UniformMatrix3x4fv(location, count int32, transpose bool, value *float32) {
+	f.record("UniformMatrix3x4fv", location, count, transpose, value)
+}
+
+func (f *FakeBackend) UniformMatrix4fv(location, count int32, transpose bool, value *float32) {
+	f.record("UniformMatrix4fv", location, count, transpose, value)
+}
+
+func (f *FakeBackend) UniformMatrix4x2fv(location, count int32, transpose bool, value *float32) {
+	f.record("UniformMatrix4x2fv", location, count, transpose, value)
+}
+
+func (f *FakeBackend) UniformMatrix4x3fv(location, count int32, transpose bool, value *float32) {
+	f.record("UniformMatrix4x3fv", location, count, transpose, value)
+}
+
+func (f *FakeBackend) VertexAttribDivisor(index, divisor uint32) {
+	f.record("VertexAttribDivisor", index, divisor)
+	f.vertexAttrib(index)[VERTEX_ATTRIB_ARRAY_DIVISOR] = int32(divisor)
+}
+
+func (f *FakeBackend) VertexAttribIPointer(index uint32, size int32, xtype uint32, stride int32, pointer unsafe.Pointer) {
+	f.record("VertexAttribIPointer", index, size, xtype, stride, pointer)
+	f.vertexAttribPointer(index, size, xtype, false, true, false, stride)
+}
+
+func (f *FakeBackend) VertexAttribPointer(index uint32, size int32, xtype uint32, normalized bool, stride int32, pointer unsafe.Pointer) {
+	f.record("VertexAttribPointer", index, size, xtype, normalized, stride, pointer)
+	f.vertexAttribPointer(index, size, xtype, normalized, false, false, stride)
+}
+
+func (f *FakeBackend) Viewport(x, y, width, height int32) {
+	f.record("Viewport", x, y, width, height)
+	f.Integers[VIEWPORT] = []int32{x, y, width, height}
+}
@@ -0,0 +1,87 @@
+//go:build !gles30
+
+package gl
+
+import "unsafe"
+
+func (f *FakeBackend) DebugMessageCallbackARB(callback debugProc, userParam unsafe.Pointer) {
+	f.record("DebugMessageCallbackARB", callback, userParam)
+	f.debug = callback
+}
+
+func (f *FakeBackend) DebugMessageControlARB(source, xtype, severity uint32, count int32, ids *uint32, enabled bool) {
+	f.record("DebugMessageControlARB", source, xtype, severity, count, ids, enabled)
+}
+
+func (f *FakeBackend) DebugMessageInsertARB(source, xtype, id, severity uint32, length int32, buf *uint8) {
+	f.record("DebugMessageInsertARB", source, xtype, id, severity, length, buf)
+	if f.debug != nil {
+		f.debug(source, xtype, id, severity, length, string(unsafe.Slice(buf, length)), nil)
+	}
+}
+
+func (f *FakeBackend) GetBufferSubData(target uint32, offset, size int, data unsafe.Pointer) {
+	f.record("GetBufferSubData", target, offset, size, data)
+	copy(unsafe.Slice((*byte)(data), size), f.bufferRange(target, offset, size))
+}
+
+func (f *FakeBackend) GetDoublev(pname uint32, data *float64) {
+	f.record("GetDoublev", pname, data)
+	v := f.Floats[pname]
+	for i, out := 0, unsafe.Slice(data, len(v)); i < len(v); i++ {
+		out[i] = float64(v[i])
+	}
+}
+
+func (f *FakeBackend) BindFragDataLocation(program, color uint32, name *uint8) {
+	f.record("BindFragDataLocation", program, color, name)
+}
+
+func (f *FakeBackend) CopyTexImage1D(target uint32, level int32, internalformat uint32, x, y, width, border int32) {
+	f.record("CopyTexImage1D", target, level, internalformat, x, y, width, border)
+}
+
+func (f *FakeBackend) DrawBuffer(buf uint32) {
+	f.record("DrawBuffer", buf)
+}
+
+func (f *FakeBackend) FramebufferTexture(target, attachment, texture uint32, level int32) {
+	f.record("FramebufferTexture", target, attachment, texture, level)
+}
+
+func (f *FakeBackend) GetBooleani_v(target, index uint32, data *bool) {
+	f.record("GetBooleani_v", target, index, data)
+}
+
+func (f *FakeBackend) GetTexImage(target uint32, level int32, format, xtype uint32, pixels unsafe.Pointer) {
+	f.record("GetTexImage", target, level, format, xtype, pixels)
+}
+
+func (f *FakeBackend) GetTexLevelParameteriv(target uint32, level int32, pname uint32, params *int32) {
+	f.record("GetTexLevelParameteriv", target, level, pname, params)
+}
+
+func (f *FakeBackend) GetTexParameterIiv(target, pname uint32, params *int32) {
+	f.record("GetTexParameterIiv", target, pname, params)
+}
+
+func (f *FakeBackend) GetTexParameterIuiv(target, pname uint32, params *uint32) {
+	f.record("GetTexParameterIuiv", target, pname, params)
+}
+
+func (f *FakeBackend) PolygonMode(face, mode uint32) {
+	f.record("PolygonMode", face, mode)
+	f.Integers[POLYGON_MODE] = []int32{int32(mode), int32(mode)}
+}
+
+func (f *FakeBackend) TexImage1D(target uint32, level, internalformat, width, border int32, format, xtype uint32, pixels unsafe.Pointer) {
+	f.record("TexImage1D", target, level, internalformat, width, border, format, xtype, pixels)
+}
+
+func (f *FakeBackend) TexParameterIiv(target, pname uint32, params *int32) {
+	f.record("TexParameterIiv", target, pname, params)
+}
+
+func (f *FakeBackend) TexParameterIuiv(target, pname uint32, params *uint32) {
+	f.record("TexParameterIuiv", target, pname, params)
+}
@@ -0,0 +1,10 @@
+//go:build gl41 || gl45
+
+package gl
+
+import "unsafe"
+
+func (f *FakeBackend) VertexAttribLPointer(index uint32, size int32, xtype uint32, stride int32, pointer unsafe.Pointer) {
+	f.record("VertexAttribLPointer", index, size, xtype, stride, pointer)
+	f.vertexAttribPointer(index, size, xtype, false, false, true, stride)
+}
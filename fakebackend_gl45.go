@@ -0,0 +1,18 @@
+//go:build gl45
+
+package gl
+
+import "unsafe"
+
+func (f *FakeBackend) InvalidateBufferData(buffer uint32) {
+	f.record("InvalidateBufferData", buffer)
+}
+
+func (f *FakeBackend) InvalidateBufferSubData(buffer uint32, offset, length int) {
+	f.record("InvalidateBufferSubData", buffer, offset, length)
+}
+
+func (f *FakeBackend) BufferStorage(target uint32, size int, data unsafe.Pointer, flags uint32) {
+	f.record("BufferStorage", target, size, data, flags)
+	f.bufferData(target, size, data, 0)
+}
@@ -0,0 +1,128 @@
+package gl
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// newFake installs a FakeBackend for the test and returns it. Safety builds
+// panic on violations so the tests fail on them.
+func newFake(t *testing.T) *FakeBackend {
+	t.Helper()
+	f := NewFakeBackend()
+	if err := InitBackend(f); err != nil {
+		t.Fatal(err)
+	}
+	panicOnSafety(t)
+	return f
+}
+
+// anyArg matches any argument of a recorded call, like a pointer the test
+// doesn't know.
+type anyArg struct{}
+
+func call(name string, args ...interface{}) Call {
+	if args == nil {
+		args = []interface{}{}
+	}
+	return Call{Name: name, Args: args}
+}
+
+// safetyCalls returns calls in safety builds, the queries of their checks,
+// and nil otherwise.
+func safetyCalls(calls ...Call) []Call {
+	if !safetyflag {
+		return nil
+	}
+	return calls
+}
+
+// checkCalls fails the test if the calls recorded by f aren't want. The
+// queries of the active texture unit issued by the safety checks of textures
+// are left out.
+func checkCalls(t *testing.T, f *FakeBackend, want ...Call) {
+	t.Helper()
+	var got []Call
+	for _, c := range f.Calls {
+		if safetyflag && c.Name == "GetIntegerv" && c.Args[0] == uint32(ACTIVE_TEXTURE) {
+			continue
+		}
+		got = append(got, c)
+	}
+	match := len(got) == len(want)
+	for i := 0; match && i < len(want); i++ {
+		match = callMatches(got[i], want[i])
+	}
+	if !match {
+		t.Errorf("calls:\n%s\nwant:\n%s", formatCalls(got), formatCalls(want))
+	}
+}
+
+func callMatches(got, want Call) bool {
+	if got.Name != want.Name || len(got.Args) != len(want.Args) {
+		return false
+	}
+	for i, arg := range want.Args {
+		if _, ok := arg.(anyArg); !ok && !reflect.DeepEqual(got.Args[i], arg) {
+			return false
+		}
+	}
+	return true
+}
+
+func formatCalls(calls []Call) string {
+	s := ""
+	for _, c := range calls {
+		s += fmt.Sprintf("\t%s%v\n", c.Name, c.Args)
+	}
+	return s
+}
+
+// callNames returns the names of the calls recorded by f.
+func callNames(f *FakeBackend) []string {
+	names := make([]string, len(f.Calls))
+	for i, c := range f.Calls {
+		names[i] = c.Name
+	}
+	return names
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// catchPanic returns the value f panicked with, nil if it returned.
+func catchPanic(f func()) (v interface{}) {
+	defer func() { v = recover() }()
+	f()
+	return nil
+}
+
+func TestFakeBackendObjects(t *testing.T) {
+	f := newFake(t)
+	buffers := GenBuffers(3)
+	textures := []Texture{GenTexture(), GenTexture()}
+	if got := f.LiveObjects(); got != 5 {
+		t.Fatalf("LiveObjects() = %d, want 5", got)
+	}
+	if buffers[0] == buffers[1] || Buffer(textures[0]) == Buffer(textures[1]) {
+		t.Errorf("duplicate names: %v %v", buffers, textures)
+	}
+	buffers[0].Bind(ARRAY_BUFFER)
+	buffers[0].Delete()
+	if got := f.LiveObjects(); got != 4 {
+		t.Errorf("LiveObjects() = %d after Delete, want 4", got)
+	}
+	if got := Get.ArrayBufferBinding(); got != 0 {
+		t.Errorf("ARRAY_BUFFER_BINDING = %d after Delete, want 0", got)
+	}
+}
@@ -0,0 +1,63 @@
+package gl
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrWaitFailed is returned by Sync.Wait when glClientWaitSync fails, which
+// means the sync object isn't valid.
+var ErrWaitFailed = errors.New("gl: glClientWaitSync failed")
+
+// Sync is a sync object, a fence in the command stream the client can wait
+// on. Sync objects aren't traced.
+type Sync uintptr
+
+// FenceSync is an alias to glFenceSync(gl.SYNC_GPU_COMMANDS_COMPLETE, 0), the
+// fence is signaled once every command issued before it has completed.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man/html/glFenceSync.xhtml
+func FenceSync() Sync {
+	return Sync(backend.FenceSync(SYNC_GPU_COMMANDS_COMPLETE, 0))
+}
+
+// ClientWait is an alias to glClientWaitSync, it blocks until s is signaled
+// or timeout expires. flush sets gl.SYNC_FLUSH_COMMANDS_BIT, without it a
+// fence still in the unflushed commands may never be reached.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man/html/glClientWaitSync.xhtml
+func (s Sync) ClientWait(flush bool, timeout time.Duration) SyncStatus {
+	var flags uint32
+	if flush {
+		flags = SYNC_FLUSH_COMMANDS_BIT
+	}
+	return SyncStatus(backend.ClientWaitSync(uintptr(s), flags, uint64(timeout.Nanoseconds())))
+}
+
+// Signaled returns true if s is signaled, without blocking.
+func (s Sync) Signaled() bool {
+	status := s.ClientWait(false, 0)
+	return status == ALREADY_SIGNALED || status == CONDITION_SATISFIED
+}
+
+// Wait blocks until s is signaled, however long it takes. It flushes the
+// command stream the first time it waits.
+func (s Sync) Wait() error {
+	flush := true
+	for {
+		switch s.ClientWait(flush, time.Second) {
+		case ALREADY_SIGNALED, CONDITION_SATISFIED:
+			return nil
+		case WAIT_FAILED:
+			return ErrWaitFailed
+		}
+		flush = false
+	}
+}
+
+// Delete is an alias to glDeleteSync.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man/html/glDeleteSync.xhtml
+func (s Sync) Delete() {
+	backend.DeleteSync(uintptr(s))
+}
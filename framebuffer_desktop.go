@@ -0,0 +1,23 @@
+//go:build !gles30
+
+package gl
+
+// Texture is an alias to glFramebufferTexture(target, attachement, texture, level).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glFramebufferTexture.xml
+func (fbo Framebuffer) Texture(target FramebufferTarget, attachement FramebufferAttachment, texture Texture, level int32) {
+	if safetyflag {
+		safetyCheckBound("Framebuffer.Texture", kindFramebuffer, uint32(target), uint32(fbo))
+	}
+	backend.FramebufferTexture(uint32(target), uint32(attachement), uint32(texture), level)
+}
+
+// DrawBuffer is an alias to glDrawBuffer(attachement).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glDrawBuffer.xml
+func (fbo Framebuffer) DrawBuffer(attachement ColorBuffer) {
+	if safetyflag {
+		safetyCheckBound("Framebuffer.DrawBuffer", kindFramebuffer, uint32(DRAW_FRAMEBUFFER), uint32(fbo))
+	}
+	backend.DrawBuffer(uint32(attachement))
+}
@@ -0,0 +1,6 @@
+package gl
+
+// const.go, enums.go, the object functions of objects*.go, the getters of
+// get*.go, the Backend methods of entrypoints*.go and the go-gl backends of
+// backend_gl*.go are generated from the OpenGL registry, see cmd/glgen.
+//go:generate go run ./cmd/glgen
@@ -0,0 +1,372 @@
+// Code generated by glgen from gl.xml. DO NOT EDIT.
+
+//go:build !gles30
+
+package gl
+
+// GetDoublev is an alias to glGetDoublev(pname, params).
+func (GetObj) GetDoublev(pname uint32, params *float64) {
+	backend.GetDoublev(pname, params)
+}
+
+// GetBooleani_v is an alias to glGetBooleani_v(pname, index, data).
+func (GetObj) GetBooleani_v(pname uint32, index uint32, data *bool) {
+	backend.GetBooleani_v(pname, index, data)
+}
+
+// params returns a pair of values indicating the range of widths supported for smooth (antialiased) lines. See glLineWidth.
+func (GetObj) SmoothLineWidthRange() [2]float32 {
+	var params [2]float32
+	backend.GetFloatv(SMOOTH_LINE_WIDTH_RANGE, &params[0])
+	return params
+}
+
+// params returns a single value indicating the level of quantization applied to smooth line width parameters.
+func (GetObj) SmoothLineWidthGranularity() float32 {
+	var params float32
+	backend.GetFloatv(SMOOTH_LINE_WIDTH_GRANULARITY, &params)
+	return params
+}
+
+// params returns a single boolean value indicating whether a fragment's RGBA color values are merged into the framebuffer using a logical operation. The initial value is GL_FALSE. See glLogicOp.
+func (GetObj) ColorLogicOp() bool {
+	var params bool
+	backend.GetBooleanv(uint32(COLOR_LOGIC_OP), &params)
+	return params
+}
+
+// params returns a single boolean value indicating whether double buffering is supported.
+func (GetObj) Doublebuffer() bool {
+	var params bool
+	backend.GetBooleanv(DOUBLEBUFFER, &params)
+	return params
+}
+
+// params returns one value, a symbolic constant indicating which buffers are being drawn to. See glDrawBuffer. The initial value is GL_BACK if there are back buffers, otherwise it is GL_FRONT.
+func (GetObj) DrawBuffer() int32 {
+	var params int32
+	backend.GetIntegerv(DRAW_BUFFER, &params)
+	return params
+}
+
+// params returns a single boolean value indicating whether antialiasing of lines is enabled. The initial value is GL_FALSE. See glLineWidth.
+func (GetObj) LineSmooth() bool {
+	var params bool
+	backend.GetBooleanv(uint32(LINE_SMOOTH), &params)
+	return params
+}
+
+// params returns one value, a symbolic constant indicating the mode of the line antialiasing hint. The initial value is GL_DONT_CARE. See glHint.
+func (GetObj) LineSmoothHint() int32 {
+	var params int32
+	backend.GetIntegerv(LINE_SMOOTH_HINT, &params)
+	return params
+}
+
+// params returns one value, a symbolic constant indicating the selected logic operation mode. The initial value is GL_COPY. See glLogicOp.
+func (GetObj) LogicOpMode() int32 {
+	var params int32
+	backend.GetIntegerv(LOGIC_OP_MODE, &params)
+	return params
+}
+
+// params returns one value, the maximum number of application-defined clipping distances. The value must be at least 8.
+func (GetObj) MaxClipDistances() int32 {
+	var params int32
+	backend.GetIntegerv(MAX_CLIP_DISTANCES, &params)
+	return params
+}
+
+// params returns one value, the number of words for geometry shader uniform variables in all uniform blocks (including default). The value must be at least 1. See glUniform.
+func (GetObj) MaxCombinedGeometryUniformComponents() int32 {
+	var params int32
+	backend.GetIntegerv(MAX_COMBINED_GEOMETRY_UNIFORM_COMPONENTS, &params)
+	return params
+}
+
+// params returns one value, the maximum number of active draw buffers when using dual-source blending. The value must be at least 1. See glBlendFunc and glBlendFuncSeparate.
+func (GetObj) MaxDualSourceDrawBuffers() int32 {
+	var params int32
+	backend.GetIntegerv(MAX_DUAL_SOURCE_DRAW_BUFFERS, &params)
+	return params
+}
+
+// params returns one value. The value gives a rough estimate of the largest rectangular texture that the GL can handle. The value must be at least 1024. Use GL_PROXY_TEXTURE_RECTANGLE to determine if a texture is too large. See glTexImage2D.
+func (GetObj) MaxRectangleTextureSize() int32 {
+	var params int32
+	backend.GetIntegerv(MAX_RECTANGLE_TEXTURE_SIZE, &params)
+	return params
+}
+
+// params returns one value. The value gives the maximum number of texels allowed in the texel array of a texture buffer object. Value must be at least 65536.
+func (GetObj) MaxTextureBufferSize() int32 {
+	var params int32
+	backend.GetIntegerv(MAX_TEXTURE_BUFFER_SIZE, &params)
+	return params
+}
+
+// params returns one value, the maximum number of interpolators available for processing varying variables used by vertex and fragment shaders. This value represents the number of individual floating-point values that can be interpolated; varying variables declared as vectors, matrices, and arrays will all consume multiple interpolators. The value must be at least 32.
+func (GetObj) MaxVaryingFloats() int32 {
+	var params int32
+	backend.GetIntegerv(MAX_VARYING_FLOATS, &params)
+	return params
+}
+
+// params returns one value, the maximum supported texture image units that can be used to access texture maps from the geometry shader. The value must be at least 16. See glActiveTexture.
+func (GetObj) MaxGeometryTextureImageUnits() int32 {
+	var params int32
+	backend.GetIntegerv(MAX_GEOMETRY_TEXTURE_IMAGE_UNITS, &params)
+	return params
+}
+
+// params returns one value, the maximum number of individual floating-point, integer, or boolean values that can be held in uniform variable storage for a geometry shader. The value must be at least 1024. See glUniform.
+func (GetObj) MaxGeometryUniformComponents() int32 {
+	var params int32
+	backend.GetIntegerv(MAX_GEOMETRY_UNIFORM_COMPONENTS, &params)
+	return params
+}
+
+// params returns one value, the maximum number of sample mask words.
+func (GetObj) MaxSampleMaskWords() int32 {
+	var params int32
+	backend.GetIntegerv(MAX_SAMPLE_MASK_WORDS, &params)
+	return params
+}
+
+// params returns one value, the maximum number of samples in a color multisample texture.
+func (GetObj) MaxColorTextureSamples() int32 {
+	var params int32
+	backend.GetIntegerv(MAX_COLOR_TEXTURE_SAMPLES, &params)
+	return params
+}
+
+// params returns one value, the maximum number of samples in a multisample depth or depth-stencil texture.
+func (GetObj) MaxDepthTextureSamples() int32 {
+	var params int32
+	backend.GetIntegerv(MAX_DEPTH_TEXTURE_SAMPLES, &params)
+	return params
+}
+
+// params returns one value, the maximum number of samples supported in integer format multisample buffers.
+func (GetObj) MaxIntegerSamples() int32 {
+	var params int32
+	backend.GetIntegerv(MAX_INTEGER_SAMPLES, &params)
+	return params
+}
+
+// params returns one value, the maximum number of uniform blocks per geometry shader. The value must be at least 12. See glUniformBlockBinding.
+func (GetObj) MaxGeometryUniformBlocks() int32 {
+	var params int32
+	backend.GetIntegerv(MAX_GEOMETRY_UNIFORM_BLOCKS, &params)
+	return params
+}
+
+// params returns one value, the maximum number of components of inputs read by a geometry shader, which must be at least 64.
+func (GetObj) MaxGeometryInputComponents() int32 {
+	var params int32
+	backend.GetIntegerv(MAX_GEOMETRY_INPUT_COMPONENTS, &params)
+	return params
+}
+
+// params returns one value, the maximum number of components of outputs written by a geometry shader, which must be at least 128.
+func (GetObj) MaxGeometryOutputComponents() int32 {
+	var params int32
+	backend.GetIntegerv(MAX_GEOMETRY_OUTPUT_COMPONENTS, &params)
+	return params
+}
+
+// params returns one value, the image height used for writing pixel data to memory. The initial value is 0. See glPixelStore.
+func (GetObj) PackImageHeight() int32 {
+	var params int32
+	backend.GetIntegerv(PACK_IMAGE_HEIGHT, &params)
+	return params
+}
+
+// params returns a single boolean value indicating whether single-bit pixels being written to memory are written first to the least significant bit of each unsigned byte. The initial value is GL_FALSE. See glPixelStore.
+func (GetObj) PackLsbFirst() bool {
+	var params bool
+	backend.GetBooleanv(PACK_LSB_FIRST, &params)
+	return params
+}
+
+// params returns one value, the number of pixel images skipped before the first pixel is written into memory. The initial value is 0. See glPixelStore.
+func (GetObj) PackSkipImages() int32 {
+	var params int32
+	backend.GetIntegerv(PACK_SKIP_IMAGES, &params)
+	return params
+}
+
+// params returns a single boolean value indicating whether the bytes of two-byte and four-byte pixel indices and components are swapped before being written to memory. The initial value is GL_FALSE. See glPixelStore.
+func (GetObj) PackSwapBytes() bool {
+	var params bool
+	backend.GetBooleanv(PACK_SWAP_BYTES, &params)
+	return params
+}
+
+// params returns one value, the point size threshold for determining the point size. See glPointParameter.
+func (GetObj) PointFadeThresholdSize() float32 {
+	var params float32
+	backend.GetFloatv(POINT_FADE_THRESHOLD_SIZE, &params)
+	return params
+}
+
+// params returns one value, the current primitive restart index. The initial value is 0. See glPrimitiveRestartIndex.
+func (GetObj) PrimitiveRestartIndex() int32 {
+	var params int32
+	backend.GetIntegerv(PRIMITIVE_RESTART_INDEX, &params)
+	return params
+}
+
+// params returns a single boolean value indicating whether vertex program point size mode is enabled. If enabled, then the point size is taken from the shader built-in gl_PointSize. If disabled, then the point size is taken from the point state as specified by glPointSize. The initial value is GL_FALSE.
+func (GetObj) ProgramPointSize() bool {
+	var params bool
+	backend.GetBooleanv(uint32(PROGRAM_POINT_SIZE), &params)
+	return params
+}
+
+// params returns one value, the currently selected provoking vertex convention. The initial value is GL_LAST_VERTEX_CONVENTION. See glProvokingVertex.
+func (GetObj) ProvokingVertex() int32 {
+	var params int32
+	backend.GetIntegerv(PROVOKING_VERTEX, &params)
+	return params
+}
+
+// params returns one value, the point size as specified by glPointSize. The initial value is 1.
+func (GetObj) PointSize() float32 {
+	var params float32
+	backend.GetFloatv(POINT_SIZE, &params)
+	return params
+}
+
+// params returns one value, the size difference between adjacent supported sizes for antialiased points. See glPointSize.
+func (GetObj) PointSizeGranularity() float32 {
+	var params float32
+	backend.GetFloatv(POINT_SIZE_GRANULARITY, &params)
+	return params
+}
+
+// params returns two values: the smallest and largest supported sizes for antialiased points. The smallest size must be at most 1, and the largest size must be at least 1. See glPointSize.
+func (GetObj) PointSizeRange() [2]float32 {
+	var params [2]float32
+	backend.GetFloatv(POINT_SIZE_RANGE, &params[0])
+	return params
+}
+
+// params returns two values: symbolic constants indicating whether front-facing and back-facing polygons are rasterized as points, lines, or filled polygons. The initial value is GL_FILL. See glPolygonMode.
+func (GetObj) PolygonMode() [2]int32 {
+	var params [2]int32
+	backend.GetIntegerv(POLYGON_MODE, &params[0])
+	return params
+}
+
+// params returns a single boolean value indicating whether polygon offset is enabled for polygons in line mode. The initial value is GL_FALSE. See glPolygonOffset.
+func (GetObj) PolygonOffsetLine() bool {
+	var params bool
+	backend.GetBooleanv(uint32(POLYGON_OFFSET_LINE), &params)
+	return params
+}
+
+// params returns a single boolean value indicating whether polygon offset is enabled for polygons in point mode. The initial value is GL_FALSE. See glPolygonOffset.
+func (GetObj) PolygonOffsetPoint() bool {
+	var params bool
+	backend.GetBooleanv(uint32(POLYGON_OFFSET_POINT), &params)
+	return params
+}
+
+// params returns a single boolean value indicating whether antialiasing of polygons is enabled. The initial value is GL_FALSE. See glPolygonMode.
+func (GetObj) PolygonSmooth() bool {
+	var params bool
+	backend.GetBooleanv(uint32(POLYGON_SMOOTH), &params)
+	return params
+}
+
+// params returns one value, a symbolic constant indicating the mode of the polygon antialiasing hint. The initial value is GL_DONT_CARE. See glHint.
+func (GetObj) PolygonSmoothHint() int32 {
+	var params int32
+	backend.GetIntegerv(POLYGON_SMOOTH_HINT, &params)
+	return params
+}
+
+// params returns a single boolean value indicating whether stereo buffers (left and right) are supported.
+func (GetObj) Stereo() bool {
+	var params bool
+	backend.GetBooleanv(STEREO, &params)
+	return params
+}
+
+// params returns a single value, the name of the texture currently bound to the target GL_TEXTURE_1D. The initial value is 0. See glBindTexture.
+func (GetObj) TextureBinding1D() Texture {
+	var params int32
+	backend.GetIntegerv(TEXTURE_BINDING_1D, &params)
+	return Texture(params)
+}
+
+// params returns a single value, the name of the texture currently bound to the target GL_TEXTURE_1D_ARRAY. The initial value is 0. See glBindTexture.
+func (GetObj) TextureBinding1DArray() Texture {
+	var params int32
+	backend.GetIntegerv(TEXTURE_BINDING_1D_ARRAY, &params)
+	return Texture(params)
+}
+
+// params returns a single value, the name of the texture currently bound to the target GL_TEXTURE_2D_MULTISAMPLE. The initial value is 0. See glBindTexture.
+func (GetObj) TextureBinding2DMultisample() Texture {
+	var params int32
+	backend.GetIntegerv(TEXTURE_BINDING_2D_MULTISAMPLE, &params)
+	return Texture(params)
+}
+
+// params returns a single value, the name of the texture currently bound to the target GL_TEXTURE_2D_MULTISAMPLE_ARRAY. The initial value is 0. See glBindTexture.
+func (GetObj) TextureBinding2DMultisampleArray() Texture {
+	var params int32
+	backend.GetIntegerv(TEXTURE_BINDING_2D_MULTISAMPLE_ARRAY, &params)
+	return Texture(params)
+}
+
+// params returns a single value, the name of the texture currently bound to the target GL_TEXTURE_BUFFER. The initial value is 0. See glBindTexture.
+func (GetObj) TextureBindingBuffer() Texture {
+	var params int32
+	backend.GetIntegerv(TEXTURE_BINDING_BUFFER, &params)
+	return Texture(params)
+}
+
+// params returns a single value, the name of the texture currently bound to the target GL_TEXTURE_RECTANGLE. The initial value is 0. See glBindTexture.
+func (GetObj) TextureBindingRectangle() Texture {
+	var params int32
+	backend.GetIntegerv(TEXTURE_BINDING_RECTANGLE, &params)
+	return Texture(params)
+}
+
+// params returns a single value indicating the mode of the texture compression hint. The initial value is GL_DONT_CARE.
+func (GetObj) TextureCompressionHint() int32 {
+	var params int32
+	backend.GetIntegerv(TEXTURE_COMPRESSION_HINT, &params)
+	return params
+}
+
+// params returns a single value, the 64-bit value of the current GL time. See glQueryCounter.
+func (GetObj) Timestamp() int64 {
+	var params int64
+	backend.GetInteger64v(TIMESTAMP, &params)
+	return params
+}
+
+// params returns a single boolean value indicating whether single-bit pixels being read from memory are read first from the least significant bit of each unsigned byte. The initial value is GL_FALSE. See glPixelStore.
+func (GetObj) UnpackLsbFirst() bool {
+	var params bool
+	backend.GetBooleanv(UNPACK_LSB_FIRST, &params)
+	return params
+}
+
+// params returns a single boolean value indicating whether the bytes of two-byte and four-byte pixel indices and components are swapped after being read from memory. The initial value is GL_FALSE. See glPixelStore.
+func (GetObj) UnpackSwapBytes() bool {
+	var params bool
+	backend.GetBooleanv(UNPACK_SWAP_BYTES, &params)
+	return params
+}
+
+// params returns one value, the flags with which the context was created (such as debugging functionality).
+func (GetObj) ContextFlags() int32 {
+	var params int32
+	backend.GetIntegerv(CONTEXT_FLAGS, &params)
+	return params
+}
@@ -1,10 +1,7 @@
 package gl
 
-import (
-	"github.com/go-gl/gl/v3.3-core/gl"
-)
-
-// Init calls gl.Init()
+// Init initializes the go-gl backend of the profile selected at build time, it
+// calls gl.Init().
 func Init() error {
-	return gl.Init()
+	return InitBackend(goglBackend{})
 }
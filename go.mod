@@ -0,0 +1,5 @@
+module github.com/luxengine/gl
+
+go 1.22
+
+require github.com/go-gl/gl v0.0.0-20231021071112-07e5d0ea2e71
@@ -1,43 +1,33 @@
 package gl
 
-import (
-	"github.com/go-gl/gl/v3.3-core/gl"
-)
-
 // GetVendor returns the current OpenGL vendor.
 func GetVendor() string {
-	return gl.GoStr(gl.GetString(gl.VENDOR))
+	return glGoStr(backend.GetString(VENDOR))
 }
 
 // GetVersion returns the current OpenGL version.
 func GetVersion() string {
-	return gl.GoStr(gl.GetString(gl.VERSION))
+	return glGoStr(backend.GetString(VERSION))
 }
 
 // GetRenderer returns the OpenGL renderer.
 func GetRenderer() string {
-	return gl.GoStr(gl.GetString(gl.RENDERER))
+	return glGoStr(backend.GetString(RENDERER))
 }
 
 // GetExtensions returns all loaded extension.
 func GetExtensions() []string {
 	var numExtensions int32
-	gl.GetIntegerv(gl.NUM_EXTENSIONS, &numExtensions)
+	backend.GetIntegerv(NUM_EXTENSIONS, &numExtensions)
 	extensions := make([]string, 0, numExtensions)
 	for i := int32(0); i < numExtensions; i++ {
-		extensions = append(extensions, gl.GoStr(gl.GetStringi(gl.EXTENSIONS, uint32(i))))
+		extensions = append(extensions, glGoStr(backend.GetStringi(EXTENSIONS, uint32(i))))
 	}
 	return extensions
 }
 
 // IsExtensionAvailable returns true if the given extension is available. False
-// if it's not.
+// if it's not. It looks it up in the set of AvailableExtensions.
 func IsExtensionAvailable(extension string) bool {
-	exts := GetExtensions()
-	for _, ext := range exts {
-		if ext == extension {
-			return true
-		}
-	}
-	return false
+	return AvailableExtensions().Has(Extension(extension))
 }
@@ -0,0 +1,464 @@
+package gl
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Limits are the implementation-dependent values of the context, the sizes,
+// counts, ranges and alignments the driver supports. The zero value of a
+// field means the context doesn't report it, like the compute limits before
+// OpenGL 4.3. Limits encodes to JSON as is, to attach to bug reports or to
+// store the requirements of an application.
+//
+// Most fields are maximums the driver must reach. The ones tagged
+// limit:"atmost", alignments, granularities and minimum offsets, are better
+// the smaller they are, and the ones tagged limit:"range" are a pair of
+// smallest and largest supported values. CompressedTextureFormats, tagged
+// limit:"set", lists the compressed internal formats of the context.
+type Limits struct {
+	// Version is the major and minor version of the context.
+	Version [2]int32 `limit:"version"`
+
+	// Textures.
+	MaxTextureSize               int32
+	Max3DTextureSize             int32
+	MaxCubeMapTextureSize        int32
+	MaxRectangleTextureSize      int32
+	MaxArrayTextureLayers        int32
+	MaxTextureBufferSize         int32
+	MaxTextureLODBias            float32
+	MaxTextureMaxAnisotropy      float32
+	MaxTextureImageUnits         int32
+	MaxVertexTextureImageUnits   int32
+	MaxGeometryTextureImageUnits int32
+	MaxComputeTextureImageUnits  int32
+	MaxCombinedTextureImageUnits int32
+	MinProgramTexelOffset        int32 `limit:"atmost"`
+	MaxProgramTexelOffset        int32
+	CompressedTextureFormats     []InternalFormat `limit:"set"`
+
+	// Framebuffers and multisampling.
+	MaxRenderbufferSize      int32
+	MaxColorAttachments      int32
+	MaxDrawBuffers           int32
+	MaxDualSourceDrawBuffers int32
+	MaxFramebufferWidth      int32
+	MaxFramebufferHeight     int32
+	MaxFramebufferLayers     int32
+	MaxFramebufferSamples    int32
+	MaxSamples               int32
+	MaxColorTextureSamples   int32
+	MaxDepthTextureSamples   int32
+	MaxIntegerSamples        int32
+	MaxSampleMaskWords       int32
+
+	// Viewports and rasterization.
+	MaxViewportDims            [2]int32
+	MaxViewports               int32
+	ViewportBoundsRange        [2]float32 `limit:"range"`
+	ViewportSubpixelBits       int32
+	SubpixelBits               int32
+	MaxClipDistances           int32
+	AliasedLineWidthRange      [2]float32 `limit:"range"`
+	SmoothLineWidthRange       [2]float32 `limit:"range"`
+	SmoothLineWidthGranularity float32    `limit:"atmost"`
+	PointSizeRange             [2]float32 `limit:"range"`
+	PointSizeGranularity       float32    `limit:"atmost"`
+
+	// Vertex input and drawing.
+	MaxVertexAttribs        int32
+	MaxVertexAttribBindings int32
+	MaxElementsIndices      int32
+	MaxElementsVertices     int32
+	MaxElementIndex         int64
+	MaxVertexAttribStride   int32
+
+	// Shader stages.
+	MaxVertexUniformComponents           int32
+	MaxVertexUniformVectors              int32
+	MaxVertexUniformBlocks               int32
+	MaxVertexOutputComponents            int32
+	MaxVaryingComponents                 int32
+	MaxVaryingVectors                    int32
+	MaxGeometryUniformComponents         int32
+	MaxGeometryUniformBlocks             int32
+	MaxGeometryInputComponents           int32
+	MaxGeometryOutputComponents          int32
+	MaxGeometryOutputVertices            int32
+	MaxGeometryTotalOutputComponents     int32
+	MaxGeometryShaderInvocations         int32
+	MaxFragmentUniformComponents         int32
+	MaxFragmentUniformVectors            int32
+	MaxFragmentUniformBlocks             int32
+	MaxFragmentInputComponents           int32
+	MaxCombinedVertexUniformComponents   int32
+	MaxCombinedGeometryUniformComponents int32
+	MaxCombinedFragmentUniformComponents int32
+	MaxUniformLocations                  int32
+
+	// Tessellation.
+	MaxPatchVertices                           int32
+	MaxTessGenLevel                            int32
+	MaxTessControlUniformComponents            int32
+	MaxTessControlUniformBlocks                int32
+	MaxTessControlTextureImageUnits            int32
+	MaxTessControlInputComponents              int32
+	MaxTessControlOutputComponents             int32
+	MaxTessControlTotalOutputComponents        int32
+	MaxTessPatchComponents                     int32
+	MaxTessEvaluationUniformComponents         int32
+	MaxTessEvaluationUniformBlocks             int32
+	MaxTessEvaluationTextureImageUnits         int32
+	MaxTessEvaluationInputComponents           int32
+	MaxTessEvaluationOutputComponents          int32
+	MaxCombinedTessControlUniformComponents    int32
+	MaxCombinedTessEvaluationUniformComponents int32
+
+	// Compute. MaxComputeWorkGroupCount and MaxComputeWorkGroupSize are
+	// indexed by axis, x, y and z.
+	MaxComputeWorkGroupCount       [3]int32
+	MaxComputeWorkGroupSize        [3]int32
+	MaxComputeWorkGroupInvocations int32
+	MaxComputeUniformBlocks        int32
+	MaxComputeSharedMemorySize     int32
+
+	// Images and atomic counters.
+	MaxImageUnits                           int32
+	MaxImageSamples                         int32
+	MaxCombinedImageUnitsAndFragmentOutputs int32
+	MaxVertexImageUniforms                  int32
+	MaxTessControlImageUniforms             int32
+	MaxTessEvaluationImageUniforms          int32
+	MaxGeometryImageUniforms                int32
+	MaxFragmentImageUniforms                int32
+	MaxComputeImageUniforms                 int32
+	MaxCombinedImageUniforms                int32
+	MaxAtomicCounterBufferBindings          int32
+	MaxAtomicCounterBufferSize              int32
+	MaxVertexAtomicCounters                 int32
+	MaxTessControlAtomicCounters            int32
+	MaxTessEvaluationAtomicCounters         int32
+	MaxGeometryAtomicCounters               int32
+	MaxFragmentAtomicCounters               int32
+	MaxComputeAtomicCounters                int32
+	MaxCombinedAtomicCounters               int32
+	MaxVertexAtomicCounterBuffers           int32
+	MaxTessControlAtomicCounterBuffers      int32
+	MaxTessEvaluationAtomicCounterBuffers   int32
+	MaxGeometryAtomicCounterBuffers         int32
+	MaxFragmentAtomicCounterBuffers         int32
+	MaxComputeAtomicCounterBuffers          int32
+	MaxCombinedAtomicCounterBuffers         int32
+
+	// Buffers.
+	MaxUniformBufferBindings                  int32
+	MaxUniformBlockSize                       int64
+	MaxCombinedUniformBlocks                  int32
+	UniformBufferOffsetAlignment              int32 `limit:"atmost"`
+	MaxShaderStorageBufferBindings            int32
+	MaxShaderStorageBlockSize                 int64
+	ShaderStorageBufferOffsetAlignment        int32 `limit:"atmost"`
+	MaxTransformFeedbackBuffers               int32
+	MaxTransformFeedbackInterleavedComponents int32
+	MaxTransformFeedbackSeparateAttribs       int32
+	MaxTransformFeedbackSeparateComponents    int32
+	// MinMapBufferAlignment is the alignment of the pointers returned by
+	// MapRange, the larger the better.
+	MinMapBufferAlignment int32
+
+	// Synchronization.
+	MaxServerWaitTimeout int64
+}
+
+// QueryLimits returns the limits of the current context. It must be called
+// on the context thread, after Init.
+func QueryLimits() Limits {
+	var l Limits
+	l.Version = [2]int32{getInteger(MAJOR_VERSION), getInteger(MINOR_VERSION)}
+
+	l.MaxTextureSize = getInteger(MAX_TEXTURE_SIZE)
+	l.Max3DTextureSize = getInteger(MAX_3D_TEXTURE_SIZE)
+	l.MaxCubeMapTextureSize = getInteger(MAX_CUBE_MAP_TEXTURE_SIZE)
+	l.MaxArrayTextureLayers = getInteger(MAX_ARRAY_TEXTURE_LAYERS)
+	l.MaxTextureLODBias = getFloat(MAX_TEXTURE_LOD_BIAS)
+	l.MaxTextureImageUnits = getInteger(MAX_TEXTURE_IMAGE_UNITS)
+	l.MaxVertexTextureImageUnits = getInteger(MAX_VERTEX_TEXTURE_IMAGE_UNITS)
+	l.MaxCombinedTextureImageUnits = getInteger(MAX_COMBINED_TEXTURE_IMAGE_UNITS)
+	l.MinProgramTexelOffset = getInteger(MIN_PROGRAM_TEXEL_OFFSET)
+	l.MaxProgramTexelOffset = getInteger(MAX_PROGRAM_TEXEL_OFFSET)
+	l.CompressedTextureFormats = getCompressedTextureFormats()
+
+	l.MaxRenderbufferSize = getInteger(MAX_RENDERBUFFER_SIZE)
+	l.MaxColorAttachments = getInteger(MAX_COLOR_ATTACHMENTS)
+	l.MaxDrawBuffers = getInteger(MAX_DRAW_BUFFERS)
+	l.MaxSamples = getInteger(MAX_SAMPLES)
+
+	l.MaxViewportDims = getIntegers2(MAX_VIEWPORT_DIMS)
+	l.SubpixelBits = getInteger(SUBPIXEL_BITS)
+	l.AliasedLineWidthRange = getFloats2(ALIASED_LINE_WIDTH_RANGE)
+
+	l.MaxVertexAttribs = getInteger(MAX_VERTEX_ATTRIBS)
+	l.MaxElementsIndices = getInteger(MAX_ELEMENTS_INDICES)
+	l.MaxElementsVertices = getInteger(MAX_ELEMENTS_VERTICES)
+
+	l.MaxVertexUniformComponents = getInteger(MAX_VERTEX_UNIFORM_COMPONENTS)
+	l.MaxVertexUniformBlocks = getInteger(MAX_VERTEX_UNIFORM_BLOCKS)
+	l.MaxVertexOutputComponents = getInteger(MAX_VERTEX_OUTPUT_COMPONENTS)
+	l.MaxVaryingComponents = getInteger(MAX_VARYING_COMPONENTS)
+	l.MaxFragmentUniformComponents = getInteger(MAX_FRAGMENT_UNIFORM_COMPONENTS)
+	l.MaxFragmentUniformBlocks = getInteger(MAX_FRAGMENT_UNIFORM_BLOCKS)
+	l.MaxFragmentInputComponents = getInteger(MAX_FRAGMENT_INPUT_COMPONENTS)
+	l.MaxCombinedVertexUniformComponents = getInteger(MAX_COMBINED_VERTEX_UNIFORM_COMPONENTS)
+	l.MaxCombinedFragmentUniformComponents = getInteger(MAX_COMBINED_FRAGMENT_UNIFORM_COMPONENTS)
+
+	l.MaxUniformBufferBindings = getInteger(MAX_UNIFORM_BUFFER_BINDINGS)
+	l.MaxUniformBlockSize = getInteger64(MAX_UNIFORM_BLOCK_SIZE)
+	l.MaxCombinedUniformBlocks = getInteger(MAX_COMBINED_UNIFORM_BLOCKS)
+	l.UniformBufferOffsetAlignment = getInteger(UNIFORM_BUFFER_OFFSET_ALIGNMENT)
+	l.MaxTransformFeedbackInterleavedComponents = getInteger(MAX_TRANSFORM_FEEDBACK_INTERLEAVED_COMPONENTS)
+	l.MaxTransformFeedbackSeparateAttribs = getInteger(MAX_TRANSFORM_FEEDBACK_SEPARATE_ATTRIBS)
+	l.MaxTransformFeedbackSeparateComponents = getInteger(MAX_TRANSFORM_FEEDBACK_SEPARATE_COMPONENTS)
+
+	l.MaxServerWaitTimeout = getInteger64(MAX_SERVER_WAIT_TIMEOUT)
+
+	l.queryProfile()
+	return l
+}
+
+// atLeast returns true if the version of the context is at least
+// major.minor.
+func (l *Limits) atLeast(major, minor int32) bool {
+	return l.Version[0] > major || l.Version[0] == major && l.Version[1] >= minor
+}
+
+// queryCompute queries the limits of compute shaders and shader storage
+// buffers, OpenGL 4.3 and OpenGL ES 3.1.
+func (l *Limits) queryCompute() {
+	for i := uint32(0); i < 3; i++ {
+		backend.GetIntegeri_v(MAX_COMPUTE_WORK_GROUP_COUNT, i, &l.MaxComputeWorkGroupCount[i])
+		backend.GetIntegeri_v(MAX_COMPUTE_WORK_GROUP_SIZE, i, &l.MaxComputeWorkGroupSize[i])
+	}
+	l.MaxComputeWorkGroupInvocations = getInteger(MAX_COMPUTE_WORK_GROUP_INVOCATIONS)
+	l.MaxComputeUniformBlocks = getInteger(MAX_COMPUTE_UNIFORM_BLOCKS)
+	l.MaxComputeTextureImageUnits = getInteger(MAX_COMPUTE_TEXTURE_IMAGE_UNITS)
+	l.MaxComputeSharedMemorySize = getInteger(MAX_COMPUTE_SHARED_MEMORY_SIZE)
+	l.MaxComputeImageUniforms = getInteger(MAX_COMPUTE_IMAGE_UNIFORMS)
+	l.MaxComputeAtomicCounters = getInteger(MAX_COMPUTE_ATOMIC_COUNTERS)
+	l.MaxComputeAtomicCounterBuffers = getInteger(MAX_COMPUTE_ATOMIC_COUNTER_BUFFERS)
+	l.MaxShaderStorageBufferBindings = getInteger(MAX_SHADER_STORAGE_BUFFER_BINDINGS)
+	l.MaxShaderStorageBlockSize = getInteger64(MAX_SHADER_STORAGE_BLOCK_SIZE)
+	l.ShaderStorageBufferOffsetAlignment = getInteger(SHADER_STORAGE_BUFFER_OFFSET_ALIGNMENT)
+	l.MaxUniformLocations = getInteger(MAX_UNIFORM_LOCATIONS)
+	l.MaxVertexAttribBindings = getInteger(MAX_VERTEX_ATTRIB_BINDINGS)
+	l.MaxFramebufferWidth = getInteger(MAX_FRAMEBUFFER_WIDTH)
+	l.MaxFramebufferHeight = getInteger(MAX_FRAMEBUFFER_HEIGHT)
+	l.MaxFramebufferLayers = getInteger(MAX_FRAMEBUFFER_LAYERS)
+	l.MaxFramebufferSamples = getInteger(MAX_FRAMEBUFFER_SAMPLES)
+}
+
+// queryTessellation queries the limits of tessellation shaders, OpenGL 4.0
+// and OpenGL ES 3.2.
+func (l *Limits) queryTessellation() {
+	l.MaxPatchVertices = getInteger(MAX_PATCH_VERTICES)
+	l.MaxTessGenLevel = getInteger(MAX_TESS_GEN_LEVEL)
+	l.MaxTessControlUniformComponents = getInteger(MAX_TESS_CONTROL_UNIFORM_COMPONENTS)
+	l.MaxTessControlUniformBlocks = getInteger(MAX_TESS_CONTROL_UNIFORM_BLOCKS)
+	l.MaxTessControlTextureImageUnits = getInteger(MAX_TESS_CONTROL_TEXTURE_IMAGE_UNITS)
+	l.MaxTessControlInputComponents = getInteger(MAX_TESS_CONTROL_INPUT_COMPONENTS)
+	l.MaxTessControlOutputComponents = getInteger(MAX_TESS_CONTROL_OUTPUT_COMPONENTS)
+	l.MaxTessControlTotalOutputComponents = getInteger(MAX_TESS_CONTROL_TOTAL_OUTPUT_COMPONENTS)
+	l.MaxTessPatchComponents = getInteger(MAX_TESS_PATCH_COMPONENTS)
+	l.MaxTessEvaluationUniformComponents = getInteger(MAX_TESS_EVALUATION_UNIFORM_COMPONENTS)
+	l.MaxTessEvaluationUniformBlocks = getInteger(MAX_TESS_EVALUATION_UNIFORM_BLOCKS)
+	l.MaxTessEvaluationTextureImageUnits = getInteger(MAX_TESS_EVALUATION_TEXTURE_IMAGE_UNITS)
+	l.MaxTessEvaluationInputComponents = getInteger(MAX_TESS_EVALUATION_INPUT_COMPONENTS)
+	l.MaxTessEvaluationOutputComponents = getInteger(MAX_TESS_EVALUATION_OUTPUT_COMPONENTS)
+	l.MaxCombinedTessControlUniformComponents = getInteger(MAX_COMBINED_TESS_CONTROL_UNIFORM_COMPONENTS)
+	l.MaxCombinedTessEvaluationUniformComponents = getInteger(MAX_COMBINED_TESS_EVALUATION_UNIFORM_COMPONENTS)
+}
+
+// queryImages queries the limits of images and atomic counters of the
+// vertex and fragment stages, OpenGL 4.2 and OpenGL ES 3.1.
+func (l *Limits) queryImages() {
+	l.MaxImageUnits = getInteger(MAX_IMAGE_UNITS)
+	l.MaxVertexImageUniforms = getInteger(MAX_VERTEX_IMAGE_UNIFORMS)
+	l.MaxFragmentImageUniforms = getInteger(MAX_FRAGMENT_IMAGE_UNIFORMS)
+	l.MaxCombinedImageUniforms = getInteger(MAX_COMBINED_IMAGE_UNIFORMS)
+	l.MaxAtomicCounterBufferBindings = getInteger(MAX_ATOMIC_COUNTER_BUFFER_BINDINGS)
+	l.MaxAtomicCounterBufferSize = getInteger(MAX_ATOMIC_COUNTER_BUFFER_SIZE)
+	l.MaxVertexAtomicCounters = getInteger(MAX_VERTEX_ATOMIC_COUNTERS)
+	l.MaxFragmentAtomicCounters = getInteger(MAX_FRAGMENT_ATOMIC_COUNTERS)
+	l.MaxCombinedAtomicCounters = getInteger(MAX_COMBINED_ATOMIC_COUNTERS)
+	l.MaxVertexAtomicCounterBuffers = getInteger(MAX_VERTEX_ATOMIC_COUNTER_BUFFERS)
+	l.MaxFragmentAtomicCounterBuffers = getInteger(MAX_FRAGMENT_ATOMIC_COUNTER_BUFFERS)
+	l.MaxCombinedAtomicCounterBuffers = getInteger(MAX_COMBINED_ATOMIC_COUNTER_BUFFERS)
+}
+
+// queryStageImages queries the limits of images and atomic counters of the
+// geometry and tessellation stages, OpenGL 4.2 and OpenGL ES 3.2.
+func (l *Limits) queryStageImages() {
+	l.MaxTessControlImageUniforms = getInteger(MAX_TESS_CONTROL_IMAGE_UNIFORMS)
+	l.MaxTessEvaluationImageUniforms = getInteger(MAX_TESS_EVALUATION_IMAGE_UNIFORMS)
+	l.MaxGeometryImageUniforms = getInteger(MAX_GEOMETRY_IMAGE_UNIFORMS)
+	l.MaxTessControlAtomicCounters = getInteger(MAX_TESS_CONTROL_ATOMIC_COUNTERS)
+	l.MaxTessEvaluationAtomicCounters = getInteger(MAX_TESS_EVALUATION_ATOMIC_COUNTERS)
+	l.MaxGeometryAtomicCounters = getInteger(MAX_GEOMETRY_ATOMIC_COUNTERS)
+	l.MaxTessControlAtomicCounterBuffers = getInteger(MAX_TESS_CONTROL_ATOMIC_COUNTER_BUFFERS)
+	l.MaxTessEvaluationAtomicCounterBuffers = getInteger(MAX_TESS_EVALUATION_ATOMIC_COUNTER_BUFFERS)
+	l.MaxGeometryAtomicCounterBuffers = getInteger(MAX_GEOMETRY_ATOMIC_COUNTER_BUFFERS)
+}
+
+func getInteger(pname uint32) int32 {
+	var v int32
+	backend.GetIntegerv(pname, &v)
+	return v
+}
+
+func getInteger64(pname uint32) int64 {
+	var v int64
+	backend.GetInteger64v(pname, &v)
+	return v
+}
+
+func getFloat(pname uint32) float32 {
+	var v float32
+	backend.GetFloatv(pname, &v)
+	return v
+}
+
+// getCompressedTextureFormats returns the compressed internal formats of the
+// context, nil if it has none.
+func getCompressedTextureFormats() []InternalFormat {
+	n := getInteger(NUM_COMPRESSED_TEXTURE_FORMATS)
+	if n <= 0 {
+		return nil
+	}
+	v := make([]int32, n)
+	backend.GetIntegerv(COMPRESSED_TEXTURE_FORMATS, &v[0])
+	formats := make([]InternalFormat, n)
+	for i := range v {
+		formats[i] = InternalFormat(v[i])
+	}
+	return formats
+}
+
+func getIntegers2(pname uint32) [2]int32 {
+	var v [2]int32
+	backend.GetIntegerv(pname, &v[0])
+	return v
+}
+
+func getFloats2(pname uint32) [2]float32 {
+	var v [2]float32
+	backend.GetFloatv(pname, &v[0])
+	return v
+}
+
+// Violation is a limit of the context that doesn't satisfy the required one.
+type Violation struct {
+	// Limit is the name of the field of Limits, like "MaxTextureSize".
+	Limit string
+	// Have is the value of the context and Want the required one, of the
+	// type of the field.
+	Have interface{}
+	Want interface{}
+	// Rule is how Have must compare to Want: "at least", "at most",
+	// "containing" for ranges or "including" for sets.
+	Rule string
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("%s is %v, need %s %v", v.Limit, v.Have, v.Rule, v.Want)
+}
+
+// Satisfies compares l to the limits an application requires and returns the
+// ones l doesn't satisfy, nil if it satisfies all of them. The zero fields of
+// required are ignored, and so are the zero bounds of a required range. A
+// maximum must be at least the required value, a limit tagged atmost at most
+// the required value, a range must contain the required range and a set must
+// include every required element. Version is compared as a version, other
+// arrays element by element.
+func (l Limits) Satisfies(required Limits) []Violation {
+	var violations []Violation
+	have, want := reflect.ValueOf(l), reflect.ValueOf(required)
+	t := have.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		h, w := have.Field(i), want.Field(i)
+		if w.IsZero() || w.Kind() == reflect.Slice && w.Len() == 0 {
+			continue
+		}
+		ok, rule := true, "at least"
+		switch f.Tag.Get("limit") {
+		case "version":
+			ok = l.atLeast(required.Version[0], required.Version[1])
+		case "atmost":
+			rule = "at most"
+			// A zero alignment or granularity isn't reported, not a
+			// perfect one.
+			ok = !h.IsZero() && compareLimit(h, w) <= 0
+		case "range":
+			rule = "containing"
+			lo, hi := w.Index(0), w.Index(1)
+			ok = (lo.IsZero() || compareLimit(h.Index(0), lo) <= 0) &&
+				(hi.IsZero() || compareLimit(h.Index(1), hi) >= 0)
+		case "set":
+			rule = "including"
+			ok = includes(h, w)
+		default:
+			if h.Kind() != reflect.Array {
+				ok = compareLimit(h, w) >= 0
+				break
+			}
+			for j := 0; j < h.Len(); j++ {
+				ok = ok && compareLimit(h.Index(j), w.Index(j)) >= 0
+			}
+		}
+		if !ok {
+			violations = append(violations, Violation{Limit: f.Name, Have: h.Interface(), Want: w.Interface(), Rule: rule})
+		}
+	}
+	return violations
+}
+
+// includes returns true if the slice set has every element of the slice
+// elems.
+func includes(set, elems reflect.Value) bool {
+	has := map[interface{}]bool{}
+	for i := 0; i < set.Len(); i++ {
+		has[set.Index(i).Interface()] = true
+	}
+	for i := 0; i < elems.Len(); i++ {
+		if !has[elems.Index(i).Interface()] {
+			return false
+		}
+	}
+	return true
+}
+
+// compareLimit returns -1, 0 or 1 if a is less than, equal to or greater than
+// b, two numbers of the same kind.
+func compareLimit(a, b reflect.Value) int {
+	switch a.Kind() {
+	case reflect.Int32, reflect.Int64:
+		x, y := a.Int(), b.Int()
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	case reflect.Float32:
+		x, y := a.Float(), b.Float()
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	default:
+		panic("gl: limit of unexpected kind " + a.Kind().String())
+	}
+	return 0
+}
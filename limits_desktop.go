@@ -0,0 +1,63 @@
+//go:build !gles30
+
+package gl
+
+// queryProfile queries the limits of desktop OpenGL, those of the newer
+// versions only if the context has them.
+func (l *Limits) queryProfile() {
+	l.MaxRectangleTextureSize = getInteger(MAX_RECTANGLE_TEXTURE_SIZE)
+	l.MaxTextureBufferSize = getInteger(MAX_TEXTURE_BUFFER_SIZE)
+	l.MaxGeometryTextureImageUnits = getInteger(MAX_GEOMETRY_TEXTURE_IMAGE_UNITS)
+
+	l.MaxDualSourceDrawBuffers = getInteger(MAX_DUAL_SOURCE_DRAW_BUFFERS)
+	l.MaxColorTextureSamples = getInteger(MAX_COLOR_TEXTURE_SAMPLES)
+	l.MaxDepthTextureSamples = getInteger(MAX_DEPTH_TEXTURE_SAMPLES)
+	l.MaxIntegerSamples = getInteger(MAX_INTEGER_SAMPLES)
+	l.MaxSampleMaskWords = getInteger(MAX_SAMPLE_MASK_WORDS)
+
+	l.MaxClipDistances = getInteger(MAX_CLIP_DISTANCES)
+	l.SmoothLineWidthRange = getFloats2(SMOOTH_LINE_WIDTH_RANGE)
+	l.SmoothLineWidthGranularity = getFloat(SMOOTH_LINE_WIDTH_GRANULARITY)
+	l.PointSizeRange = getFloats2(POINT_SIZE_RANGE)
+	l.PointSizeGranularity = getFloat(POINT_SIZE_GRANULARITY)
+
+	l.MaxGeometryUniformComponents = getInteger(MAX_GEOMETRY_UNIFORM_COMPONENTS)
+	l.MaxGeometryUniformBlocks = getInteger(MAX_GEOMETRY_UNIFORM_BLOCKS)
+	l.MaxGeometryInputComponents = getInteger(MAX_GEOMETRY_INPUT_COMPONENTS)
+	l.MaxGeometryOutputComponents = getInteger(MAX_GEOMETRY_OUTPUT_COMPONENTS)
+	l.MaxGeometryOutputVertices = getInteger(MAX_GEOMETRY_OUTPUT_VERTICES)
+	l.MaxGeometryTotalOutputComponents = getInteger(MAX_GEOMETRY_TOTAL_OUTPUT_COMPONENTS)
+	l.MaxCombinedGeometryUniformComponents = getInteger(MAX_COMBINED_GEOMETRY_UNIFORM_COMPONENTS)
+
+	if l.atLeast(4, 0) {
+		l.MaxTransformFeedbackBuffers = getInteger(MAX_TRANSFORM_FEEDBACK_BUFFERS)
+		l.MaxGeometryShaderInvocations = getInteger(MAX_GEOMETRY_SHADER_INVOCATIONS)
+		l.queryTessellation()
+	}
+	if l.atLeast(4, 1) {
+		l.MaxViewports = getInteger(MAX_VIEWPORTS)
+		l.ViewportBoundsRange = getFloats2(VIEWPORT_BOUNDS_RANGE)
+		l.ViewportSubpixelBits = getInteger(VIEWPORT_SUBPIXEL_BITS)
+		l.MaxVertexUniformVectors = getInteger(MAX_VERTEX_UNIFORM_VECTORS)
+		l.MaxVaryingVectors = getInteger(MAX_VARYING_VECTORS)
+		l.MaxFragmentUniformVectors = getInteger(MAX_FRAGMENT_UNIFORM_VECTORS)
+	}
+	if l.atLeast(4, 2) {
+		l.MinMapBufferAlignment = getInteger(MIN_MAP_BUFFER_ALIGNMENT)
+		l.MaxImageSamples = getInteger(MAX_IMAGE_SAMPLES)
+		l.MaxCombinedImageUnitsAndFragmentOutputs = getInteger(MAX_COMBINED_IMAGE_UNITS_AND_FRAGMENT_OUTPUTS)
+		l.queryImages()
+		l.queryStageImages()
+	}
+	if l.atLeast(4, 3) {
+		l.MaxElementIndex = getInteger64(MAX_ELEMENT_INDEX)
+		l.queryCompute()
+	}
+	if l.atLeast(4, 4) {
+		l.MaxVertexAttribStride = getInteger(MAX_VERTEX_ATTRIB_STRIDE)
+	}
+	exts := AvailableExtensions()
+	if l.atLeast(4, 6) || exts.Has(ARB_texture_filter_anisotropic) || exts.Has(EXT_texture_filter_anisotropic) {
+		l.MaxTextureMaxAnisotropy = getFloat(MAX_TEXTURE_MAX_ANISOTROPY)
+	}
+}
@@ -0,0 +1,29 @@
+//go:build gles30
+
+package gl
+
+// queryProfile queries the limits of OpenGL ES, those of 3.1 and 3.2 only if
+// the context has them.
+func (l *Limits) queryProfile() {
+	l.MaxVertexUniformVectors = getInteger(MAX_VERTEX_UNIFORM_VECTORS)
+	l.MaxVaryingVectors = getInteger(MAX_VARYING_VECTORS)
+	l.MaxFragmentUniformVectors = getInteger(MAX_FRAGMENT_UNIFORM_VECTORS)
+	l.MaxElementIndex = getInteger64(MAX_ELEMENT_INDEX)
+	if l.atLeast(3, 1) {
+		l.MaxColorTextureSamples = getInteger(MAX_COLOR_TEXTURE_SAMPLES)
+		l.MaxDepthTextureSamples = getInteger(MAX_DEPTH_TEXTURE_SAMPLES)
+		l.MaxIntegerSamples = getInteger(MAX_INTEGER_SAMPLES)
+		l.MaxSampleMaskWords = getInteger(MAX_SAMPLE_MASK_WORDS)
+		l.MaxVertexAttribStride = getInteger(MAX_VERTEX_ATTRIB_STRIDE)
+		l.queryCompute()
+		l.queryImages()
+	}
+	if l.atLeast(3, 2) {
+		l.MaxGeometryShaderInvocations = getInteger(MAX_GEOMETRY_SHADER_INVOCATIONS)
+		l.queryTessellation()
+		l.queryStageImages()
+	}
+	if AvailableExtensions().Has(EXT_texture_filter_anisotropic) {
+		l.MaxTextureMaxAnisotropy = getFloat(MAX_TEXTURE_MAX_ANISOTROPY)
+	}
+}
@@ -0,0 +1,115 @@
+package gl
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestQueryLimits(t *testing.T) {
+	tests := []struct {
+		profile    string
+		version    [2]int32
+		extensions []string
+		// want are the limits queried, the others of the test must be
+		// left zero.
+		want map[string]bool
+	}{
+		{"3.3", [2]int32{3, 3}, nil, nil},
+		{"3.3", [2]int32{3, 3}, []string{string(ARB_texture_filter_anisotropic)}, map[string]bool{"MaxTextureMaxAnisotropy": true}},
+		{"3.3", [2]int32{4, 0}, nil, map[string]bool{"MaxPatchVertices": true, "MaxGeometryShaderInvocations": true}},
+		{"3.3", [2]int32{4, 2}, nil, map[string]bool{"MaxPatchVertices": true, "MaxGeometryShaderInvocations": true, "MaxImageUnits": true, "MaxGeometryImageUniforms": true, "MaxAtomicCounterBufferSize": true, "MinMapBufferAlignment": true}},
+		{"3.3", [2]int32{4, 6}, nil, map[string]bool{"MaxPatchVertices": true, "MaxGeometryShaderInvocations": true, "MaxImageUnits": true, "MaxGeometryImageUniforms": true, "MaxAtomicCounterBufferSize": true, "MinMapBufferAlignment": true, "MaxComputeImageUniforms": true, "MaxVertexAttribStride": true, "MaxTextureMaxAnisotropy": true}},
+		{"3.0-es", [2]int32{3, 0}, nil, nil},
+		{"3.0-es", [2]int32{3, 0}, []string{string(EXT_texture_filter_anisotropic)}, map[string]bool{"MaxTextureMaxAnisotropy": true}},
+		{"3.0-es", [2]int32{3, 1}, nil, map[string]bool{"MaxImageUnits": true, "MaxAtomicCounterBufferSize": true, "MaxComputeImageUniforms": true, "MaxVertexAttribStride": true}},
+		{"3.0-es", [2]int32{3, 2}, nil, map[string]bool{"MaxImageUnits": true, "MaxAtomicCounterBufferSize": true, "MaxComputeImageUniforms": true, "MaxVertexAttribStride": true, "MaxPatchVertices": true, "MaxGeometryShaderInvocations": true, "MaxGeometryImageUniforms": true}},
+	}
+	pnames := map[string]uint32{
+		"MaxPatchVertices":             MAX_PATCH_VERTICES,
+		"MaxGeometryShaderInvocations": MAX_GEOMETRY_SHADER_INVOCATIONS,
+		"MaxImageUnits":                MAX_IMAGE_UNITS,
+		"MaxGeometryImageUniforms":     MAX_GEOMETRY_IMAGE_UNIFORMS,
+		"MaxAtomicCounterBufferSize":   MAX_ATOMIC_COUNTER_BUFFER_SIZE,
+		"MinMapBufferAlignment":        MIN_MAP_BUFFER_ALIGNMENT,
+		"MaxComputeImageUniforms":      MAX_COMPUTE_IMAGE_UNIFORMS,
+		"MaxVertexAttribStride":        MAX_VERTEX_ATTRIB_STRIDE,
+	}
+	for _, tt := range tests {
+		if (tt.profile == "3.0-es") != (Profile == "3.0-es") {
+			continue
+		}
+		t.Run(fmt.Sprintf("%s/%d.%d", tt.profile, tt.version[0], tt.version[1]), func(t *testing.T) {
+			f := newFake(t)
+			f.Integers[MAJOR_VERSION] = []int32{tt.version[0]}
+			f.Integers[MINOR_VERSION] = []int32{tt.version[1]}
+			for _, pname := range pnames {
+				f.Integers[pname] = []int32{8}
+			}
+			f.Floats[MAX_TEXTURE_MAX_ANISOTROPY] = []float32{16}
+			f.Extensions = tt.extensions
+			resetExtensions()
+			defer resetExtensions()
+
+			l := reflect.ValueOf(QueryLimits())
+			for name := range pnames {
+				if got := l.FieldByName(name).Int(); (got != 0) != tt.want[name] {
+					t.Errorf("%s = %d, want it queried: %v", name, got, tt.want[name])
+				}
+			}
+			if got := l.FieldByName("MaxTextureMaxAnisotropy").Float(); (got != 0) != tt.want["MaxTextureMaxAnisotropy"] {
+				t.Errorf("MaxTextureMaxAnisotropy = %v, want it queried: %v", got, tt.want["MaxTextureMaxAnisotropy"])
+			}
+		})
+	}
+}
+
+func TestQueryCompressedTextureFormats(t *testing.T) {
+	f := newFake(t)
+	if got := QueryLimits().CompressedTextureFormats; got != nil {
+		t.Errorf("CompressedTextureFormats = %v without formats, want nil", got)
+	}
+	f.Integers[NUM_COMPRESSED_TEXTURE_FORMATS] = []int32{2}
+	f.Integers[COMPRESSED_TEXTURE_FORMATS] = []int32{int32(COMPRESSED_RED_RGTC1), int32(COMPRESSED_RG11_EAC)}
+	want := []InternalFormat{COMPRESSED_RED_RGTC1, COMPRESSED_RG11_EAC}
+	if got := QueryLimits().CompressedTextureFormats; !reflect.DeepEqual(got, want) {
+		t.Errorf("CompressedTextureFormats = %v, want %v", got, want)
+	}
+}
+
+func TestLimitsSatisfies(t *testing.T) {
+	have := Limits{
+		Version:                      [2]int32{4, 2},
+		MaxTextureSize:               4096,
+		MaxTessGenLevel:              64,
+		MinMapBufferAlignment:        64,
+		UniformBufferOffsetAlignment: 256,
+		AliasedLineWidthRange:        [2]float32{1, 8},
+		CompressedTextureFormats:     []InternalFormat{COMPRESSED_RED_RGTC1, COMPRESSED_RG11_EAC},
+	}
+	tests := []struct {
+		name     string
+		required Limits
+		want     []string
+	}{
+		{"nothing", Limits{}, nil},
+		{"satisfied", Limits{Version: [2]int32{4, 1}, MaxTextureSize: 4096, MaxTessGenLevel: 32, MinMapBufferAlignment: 16, UniformBufferOffsetAlignment: 256, AliasedLineWidthRange: [2]float32{1, 4}}, nil},
+		{"version", Limits{Version: [2]int32{4, 3}}, []string{"Version"}},
+		{"maximum", Limits{MaxTessGenLevel: 128, MinMapBufferAlignment: 128}, []string{"MaxTessGenLevel", "MinMapBufferAlignment"}},
+		{"atmost", Limits{UniformBufferOffsetAlignment: 64}, []string{"UniformBufferOffsetAlignment"}},
+		{"range", Limits{AliasedLineWidthRange: [2]float32{0, 16}}, []string{"AliasedLineWidthRange"}},
+		{"formats included", Limits{CompressedTextureFormats: []InternalFormat{COMPRESSED_RG11_EAC}}, nil},
+		{"format missing", Limits{CompressedTextureFormats: []InternalFormat{COMPRESSED_RG11_EAC, COMPRESSED_R11_EAC}}, []string{"CompressedTextureFormats"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, v := range have.Satisfies(tt.required) {
+				got = append(got, v.Limit)
+			}
+			if !equalStrings(got, tt.want) {
+				t.Errorf("Satisfies() violated %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
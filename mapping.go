@@ -0,0 +1,169 @@
+package gl
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"unsafe"
+)
+
+var (
+	// ErrMapFailed is returned by Buffer.MapRange when glMapBufferRange
+	// returned NULL without raising an OpenGL error.
+	ErrMapFailed = errors.New("gl: glMapBufferRange returned NULL")
+	// ErrMappingCorrupted is returned by Mapping.Unmap when glUnmapBuffer
+	// returned GL_FALSE: the data store was corrupted while it was mapped,
+	// typically by a screen mode change, and must be uploaded again.
+	ErrMappingCorrupted = errors.New("gl: buffer data store corrupted while mapped")
+)
+
+// mappingPoison fills the memory of the mappings of safety builds once they
+// are unmapped, so reads through a stale slice stand out.
+const mappingPoison = 0xDB
+
+// Mapping is a range of a buffer mapped into client memory by
+// Buffer.MapRange. Its memory is only valid until Unmap.
+//
+// With -tags safety the slices of a mapping point to a copy of the range that
+// is written to the buffer on FlushRange and Unmap, then filled with 0xDB, so
+// a slice kept after Unmap can't touch memory OpenGL took back. Persistent
+// mappings are never copied, they are meant to be written while in use.
+type Mapping struct {
+	buffer Buffer
+	target BufferTarget
+	access MapAccess
+
+	// memory is the range mapped by OpenGL and data the memory handed out,
+	// a copy of memory in safety builds.
+	memory   []byte
+	data     []byte
+	shadow   bool
+	unmapped bool
+}
+
+// MapRange is an alias to glMapBufferRange, it maps length bytes of the buffer
+// starting at offset with access, a combination of the gl.MAP_*_BIT flags. It
+// returns the OpenGL error if the mapping failed.
+//
+// In safety builds the copy handed out by write-only mappings that don't
+// invalidate their range starts with the content of the range, read before it
+// is mapped, so writing it back whole leaves the bytes that weren't written
+// as they were. On OpenGL ES the range is read through a read-only mapping;
+// if that fails the mapping isn't copied and its slices keep pointing to the
+// driver memory after Unmap.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glMapBufferRange.xml
+func (b Buffer) MapRange(target BufferTarget, offset, length int, access MapAccess) (*Mapping, error) {
+	var content []byte
+	shadow := safetyflag && access&MAP_PERSISTENT_BIT == 0
+	if safetyflag {
+		safetyCheckBound("Buffer.MapRange", kindBuffer, uint32(target), uint32(b))
+		safetyCheckBufferRange("Buffer.MapRange", uint32(target), offset, length)
+		// Write-only mappings can't read the range themselves.
+		if shadow && length > 0 && access&(MAP_READ_BIT|MAP_INVALIDATE_RANGE_BIT|MAP_INVALIDATE_BUFFER_BIT) == 0 {
+			content = make([]byte, length)
+			shadow = getBufferSubData(uint32(target), offset, length, unsafe.Pointer(&content[0])) == nil
+		}
+	}
+	p := backend.MapBufferRange(uint32(target), offset, length, uint32(access))
+	if p == nil {
+		if err := GetError(); err != nil {
+			return nil, err
+		}
+		return nil, ErrMapFailed
+	}
+	m := &Mapping{buffer: b, target: target, access: access, memory: unsafe.Slice((*byte)(p), length), shadow: shadow}
+	m.data = m.memory
+	switch {
+	case !shadow:
+	case content != nil:
+		m.data = content
+	default:
+		m.data = make([]byte, length)
+		// Unmap writes the whole copy back, it must start with the content
+		// of the buffer unless the mapping discards it.
+		if access&(MAP_INVALIDATE_RANGE_BIT|MAP_INVALIDATE_BUFFER_BIT) == 0 {
+			copy(m.data, m.memory)
+		}
+	}
+	return m, nil
+}
+
+// Bytes returns the mapped memory, nil after Unmap.
+func (m *Mapping) Bytes() []byte {
+	if safetyflag {
+		safetyCheckMapping("Mapping.Bytes", m.unmapped)
+	}
+	return m.data
+}
+
+// MappedSlice returns the mapped memory of m as a slice of T, nil after
+// Unmap. Trailing bytes that don't make a whole T are left out. It panics if
+// T contains Go pointers or if the mapped memory isn't aligned for T.
+func MappedSlice[T any](m *Mapping) []T {
+	if safetyflag {
+		safetyCheckMapping("MappedSlice", m.unmapped)
+	}
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if err := checkBufferElement(t); err != nil {
+		panic(err)
+	}
+	if len(m.data) == 0 || t.Size() == 0 {
+		return nil
+	}
+	p := unsafe.Pointer(&m.data[0])
+	if uintptr(p)%uintptr(t.Align()) != 0 {
+		panic(fmt.Sprintf("gl: mapped memory at %p isn't aligned for %s", p, t))
+	}
+	return unsafe.Slice((*T)(p), len(m.data)/int(t.Size()))
+}
+
+// FlushRange is an alias to glFlushMappedBufferRange, it makes the writes to
+// length bytes of the mapping starting at offset visible to OpenGL. offset is
+// relative to the start of the mapping, which must have been made with
+// gl.MAP_FLUSH_EXPLICIT_BIT.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glFlushMappedBufferRange.xml
+func (m *Mapping) FlushRange(offset, length int) {
+	if safetyflag {
+		safetyCheckMapping("Mapping.FlushRange", m.unmapped)
+		safetyCheckBound("Mapping.FlushRange", kindBuffer, uint32(m.target), uint32(m.buffer))
+		safetyCheckFlushRange("Mapping.FlushRange", m.access, offset, length, len(m.memory))
+	}
+	if m.unmapped {
+		return
+	}
+	if m.shadow {
+		copy(m.memory[offset:offset+length], m.data[offset:offset+length])
+	}
+	backend.FlushMappedBufferRange(uint32(m.target), offset, length)
+}
+
+// Unmap is an alias to glUnmapBuffer, the slices of the mapping must not be
+// used anymore. It returns ErrMappingCorrupted if OpenGL reports the data
+// store was corrupted while mapped. Unmapping twice does nothing.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glMapBufferRange.xml
+func (m *Mapping) Unmap() error {
+	if safetyflag {
+		safetyCheckMapping("Mapping.Unmap", m.unmapped)
+		safetyCheckBound("Mapping.Unmap", kindBuffer, uint32(m.target), uint32(m.buffer))
+	}
+	if m.unmapped {
+		return nil
+	}
+	if m.shadow && m.access&MAP_WRITE_BIT != 0 && m.access&MAP_FLUSH_EXPLICIT_BIT == 0 {
+		copy(m.memory, m.data)
+	}
+	ok := backend.UnmapBuffer(uint32(m.target))
+	if m.shadow {
+		for i := range m.data {
+			m.data[i] = mappingPoison
+		}
+	}
+	m.unmapped, m.memory, m.data = true, nil, nil
+	if !ok {
+		return ErrMappingCorrupted
+	}
+	return nil
+}
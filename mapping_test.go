@@ -0,0 +1,65 @@
+package gl
+
+import (
+	"bytes"
+	"testing"
+	"unsafe"
+)
+
+func TestMappingShadow(t *testing.T) {
+	tests := []struct {
+		name   string
+		access MapAccess
+		// shadowed is true if safety builds hand out a copy.
+		shadowed bool
+	}{
+		{"read", MAP_READ_BIT, true},
+		{"read write", MAP_READ_BIT | MAP_WRITE_BIT, true},
+		{"write only", MAP_WRITE_BIT, true},
+		{"write only explicit flush", MAP_WRITE_BIT | MAP_FLUSH_EXPLICIT_BIT, true},
+		{"write invalidate range", MAP_WRITE_BIT | MAP_INVALIDATE_RANGE_BIT, true},
+		{"write invalidate buffer", MAP_WRITE_BIT | MAP_INVALIDATE_BUFFER_BIT, true},
+		{"persistent", MAP_READ_BIT | MAP_WRITE_BIT | MAP_PERSISTENT_BIT, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newFake(t)
+			b := GenBuffer()
+			b.Bind(ARRAY_BUFFER)
+			data := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+			b.Data(ARRAY_BUFFER, len(data), unsafe.Pointer(&data[0]), DYNAMIC_DRAW)
+			m, err := b.MapRange(ARRAY_BUFFER, 2, 4, tt.access)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, want := m.shadow, safetyflag && tt.shadowed; got != want {
+				t.Errorf("shadow = %v, want %v", got, want)
+			}
+			if copied := &m.Bytes()[0] != &m.memory[0]; copied != m.shadow {
+				t.Errorf("Bytes() is a copy: %v, want %v", copied, m.shadow)
+			}
+			if tt.access&MAP_WRITE_BIT != 0 {
+				m.Bytes()[1] = 42
+				if tt.access&MAP_FLUSH_EXPLICIT_BIT != 0 {
+					m.FlushRange(1, 1)
+				}
+			}
+			if err := m.Unmap(); err != nil {
+				t.Fatal(err)
+			}
+			got := make([]byte, len(data))
+			b.GetSubData(ARRAY_BUFFER, 0, len(got), unsafe.Pointer(&got[0]))
+			want := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+			if tt.access&MAP_WRITE_BIT != 0 {
+				want[3] = 42
+			}
+			// The rest of an invalidated range is undefined.
+			if tt.access&(MAP_INVALIDATE_RANGE_BIT|MAP_INVALIDATE_BUFFER_BIT) != 0 {
+				got[2], got[4], got[5] = want[2], want[4], want[5]
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("buffer holds %v after Unmap, want %v", got, want)
+			}
+		})
+	}
+}
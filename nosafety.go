@@ -0,0 +1,27 @@
+//go:build !safety
+
+package gl
+
+// safetyflag is false when building without -tags safety, every check guarded
+// by it is removed by the compiler.
+const safetyflag = false
+
+func safetyBind(kind objectKind, target, name uint32) {}
+
+func safetyReset() {}
+
+func safetyDelete(kind objectKind, name uint32) {}
+
+func safetyCheckBound(fn string, kind objectKind, target, name uint32) {}
+
+func safetyCheckAnyBound(fn string, kind objectKind, target uint32) {}
+
+func safetyCheckBufferRange(fn string, target uint32, offset, size int) {}
+
+func safetyCheckBufferObjectRange(fn string, b uint32, offset, size int) {}
+
+func safetyCheckBufferOverlap(fn string, readOffset, writeOffset, size int) {}
+
+func safetyCheckMapping(fn string, unmapped bool) {}
+
+func safetyCheckFlushRange(fn string, access MapAccess, offset, length, size int) {}
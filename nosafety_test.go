@@ -0,0 +1,7 @@
+//go:build !safety
+
+package gl
+
+import "testing"
+
+func panicOnSafety(t *testing.T) {}
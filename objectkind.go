@@ -0,0 +1,40 @@
+package gl
+
+// objectKind identifies which OpenGL object namespace a name belongs to. A
+// buffer and a texture can both be named 3, so every bookkeeping structure in
+// this package keys on the kind as well as the name.
+type objectKind uint8
+
+const (
+	kindBuffer objectKind = iota
+	kindTexture
+	kindFramebuffer
+	kindRenderBuffer
+	kindVertexArray
+	kindProgram
+	kindShader
+	kindTransformFeedback
+)
+
+func (k objectKind) String() string {
+	switch k {
+	case kindBuffer:
+		return "Buffer"
+	case kindTexture:
+		return "Texture"
+	case kindFramebuffer:
+		return "Framebuffer"
+	case kindRenderBuffer:
+		return "RenderBuffer"
+	case kindVertexArray:
+		return "VertexArray"
+	case kindProgram:
+		return "Program"
+	case kindShader:
+		return "Shader"
+	case kindTransformFeedback:
+		return "TransformFeedback"
+	default:
+		return "unknown object"
+	}
+}
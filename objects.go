@@ -0,0 +1,1009 @@
+// Code generated by glgen from gl.xml. DO NOT EDIT.
+
+package gl
+
+// GenBuffer is an alias to glGenBuffers(1, &b).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glGenBuffers.xml
+func GenBuffer() Buffer {
+	var b uint32
+	backend.GenBuffers(1, &b)
+	return Buffer(b)
+}
+
+// GenBuffers is an alias to glGenBuffers(n, &b[0]). It returns nil when n is 0.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glGenBuffers.xml
+func GenBuffers(n int32) []Buffer {
+	if n == 0 {
+		return nil
+	}
+	b := make([]Buffer, n)
+	backend.GenBuffers(n, (*uint32)(&b[0]))
+	return b
+}
+
+// Bind is an alias to glBindBuffer(target, b).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindBuffer.xml
+func (b Buffer) Bind(target BufferTarget) {
+	backend.BindBuffer(uint32(target), uint32(b))
+	if safetyflag {
+		safetyBind(kindBuffer, uint32(target), uint32(b))
+	}
+}
+
+// Unbind is an alias to glBindBuffer(target, 0).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindBuffer.xml
+func (b Buffer) Unbind(target BufferTarget) {
+	if safetyflag {
+		safetyCheckBound("Buffer.Unbind", kindBuffer, uint32(target), uint32(b))
+	}
+	backend.BindBuffer(uint32(target), 0)
+	if safetyflag {
+		safetyBind(kindBuffer, uint32(target), 0)
+	}
+}
+
+// Delete is an alias to glDeleteBuffers(1, &b). The buffer should not be used after calling this.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glDeleteBuffers.xml
+func (b Buffer) Delete() {
+	backend.DeleteBuffers(1, (*uint32)(&b))
+	if safetyflag {
+		safetyDelete(kindBuffer, uint32(b))
+	}
+}
+
+// GenTexture is an alias to glGenTextures(1, &t).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glGenTextures.xml
+func GenTexture() Texture {
+	var t uint32
+	backend.GenTextures(1, &t)
+	return Texture(t)
+}
+
+// GenTextures is an alias to glGenTextures(n, &t[0]). It returns nil when n is 0.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glGenTextures.xml
+func GenTextures(n int32) []Texture {
+	if n == 0 {
+		return nil
+	}
+	t := make([]Texture, n)
+	backend.GenTextures(n, (*uint32)(&t[0]))
+	return t
+}
+
+// Bind is an alias to glBindTexture(target, t).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindTexture.xml
+func (t Texture) Bind(target TextureTarget) {
+	backend.BindTexture(uint32(target), uint32(t))
+	if safetyflag {
+		safetyBind(kindTexture, uint32(target), uint32(t))
+	}
+}
+
+// Unbind is an alias to glBindTexture(target, 0).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindTexture.xml
+func (t Texture) Unbind(target TextureTarget) {
+	if safetyflag {
+		safetyCheckBound("Texture.Unbind", kindTexture, uint32(target), uint32(t))
+	}
+	backend.BindTexture(uint32(target), 0)
+	if safetyflag {
+		safetyBind(kindTexture, uint32(target), 0)
+	}
+}
+
+// Delete is an alias to glDeleteTextures(1, &t). The texture should not be used after calling this.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glDeleteTextures.xml
+func (t Texture) Delete() {
+	backend.DeleteTextures(1, (*uint32)(&t))
+	if safetyflag {
+		safetyDelete(kindTexture, uint32(t))
+	}
+}
+
+// IsTexture is an alias to glIsTexture(t).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glIsTexture.xml
+func (t Texture) IsTexture() bool {
+	return backend.IsTexture(uint32(t))
+}
+
+// GenTexture2D is an alias to glGenTextures(1, &t).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glGenTextures.xml
+func GenTexture2D() Texture2D {
+	var t uint32
+	backend.GenTextures(1, &t)
+	return Texture2D(t)
+}
+
+// GenTextures2D is an alias to glGenTextures(n, &t[0]). It returns nil when n is 0.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glGenTextures.xml
+func GenTextures2D(n int32) []Texture2D {
+	if n == 0 {
+		return nil
+	}
+	t := make([]Texture2D, n)
+	backend.GenTextures(n, (*uint32)(&t[0]))
+	return t
+}
+
+// Bind is an alias to glBindTexture(gl.TEXTURE_2D, t).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindTexture.xml
+func (t Texture2D) Bind() {
+	backend.BindTexture(uint32(TEXTURE_2D), uint32(t))
+	if safetyflag {
+		safetyBind(kindTexture, uint32(TEXTURE_2D), uint32(t))
+	}
+}
+
+// Unbind is an alias to glBindTexture(gl.TEXTURE_2D, 0).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindTexture.xml
+func (t Texture2D) Unbind() {
+	if safetyflag {
+		safetyCheckBound("Texture2D.Unbind", kindTexture, uint32(TEXTURE_2D), uint32(t))
+	}
+	backend.BindTexture(uint32(TEXTURE_2D), 0)
+	if safetyflag {
+		safetyBind(kindTexture, uint32(TEXTURE_2D), 0)
+	}
+}
+
+// Delete is an alias to glDeleteTextures(1, &t). The texture should not be used after calling this.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glDeleteTextures.xml
+func (t Texture2D) Delete() {
+	backend.DeleteTextures(1, (*uint32)(&t))
+	if safetyflag {
+		safetyDelete(kindTexture, uint32(t))
+	}
+}
+
+// GenFramebuffer is an alias to glGenFramebuffers(1, &fbo).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glGenFramebuffers.xml
+func GenFramebuffer() Framebuffer {
+	var fbo uint32
+	backend.GenFramebuffers(1, &fbo)
+	return Framebuffer(fbo)
+}
+
+// GenFramebuffers is an alias to glGenFramebuffers(n, &fbo[0]). It returns nil when n is 0.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glGenFramebuffers.xml
+func GenFramebuffers(n int32) []Framebuffer {
+	if n == 0 {
+		return nil
+	}
+	fbo := make([]Framebuffer, n)
+	backend.GenFramebuffers(n, (*uint32)(&fbo[0]))
+	return fbo
+}
+
+// Bind is an alias to glBindFramebuffer(target, fbo).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindFramebuffer.xml
+func (fbo Framebuffer) Bind(target FramebufferTarget) {
+	backend.BindFramebuffer(uint32(target), uint32(fbo))
+	if safetyflag {
+		safetyBind(kindFramebuffer, uint32(target), uint32(fbo))
+	}
+}
+
+// Unbind is an alias to glBindFramebuffer(target, 0).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindFramebuffer.xml
+func (fbo Framebuffer) Unbind(target FramebufferTarget) {
+	if safetyflag {
+		safetyCheckBound("Framebuffer.Unbind", kindFramebuffer, uint32(target), uint32(fbo))
+	}
+	backend.BindFramebuffer(uint32(target), 0)
+	if safetyflag {
+		safetyBind(kindFramebuffer, uint32(target), 0)
+	}
+}
+
+// Delete is an alias to glDeleteFramebuffers(1, &fbo). The framebuffer should not be used after calling this.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glDeleteFramebuffers.xml
+func (fbo Framebuffer) Delete() {
+	backend.DeleteFramebuffers(1, (*uint32)(&fbo))
+	if safetyflag {
+		safetyDelete(kindFramebuffer, uint32(fbo))
+	}
+}
+
+// GenRenderBuffer is an alias to glGenRenderbuffers(1, &rb).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glGenRenderbuffers.xml
+func GenRenderBuffer() RenderBuffer {
+	var rb uint32
+	backend.GenRenderbuffers(1, &rb)
+	return RenderBuffer(rb)
+}
+
+// GenRenderBuffers is an alias to glGenRenderbuffers(n, &rb[0]). It returns nil when n is 0.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glGenRenderbuffers.xml
+func GenRenderBuffers(n int32) []RenderBuffer {
+	if n == 0 {
+		return nil
+	}
+	rb := make([]RenderBuffer, n)
+	backend.GenRenderbuffers(n, (*uint32)(&rb[0]))
+	return rb
+}
+
+// Bind is an alias to glBindRenderbuffer(gl.RENDERBUFFER, rb).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindRenderbuffer.xml
+func (rb RenderBuffer) Bind() {
+	backend.BindRenderbuffer(RENDERBUFFER, uint32(rb))
+	if safetyflag {
+		safetyBind(kindRenderBuffer, RENDERBUFFER, uint32(rb))
+	}
+}
+
+// Unbind is an alias to glBindRenderbuffer(gl.RENDERBUFFER, 0).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindRenderbuffer.xml
+func (rb RenderBuffer) Unbind() {
+	if safetyflag {
+		safetyCheckBound("RenderBuffer.Unbind", kindRenderBuffer, RENDERBUFFER, uint32(rb))
+	}
+	backend.BindRenderbuffer(RENDERBUFFER, 0)
+	if safetyflag {
+		safetyBind(kindRenderBuffer, RENDERBUFFER, 0)
+	}
+}
+
+// Delete is an alias to glDeleteRenderbuffers(1, &rb). The renderbuffer should not be used after calling this.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glDeleteRenderbuffers.xml
+func (rb RenderBuffer) Delete() {
+	backend.DeleteRenderbuffers(1, (*uint32)(&rb))
+	if safetyflag {
+		safetyDelete(kindRenderBuffer, uint32(rb))
+	}
+}
+
+// GenVertexArray is an alias to glGenVertexArrays(1, &vao).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glGenVertexArrays.xml
+func GenVertexArray() VertexArray {
+	var vao uint32
+	backend.GenVertexArrays(1, &vao)
+	return VertexArray(vao)
+}
+
+// GenVertexArrays is an alias to glGenVertexArrays(n, &vao[0]). It returns nil when n is 0.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glGenVertexArrays.xml
+func GenVertexArrays(n int32) []VertexArray {
+	if n == 0 {
+		return nil
+	}
+	vao := make([]VertexArray, n)
+	backend.GenVertexArrays(n, (*uint32)(&vao[0]))
+	return vao
+}
+
+// Bind is an alias to glBindVertexArray(vao).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindVertexArray.xml
+func (vao VertexArray) Bind() {
+	backend.BindVertexArray(uint32(vao))
+	if safetyflag {
+		safetyBind(kindVertexArray, VERTEX_ARRAY_BINDING, uint32(vao))
+	}
+}
+
+// Unbind is an alias to glBindVertexArray(0).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindVertexArray.xml
+func (vao VertexArray) Unbind() {
+	if safetyflag {
+		safetyCheckBound("VertexArray.Unbind", kindVertexArray, VERTEX_ARRAY_BINDING, uint32(vao))
+	}
+	backend.BindVertexArray(0)
+	if safetyflag {
+		safetyBind(kindVertexArray, VERTEX_ARRAY_BINDING, 0)
+	}
+}
+
+// Delete is an alias to glDeleteVertexArrays(1, &vao). The vertex array should not be used after calling this.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glDeleteVertexArrays.xml
+func (vao VertexArray) Delete() {
+	backend.DeleteVertexArrays(1, (*uint32)(&vao))
+	if safetyflag {
+		safetyDelete(kindVertexArray, uint32(vao))
+	}
+}
+
+// GenTransformFeedback is an alias to glGenTransformFeedbacks(1, &tf).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man/html/glGenTransformFeedbacks.xhtml
+func GenTransformFeedback() TransformFeedback {
+	var tf uint32
+	backend.GenTransformFeedbacks(1, &tf)
+	return TransformFeedback(tf)
+}
+
+// GenTransformFeedbacks is an alias to glGenTransformFeedbacks(n, &tf[0]). It returns nil when n is 0.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man/html/glGenTransformFeedbacks.xhtml
+func GenTransformFeedbacks(n int32) []TransformFeedback {
+	if n == 0 {
+		return nil
+	}
+	tf := make([]TransformFeedback, n)
+	backend.GenTransformFeedbacks(n, (*uint32)(&tf[0]))
+	return tf
+}
+
+// Bind is an alias to glBindTransformFeedback(gl.TRANSFORM_FEEDBACK, tf).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man/html/glBindTransformFeedback.xhtml
+func (tf TransformFeedback) Bind() {
+	backend.BindTransformFeedback(TRANSFORM_FEEDBACK, uint32(tf))
+	if safetyflag {
+		safetyBind(kindTransformFeedback, TRANSFORM_FEEDBACK, uint32(tf))
+	}
+}
+
+// Unbind is an alias to glBindTransformFeedback(gl.TRANSFORM_FEEDBACK, 0).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man/html/glBindTransformFeedback.xhtml
+func (tf TransformFeedback) Unbind() {
+	if safetyflag {
+		safetyCheckBound("TransformFeedback.Unbind", kindTransformFeedback, TRANSFORM_FEEDBACK, uint32(tf))
+	}
+	backend.BindTransformFeedback(TRANSFORM_FEEDBACK, 0)
+	if safetyflag {
+		safetyBind(kindTransformFeedback, TRANSFORM_FEEDBACK, 0)
+	}
+}
+
+// Delete is an alias to glDeleteTransformFeedbacks(1, &tf). The transform feedback should not be used after calling this.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man/html/glDeleteTransformFeedbacks.xhtml
+func (tf TransformFeedback) Delete() {
+	backend.DeleteTransformFeedbacks(1, (*uint32)(&tf))
+	if safetyflag {
+		safetyDelete(kindTransformFeedback, uint32(tf))
+	}
+}
+
+// GenArrayBuffer is an alias to glGenBuffers(1, &b).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glGenBuffers.xml
+func GenArrayBuffer() ArrayBuffer {
+	var b uint32
+	backend.GenBuffers(1, &b)
+	return ArrayBuffer(b)
+}
+
+// GenArrayBuffers is an alias to glGenBuffers(n, &b[0]). It returns nil when n is 0.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glGenBuffers.xml
+func GenArrayBuffers(n int32) []ArrayBuffer {
+	if n == 0 {
+		return nil
+	}
+	b := make([]ArrayBuffer, n)
+	backend.GenBuffers(n, (*uint32)(&b[0]))
+	return b
+}
+
+// Bind is an alias to glBindBuffer(gl.ARRAY_BUFFER, b).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindBuffer.xml
+func (b ArrayBuffer) Bind() {
+	backend.BindBuffer(uint32(ARRAY_BUFFER), uint32(b))
+	if safetyflag {
+		safetyBind(kindBuffer, uint32(ARRAY_BUFFER), uint32(b))
+	}
+}
+
+// Unbind is an alias to glBindBuffer(gl.ARRAY_BUFFER, 0).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindBuffer.xml
+func (b ArrayBuffer) Unbind() {
+	if safetyflag {
+		safetyCheckBound("ArrayBuffer.Unbind", kindBuffer, uint32(ARRAY_BUFFER), uint32(b))
+	}
+	backend.BindBuffer(uint32(ARRAY_BUFFER), 0)
+	if safetyflag {
+		safetyBind(kindBuffer, uint32(ARRAY_BUFFER), 0)
+	}
+}
+
+// Delete is an alias to glDeleteBuffers(1, &b). The buffer should not be used after calling this.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glDeleteBuffers.xml
+func (b ArrayBuffer) Delete() {
+	backend.DeleteBuffers(1, (*uint32)(&b))
+	if safetyflag {
+		safetyDelete(kindBuffer, uint32(b))
+	}
+}
+
+// GenUniformBuffer is an alias to glGenBuffers(1, &b).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glGenBuffers.xml
+func GenUniformBuffer() UniformBuffer {
+	var b uint32
+	backend.GenBuffers(1, &b)
+	return UniformBuffer(b)
+}
+
+// GenUniformBuffers is an alias to glGenBuffers(n, &b[0]). It returns nil when n is 0.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glGenBuffers.xml
+func GenUniformBuffers(n int32) []UniformBuffer {
+	if n == 0 {
+		return nil
+	}
+	b := make([]UniformBuffer, n)
+	backend.GenBuffers(n, (*uint32)(&b[0]))
+	return b
+}
+
+// Bind is an alias to glBindBuffer(gl.UNIFORM_BUFFER, b).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindBuffer.xml
+func (b UniformBuffer) Bind() {
+	backend.BindBuffer(uint32(UNIFORM_BUFFER), uint32(b))
+	if safetyflag {
+		safetyBind(kindBuffer, uint32(UNIFORM_BUFFER), uint32(b))
+	}
+}
+
+// Unbind is an alias to glBindBuffer(gl.UNIFORM_BUFFER, 0).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindBuffer.xml
+func (b UniformBuffer) Unbind() {
+	if safetyflag {
+		safetyCheckBound("UniformBuffer.Unbind", kindBuffer, uint32(UNIFORM_BUFFER), uint32(b))
+	}
+	backend.BindBuffer(uint32(UNIFORM_BUFFER), 0)
+	if safetyflag {
+		safetyBind(kindBuffer, uint32(UNIFORM_BUFFER), 0)
+	}
+}
+
+// Delete is an alias to glDeleteBuffers(1, &b). The buffer should not be used after calling this.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glDeleteBuffers.xml
+func (b UniformBuffer) Delete() {
+	backend.DeleteBuffers(1, (*uint32)(&b))
+	if safetyflag {
+		safetyDelete(kindBuffer, uint32(b))
+	}
+}
+
+// GenPixelPackBuffer is an alias to glGenBuffers(1, &b).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glGenBuffers.xml
+func GenPixelPackBuffer() PixelPackBuffer {
+	var b uint32
+	backend.GenBuffers(1, &b)
+	return PixelPackBuffer(b)
+}
+
+// GenPixelPackBuffers is an alias to glGenBuffers(n, &b[0]). It returns nil when n is 0.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glGenBuffers.xml
+func GenPixelPackBuffers(n int32) []PixelPackBuffer {
+	if n == 0 {
+		return nil
+	}
+	b := make([]PixelPackBuffer, n)
+	backend.GenBuffers(n, (*uint32)(&b[0]))
+	return b
+}
+
+// Bind is an alias to glBindBuffer(gl.PIXEL_PACK_BUFFER, b).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindBuffer.xml
+func (b PixelPackBuffer) Bind() {
+	backend.BindBuffer(uint32(PIXEL_PACK_BUFFER), uint32(b))
+	if safetyflag {
+		safetyBind(kindBuffer, uint32(PIXEL_PACK_BUFFER), uint32(b))
+	}
+}
+
+// Unbind is an alias to glBindBuffer(gl.PIXEL_PACK_BUFFER, 0).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindBuffer.xml
+func (b PixelPackBuffer) Unbind() {
+	if safetyflag {
+		safetyCheckBound("PixelPackBuffer.Unbind", kindBuffer, uint32(PIXEL_PACK_BUFFER), uint32(b))
+	}
+	backend.BindBuffer(uint32(PIXEL_PACK_BUFFER), 0)
+	if safetyflag {
+		safetyBind(kindBuffer, uint32(PIXEL_PACK_BUFFER), 0)
+	}
+}
+
+// Delete is an alias to glDeleteBuffers(1, &b). The buffer should not be used after calling this.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glDeleteBuffers.xml
+func (b PixelPackBuffer) Delete() {
+	backend.DeleteBuffers(1, (*uint32)(&b))
+	if safetyflag {
+		safetyDelete(kindBuffer, uint32(b))
+	}
+}
+
+// GenPixelUnpackBuffer is an alias to glGenBuffers(1, &b).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glGenBuffers.xml
+func GenPixelUnpackBuffer() PixelUnpackBuffer {
+	var b uint32
+	backend.GenBuffers(1, &b)
+	return PixelUnpackBuffer(b)
+}
+
+// GenPixelUnpackBuffers is an alias to glGenBuffers(n, &b[0]). It returns nil when n is 0.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glGenBuffers.xml
+func GenPixelUnpackBuffers(n int32) []PixelUnpackBuffer {
+	if n == 0 {
+		return nil
+	}
+	b := make([]PixelUnpackBuffer, n)
+	backend.GenBuffers(n, (*uint32)(&b[0]))
+	return b
+}
+
+// Bind is an alias to glBindBuffer(gl.PIXEL_UNPACK_BUFFER, b).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindBuffer.xml
+func (b PixelUnpackBuffer) Bind() {
+	backend.BindBuffer(uint32(PIXEL_UNPACK_BUFFER), uint32(b))
+	if safetyflag {
+		safetyBind(kindBuffer, uint32(PIXEL_UNPACK_BUFFER), uint32(b))
+	}
+}
+
+// Unbind is an alias to glBindBuffer(gl.PIXEL_UNPACK_BUFFER, 0).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindBuffer.xml
+func (b PixelUnpackBuffer) Unbind() {
+	if safetyflag {
+		safetyCheckBound("PixelUnpackBuffer.Unbind", kindBuffer, uint32(PIXEL_UNPACK_BUFFER), uint32(b))
+	}
+	backend.BindBuffer(uint32(PIXEL_UNPACK_BUFFER), 0)
+	if safetyflag {
+		safetyBind(kindBuffer, uint32(PIXEL_UNPACK_BUFFER), 0)
+	}
+}
+
+// Delete is an alias to glDeleteBuffers(1, &b). The buffer should not be used after calling this.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glDeleteBuffers.xml
+func (b PixelUnpackBuffer) Delete() {
+	backend.DeleteBuffers(1, (*uint32)(&b))
+	if safetyflag {
+		safetyDelete(kindBuffer, uint32(b))
+	}
+}
+
+// GenCopyReadBuffer is an alias to glGenBuffers(1, &b).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glGenBuffers.xml
+func GenCopyReadBuffer() CopyReadBuffer {
+	var b uint32
+	backend.GenBuffers(1, &b)
+	return CopyReadBuffer(b)
+}
+
+// GenCopyReadBuffers is an alias to glGenBuffers(n, &b[0]). It returns nil when n is 0.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glGenBuffers.xml
+func GenCopyReadBuffers(n int32) []CopyReadBuffer {
+	if n == 0 {
+		return nil
+	}
+	b := make([]CopyReadBuffer, n)
+	backend.GenBuffers(n, (*uint32)(&b[0]))
+	return b
+}
+
+// Bind is an alias to glBindBuffer(gl.COPY_READ_BUFFER, b).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindBuffer.xml
+func (b CopyReadBuffer) Bind() {
+	backend.BindBuffer(uint32(COPY_READ_BUFFER), uint32(b))
+	if safetyflag {
+		safetyBind(kindBuffer, uint32(COPY_READ_BUFFER), uint32(b))
+	}
+}
+
+// Unbind is an alias to glBindBuffer(gl.COPY_READ_BUFFER, 0).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindBuffer.xml
+func (b CopyReadBuffer) Unbind() {
+	if safetyflag {
+		safetyCheckBound("CopyReadBuffer.Unbind", kindBuffer, uint32(COPY_READ_BUFFER), uint32(b))
+	}
+	backend.BindBuffer(uint32(COPY_READ_BUFFER), 0)
+	if safetyflag {
+		safetyBind(kindBuffer, uint32(COPY_READ_BUFFER), 0)
+	}
+}
+
+// Delete is an alias to glDeleteBuffers(1, &b). The buffer should not be used after calling this.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glDeleteBuffers.xml
+func (b CopyReadBuffer) Delete() {
+	backend.DeleteBuffers(1, (*uint32)(&b))
+	if safetyflag {
+		safetyDelete(kindBuffer, uint32(b))
+	}
+}
+
+// GenTransformFeedbackBuffer is an alias to glGenBuffers(1, &b).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glGenBuffers.xml
+func GenTransformFeedbackBuffer() TransformFeedbackBuffer {
+	var b uint32
+	backend.GenBuffers(1, &b)
+	return TransformFeedbackBuffer(b)
+}
+
+// GenTransformFeedbackBuffers is an alias to glGenBuffers(n, &b[0]). It returns nil when n is 0.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glGenBuffers.xml
+func GenTransformFeedbackBuffers(n int32) []TransformFeedbackBuffer {
+	if n == 0 {
+		return nil
+	}
+	b := make([]TransformFeedbackBuffer, n)
+	backend.GenBuffers(n, (*uint32)(&b[0]))
+	return b
+}
+
+// Bind is an alias to glBindBuffer(gl.TRANSFORM_FEEDBACK_BUFFER, b).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindBuffer.xml
+func (b TransformFeedbackBuffer) Bind() {
+	backend.BindBuffer(uint32(TRANSFORM_FEEDBACK_BUFFER), uint32(b))
+	if safetyflag {
+		safetyBind(kindBuffer, uint32(TRANSFORM_FEEDBACK_BUFFER), uint32(b))
+	}
+}
+
+// Unbind is an alias to glBindBuffer(gl.TRANSFORM_FEEDBACK_BUFFER, 0).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindBuffer.xml
+func (b TransformFeedbackBuffer) Unbind() {
+	if safetyflag {
+		safetyCheckBound("TransformFeedbackBuffer.Unbind", kindBuffer, uint32(TRANSFORM_FEEDBACK_BUFFER), uint32(b))
+	}
+	backend.BindBuffer(uint32(TRANSFORM_FEEDBACK_BUFFER), 0)
+	if safetyflag {
+		safetyBind(kindBuffer, uint32(TRANSFORM_FEEDBACK_BUFFER), 0)
+	}
+}
+
+// Delete is an alias to glDeleteBuffers(1, &b). The buffer should not be used after calling this.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glDeleteBuffers.xml
+func (b TransformFeedbackBuffer) Delete() {
+	backend.DeleteBuffers(1, (*uint32)(&b))
+	if safetyflag {
+		safetyDelete(kindBuffer, uint32(b))
+	}
+}
+
+// GetShaderType returns this shaders shader type.
+func (s Shader) GetShaderType() int32 {
+	var params int32
+	backend.GetShaderiv(uint32(s), SHADER_TYPE, &params)
+	return params
+}
+
+// GetDeleteStatus returns true if shader is currently flagged for deletion, and
+// false otherwise.
+func (s Shader) GetDeleteStatus() bool {
+	var params int32
+	backend.GetShaderiv(uint32(s), DELETE_STATUS, &params)
+	return params == TRUE
+}
+
+// GetCompileStatus returns true if the last compile operation on shader was
+// successful, and false otherwise.
+func (s Shader) GetCompileStatus() bool {
+	var params int32
+	backend.GetShaderiv(uint32(s), COMPILE_STATUS, &params)
+	return params == TRUE
+}
+
+// GetInfoLogLength returns the number of characters in the information log for
+// shader including the null termination character (i.e., the size of the
+// character buffer required to store the information log). If shader has no
+// information log, a value of 0 is returned.
+func (s Shader) GetInfoLogLength() int {
+	var params int32
+	backend.GetShaderiv(uint32(s), INFO_LOG_LENGTH, &params)
+	return int(params)
+}
+
+// GetShaderSourceLength returns the length of the concatenation of the source
+// strings that make up the shader source for the shader, including the null
+// termination character. (i.e., the size of the character buffer required to
+// store the shader source). If no source code exists, 0 is returned.
+func (s Shader) GetShaderSourceLength() int {
+	var params int32
+	backend.GetShaderiv(uint32(s), SHADER_SOURCE_LENGTH, &params)
+	return int(params)
+}
+
+// GetDeleteStatus returns true if program is currently flagged for deletion,
+// and false otherwise.
+func (p Program) GetDeleteStatus() bool {
+	var params int32
+	backend.GetProgramiv(uint32(p), DELETE_STATUS, &params)
+	return params == TRUE
+}
+
+// GetLinkStatus returns true if the last link operation on program was
+// successful, and false otherwise.
+func (p Program) GetLinkStatus() bool {
+	var params int32
+	backend.GetProgramiv(uint32(p), LINK_STATUS, &params)
+	return params == TRUE
+}
+
+// GetValidateStatus returns true or if the last validation operation on program
+// was successful, and false otherwise.
+func (p Program) GetValidateStatus() bool {
+	var params int32
+	backend.GetProgramiv(uint32(p), VALIDATE_STATUS, &params)
+	return params == TRUE
+}
+
+// GetInfoLogLength returns the number of characters in the information log for
+// program including the null termination character (i.e., the size of the
+// character buffer required to store the information log). If program has no
+// information log, a value of 0 is returned.
+func (p Program) GetInfoLogLength() int {
+	var params int32
+	backend.GetProgramiv(uint32(p), INFO_LOG_LENGTH, &params)
+	return int(params)
+}
+
+// GetNumAttachedShaders returns the number of shader objects attached to
+// program.
+func (p Program) GetNumAttachedShaders() int {
+	var params int32
+	backend.GetProgramiv(uint32(p), ATTACHED_SHADERS, &params)
+	return int(params)
+}
+
+// GetActiveAttributes returns the number of active attribute variables for
+// program.
+func (p Program) GetActiveAttributes() int {
+	var params int32
+	backend.GetProgramiv(uint32(p), ACTIVE_ATTRIBUTES, &params)
+	return int(params)
+}
+
+// GetActiveAttributeMaxLength returns the length of the longest active
+// attribute name for program, including the null termination character (i.e.,
+// the size of the character buffer required to store the longest attribute
+// name). If no active attributes exist, 0 is returned.
+func (p Program) GetActiveAttributeMaxLength() int {
+	var params int32
+	backend.GetProgramiv(uint32(p), ACTIVE_ATTRIBUTE_MAX_LENGTH, &params)
+	return int(params)
+}
+
+// GetNumActiveUniforms returns the number of active uniform variables for
+// program.
+func (p Program) GetNumActiveUniforms() int {
+	var params int32
+	backend.GetProgramiv(uint32(p), ACTIVE_UNIFORMS, &params)
+	return int(params)
+}
+
+// GetActiveUniformMaxLength returns the length of the longest active uniform
+// variable name for program, including the null termination character (i.e.,
+// the size of the character buffer required to store the longest uniform
+// variable name). If no active uniform variables exist, 0 is returned.
+func (p Program) GetActiveUniformMaxLength() int {
+	var params int32
+	backend.GetProgramiv(uint32(p), ACTIVE_UNIFORM_MAX_LENGTH, &params)
+	return int(params)
+}
+
+// GetTransformFeedbackBufferMode returns a symbolic constant indicating the
+// buffer mode used when transform feedback is active. This may be
+// GL_SEPARATE_ATTRIBS or GL_INTERLEAVED_ATTRIBS.
+func (p Program) GetTransformFeedbackBufferMode() int32 {
+	var params int32
+	backend.GetProgramiv(uint32(p), TRANSFORM_FEEDBACK_BUFFER_MODE, &params)
+	return params
+}
+
+// GetNumTransformFeedbackVaryings returns the number of varying variables to
+// capture in transform feedback mode for the program.
+func (p Program) GetNumTransformFeedbackVaryings() int {
+	var params int32
+	backend.GetProgramiv(uint32(p), TRANSFORM_FEEDBACK_VARYINGS, &params)
+	return int(params)
+}
+
+// GetTransformFeedbackVaryingMaxLength returns the length of the longest
+// variable name to be used for transform feedback, including the
+// null-terminator.
+func (p Program) GetTransformFeedbackVaryingMaxLength() int {
+	var params int32
+	backend.GetProgramiv(uint32(p), TRANSFORM_FEEDBACK_VARYING_MAX_LENGTH, &params)
+	return int(params)
+}
+
+// GetBaseLevel is an alias to glGetTexParameteriv(gl.TEXTURE_2D, gl.TEXTURE_BASE_LEVEL, &params).
+func (t Texture2D) GetBaseLevel() int32 {
+	if safetyflag {
+		safetyCheckBound("Texture2D.GetBaseLevel", kindTexture, uint32(TEXTURE_2D), uint32(t))
+	}
+	var params int32
+	backend.GetTexParameteriv(uint32(TEXTURE_2D), uint32(TEXTURE_BASE_LEVEL), &params)
+	return params
+}
+
+// GetCompareMode is an alias to glGetTexParameteriv(gl.TEXTURE_2D, gl.TEXTURE_COMPARE_MODE, &params).
+func (t Texture2D) GetCompareMode() int32 {
+	if safetyflag {
+		safetyCheckBound("Texture2D.GetCompareMode", kindTexture, uint32(TEXTURE_2D), uint32(t))
+	}
+	var params int32
+	backend.GetTexParameteriv(uint32(TEXTURE_2D), uint32(TEXTURE_COMPARE_MODE), &params)
+	return params
+}
+
+// GetCompareFunc is an alias to glGetTexParameteriv(gl.TEXTURE_2D, gl.TEXTURE_COMPARE_FUNC, &params).
+func (t Texture2D) GetCompareFunc() int32 {
+	if safetyflag {
+		safetyCheckBound("Texture2D.GetCompareFunc", kindTexture, uint32(TEXTURE_2D), uint32(t))
+	}
+	var params int32
+	backend.GetTexParameteriv(uint32(TEXTURE_2D), uint32(TEXTURE_COMPARE_FUNC), &params)
+	return params
+}
+
+// GetMagFilter is an alias to glGetTexParameteriv(gl.TEXTURE_2D, gl.TEXTURE_MAG_FILTER, &params).
+func (t Texture2D) GetMagFilter() int32 {
+	if safetyflag {
+		safetyCheckBound("Texture2D.GetMagFilter", kindTexture, uint32(TEXTURE_2D), uint32(t))
+	}
+	var params int32
+	backend.GetTexParameteriv(uint32(TEXTURE_2D), uint32(TEXTURE_MAG_FILTER), &params)
+	return params
+}
+
+// GetMaxLevel is an alias to glGetTexParameteriv(gl.TEXTURE_2D, gl.TEXTURE_MAX_LEVEL, &params).
+func (t Texture2D) GetMaxLevel() int32 {
+	if safetyflag {
+		safetyCheckBound("Texture2D.GetMaxLevel", kindTexture, uint32(TEXTURE_2D), uint32(t))
+	}
+	var params int32
+	backend.GetTexParameteriv(uint32(TEXTURE_2D), uint32(TEXTURE_MAX_LEVEL), &params)
+	return params
+}
+
+// GetMaxLOD is an alias to glGetTexParameteriv(gl.TEXTURE_2D, gl.TEXTURE_MAX_LOD, &params).
+func (t Texture2D) GetMaxLOD() int32 {
+	if safetyflag {
+		safetyCheckBound("Texture2D.GetMaxLOD", kindTexture, uint32(TEXTURE_2D), uint32(t))
+	}
+	var params int32
+	backend.GetTexParameteriv(uint32(TEXTURE_2D), uint32(TEXTURE_MAX_LOD), &params)
+	return params
+}
+
+// GetMinFilter is an alias to glGetTexParameteriv(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER, &params).
+func (t Texture2D) GetMinFilter() int32 {
+	if safetyflag {
+		safetyCheckBound("Texture2D.GetMinFilter", kindTexture, uint32(TEXTURE_2D), uint32(t))
+	}
+	var params int32
+	backend.GetTexParameteriv(uint32(TEXTURE_2D), uint32(TEXTURE_MIN_FILTER), &params)
+	return params
+}
+
+// GetMinLOD is an alias to glGetTexParameteriv(gl.TEXTURE_2D, gl.TEXTURE_MIN_LOD, &params).
+func (t Texture2D) GetMinLOD() int32 {
+	if safetyflag {
+		safetyCheckBound("Texture2D.GetMinLOD", kindTexture, uint32(TEXTURE_2D), uint32(t))
+	}
+	var params int32
+	backend.GetTexParameteriv(uint32(TEXTURE_2D), uint32(TEXTURE_MIN_LOD), &params)
+	return params
+}
+
+// GetSwizzleR is an alias to glGetTexParameteriv(gl.TEXTURE_2D, gl.TEXTURE_SWIZZLE_R, &params).
+func (t Texture2D) GetSwizzleR() int32 {
+	if safetyflag {
+		safetyCheckBound("Texture2D.GetSwizzleR", kindTexture, uint32(TEXTURE_2D), uint32(t))
+	}
+	var params int32
+	backend.GetTexParameteriv(uint32(TEXTURE_2D), uint32(TEXTURE_SWIZZLE_R), &params)
+	return params
+}
+
+// GetSwizzleG is an alias to glGetTexParameteriv(gl.TEXTURE_2D, gl.TEXTURE_SWIZZLE_G, &params).
+func (t Texture2D) GetSwizzleG() int32 {
+	if safetyflag {
+		safetyCheckBound("Texture2D.GetSwizzleG", kindTexture, uint32(TEXTURE_2D), uint32(t))
+	}
+	var params int32
+	backend.GetTexParameteriv(uint32(TEXTURE_2D), uint32(TEXTURE_SWIZZLE_G), &params)
+	return params
+}
+
+// GetSwizzleB is an alias to glGetTexParameteriv(gl.TEXTURE_2D, gl.TEXTURE_SWIZZLE_B, &params).
+func (t Texture2D) GetSwizzleB() int32 {
+	if safetyflag {
+		safetyCheckBound("Texture2D.GetSwizzleB", kindTexture, uint32(TEXTURE_2D), uint32(t))
+	}
+	var params int32
+	backend.GetTexParameteriv(uint32(TEXTURE_2D), uint32(TEXTURE_SWIZZLE_B), &params)
+	return params
+}
+
+// GetSwizzleA is an alias to glGetTexParameteriv(gl.TEXTURE_2D, gl.TEXTURE_SWIZZLE_A, &params).
+func (t Texture2D) GetSwizzleA() int32 {
+	if safetyflag {
+		safetyCheckBound("Texture2D.GetSwizzleA", kindTexture, uint32(TEXTURE_2D), uint32(t))
+	}
+	var params int32
+	backend.GetTexParameteriv(uint32(TEXTURE_2D), uint32(TEXTURE_SWIZZLE_A), &params)
+	return params
+}
+
+// GetWrapS is an alias to glGetTexParameteriv(gl.TEXTURE_2D, gl.TEXTURE_WRAP_S, &params).
+func (t Texture2D) GetWrapS() int32 {
+	if safetyflag {
+		safetyCheckBound("Texture2D.GetWrapS", kindTexture, uint32(TEXTURE_2D), uint32(t))
+	}
+	var params int32
+	backend.GetTexParameteriv(uint32(TEXTURE_2D), uint32(TEXTURE_WRAP_S), &params)
+	return params
+}
+
+// GetWrapT is an alias to glGetTexParameteriv(gl.TEXTURE_2D, gl.TEXTURE_WRAP_T, &params).
+func (t Texture2D) GetWrapT() int32 {
+	if safetyflag {
+		safetyCheckBound("Texture2D.GetWrapT", kindTexture, uint32(TEXTURE_2D), uint32(t))
+	}
+	var params int32
+	backend.GetTexParameteriv(uint32(TEXTURE_2D), uint32(TEXTURE_WRAP_T), &params)
+	return params
+}
+
+// GetWrapR is an alias to glGetTexParameteriv(gl.TEXTURE_2D, gl.TEXTURE_WRAP_R, &params).
+func (t Texture2D) GetWrapR() int32 {
+	if safetyflag {
+		safetyCheckBound("Texture2D.GetWrapR", kindTexture, uint32(TEXTURE_2D), uint32(t))
+	}
+	var params int32
+	backend.GetTexParameteriv(uint32(TEXTURE_2D), uint32(TEXTURE_WRAP_R), &params)
+	return params
+}
@@ -0,0 +1,113 @@
+// Code generated by glgen from gl.xml. DO NOT EDIT.
+
+//go:build !gles30
+
+package gl
+
+// GenTextureBuffer is an alias to glGenBuffers(1, &b).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glGenBuffers.xml
+func GenTextureBuffer() TextureBuffer {
+	var b uint32
+	backend.GenBuffers(1, &b)
+	return TextureBuffer(b)
+}
+
+// GenTextureBuffers is an alias to glGenBuffers(n, &b[0]). It returns nil when n is 0.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glGenBuffers.xml
+func GenTextureBuffers(n int32) []TextureBuffer {
+	if n == 0 {
+		return nil
+	}
+	b := make([]TextureBuffer, n)
+	backend.GenBuffers(n, (*uint32)(&b[0]))
+	return b
+}
+
+// Bind is an alias to glBindBuffer(gl.TEXTURE_BUFFER, b).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindBuffer.xml
+func (b TextureBuffer) Bind() {
+	backend.BindBuffer(TEXTURE_BUFFER, uint32(b))
+	if safetyflag {
+		safetyBind(kindBuffer, TEXTURE_BUFFER, uint32(b))
+	}
+}
+
+// Unbind is an alias to glBindBuffer(gl.TEXTURE_BUFFER, 0).
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindBuffer.xml
+func (b TextureBuffer) Unbind() {
+	if safetyflag {
+		safetyCheckBound("TextureBuffer.Unbind", kindBuffer, TEXTURE_BUFFER, uint32(b))
+	}
+	backend.BindBuffer(TEXTURE_BUFFER, 0)
+	if safetyflag {
+		safetyBind(kindBuffer, TEXTURE_BUFFER, 0)
+	}
+}
+
+// Delete is an alias to glDeleteBuffers(1, &b). The buffer should not be used after calling this.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glDeleteBuffers.xml
+func (b TextureBuffer) Delete() {
+	backend.DeleteBuffers(1, (*uint32)(&b))
+	if safetyflag {
+		safetyDelete(kindBuffer, uint32(b))
+	}
+}
+
+// GetNumGeometryVerticesOut returns the maximum number of vertices that the
+// geometry shader in program will output.
+func (p Program) GetNumGeometryVerticesOut() int {
+	var params int32
+	backend.GetProgramiv(uint32(p), GEOMETRY_VERTICES_OUT, &params)
+	return int(params)
+}
+
+// GetGeometryInputType returns a symbolic constant indicating the primitive
+// type accepted as input to the geometry shader contained in program.
+func (p Program) GetGeometryInputType() int32 {
+	var params int32
+	backend.GetProgramiv(uint32(p), GEOMETRY_INPUT_TYPE, &params)
+	return params
+}
+
+// GetGeometryOutputType returns a symbolic constant indicating the primitive
+// type that will be output by the geometry shader contained in program.
+func (p Program) GetGeometryOutputType() int32 {
+	var params int32
+	backend.GetProgramiv(uint32(p), GEOMETRY_OUTPUT_TYPE, &params)
+	return params
+}
+
+// GetBorderColor is an alias to glGetTexParameterfv(gl.TEXTURE_2D, gl.TEXTURE_BORDER_COLOR, &params[0]).
+func (t Texture2D) GetBorderColor() [4]float32 {
+	if safetyflag {
+		safetyCheckBound("Texture2D.GetBorderColor", kindTexture, uint32(TEXTURE_2D), uint32(t))
+	}
+	var params [4]float32
+	backend.GetTexParameterfv(uint32(TEXTURE_2D), uint32(TEXTURE_BORDER_COLOR), &params[0])
+	return params
+}
+
+// GetLODBias is an alias to glGetTexParameterfv(gl.TEXTURE_2D, gl.TEXTURE_LOD_BIAS, &params).
+func (t Texture2D) GetLODBias() float32 {
+	if safetyflag {
+		safetyCheckBound("Texture2D.GetLODBias", kindTexture, uint32(TEXTURE_2D), uint32(t))
+	}
+	var params float32
+	backend.GetTexParameterfv(uint32(TEXTURE_2D), uint32(TEXTURE_LOD_BIAS), &params)
+	return params
+}
+
+// GetSwizzleRGBA is an alias to glGetTexParameteriv(gl.TEXTURE_2D, gl.TEXTURE_SWIZZLE_RGBA, &params[0]).
+func (t Texture2D) GetSwizzleRGBA() [4]int32 {
+	if safetyflag {
+		safetyCheckBound("Texture2D.GetSwizzleRGBA", kindTexture, uint32(TEXTURE_2D), uint32(t))
+	}
+	var params [4]int32
+	backend.GetTexParameteriv(uint32(TEXTURE_2D), uint32(TEXTURE_SWIZZLE_RGBA), &params[0])
+	return params
+}
@@ -0,0 +1,31 @@
+package gl
+
+import "testing"
+
+func TestGenObjects(t *testing.T) {
+	tests := []struct {
+		name string
+		gen  func(n int32) int
+	}{
+		{"GenBuffers", func(n int32) int { return len(GenBuffers(n)) }},
+		{"GenTextures", func(n int32) int { return len(GenTextures(n)) }},
+		{"GenTextures2D", func(n int32) int { return len(GenTextures2D(n)) }},
+		{"GenFramebuffers", func(n int32) int { return len(GenFramebuffers(n)) }},
+		{"GenVertexArrays", func(n int32) int { return len(GenVertexArrays(n)) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFake(t)
+			f.Reset()
+			if got := tt.gen(0); got != 0 {
+				t.Errorf("%s(0) returned %d objects", tt.name, got)
+			}
+			if len(f.Calls) != 0 {
+				t.Errorf("%s(0) made calls:\n%s", tt.name, formatCalls(f.Calls))
+			}
+			if got := tt.gen(3); got != 3 || f.LiveObjects() != 3 {
+				t.Errorf("%s(3) returned %d objects, %d live", tt.name, got, f.LiveObjects())
+			}
+		})
+	}
+}
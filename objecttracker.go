@@ -0,0 +1,471 @@
+package gl
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"unsafe"
+)
+
+// These errors are reported by the object tracker.
+var (
+	ErrTrackingObjects = errors.New("gl: already tracking objects")
+	ErrDoubleDelete    = errors.New("gl: object deleted twice")
+	ErrUseAfterDelete  = errors.New("gl: object used after being deleted")
+)
+
+// ObjectError is a misuse of an object found by the object tracker, it
+// matches ErrDoubleDelete or ErrUseAfterDelete with errors.Is.
+type ObjectError struct {
+	Err  error
+	Kind string
+	Name uint32
+
+	// Func is the function of this package that was called, like
+	// "Texture.Bind", Call the OpenGL entry point it used and Caller the
+	// file:line it was called from.
+	Func   string
+	Call   string
+	Caller string
+
+	// Created and Deleted are the stacks where the object was created and
+	// deleted.
+	Created string
+	Deleted string
+}
+
+func (e *ObjectError) Error() string {
+	msg := fmt.Sprintf("%s: %s %d", e.Err, e.Kind, e.Name)
+	if e.Func != "" {
+		msg += " in " + e.Func
+	}
+	msg += " (" + e.Call + ")"
+	if e.Caller != "" {
+		msg += " at " + e.Caller
+	}
+	return msg
+}
+
+func (e *ObjectError) Unwrap() error {
+	return e.Err
+}
+
+// TrackedObject is a live object recorded by the object tracker.
+type TrackedObject struct {
+	Kind  string
+	Name  uint32
+	Stack string // where the object was created
+}
+
+// TrackObjects starts recording every object created and deleted through this
+// package, along with the stack that created it. Deleting an object twice or
+// passing a deleted object to OpenGL is reported to report, or logged with the
+// log package when report is nil. Objects created before tracking started are
+// ignored. Recording stacks is slow, it's meant for debugging leaks.
+func TrackObjects(report func(error)) error {
+	if trackerLayer != nil {
+		return ErrTrackingObjects
+	}
+	if report == nil {
+		report = func(err error) { log.Print(err) }
+	}
+	t := &objectTracker{
+		Backend: backend,
+		report:  report,
+		live:    map[trackedKey][]uintptr{},
+		deleted: map[trackedKey]trackedDeletion{},
+	}
+	trackerLayer = t
+	backend = t
+	return nil
+}
+
+// StopTrackingObjects stops the object tracker and forgets everything it
+// recorded.
+func StopTrackingObjects() {
+	t := trackerLayer
+	if t == nil {
+		return
+	}
+	trackerLayer = nil
+	if unlinkLayer(t) {
+		return
+	}
+	// A Backend from outside of this package wraps the tracker, keep it in
+	// the chain but let everything through.
+	t.mu.Lock()
+	t.stopped = true
+	t.live, t.deleted = nil, nil
+	t.mu.Unlock()
+}
+
+// TrackedObjects returns the live objects recorded by the object tracker
+// grouped by type, like "Texture" or "Buffer", and sorted by name. It returns
+// nil when objects aren't being tracked.
+func TrackedObjects() map[string][]TrackedObject {
+	t := trackerLayer
+	if t == nil {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	objects := map[string][]TrackedObject{}
+	for k, pcs := range t.live {
+		kind := k.kind.String()
+		objects[kind] = append(objects[kind], TrackedObject{Kind: kind, Name: k.name, Stack: formatStack(pcs)})
+	}
+	for _, objs := range objects {
+		sort.Slice(objs, func(i, j int) bool { return objs[i].Name < objs[j].Name })
+	}
+	return objects
+}
+
+// WriteTrackedObjects writes a report of the live objects recorded by the
+// object tracker to w.
+func WriteTrackedObjects(w io.Writer) error {
+	objects := TrackedObjects()
+	kinds := make([]string, 0, len(objects))
+	for kind := range objects {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	for _, kind := range kinds {
+		if _, err := fmt.Fprintf(w, "%d live %s\n", len(objects[kind]), kind); err != nil {
+			return err
+		}
+		for _, obj := range objects[kind] {
+			if _, err := fmt.Fprintf(w, "%s %d created at\n%s", obj.Kind, obj.Name, obj.Stack); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type trackedKey struct {
+	kind objectKind
+	name uint32
+}
+
+type trackedDeletion struct {
+	created, deleted []uintptr
+}
+
+// objectTracker is the backend installed by TrackObjects. OpenGL recycles
+// deleted names so a deleted object is forgotten as soon as its name is
+// created again.
+type objectTracker struct {
+	Backend
+	report func(error)
+
+	mu sync.Mutex
+	// stopped is set when StopTrackingObjects couldn't take the tracker out
+	// of the chain.
+	stopped bool
+	live    map[trackedKey][]uintptr
+	deleted map[trackedKey]trackedDeletion
+}
+
+// trackerLayer is the tracker installed by TrackObjects, it may be below
+// another Backend.
+var trackerLayer *objectTracker
+
+func (t *objectTracker) next() *Backend {
+	return &t.Backend
+}
+
+// callers returns the stack of the caller of the tracker method.
+func callers() []uintptr {
+	var pcs [32]uintptr
+	// 0 is runtime.Callers, 1 callers, 2 the tracker helper and 3 the method.
+	return append([]uintptr(nil), pcs[:runtime.Callers(4, pcs[:])]...)
+}
+
+func formatStack(pcs []uintptr) string {
+	var sb strings.Builder
+	frames := runtime.CallersFrames(pcs)
+	for {
+		frame, more := frames.Next()
+		fmt.Fprintf(&sb, "\t%s\n\t\t%s:%d\n", frame.Function, frame.File, frame.Line)
+		if !more {
+			return sb.String()
+		}
+	}
+}
+
+func (t *objectTracker) create(kind objectKind, names ...uint32) {
+	pcs := callers()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.stopped {
+		return
+	}
+	for _, name := range names {
+		k := trackedKey{kind, name}
+		delete(t.deleted, k)
+		t.live[k] = pcs
+	}
+}
+
+func (t *objectTracker) delete(call string, kind objectKind, names ...uint32) {
+	pcs := callers()
+	t.mu.Lock()
+	if t.stopped {
+		t.mu.Unlock()
+		return
+	}
+	var errs []error
+	for _, name := range names {
+		k := trackedKey{kind, name}
+		if created, ok := t.live[k]; ok {
+			delete(t.live, k)
+			t.deleted[k] = trackedDeletion{created: created, deleted: pcs}
+		} else if d, ok := t.deleted[k]; ok {
+			errs = append(errs, t.objectError(ErrDoubleDelete, call, kind, name, d))
+		}
+	}
+	t.mu.Unlock()
+	for _, err := range errs {
+		t.report(err)
+	}
+}
+
+// use reports names that were deleted. 0 is never reported, it's how objects
+// are unbound.
+func (t *objectTracker) use(call string, kind objectKind, names ...uint32) {
+	t.mu.Lock()
+	var errs []error
+	for _, name := range names {
+		if d, ok := t.deleted[trackedKey{kind, name}]; ok && name != 0 {
+			errs = append(errs, t.objectError(ErrUseAfterDelete, call, kind, name, d))
+		}
+	}
+	t.mu.Unlock()
+	for _, err := range errs {
+		t.report(err)
+	}
+}
+
+func (t *objectTracker) objectError(err error, call string, kind objectKind, name uint32, d trackedDeletion) *ObjectError {
+	fn, caller := callerInfo()
+	return &ObjectError{
+		Err:     err,
+		Kind:    kind.String(),
+		Name:    name,
+		Func:    fn,
+		Call:    call,
+		Caller:  caller,
+		Created: formatStack(d.created),
+		Deleted: formatStack(d.deleted),
+	}
+}
+
+func trackedNames(n int32, p *uint32) []uint32 {
+	if n <= 0 {
+		return nil
+	}
+	return unsafe.Slice(p, n)
+}
+
+func (t *objectTracker) AttachShader(program, shader uint32) {
+	t.use("glAttachShader", kindProgram, program)
+	t.use("glAttachShader", kindShader, shader)
+	t.Backend.AttachShader(program, shader)
+}
+
+func (t *objectTracker) BindBuffer(target, buffer uint32) {
+	t.use("glBindBuffer", kindBuffer, buffer)
+	t.Backend.BindBuffer(target, buffer)
+}
+
+func (t *objectTracker) BindBufferBase(target, index, buffer uint32) {
+	t.use("glBindBufferBase", kindBuffer, buffer)
+	t.Backend.BindBufferBase(target, index, buffer)
+}
+
+func (t *objectTracker) BindBufferRange(target, index, buffer uint32, offset, size int) {
+	t.use("glBindBufferRange", kindBuffer, buffer)
+	t.Backend.BindBufferRange(target, index, buffer, offset, size)
+}
+
+func (t *objectTracker) BindFramebuffer(target, framebuffer uint32) {
+	t.use("glBindFramebuffer", kindFramebuffer, framebuffer)
+	t.Backend.BindFramebuffer(target, framebuffer)
+}
+
+func (t *objectTracker) BindRenderbuffer(target, renderbuffer uint32) {
+	t.use("glBindRenderbuffer", kindRenderBuffer, renderbuffer)
+	t.Backend.BindRenderbuffer(target, renderbuffer)
+}
+
+func (t *objectTracker) BindTexture(target, texture uint32) {
+	t.use("glBindTexture", kindTexture, texture)
+	t.Backend.BindTexture(target, texture)
+}
+
+func (t *objectTracker) BindTransformFeedback(target, id uint32) {
+	t.use("glBindTransformFeedback", kindTransformFeedback, id)
+	t.Backend.BindTransformFeedback(target, id)
+}
+
+func (t *objectTracker) BindVertexArray(array uint32) {
+	t.use("glBindVertexArray", kindVertexArray, array)
+	t.Backend.BindVertexArray(array)
+}
+
+func (t *objectTracker) CompileShader(shader uint32) {
+	t.use("glCompileShader", kindShader, shader)
+	t.Backend.CompileShader(shader)
+}
+
+func (t *objectTracker) CreateProgram() uint32 {
+	p := t.Backend.CreateProgram()
+	t.create(kindProgram, p)
+	return p
+}
+
+func (t *objectTracker) CreateShader(xtype uint32) uint32 {
+	s := t.Backend.CreateShader(xtype)
+	t.create(kindShader, s)
+	return s
+}
+
+func (t *objectTracker) DeleteBuffers(n int32, buffers *uint32) {
+	t.delete("glDeleteBuffers", kindBuffer, trackedNames(n, buffers)...)
+	t.Backend.DeleteBuffers(n, buffers)
+}
+
+func (t *objectTracker) DeleteFramebuffers(n int32, framebuffers *uint32) {
+	t.delete("glDeleteFramebuffers", kindFramebuffer, trackedNames(n, framebuffers)...)
+	t.Backend.DeleteFramebuffers(n, framebuffers)
+}
+
+func (t *objectTracker) DeleteProgram(program uint32) {
+	t.delete("glDeleteProgram", kindProgram, program)
+	t.Backend.DeleteProgram(program)
+}
+
+func (t *objectTracker) DeleteRenderbuffers(n int32, renderbuffers *uint32) {
+	t.delete("glDeleteRenderbuffers", kindRenderBuffer, trackedNames(n, renderbuffers)...)
+	t.Backend.DeleteRenderbuffers(n, renderbuffers)
+}
+
+func (t *objectTracker) DeleteShader(shader uint32) {
+	t.delete("glDeleteShader", kindShader, shader)
+	t.Backend.DeleteShader(shader)
+}
+
+func (t *objectTracker) DeleteTextures(n int32, textures *uint32) {
+	t.delete("glDeleteTextures", kindTexture, trackedNames(n, textures)...)
+	t.Backend.DeleteTextures(n, textures)
+}
+
+func (t *objectTracker) DeleteTransformFeedbacks(n int32, ids *uint32) {
+	t.delete("glDeleteTransformFeedbacks", kindTransformFeedback, trackedNames(n, ids)...)
+	t.Backend.DeleteTransformFeedbacks(n, ids)
+}
+
+func (t *objectTracker) DeleteVertexArrays(n int32, arrays *uint32) {
+	t.delete("glDeleteVertexArrays", kindVertexArray, trackedNames(n, arrays)...)
+	t.Backend.DeleteVertexArrays(n, arrays)
+}
+
+func (t *objectTracker) FramebufferRenderbuffer(target, attachment, renderbuffertarget, renderbuffer uint32) {
+	t.use("glFramebufferRenderbuffer", kindRenderBuffer, renderbuffer)
+	t.Backend.FramebufferRenderbuffer(target, attachment, renderbuffertarget, renderbuffer)
+}
+
+func (t *objectTracker) GenBuffers(n int32, buffers *uint32) {
+	t.Backend.GenBuffers(n, buffers)
+	t.create(kindBuffer, trackedNames(n, buffers)...)
+}
+
+func (t *objectTracker) GenFramebuffers(n int32, framebuffers *uint32) {
+	t.Backend.GenFramebuffers(n, framebuffers)
+	t.create(kindFramebuffer, trackedNames(n, framebuffers)...)
+}
+
+func (t *objectTracker) GenRenderbuffers(n int32, renderbuffers *uint32) {
+	t.Backend.GenRenderbuffers(n, renderbuffers)
+	t.create(kindRenderBuffer, trackedNames(n, renderbuffers)...)
+}
+
+func (t *objectTracker) GenTextures(n int32, textures *uint32) {
+	t.Backend.GenTextures(n, textures)
+	t.create(kindTexture, trackedNames(n, textures)...)
+}
+
+func (t *objectTracker) GenTransformFeedbacks(n int32, ids *uint32) {
+	t.Backend.GenTransformFeedbacks(n, ids)
+	t.create(kindTransformFeedback, trackedNames(n, ids)...)
+}
+
+func (t *objectTracker) GenVertexArrays(n int32, arrays *uint32) {
+	t.Backend.GenVertexArrays(n, arrays)
+	t.create(kindVertexArray, trackedNames(n, arrays)...)
+}
+
+func (t *objectTracker) GetActiveAttrib(program, index uint32, bufSize int32, length, size *int32, xtype *uint32, name *uint8) {
+	t.use("glGetActiveAttrib", kindProgram, program)
+	t.Backend.GetActiveAttrib(program, index, bufSize, length, size, xtype, name)
+}
+
+func (t *objectTracker) GetAttachedShaders(program uint32, maxCount int32, count *int32, shaders *uint32) {
+	t.use("glGetAttachedShaders", kindProgram, program)
+	t.Backend.GetAttachedShaders(program, maxCount, count, shaders)
+}
+
+func (t *objectTracker) GetAttribLocation(program uint32, name *uint8) int32 {
+	t.use("glGetAttribLocation", kindProgram, program)
+	return t.Backend.GetAttribLocation(program, name)
+}
+
+func (t *objectTracker) GetProgramInfoLog(program uint32, bufSize int32, length *int32, infoLog *uint8) {
+	t.use("glGetProgramInfoLog", kindProgram, program)
+	t.Backend.GetProgramInfoLog(program, bufSize, length, infoLog)
+}
+
+func (t *objectTracker) GetProgramiv(program, pname uint32, params *int32) {
+	t.use("glGetProgramiv", kindProgram, program)
+	t.Backend.GetProgramiv(program, pname, params)
+}
+
+func (t *objectTracker) GetShaderInfoLog(shader uint32, bufSize int32, length *int32, infoLog *uint8) {
+	t.use("glGetShaderInfoLog", kindShader, shader)
+	t.Backend.GetShaderInfoLog(shader, bufSize, length, infoLog)
+}
+
+func (t *objectTracker) GetShaderSource(shader uint32, bufSize int32, length *int32, source *uint8) {
+	t.use("glGetShaderSource", kindShader, shader)
+	t.Backend.GetShaderSource(shader, bufSize, length, source)
+}
+
+func (t *objectTracker) GetShaderiv(shader, pname uint32, params *int32) {
+	t.use("glGetShaderiv", kindShader, shader)
+	t.Backend.GetShaderiv(shader, pname, params)
+}
+
+func (t *objectTracker) GetUniformLocation(program uint32, name *uint8) int32 {
+	t.use("glGetUniformLocation", kindProgram, program)
+	return t.Backend.GetUniformLocation(program, name)
+}
+
+func (t *objectTracker) LinkProgram(program uint32) {
+	t.use("glLinkProgram", kindProgram, program)
+	t.Backend.LinkProgram(program)
+}
+
+func (t *objectTracker) ShaderSource(shader uint32, count int32, xstring **uint8, length *int32) {
+	t.use("glShaderSource", kindShader, shader)
+	t.Backend.ShaderSource(shader, count, xstring, length)
+}
+
+func (t *objectTracker) UseProgram(program uint32) {
+	t.use("glUseProgram", kindProgram, program)
+	t.Backend.UseProgram(program)
+}
@@ -0,0 +1,13 @@
+//go:build !gles30
+
+package gl
+
+func (t *objectTracker) BindFragDataLocation(program, color uint32, name *uint8) {
+	t.use("glBindFragDataLocation", kindProgram, program)
+	t.Backend.BindFragDataLocation(program, color, name)
+}
+
+func (t *objectTracker) FramebufferTexture(target, attachment, texture uint32, level int32) {
+	t.use("glFramebufferTexture", kindTexture, texture)
+	t.Backend.FramebufferTexture(target, attachment, texture, level)
+}
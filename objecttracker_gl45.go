@@ -0,0 +1,13 @@
+//go:build gl45
+
+package gl
+
+func (t *objectTracker) InvalidateBufferData(buffer uint32) {
+	t.use("glInvalidateBufferData", kindBuffer, buffer)
+	t.Backend.InvalidateBufferData(buffer)
+}
+
+func (t *objectTracker) InvalidateBufferSubData(buffer uint32, offset, length int) {
+	t.use("glInvalidateBufferSubData", kindBuffer, buffer)
+	t.Backend.InvalidateBufferSubData(buffer, offset, length)
+}
@@ -0,0 +1,34 @@
+//go:build gl45
+
+package gl
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestObjectTrackerInvalidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		invalidate func(b Buffer)
+	}{
+		{"InvalidateBufferData", func(b Buffer) { backend.InvalidateBufferData(uint32(b)) }},
+		{"InvalidateBufferSubData", func(b Buffer) { backend.InvalidateBufferSubData(uint32(b), 0, 4) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newFake(t)
+			var got []error
+			if err := TrackObjects(func(err error) { got = append(got, err) }); err != nil {
+				t.Fatal(err)
+			}
+			defer StopTrackingObjects()
+			b := GenBuffer()
+			b.Delete()
+			tt.invalidate(b)
+			if len(got) != 1 || !errors.Is(got[0], ErrUseAfterDelete) {
+				t.Errorf("reported %v, want ErrUseAfterDelete", got)
+			}
+		})
+	}
+}
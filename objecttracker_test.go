@@ -0,0 +1,171 @@
+package gl
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+// wrapBackend is a Backend from outside of the package layers, that
+// StopTrackingObjects can't take the tracker out from under.
+type wrapBackend struct {
+	Backend
+}
+
+func TestObjectTracker(t *testing.T) {
+	tests := []struct {
+		name string
+		do   func()
+		want []error
+	}{
+		{
+			name: "clean",
+			do: func() {
+				b := GenBuffer()
+				b.Bind(ARRAY_BUFFER)
+				b.Unbind(ARRAY_BUFFER)
+				b.Delete()
+			},
+		},
+		{
+			name: "double delete",
+			do: func() {
+				b := GenBuffer()
+				b.Delete()
+				b.Delete()
+			},
+			want: []error{ErrDoubleDelete},
+		},
+		{
+			name: "use after delete",
+			do: func() {
+				p := CreateProgram()
+				p.Delete()
+				p.Use()
+			},
+			want: []error{ErrUseAfterDelete},
+		},
+		{
+			name: "attribute query after delete",
+			do: func() {
+				p := CreateProgram()
+				p.Delete()
+				p.GetAttribLocation("position")
+			},
+			want: []error{ErrUseAfterDelete},
+		},
+		{
+			name: "recycled name",
+			do: func() {
+				GenBuffer().Delete()
+				GenBuffer().Delete()
+			},
+		},
+		{
+			name: "created before tracking",
+			do: func() {
+				Buffer(1000).Delete()
+				Buffer(1000).Delete()
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newFake(t)
+			var got []error
+			if err := TrackObjects(func(err error) { got = append(got, err) }); err != nil {
+				t.Fatal(err)
+			}
+			defer StopTrackingObjects()
+			tt.do()
+			if len(got) != len(tt.want) {
+				t.Fatalf("reported %v, want %v", got, tt.want)
+			}
+			for i, err := range got {
+				var oerr *ObjectError
+				if !errors.Is(err, tt.want[i]) || !errors.As(err, &oerr) || oerr.Created == "" || oerr.Deleted == "" {
+					t.Errorf("reported %#v, want an ObjectError matching %v", err, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestTrackedObjects(t *testing.T) {
+	newFake(t)
+	if TrackedObjects() != nil {
+		t.Fatalf("TrackedObjects() isn't nil before TrackObjects")
+	}
+	if err := TrackObjects(nil); err != nil {
+		t.Fatal(err)
+	}
+	defer StopTrackingObjects()
+	if err := TrackObjects(nil); err != ErrTrackingObjects {
+		t.Errorf("second TrackObjects() = %v, want ErrTrackingObjects", err)
+	}
+	buffers := GenBuffers(2)
+	GenTexture()
+	buffers[0].Delete()
+	objects := TrackedObjects()
+	if len(objects["Buffer"]) != 1 || objects["Buffer"][0].Name != uint32(buffers[1]) || len(objects["Texture"]) != 1 {
+		t.Errorf("TrackedObjects() = %v, want buffer %d and a texture", objects, buffers[1])
+	}
+	var report bytes.Buffer
+	if err := WriteTrackedObjects(&report); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(report.Bytes(), []byte("1 live Buffer\n")) {
+		t.Errorf("WriteTrackedObjects() wrote\n%s", report.Bytes())
+	}
+}
+
+func TestStopTrackingObjectsBelowLayers(t *testing.T) {
+	tests := []struct {
+		name          string
+		install, stop func()
+	}{
+		{"trace", func() { StartTrace(&bytes.Buffer{}) }, func() { StopTrace() }},
+		{"state cache", func() { EnableStateCache() }, DisableStateCache},
+		{"thread check", func() { CheckThread(nil) }, StopCheckingThread},
+		{"outside backend", func() { backend = wrapBackend{backend} }, func() {}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFake(t)
+			var got []error
+			if err := TrackObjects(func(err error) { got = append(got, err) }); err != nil {
+				t.Fatal(err)
+			}
+			tt.install()
+			defer tt.stop()
+			GenBuffer()
+			StopTrackingObjects()
+			if TrackedObjects() != nil {
+				t.Errorf("TrackedObjects() isn't nil after StopTrackingObjects")
+			}
+			b := GenBuffer()
+			b.Delete()
+			b.Delete()
+			if got != nil {
+				t.Errorf("reported %v after StopTrackingObjects", got)
+			}
+			for l := backend; l != Backend(f); {
+				if _, ok := l.(*objectTracker); ok {
+					if tt.name != "outside backend" {
+						t.Errorf("tracker still installed after StopTrackingObjects")
+					}
+					break
+				}
+				if w, ok := l.(wrapBackend); ok {
+					l = w.Backend
+				} else {
+					l = *l.(backendLayer).next()
+				}
+			}
+			if err := TrackObjects(nil); err != nil {
+				t.Errorf("TrackObjects() after StopTrackingObjects = %v", err)
+			}
+			StopTrackingObjects()
+		})
+	}
+}
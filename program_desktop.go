@@ -0,0 +1,10 @@
+//go:build !gles30
+
+package gl
+
+//BindFragDataLocation is an alias to glBindFragDataLocation(p, color, name).
+//
+//Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindFragDataLocation.xml
+func (p Program) BindFragDataLocation(color uint32, name string) {
+	backend.BindFragDataLocation(uint32(p), color, glStr(name+"\x00"))
+}
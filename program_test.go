@@ -0,0 +1,104 @@
+package gl
+
+import "testing"
+
+func TestProgramCalls(t *testing.T) {
+	tests := []struct {
+		name string
+		do   func(p Program)
+		want []Call
+	}{
+		{
+			name: "AttachShader",
+			do:   func(p Program) { p.AttachShader(7) },
+			want: []Call{call("AttachShader", uint32(p1), uint32(7))},
+		},
+		{
+			name: "Link",
+			do:   func(p Program) { p.Link() },
+			want: []Call{call("LinkProgram", uint32(p1))},
+		},
+		{
+			name: "Use",
+			do:   func(p Program) { p.Use() },
+			want: []Call{call("UseProgram", uint32(p1))},
+		},
+		{
+			name: "Unuse",
+			do:   func(p Program) { p.Use(); p.Unuse() },
+			want: []Call{call("UseProgram", uint32(p1)), call("UseProgram", uint32(0))},
+		},
+		{
+			name: "GetLinkStatus",
+			do:   func(p Program) { p.GetLinkStatus() },
+			want: []Call{call("GetProgramiv", uint32(p1), uint32(LINK_STATUS), anyArg{})},
+		},
+		{
+			name: "Delete",
+			do:   func(p Program) { p.Delete() },
+			want: []Call{call("DeleteProgram", uint32(p1))},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFake(t)
+			p := CreateProgram()
+			if p != p1 {
+				t.Fatalf("CreateProgram() = %d, want %d", p, p1)
+			}
+			f.Reset()
+			tt.do(p)
+			checkCalls(t, f, tt.want...)
+		})
+	}
+}
+
+// p1 is the first program name the FakeBackend allocates.
+const p1 Program = 1
+
+func TestProgramQueries(t *testing.T) {
+	f := newFake(t)
+	p := CreateProgram()
+	if !p.GetLinkStatus() {
+		t.Errorf("GetLinkStatus() = false, want true")
+	}
+	f.Integers[LINK_STATUS] = []int32{FALSE}
+	if p.GetLinkStatus() {
+		t.Errorf("GetLinkStatus() = true after a failed link, want false")
+	}
+	p.Use()
+	if got := CurrentProgram(); got != p {
+		t.Errorf("CurrentProgram() = %d, want %d", got, p)
+	}
+	if a, b := p.GetUniformLocation("a"), p.GetUniformLocation("b"); a == b {
+		t.Errorf("uniforms a and b share location %d", a)
+	}
+	if a := p.GetUniformLocation("a"); a != p.GetUniformLocation("a") {
+		t.Errorf("GetUniformLocation(%q) isn't stable", "a")
+	}
+}
+
+func TestInfoLogs(t *testing.T) {
+	tests := []struct {
+		name string
+		log  string
+	}{
+		{"empty", ""},
+		{"one line", "0:1(1): error: syntax error"},
+		{"several lines", "warning: unused variable\nerror: undeclared identifier\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFake(t)
+			s, p := CreateShader(VERTEX_SHADER), CreateProgram()
+			f.InfoLogs[uint32(s)] = tt.log
+			f.InfoLogs[uint32(p)] = tt.log
+			if got := s.GetInfoLog(); got != tt.log {
+				t.Errorf("Shader.GetInfoLog() = %q, want %q", got, tt.log)
+			}
+			if got := p.GetInfoLog(); got != tt.log {
+				t.Errorf("Program.GetInfoLog() = %q, want %q", got, tt.log)
+			}
+		})
+	}
+}
@@ -0,0 +1,599 @@
+package gl
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"unsafe"
+)
+
+// ErrBadTrace is returned by Replay when its input isn't a trace written by
+// StartTrace.
+var ErrBadTrace = errors.New("gl: not a trace")
+
+// Replay reads a trace written by StartTrace and issues its calls again to the
+// current context. The object names and uniform locations recorded in the
+// trace are mapped to the ones the current context allocates, names that were
+// not created in the trace are used as is.
+func Replay(r io.Reader) error {
+	tr := &traceReader{
+		r:         bufio.NewReader(r),
+		names:     map[objectKind]map[uint32]uint32{},
+		locations: map[uniformKey]int32{},
+	}
+	var magic [len(traceMagic)]byte
+	if _, err := io.ReadFull(tr.r, magic[:]); err != nil || magic != traceMagic {
+		return ErrBadTrace
+	}
+	for {
+		op, err := binary.ReadUvarint(tr.r)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		tr.replay(traceOp(op))
+		if tr.err != nil {
+			if tr.err == io.EOF {
+				return io.ErrUnexpectedEOF
+			}
+			return tr.err
+		}
+	}
+}
+
+// uniformKey identifies a uniform location of a program of the trace.
+type uniformKey struct {
+	program  uint32
+	location int32
+}
+
+// traceReader decodes what traceWriter encodes. The first error is kept and
+// every following read returns zero values.
+type traceReader struct {
+	r   *bufio.Reader
+	err error
+
+	// names maps the names of the trace to the names of the current context.
+	names map[objectKind]map[uint32]uint32
+	// locations maps the uniform locations of the trace to the ones of the
+	// current context.
+	locations map[uniformKey]int32
+	// program is the program of the trace currently in use.
+	program uint32
+}
+
+func (r *traceReader) u32() uint32 {
+	if r.err != nil {
+		return 0
+	}
+	var v uint64
+	v, r.err = binary.ReadUvarint(r.r)
+	return uint32(v)
+}
+
+func (r *traceReader) i32() int32 {
+	return int32(r.int())
+}
+
+func (r *traceReader) int() int {
+	if r.err != nil {
+		return 0
+	}
+	var v int64
+	v, r.err = binary.ReadVarint(r.r)
+	return int(v)
+}
+
+func (r *traceReader) f32() float32 {
+	var b [4]byte
+	r.read(b[:])
+	return math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+}
+
+func (r *traceReader) bool() bool {
+	var b [1]byte
+	r.read(b[:])
+	return b[0] != 0
+}
+
+func (r *traceReader) read(b []byte) {
+	if r.err == nil {
+		_, r.err = io.ReadFull(r.r, b)
+	}
+}
+
+// traceChunk bounds what bytes allocates ahead of reading, so a corrupt
+// length runs into the end of the trace instead of allocating gigabytes.
+const traceChunk = 64 << 10
+
+// bytes reads n bytes, a chunk at a time.
+func (r *traceReader) bytes(n uint32) []byte {
+	b := make([]byte, 0, min(n, traceChunk))
+	for r.err == nil && uint32(len(b)) < n {
+		m := min(n-uint32(len(b)), traceChunk)
+		b = append(b, make([]byte, m)...)
+		r.read(b[len(b)-int(m):])
+	}
+	return b
+}
+
+// blob returns a blob written by traceWriter.blob, nil if a nil pointer was
+// recorded.
+func (r *traceReader) blob() []byte {
+	n := r.u32()
+	if n == 0 || r.err != nil {
+		return nil
+	}
+	return r.bytes(n - 1)
+}
+
+func (r *traceReader) str() string {
+	n := r.u32()
+	if r.err != nil {
+		return ""
+	}
+	return string(r.bytes(n))
+}
+
+// ptr returns a pointer to the first byte of b, nil if b is nil. A non nil
+// empty blob still yields a non nil pointer.
+func ptr(b []byte) unsafe.Pointer {
+	if b == nil {
+		return nil
+	}
+	if len(b) == 0 {
+		b = make([]byte, 1)
+	}
+	return unsafe.Pointer(&b[0])
+}
+
+// first returns a pointer to the first element of s, nil if it's empty.
+func first[T any](s []T) *T {
+	if len(s) == 0 {
+		return nil
+	}
+	return &s[0]
+}
+
+// floats reads a blob of float32 values, int32s and uint32s do the same for
+// integers. The blob is copied so the values are properly aligned.
+func (r *traceReader) floats() []float32 {
+	b := r.blob()
+	v := make([]float32, len(b)/4)
+	copy(unsafe.Slice((*byte)(unsafe.Pointer(first(v))), len(v)*4), b)
+	return v
+}
+
+func (r *traceReader) int32s() []int32 {
+	b := r.blob()
+	v := make([]int32, len(b)/4)
+	copy(unsafe.Slice((*byte)(unsafe.Pointer(first(v))), len(v)*4), b)
+	return v
+}
+
+func (r *traceReader) uint32s() []uint32 {
+	b := r.blob()
+	v := make([]uint32, len(b)/4)
+	copy(unsafe.Slice((*byte)(unsafe.Pointer(first(v))), len(v)*4), b)
+	return v
+}
+
+// offset reads a pointer that is an offset in a buffer object.
+func (r *traceReader) offset() unsafe.Pointer {
+	if r.err != nil {
+		return nil
+	}
+	var v uint64
+	v, r.err = binary.ReadUvarint(r.r)
+	return glPtrOffset(int(v))
+}
+
+// pixels reads what tracer.pixels wrote.
+func (r *traceReader) pixels() unsafe.Pointer {
+	if r.bool() {
+		return r.offset()
+	}
+	return ptr(r.blob())
+}
+
+// unavailable fails the replay of a call to an entry point that isn't part of
+// the Backend of the profile, like a trace of the 4.5 profile replayed in the
+// 3.3 one.
+func (r *traceReader) unavailable(entryPoint string) {
+	r.err = fmt.Errorf("gl: %s isn't available in the %s profile", entryPoint, Profile)
+}
+
+// name reads an object name of the trace and returns the name of the same
+// object in the current context.
+func (r *traceReader) name(kind objectKind) uint32 {
+	return r.mapped(kind, r.u32())
+}
+
+// mapped returns the name in the current context of the object of the trace
+// called name.
+func (r *traceReader) mapped(kind objectKind, name uint32) uint32 {
+	if mapped, ok := r.names[kind][name]; ok {
+		return mapped
+	}
+	return name
+}
+
+// mapNames records that the names of the trace are now called current.
+func (r *traceReader) mapNames(kind objectKind, trace, current []uint32) {
+	if r.names[kind] == nil {
+		r.names[kind] = map[uint32]uint32{}
+	}
+	for i := range trace {
+		r.names[kind][trace[i]] = current[i]
+	}
+}
+
+// genNames reads names written by traceWriter.names, allocates as many with
+// gen and maps them.
+func (r *traceReader) genNames(kind objectKind, gen func(int32, *uint32)) {
+	trace := r.traceNames()
+	if r.err != nil || len(trace) == 0 {
+		return
+	}
+	current := make([]uint32, len(trace))
+	gen(int32(len(current)), &current[0])
+	r.mapNames(kind, trace, current)
+}
+
+// deleteNames reads names written by traceWriter.names and deletes the objects
+// they map to with del.
+func (r *traceReader) deleteNames(kind objectKind, del func(int32, *uint32)) {
+	trace := r.traceNames()
+	if r.err != nil || len(trace) == 0 {
+		return
+	}
+	current := make([]uint32, len(trace))
+	for i, name := range trace {
+		current[i] = r.mapped(kind, name)
+	}
+	del(int32(len(current)), &current[0])
+}
+
+func (r *traceReader) traceNames() []uint32 {
+	n := r.i32()
+	if r.err != nil || n <= 0 {
+		return nil
+	}
+	names := make([]uint32, n)
+	for i := range names {
+		names[i] = r.u32()
+	}
+	return names
+}
+
+// location reads a uniform location of the trace and returns the location of
+// the same uniform in the program currently in use.
+func (r *traceReader) location() int32 {
+	loc := r.i32()
+	if mapped, ok := r.locations[uniformKey{r.program, loc}]; ok {
+		return mapped
+	}
+	return loc
+}
+
+// replay reads the arguments of op and issues it to the backend.
+func (r *traceReader) replay(op traceOp) {
+	switch op {
+	case traceBufferData:
+		target, size, data, usage := r.u32(), r.int(), r.blob(), r.u32()
+		backend.BufferData(target, size, ptr(data), usage)
+	case traceCreateProgram:
+		trace := r.u32()
+		r.mapNames(kindProgram, []uint32{trace}, []uint32{backend.CreateProgram()})
+	case traceCreateShader:
+		xtype, trace := r.u32(), r.u32()
+		r.mapNames(kindShader, []uint32{trace}, []uint32{backend.CreateShader(xtype)})
+	case traceDeleteBuffers:
+		r.deleteNames(kindBuffer, backend.DeleteBuffers)
+	case traceDeleteFramebuffers:
+		r.deleteNames(kindFramebuffer, backend.DeleteFramebuffers)
+	case traceDeleteRenderbuffers:
+		r.deleteNames(kindRenderBuffer, backend.DeleteRenderbuffers)
+	case traceDeleteTextures:
+		r.deleteNames(kindTexture, backend.DeleteTextures)
+	case traceDeleteTransformFeedbacks:
+		r.deleteNames(kindTransformFeedback, backend.DeleteTransformFeedbacks)
+	case traceDeleteVertexArrays:
+		r.deleteNames(kindVertexArray, backend.DeleteVertexArrays)
+	case traceDrawBuffers:
+		n, bufs := r.i32(), r.uint32s()
+		backend.DrawBuffers(n, first(bufs))
+	case traceGenBuffers:
+		r.genNames(kindBuffer, backend.GenBuffers)
+	case traceGenFramebuffers:
+		r.genNames(kindFramebuffer, backend.GenFramebuffers)
+	case traceGenRenderbuffers:
+		r.genNames(kindRenderBuffer, backend.GenRenderbuffers)
+	case traceGenTextures:
+		r.genNames(kindTexture, backend.GenTextures)
+	case traceGenTransformFeedbacks:
+		r.genNames(kindTransformFeedback, backend.GenTransformFeedbacks)
+	case traceGenVertexArrays:
+		r.genNames(kindVertexArray, backend.GenVertexArrays)
+	case traceGetUniformLocation:
+		program, name, loc := r.u32(), r.str(), r.i32()
+		current := backend.GetUniformLocation(r.mapped(kindProgram, program), glStr(name+"\x00"))
+		r.locations[uniformKey{program, loc}] = current
+	case traceShaderSource:
+		shader, count := r.name(kindShader), r.i32()
+		if count <= 0 {
+			backend.ShaderSource(shader, count, nil, nil)
+			return
+		}
+		sources := make([]string, count)
+		for i := range sources {
+			sources[i] = r.str() + "\x00"
+		}
+		if r.err != nil {
+			return
+		}
+		csources, free := glStrs(sources...)
+		backend.ShaderSource(shader, count, csources, nil)
+		free()
+	case traceTexImage2D:
+		target, level, internalformat, width, height, border, format, xtype := r.u32(), r.i32(), r.i32(), r.i32(), r.i32(), r.i32(), r.u32(), r.u32()
+		backend.TexImage2D(target, level, internalformat, width, height, border, format, xtype, r.pixels())
+	case traceTexImage3D:
+		target, level, internalformat, width, height, depth, border, format, xtype := r.u32(), r.i32(), r.i32(), r.i32(), r.i32(), r.i32(), r.i32(), r.u32(), r.u32()
+		backend.TexImage3D(target, level, internalformat, width, height, depth, border, format, xtype, r.pixels())
+	case traceTexParameterfv:
+		target, pname, params := r.u32(), r.u32(), r.floats()
+		backend.TexParameterfv(target, pname, first(params))
+	case traceTexParameteriv:
+		target, pname, params := r.u32(), r.u32(), r.int32s()
+		backend.TexParameteriv(target, pname, first(params))
+	case traceUseProgram:
+		r.program = r.u32()
+		backend.UseProgram(r.mapped(kindProgram, r.program))
+	case traceVertexAttribIPointer:
+		index, size, xtype, stride, pointer := r.u32(), r.i32(), r.u32(), r.i32(), r.offset()
+		backend.VertexAttribIPointer(index, size, xtype, stride, pointer)
+	case traceVertexAttribPointer:
+		index, size, xtype, normalized, stride, pointer := r.u32(), r.i32(), r.u32(), r.bool(), r.i32(), r.offset()
+		backend.VertexAttribPointer(index, size, xtype, normalized, stride, pointer)
+	case traceUniform1fv:
+		loc, count, v := r.location(), r.i32(), r.floats()
+		backend.Uniform1fv(loc, count, first(v))
+	case traceUniform1iv:
+		loc, count, v := r.location(), r.i32(), r.int32s()
+		backend.Uniform1iv(loc, count, first(v))
+	case traceUniform1uiv:
+		loc, count, v := r.location(), r.i32(), r.uint32s()
+		backend.Uniform1uiv(loc, count, first(v))
+	case traceUniform2fv:
+		loc, count, v := r.location(), r.i32(), r.floats()
+		backend.Uniform2fv(loc, count, first(v))
+	case traceUniform2iv:
+		loc, count, v := r.location(), r.i32(), r.int32s()
+		backend.Uniform2iv(loc, count, first(v))
+	case traceUniform2uiv:
+		loc, count, v := r.location(), r.i32(), r.uint32s()
+		backend.Uniform2uiv(loc, count, first(v))
+	case traceUniform3fv:
+		loc, count, v := r.location(), r.i32(), r.floats()
+		backend.Uniform3fv(loc, count, first(v))
+	case traceUniform3iv:
+		loc, count, v := r.location(), r.i32(), r.int32s()
+		backend.Uniform3iv(loc, count, first(v))
+	case traceUniform3uiv:
+		loc, count, v := r.location(), r.i32(), r.uint32s()
+		backend.Uniform3uiv(loc, count, first(v))
+	case traceUniform4fv:
+		loc, count, v := r.location(), r.i32(), r.floats()
+		backend.Uniform4fv(loc, count, first(v))
+	case traceUniform4iv:
+		loc, count, v := r.location(), r.i32(), r.int32s()
+		backend.Uniform4iv(loc, count, first(v))
+	case traceUniform4uiv:
+		loc, count, v := r.location(), r.i32(), r.uint32s()
+		backend.Uniform4uiv(loc, count, first(v))
+	case traceUniformMatrix2fv:
+		loc, count, transpose, v := r.location(), r.i32(), r.bool(), r.floats()
+		backend.UniformMatrix2fv(loc, count, transpose, first(v))
+	case traceUniformMatrix2x3fv:
+		loc, count, transpose, v := r.location(), r.i32(), r.bool(), r.floats()
+		backend.UniformMatrix2x3fv(loc, count, transpose, first(v))
+	case traceUniformMatrix2x4fv:
+		loc, count, transpose, v := r.location(), r.i32(), r.bool(), r.floats()
+		backend.UniformMatrix2x4fv(loc, count, transpose, first(v))
+	case traceUniformMatrix3fv:
+		loc, count, transpose, v := r.location(), r.i32(), r.bool(), r.floats()
+		backend.UniformMatrix3fv(loc, count, transpose, first(v))
+	case traceUniformMatrix3x2fv:
+		loc, count, transpose, v := r.location(), r.i32(), r.bool(), r.floats()
+		backend.UniformMatrix3x2fv(loc, count, transpose, first(v))
+	case traceUniformMatrix3x4fv:
+		loc, count, transpose, v := r.location(), r.i32(), r.bool(), r.floats()
+		backend.UniformMatrix3x4fv(loc, count, transpose, first(v))
+	case traceUniformMatrix4fv:
+		loc, count, transpose, v := r.location(), r.i32(), r.bool(), r.floats()
+		backend.UniformMatrix4fv(loc, count, transpose, first(v))
+	case traceUniformMatrix4x2fv:
+		loc, count, transpose, v := r.location(), r.i32(), r.bool(), r.floats()
+		backend.UniformMatrix4x2fv(loc, count, transpose, first(v))
+	case traceUniformMatrix4x3fv:
+		loc, count, transpose, v := r.location(), r.i32(), r.bool(), r.floats()
+		backend.UniformMatrix4x3fv(loc, count, transpose, first(v))
+	case traceActiveTexture:
+		backend.ActiveTexture(r.u32())
+	case traceAttachShader:
+		backend.AttachShader(r.name(kindProgram), r.name(kindShader))
+	case traceBeginTransformFeedback:
+		backend.BeginTransformFeedback(r.u32())
+	case traceBindBuffer:
+		backend.BindBuffer(r.u32(), r.name(kindBuffer))
+	case traceBindBufferBase:
+		backend.BindBufferBase(r.u32(), r.u32(), r.name(kindBuffer))
+	case traceBindBufferRange:
+		backend.BindBufferRange(r.u32(), r.u32(), r.name(kindBuffer), r.int(), r.int())
+	case traceBindFramebuffer:
+		backend.BindFramebuffer(r.u32(), r.name(kindFramebuffer))
+	case traceBindRenderbuffer:
+		backend.BindRenderbuffer(r.u32(), r.name(kindRenderBuffer))
+	case traceBindTexture:
+		backend.BindTexture(r.u32(), r.name(kindTexture))
+	case traceBindTransformFeedback:
+		backend.BindTransformFeedback(r.u32(), r.name(kindTransformFeedback))
+	case traceBindVertexArray:
+		backend.BindVertexArray(r.name(kindVertexArray))
+	case traceBlendColor:
+		backend.BlendColor(r.f32(), r.f32(), r.f32(), r.f32())
+	case traceBlendEquationSeparate:
+		backend.BlendEquationSeparate(r.u32(), r.u32())
+	case traceBlendFuncSeparate:
+		backend.BlendFuncSeparate(r.u32(), r.u32(), r.u32(), r.u32())
+	case traceBufferStorage:
+		target, size, data, flags := r.u32(), r.int(), r.blob(), r.u32()
+		if b, ok := backend.(interface {
+			BufferStorage(target uint32, size int, data unsafe.Pointer, flags uint32)
+		}); ok {
+			b.BufferStorage(target, size, ptr(data), flags)
+		} else {
+			r.unavailable("glBufferStorage")
+		}
+	case traceInvalidateBufferData:
+		buffer := r.name(kindBuffer)
+		if b, ok := backend.(interface{ InvalidateBufferData(buffer uint32) }); ok {
+			b.InvalidateBufferData(buffer)
+		} else {
+			r.unavailable("glInvalidateBufferData")
+		}
+	case traceInvalidateBufferSubData:
+		buffer, offset, length := r.name(kindBuffer), r.int(), r.int()
+		if b, ok := backend.(interface {
+			InvalidateBufferSubData(buffer uint32, offset, length int)
+		}); ok {
+			b.InvalidateBufferSubData(buffer, offset, length)
+		} else {
+			r.unavailable("glInvalidateBufferSubData")
+		}
+	case traceVertexAttribLPointer:
+		index, size, xtype, stride, pointer := r.u32(), r.i32(), r.u32(), r.i32(), r.offset()
+		if b, ok := backend.(interface {
+			VertexAttribLPointer(index uint32, size int32, xtype uint32, stride int32, pointer unsafe.Pointer)
+		}); ok {
+			b.VertexAttribLPointer(index, size, xtype, stride, pointer)
+		} else {
+			r.unavailable("glVertexAttribLPointer")
+		}
+	case traceBufferSubData:
+		target, offset, size, data := r.u32(), r.int(), r.int(), r.blob()
+		backend.BufferSubData(target, offset, size, ptr(data))
+	case traceClearColor:
+		backend.ClearColor(r.f32(), r.f32(), r.f32(), r.f32())
+	case traceClearDepthf:
+		backend.ClearDepthf(r.f32())
+	case traceClearStencil:
+		backend.ClearStencil(r.i32())
+	case traceColorMask:
+		backend.ColorMask(r.bool(), r.bool(), r.bool(), r.bool())
+	case traceCompileShader:
+		backend.CompileShader(r.name(kindShader))
+	case traceCopyBufferSubData:
+		backend.CopyBufferSubData(r.u32(), r.u32(), r.int(), r.int(), r.int())
+	case traceCopyTexImage2D:
+		backend.CopyTexImage2D(r.u32(), r.i32(), r.u32(), r.i32(), r.i32(), r.i32(), r.i32(), r.i32())
+	case traceCullFace:
+		backend.CullFace(r.u32())
+	case traceDeleteProgram:
+		backend.DeleteProgram(r.name(kindProgram))
+	case traceDeleteShader:
+		backend.DeleteShader(r.name(kindShader))
+	case traceDepthFunc:
+		backend.DepthFunc(r.u32())
+	case traceDepthMask:
+		backend.DepthMask(r.bool())
+	case traceDepthRangef:
+		backend.DepthRangef(r.f32(), r.f32())
+	case traceDisable:
+		backend.Disable(r.u32())
+	case traceDisableVertexAttribArray:
+		backend.DisableVertexAttribArray(r.u32())
+	case traceEnable:
+		backend.Enable(r.u32())
+	case traceEnableVertexAttribArray:
+		backend.EnableVertexAttribArray(r.u32())
+	case traceEndTransformFeedback:
+		backend.EndTransformFeedback()
+	case traceFramebufferRenderbuffer:
+		backend.FramebufferRenderbuffer(r.u32(), r.u32(), r.u32(), r.name(kindRenderBuffer))
+	case traceFrontFace:
+		backend.FrontFace(r.u32())
+	case traceLineWidth:
+		backend.LineWidth(r.f32())
+	case traceLinkProgram:
+		backend.LinkProgram(r.name(kindProgram))
+	case tracePauseTransformFeedback:
+		backend.PauseTransformFeedback()
+	case tracePixelStorei:
+		backend.PixelStorei(r.u32(), r.i32())
+	case tracePolygonOffset:
+		backend.PolygonOffset(r.f32(), r.f32())
+	case traceReadBuffer:
+		backend.ReadBuffer(r.u32())
+	case traceRenderbufferStorage:
+		backend.RenderbufferStorage(r.u32(), r.u32(), r.i32(), r.i32())
+	case traceResumeTransformFeedback:
+		backend.ResumeTransformFeedback()
+	case traceSampleCoverage:
+		backend.SampleCoverage(r.f32(), r.bool())
+	case traceScissor:
+		backend.Scissor(r.i32(), r.i32(), r.i32(), r.i32())
+	case traceStencilFunc:
+		backend.StencilFunc(r.u32(), r.i32(), r.u32())
+	case traceStencilFuncSeparate:
+		backend.StencilFuncSeparate(r.u32(), r.u32(), r.i32(), r.u32())
+	case traceStencilMask:
+		backend.StencilMask(r.u32())
+	case traceStencilMaskSeparate:
+		backend.StencilMaskSeparate(r.u32(), r.u32())
+	case traceStencilOp:
+		backend.StencilOp(r.u32(), r.u32(), r.u32())
+	case traceStencilOpSeparate:
+		backend.StencilOpSeparate(r.u32(), r.u32(), r.u32(), r.u32())
+	case traceTexParameterf:
+		backend.TexParameterf(r.u32(), r.u32(), r.f32())
+	case traceTexParameteri:
+		backend.TexParameteri(r.u32(), r.u32(), r.i32())
+	case traceUniform1f:
+		backend.Uniform1f(r.location(), r.f32())
+	case traceUniform1i:
+		backend.Uniform1i(r.location(), r.i32())
+	case traceUniform1ui:
+		backend.Uniform1ui(r.location(), r.u32())
+	case traceUniform2f:
+		backend.Uniform2f(r.location(), r.f32(), r.f32())
+	case traceUniform2i:
+		backend.Uniform2i(r.location(), r.i32(), r.i32())
+	case traceUniform2ui:
+		backend.Uniform2ui(r.location(), r.u32(), r.u32())
+	case traceUniform3f:
+		backend.Uniform3f(r.location(), r.f32(), r.f32(), r.f32())
+	case traceUniform3i:
+		backend.Uniform3i(r.location(), r.i32(), r.i32(), r.i32())
+	case traceUniform3ui:
+		backend.Uniform3ui(r.location(), r.u32(), r.u32(), r.u32())
+	case traceUniform4f:
+		backend.Uniform4f(r.location(), r.f32(), r.f32(), r.f32(), r.f32())
+	case traceUniform4i:
+		backend.Uniform4i(r.location(), r.i32(), r.i32(), r.i32(), r.i32())
+	case traceUniform4ui:
+		backend.Uniform4ui(r.location(), r.u32(), r.u32(), r.u32(), r.u32())
+	case traceVertexAttribDivisor:
+		backend.VertexAttribDivisor(r.u32(), r.u32())
+	case traceViewport:
+		backend.Viewport(r.i32(), r.i32(), r.i32(), r.i32())
+	default:
+		r.replayProfile(op)
+	}
+}
@@ -0,0 +1,33 @@
+//go:build !gles30
+
+package gl
+
+import "fmt"
+
+// replayProfile replays the ops of the entry points OpenGL ES doesn't have.
+func (r *traceReader) replayProfile(op traceOp) {
+	switch op {
+	case traceBindFragDataLocation:
+		program, color, name := r.name(kindProgram), r.u32(), r.str()
+		backend.BindFragDataLocation(program, color, glStr(name+"\x00"))
+	case traceTexImage1D:
+		target, level, internalformat, width, border, format, xtype := r.u32(), r.i32(), r.i32(), r.i32(), r.i32(), r.u32(), r.u32()
+		backend.TexImage1D(target, level, internalformat, width, border, format, xtype, r.pixels())
+	case traceTexParameterIiv:
+		target, pname, params := r.u32(), r.u32(), r.int32s()
+		backend.TexParameterIiv(target, pname, first(params))
+	case traceTexParameterIuiv:
+		target, pname, params := r.u32(), r.u32(), r.uint32s()
+		backend.TexParameterIuiv(target, pname, first(params))
+	case traceCopyTexImage1D:
+		backend.CopyTexImage1D(r.u32(), r.i32(), r.u32(), r.i32(), r.i32(), r.i32(), r.i32())
+	case traceDrawBuffer:
+		backend.DrawBuffer(r.u32())
+	case traceFramebufferTexture:
+		backend.FramebufferTexture(r.u32(), r.u32(), r.name(kindTexture), r.i32())
+	case tracePolygonMode:
+		backend.PolygonMode(r.u32(), r.u32())
+	default:
+		r.err = fmt.Errorf("gl: unknown trace op %d", op)
+	}
+}
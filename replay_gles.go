@@ -0,0 +1,15 @@
+//go:build gles30
+
+package gl
+
+import "fmt"
+
+// replayProfile fails on the ops of the desktop entry points, OpenGL ES doesn't
+// have them.
+func (r *traceReader) replayProfile(op traceOp) {
+	if op < traceOpCount {
+		r.err = fmt.Errorf("gl: trace op %d isn't available in OpenGL ES", op)
+		return
+	}
+	r.err = fmt.Errorf("gl: unknown trace op %d", op)
+}
@@ -0,0 +1,192 @@
+//go:build safety
+
+package gl
+
+import (
+	"fmt"
+	"log"
+	"os"
+)
+
+// safetyflag is true when building with -tags safety. Every wrapper guards its
+// pre and post operation checks with it so they disappear from normal builds.
+const safetyflag = true
+
+// SafetyPanic makes every safety violation panic instead of being logged.
+// Only available when building with -tags safety.
+var SafetyPanic = false
+
+// SafetyLogger receives the safety violations when SafetyPanic is false. Only
+// available when building with -tags safety.
+var SafetyLogger = log.New(os.Stderr, "gl safety: ", 0)
+
+// bindingKey identifies a binding point. Textures are bound per texture unit,
+// every other kind uses unit 0.
+type bindingKey struct {
+	kind   objectKind
+	unit   uint32
+	target uint32
+}
+
+// bindings is the shadow of every binding done through this package. Binds
+// issued directly through go-gl are invisible to it.
+var bindings = map[bindingKey]uint32{}
+
+var safetyTargetNames = map[uint32]string{
+	uint32(ARRAY_BUFFER):              "GL_ARRAY_BUFFER",
+	uint32(COPY_READ_BUFFER):          "GL_COPY_READ_BUFFER",
+	uint32(COPY_WRITE_BUFFER):         "GL_COPY_WRITE_BUFFER",
+	uint32(ELEMENT_ARRAY_BUFFER):      "GL_ELEMENT_ARRAY_BUFFER",
+	uint32(PIXEL_PACK_BUFFER):         "GL_PIXEL_PACK_BUFFER",
+	uint32(PIXEL_UNPACK_BUFFER):       "GL_PIXEL_UNPACK_BUFFER",
+	TEXTURE_BUFFER:                    "GL_TEXTURE_BUFFER",
+	uint32(TRANSFORM_FEEDBACK_BUFFER): "GL_TRANSFORM_FEEDBACK_BUFFER",
+	uint32(UNIFORM_BUFFER):            "GL_UNIFORM_BUFFER",
+	uint32(TEXTURE_1D):                "GL_TEXTURE_1D",
+	uint32(TEXTURE_1D_ARRAY):          "GL_TEXTURE_1D_ARRAY",
+	uint32(TEXTURE_2D):                "GL_TEXTURE_2D",
+	uint32(TEXTURE_2D_ARRAY):          "GL_TEXTURE_2D_ARRAY",
+	uint32(TEXTURE_2D_MULTISAMPLE):    "GL_TEXTURE_2D_MULTISAMPLE",
+	uint32(TEXTURE_3D):                "GL_TEXTURE_3D",
+	uint32(TEXTURE_CUBE_MAP):          "GL_TEXTURE_CUBE_MAP",
+	uint32(TEXTURE_RECTANGLE):         "GL_TEXTURE_RECTANGLE",
+	uint32(FRAMEBUFFER):               "GL_FRAMEBUFFER",
+	uint32(DRAW_FRAMEBUFFER):          "GL_DRAW_FRAMEBUFFER",
+	uint32(READ_FRAMEBUFFER):          "GL_READ_FRAMEBUFFER",
+	RENDERBUFFER:                      "GL_RENDERBUFFER",
+	TRANSFORM_FEEDBACK:                "GL_TRANSFORM_FEEDBACK",
+	VERTEX_ARRAY_BINDING:              "the vertex array binding",
+	CURRENT_PROGRAM:                   "the current program",
+}
+
+func safetyTargetName(target uint32) string {
+	if name, ok := safetyTargetNames[target]; ok {
+		return name
+	}
+	return fmt.Sprintf("target 0x%X", target)
+}
+
+// safetyKey returns the binding point used for kind on target. Texture
+// bindings are looked up on the currently active texture unit and
+// GL_FRAMEBUFFER is an alias for GL_DRAW_FRAMEBUFFER when reading back.
+func safetyKey(kind objectKind, target uint32) bindingKey {
+	k := bindingKey{kind: kind, target: target}
+	switch kind {
+	case kindTexture:
+		var unit int32
+		backend.GetIntegerv(ACTIVE_TEXTURE, &unit)
+		k.unit = uint32(unit)
+	case kindFramebuffer:
+		if target == uint32(FRAMEBUFFER) {
+			k.target = uint32(DRAW_FRAMEBUFFER)
+		}
+	}
+	return k
+}
+
+// safetyBind records that name is now bound to target.
+func safetyBind(kind objectKind, target, name uint32) {
+	if kind == kindFramebuffer && target == uint32(FRAMEBUFFER) {
+		bindings[bindingKey{kind: kind, target: uint32(DRAW_FRAMEBUFFER)}] = name
+		bindings[bindingKey{kind: kind, target: uint32(READ_FRAMEBUFFER)}] = name
+		return
+	}
+	bindings[safetyKey(kind, target)] = name
+}
+
+// safetyReset forgets every binding, the context is a new one.
+func safetyReset() {
+	bindings = map[bindingKey]uint32{}
+}
+
+// safetyDelete forgets every binding of name, OpenGL reverts them to 0 when
+// the object is deleted.
+func safetyDelete(kind objectKind, name uint32) {
+	for k, v := range bindings {
+		if k.kind == kind && v == name {
+			delete(bindings, k)
+		}
+	}
+}
+
+// safetyCheckBound reports a violation if name is not the object bound to
+// target. fn is the name of the wrapper doing the check.
+func safetyCheckBound(fn string, kind objectKind, target, name uint32) {
+	bound := bindings[safetyKey(kind, target)]
+	if bound == name {
+		return
+	}
+	if bound == 0 {
+		safetyReport(fn, "called on %s %d but no %s is bound to %s", kind, name, kind, safetyTargetName(target))
+		return
+	}
+	safetyReport(fn, "called on %s %d but %s %d is bound to %s", kind, name, kind, bound, safetyTargetName(target))
+}
+
+// safetyCheckAnyBound reports a violation if nothing is bound to target. It is
+// used by functions that act on the bound object but have no receiver to
+// compare it to.
+func safetyCheckAnyBound(fn string, kind objectKind, target uint32) {
+	if bindings[safetyKey(kind, target)] == 0 {
+		safetyReport(fn, "called while no %s is bound to %s", kind, safetyTargetName(target))
+	}
+}
+
+// safetyCheckBufferRange reports a violation if size bytes at offset don't fit
+// in the data store of the buffer bound to target.
+func safetyCheckBufferRange(fn string, target uint32, offset, size int) {
+	var n int64
+	backend.GetBufferParameteri64v(target, BUFFER_SIZE, &n)
+	if offset < 0 || size < 0 || int64(offset)+int64(size) > n {
+		safetyReport(fn, "of [%d:%d] out of the %d bytes of the buffer bound to %s", offset, offset+size, n, safetyTargetName(target))
+	}
+}
+
+// safetyCheckBufferObjectRange is safetyCheckBufferRange for buffer b, which
+// needn't be bound. It is bound to gl.COPY_WRITE_BUFFER for the query, and
+// the binding the checks know of is restored.
+func safetyCheckBufferObjectRange(fn string, b uint32, offset, size int) {
+	target := uint32(COPY_WRITE_BUFFER)
+	backend.BindBuffer(target, b)
+	safetyCheckBufferRange(fn, target, offset, size)
+	backend.BindBuffer(target, bindings[safetyKey(kindBuffer, target)])
+}
+
+// safetyCheckBufferOverlap reports a violation if the source and destination
+// ranges of a copy within a buffer overlap.
+func safetyCheckBufferOverlap(fn string, readOffset, writeOffset, size int) {
+	if readOffset < writeOffset+size && writeOffset < readOffset+size {
+		safetyReport(fn, "of overlapping ranges [%d:%d] and [%d:%d] of the same buffer", readOffset, readOffset+size, writeOffset, writeOffset+size)
+	}
+}
+
+// safetyCheckMapping reports a violation if a Mapping is used after Unmap.
+func safetyCheckMapping(fn string, unmapped bool) {
+	if unmapped {
+		safetyReport(fn, "called after Unmap")
+	}
+}
+
+// safetyCheckFlushRange reports a violation if length bytes at offset can't
+// be flushed from a mapping of size bytes made with access.
+func safetyCheckFlushRange(fn string, access MapAccess, offset, length, size int) {
+	if access&MAP_FLUSH_EXPLICIT_BIT == 0 {
+		safetyReport(fn, "called on a mapping without GL_MAP_FLUSH_EXPLICIT_BIT")
+	}
+	if offset < 0 || length < 0 || offset+length > size {
+		safetyReport(fn, "of [%d:%d] out of a mapping of %d bytes", offset, offset+length, size)
+	}
+}
+
+// safetyReport logs or panics with the call site of the user code that called
+// the wrapper, however many frames of the package are in between.
+func safetyReport(fn, format string, args ...interface{}) {
+	msg := fn + " " + fmt.Sprintf(format, args...)
+	if _, caller := callerInfo(); caller != "" {
+		msg = caller + ": " + msg
+	}
+	if SafetyPanic {
+		panic(msg)
+	}
+	SafetyLogger.Print(msg)
+}
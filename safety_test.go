@@ -0,0 +1,36 @@
+//go:build safety
+
+package gl
+
+import (
+	"strings"
+	"testing"
+)
+
+// panicOnSafety makes the safety violations of the test panic.
+func panicOnSafety(t *testing.T) {
+	SafetyPanic = true
+	t.Cleanup(func() { SafetyPanic = false })
+}
+
+func TestSafetyReportCallSite(t *testing.T) {
+	tests := []struct {
+		name string
+		do   func(b Buffer)
+	}{
+		{"wrapper", func(b Buffer) { b.Unbind(ELEMENT_ARRAY_BUFFER) }},
+		{"nested check", func(b Buffer) { b.InvalidateRange(8, 16) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newFake(t)
+			b := GenBuffer()
+			b.Bind(ARRAY_BUFFER)
+			b.Data(ARRAY_BUFFER, 16, nil, STATIC_DRAW)
+			v := catchPanic(func() { tt.do(b) })
+			if msg, _ := v.(string); !strings.Contains(msg, "safety_test.go:") {
+				t.Errorf("violation %q doesn't point at the test", v)
+			}
+		})
+	}
+}
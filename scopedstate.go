@@ -0,0 +1,185 @@
+package gl
+
+// WithViewport sets the viewport, runs fn and restores the previous
+// viewport, even if fn panics.
+func WithViewport(x, y, width, height int32, fn func()) {
+	prev := Get.Viewport()
+	backend.Viewport(x, y, width, height)
+	defer backend.Viewport(prev[0], prev[1], prev[2], prev[3])
+	fn()
+}
+
+// WithFramebuffer binds fbo to target, runs fn and restores the previous
+// bindings, even if fn panics. gl.FRAMEBUFFER saves and restores both the
+// draw and the read binding.
+func WithFramebuffer(fbo Framebuffer, target FramebufferTarget, fn func()) {
+	draw, read := Get.DrawFramebufferBinding(), Get.ReadFramebufferBinding()
+	fbo.Bind(target)
+	defer func() {
+		if target != READ_FRAMEBUFFER {
+			draw.Bind(DRAW_FRAMEBUFFER)
+		}
+		if target != DRAW_FRAMEBUFFER {
+			read.Bind(READ_FRAMEBUFFER)
+		}
+	}()
+	fn()
+}
+
+// WithProgram makes p the current program, runs fn and restores the previous
+// one, even if fn panics.
+func WithProgram(p Program, fn func()) {
+	prev := Get.CurrentProgram()
+	p.Use()
+	defer prev.Use()
+	fn()
+}
+
+// WithTextureBinding binds tex to target of texture unit unit, 0 for
+// gl.TEXTURE0, runs fn with that unit active and restores the previous
+// binding and active unit, even if fn panics.
+func WithTextureBinding(unit uint32, target TextureTarget, tex Texture, fn func()) {
+	active := uint32(Get.ActiveTexture())
+	ActiveTexture(TEXTURE0 + unit)
+	prev := textureBindingOf(target)
+	tex.Bind(target)
+	defer func() {
+		ActiveTexture(TEXTURE0 + unit)
+		prev.Bind(target)
+		ActiveTexture(active)
+	}()
+	fn()
+}
+
+// textureBindingOf returns the texture bound to target on the active unit.
+func textureBindingOf(target TextureTarget) Texture {
+	for _, b := range stateTextureBindings {
+		if b.target == target {
+			return b.get(Get)
+		}
+	}
+	for _, b := range profileTextureBindings {
+		if b.target == target {
+			return b.get(Get)
+		}
+	}
+	panic("gl: no binding for texture target " + target.String())
+}
+
+// StateMask selects the state PushState saves, like the bits of the
+// glPushAttrib of the compatibility profile.
+type StateMask uint32
+
+// The state PushState can save.
+const (
+	// ViewportBit is the viewport, see Viewport.
+	ViewportBit StateMask = 1 << iota
+	// ClearColorBit is the clear color, see ClearColor.
+	ClearColorBit
+	// StencilBit is GL_STENCIL_TEST and the stencil state of both faces and
+	// the stencil clear value, see Stencil.
+	StencilBit
+	// CullFaceBit is GL_CULL_FACE, the culled face and the front face
+	// winding, see CullFace.
+	CullFaceBit
+	// DepthBit is GL_DEPTH_TEST, the depth function, write mask, range and
+	// clear value, see Depth.
+	DepthBit
+	// ColorBit is the color write mask, see Color.
+	ColorBit
+
+	// AllStateBits is every bit of StateMask.
+	AllStateBits = ViewportBit | ClearColorBit | StencilBit | CullFaceBit | DepthBit | ColorBit
+)
+
+// pushedState is an entry of the PushState stack, only the fields of mask
+// are set.
+type pushedState struct {
+	mask StateMask
+
+	viewport   [4]int32
+	clearColor [4]float32
+	colorMask  [4]bool
+
+	stencilTest bool
+	stencil     StencilState
+
+	cullTest  bool
+	cullFace  Face
+	frontFace Winding
+
+	depthTest bool
+	depth     DepthState
+}
+
+var stateStack []pushedState
+
+// PushState saves the state selected by mask on a stack, PopState restores
+// the last saved state. Pushes and pops must be balanced, they don't nest
+// across contexts.
+func PushState(mask StateMask) {
+	s := pushedState{mask: mask}
+	if mask&ViewportBit != 0 {
+		s.viewport = Get.Viewport()
+	}
+	if mask&ClearColorBit != 0 {
+		s.clearColor = Get.ColorClearValue()
+	}
+	if mask&ColorBit != 0 {
+		s.colorMask = Get.ColorWritemask()
+	}
+	if mask&StencilBit != 0 {
+		s.stencilTest = Get.StencilTest()
+		s.stencil = captureStencil()
+	}
+	if mask&CullFaceBit != 0 {
+		s.cullTest = Get.CullFace()
+		s.cullFace = Face(Get.CullFaceMode())
+		s.frontFace = Winding(Get.FrontFace())
+	}
+	if mask&DepthBit != 0 {
+		s.depthTest = Get.DepthTest()
+		s.depth = captureDepth()
+	}
+	stateStack = append(stateStack, s)
+}
+
+// PopState restores the state saved by the last PushState. It panics if the
+// stack is empty.
+func PopState() {
+	if len(stateStack) == 0 {
+		panic("gl: PopState without PushState")
+	}
+	s := stateStack[len(stateStack)-1]
+	stateStack = stateStack[:len(stateStack)-1]
+	if s.mask&ViewportBit != 0 {
+		backend.Viewport(s.viewport[0], s.viewport[1], s.viewport[2], s.viewport[3])
+	}
+	if s.mask&ClearColorBit != 0 {
+		backend.ClearColor(s.clearColor[0], s.clearColor[1], s.clearColor[2], s.clearColor[3])
+	}
+	if s.mask&ColorBit != 0 {
+		backend.ColorMask(s.colorMask[0], s.colorMask[1], s.colorMask[2], s.colorMask[3])
+	}
+	if s.mask&StencilBit != 0 {
+		setCapability(STENCIL_TEST, s.stencilTest)
+		applyStencil(s.stencil, captureStencil())
+	}
+	if s.mask&CullFaceBit != 0 {
+		setCapability(CULL_FACE, s.cullTest)
+		backend.CullFace(uint32(s.cullFace))
+		backend.FrontFace(uint32(s.frontFace))
+	}
+	if s.mask&DepthBit != 0 {
+		setCapability(DEPTH_TEST, s.depthTest)
+		applyDepth(s.depth, captureDepth())
+	}
+}
+
+func setCapability(c Capability, enabled bool) {
+	if enabled {
+		backend.Enable(uint32(c))
+	} else {
+		backend.Disable(uint32(c))
+	}
+}
@@ -0,0 +1,140 @@
+package gl
+
+import "testing"
+
+func TestWithHelpers(t *testing.T) {
+	tests := []struct {
+		name string
+		// with runs fn through the helper, set sets the state the helper
+		// saves and get reads it.
+		with func(fn func())
+		set  func()
+		get  func() interface{}
+		// inside is the state fn sees.
+		inside interface{}
+	}{
+		{"viewport", func(fn func()) { WithViewport(1, 2, 3, 4, fn) },
+			func() { backend.Viewport(0, 0, 640, 480) },
+			func() interface{} { return Get.Viewport() },
+			[4]int32{1, 2, 3, 4}},
+		{"framebuffer", func(fn func()) { WithFramebuffer(5, FRAMEBUFFER, fn) },
+			func() {
+				backend.BindFramebuffer(uint32(DRAW_FRAMEBUFFER), 1)
+				backend.BindFramebuffer(uint32(READ_FRAMEBUFFER), 2)
+			},
+			func() interface{} {
+				return [2]Framebuffer{Get.DrawFramebufferBinding(), Get.ReadFramebufferBinding()}
+			},
+			[2]Framebuffer{5, 5}},
+		{"read framebuffer", func(fn func()) { WithFramebuffer(5, READ_FRAMEBUFFER, fn) },
+			func() {
+				backend.BindFramebuffer(uint32(DRAW_FRAMEBUFFER), 1)
+				backend.BindFramebuffer(uint32(READ_FRAMEBUFFER), 2)
+			},
+			func() interface{} {
+				return [2]Framebuffer{Get.DrawFramebufferBinding(), Get.ReadFramebufferBinding()}
+			},
+			[2]Framebuffer{1, 5}},
+		{"program", func(fn func()) { WithProgram(7, fn) },
+			func() { backend.UseProgram(3) },
+			func() interface{} { return Get.CurrentProgram() },
+			Program(7)},
+		{"texture", func(fn func()) { WithTextureBinding(2, TEXTURE_2D, 9, fn) },
+			func() {
+				backend.ActiveTexture(uint32(TEXTURE2))
+				backend.BindTexture(uint32(TEXTURE_2D), 4)
+				backend.ActiveTexture(uint32(TEXTURE1))
+			},
+			func() interface{} {
+				active := Get.ActiveTexture()
+				backend.ActiveTexture(uint32(TEXTURE2))
+				tex := textureBindingOf(TEXTURE_2D)
+				backend.ActiveTexture(uint32(active))
+				return [2]uint32{uint32(active), uint32(tex)}
+			},
+			[2]uint32{uint32(TEXTURE2), 9}},
+	}
+	for _, tt := range tests {
+		for _, panics := range []bool{false, true} {
+			name := tt.name
+			if panics {
+				name += "/panic"
+			}
+			t.Run(name, func(t *testing.T) {
+				newFake(t)
+				tt.set()
+				before := tt.get()
+				var inside interface{}
+				v := catchPanic(func() {
+					tt.with(func() {
+						inside = tt.get()
+						if panics {
+							panic("fn")
+						}
+					})
+				})
+				if (v != nil) != panics {
+					t.Errorf("recovered %v, want a panic: %v", v, panics)
+				}
+				if inside != tt.inside {
+					t.Errorf("fn ran with %v, want %v", inside, tt.inside)
+				}
+				if after := tt.get(); after != before {
+					t.Errorf("restored %v, want %v", after, before)
+				}
+			})
+		}
+	}
+}
+
+func TestPushState(t *testing.T) {
+	type state struct {
+		viewport   [4]int32
+		clearColor [4]float32
+		depthTest  bool
+		cullFace   Face
+	}
+	set := func(s state) {
+		backend.Viewport(s.viewport[0], s.viewport[1], s.viewport[2], s.viewport[3])
+		backend.ClearColor(s.clearColor[0], s.clearColor[1], s.clearColor[2], s.clearColor[3])
+		setCapability(DEPTH_TEST, s.depthTest)
+		backend.CullFace(uint32(s.cullFace))
+	}
+	get := func() state {
+		return state{Get.Viewport(), Get.ColorClearValue(), Get.DepthTest(), Face(Get.CullFaceMode())}
+	}
+	pushed := state{[4]int32{0, 0, 640, 480}, [4]float32{0, 0, 0, 1}, true, BACK}
+	changed := state{[4]int32{1, 2, 3, 4}, [4]float32{1, 1, 1, 1}, false, FRONT}
+	tests := []struct {
+		name string
+		mask StateMask
+		want state
+	}{
+		{"nothing", 0, changed},
+		{"viewport", ViewportBit, state{pushed.viewport, changed.clearColor, changed.depthTest, changed.cullFace}},
+		{"clear color and depth", ClearColorBit | DepthBit, state{changed.viewport, pushed.clearColor, pushed.depthTest, changed.cullFace}},
+		{"all", AllStateBits, pushed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newFake(t)
+			set(pushed)
+			PushState(tt.mask)
+			set(changed)
+			PopState()
+			if got := get(); got != tt.want {
+				t.Errorf("state after PopState() = %+v, want %+v", got, tt.want)
+			}
+			if len(stateStack) != 0 {
+				t.Errorf("%d states left on the stack", len(stateStack))
+			}
+		})
+	}
+}
+
+func TestPopStateEmpty(t *testing.T) {
+	newFake(t)
+	if catchPanic(PopState) == nil {
+		t.Error("PopState without PushState didn't panic")
+	}
+}
@@ -0,0 +1,576 @@
+package gl
+
+// State is a snapshot of the state of the context that applications commonly
+// change: the bindings, the enabled capabilities, the blend, depth, stencil
+// and rasterization state, the viewport and scissor box, the write masks,
+// the clear values and the pixel store parameters. The state stored in
+// objects, like texture parameters, vertex attributes or the draw buffers of
+// a framebuffer, isn't part of it.
+type State struct {
+	// Bindings of the generic buffer binding points.
+	ArrayBuffer             Buffer
+	ElementArrayBuffer      Buffer
+	CopyReadBuffer          Buffer
+	CopyWriteBuffer         Buffer
+	PixelPackBuffer         Buffer
+	PixelUnpackBuffer       Buffer
+	UniformBuffer           Buffer
+	TransformFeedbackBuffer Buffer
+
+	// UniformBuffers and TransformFeedbackBuffers are the indexed binding
+	// points, one per index up to the last one with a buffer bound.
+	UniformBuffers           []BufferRange
+	TransformFeedbackBuffers []BufferRange
+
+	DrawFramebuffer   Framebuffer
+	ReadFramebuffer   Framebuffer
+	Renderbuffer      RenderBuffer
+	Program           Program
+	VertexArray       VertexArray
+	TransformFeedback TransformFeedback
+
+	// ActiveTexture is the active texture unit, gl.TEXTURE0 + i, and
+	// Textures the textures bound to every unit up to the last one with a
+	// texture bound or the active one, whichever comes later.
+	ActiveTexture uint32
+	Textures      []TextureUnit
+
+	// Enabled holds every capability State tracks, enabled or not.
+	Enabled map[Capability]bool
+
+	Blend   BlendState
+	Depth   DepthState
+	Stencil StencilState
+	Raster  RasterState
+
+	Viewport   [4]int32
+	Scissor    [4]int32
+	ColorMask  [4]bool
+	ClearColor [4]float32
+
+	Pack   PixelStore
+	Unpack PixelStore
+}
+
+// BufferRange is the binding of an indexed buffer binding point. Size is 0
+// when the whole buffer is bound with BindBufferBase.
+type BufferRange struct {
+	Buffer       Buffer
+	Offset, Size int64
+}
+
+// TextureUnit holds the texture bound to every target of a texture unit,
+// targets without texture are omitted.
+type TextureUnit map[TextureTarget]Texture
+
+// BlendState is the state of glBlendColor, glBlendEquationSeparate and
+// glBlendFuncSeparate.
+type BlendState struct {
+	Color                      [4]float32
+	EquationRGB, EquationAlpha BlendEquation
+	SrcRGB, DstRGB             BlendFactor
+	SrcAlpha, DstAlpha         BlendFactor
+}
+
+// DepthState is the state of glDepthFunc, glDepthMask, glDepthRange and
+// glClearDepth.
+type DepthState struct {
+	Func  DepthFunc
+	Mask  bool
+	Range [2]float32
+	Clear float32
+}
+
+// StencilState is the state of the stencil test of both faces and
+// glClearStencil.
+type StencilState struct {
+	Front, Back StencilFaceState
+	Clear       int32
+}
+
+// StencilFaceState is the stencil state of a face, set with
+// glStencilFuncSeparate, glStencilOpSeparate and glStencilMaskSeparate.
+type StencilFaceState struct {
+	Func                         StencilFunc
+	Ref                          int32
+	ValueMask, WriteMask         uint32
+	Fail                         StencilOp
+	PassDepthFail, PassDepthPass StencilOp
+}
+
+// RasterState is the state of the rasterization. PolygonMode is only used
+// by the desktop profiles.
+type RasterState struct {
+	CullFace             Face
+	FrontFace            Winding
+	LineWidth            float32
+	PolygonOffsetFactor  float32
+	PolygonOffsetUnits   float32
+	PolygonMode          PolygonMode
+	SampleCoverageValue  float32
+	SampleCoverageInvert bool
+}
+
+// PixelStore holds the parameters of glPixelStorei for packing or
+// unpacking. ImageHeight and SkipImages aren't used for packing in OpenGL
+// ES, SwapBytes and LSBFirst only exist in the desktop profiles.
+type PixelStore struct {
+	Alignment   int32
+	RowLength   int32
+	SkipRows    int32
+	SkipPixels  int32
+	ImageHeight int32
+	SkipImages  int32
+	SwapBytes   bool
+	LSBFirst    bool
+}
+
+// stateCapabilities are the capabilities State tracks in every profile, see
+// profileCapabilities for the others.
+var stateCapabilities = []Capability{
+	BLEND,
+	CULL_FACE,
+	DEPTH_TEST,
+	DITHER,
+	POLYGON_OFFSET_FILL,
+	RASTERIZER_DISCARD,
+	SAMPLE_ALPHA_TO_COVERAGE,
+	SAMPLE_COVERAGE,
+	SCISSOR_TEST,
+	STENCIL_TEST,
+}
+
+// textureBinding is a texture target and the getter of its binding.
+type textureBinding struct {
+	target TextureTarget
+	get    func(GetObj) Texture
+}
+
+// stateTextureBindings are the texture targets State tracks in every profile,
+// see profileTextureBindings for the others.
+var stateTextureBindings = []textureBinding{
+	{TEXTURE_2D, GetObj.TextureBinding2D},
+	{TEXTURE_2D_ARRAY, GetObj.TextureBinding2DArray},
+	{TEXTURE_3D, GetObj.TextureBinding3D},
+	{TEXTURE_CUBE_MAP, GetObj.TextureBindingCubeMap},
+}
+
+// CaptureState reads the state of the context into a new State. It queries
+// every binding of every texture unit and every indexed buffer binding
+// point, with a glGet each, it is meant to be used around code that doesn't
+// restore what it changes, not every draw call.
+func CaptureState() *State {
+	s := captureState(int(Get.MaxCombinedTextureImageUnits()), int(Get.MaxUniformBufferBindings()),
+		int(getInteger(MAX_TRANSFORM_FEEDBACK_SEPARATE_ATTRIBS)))
+	last := int(s.ActiveTexture - TEXTURE0)
+	for i, unit := range s.Textures {
+		if len(unit) > 0 && i > last {
+			last = i
+		}
+	}
+	if last < len(s.Textures) {
+		s.Textures = s.Textures[:last+1]
+	}
+	s.UniformBuffers = trimBufferRanges(s.UniformBuffers)
+	s.TransformFeedbackBuffers = trimBufferRanges(s.TransformFeedbackBuffers)
+	return s
+}
+
+// captureState reads the state of the context, the bindings of the first
+// units texture units, uniformBuffers uniform buffer binding points and
+// feedbackBuffers transform feedback buffer binding points only.
+func captureState(units, uniformBuffers, feedbackBuffers int) *State {
+	s := &State{
+		ArrayBuffer:             Get.ArrayBufferBinding(),
+		ElementArrayBuffer:      Get.ElementArrayBufferBinding(),
+		CopyReadBuffer:          Get.CopyReadBufferBinding(),
+		CopyWriteBuffer:         Get.CopyWriteBufferBinding(),
+		PixelPackBuffer:         Get.PixelPackBufferBinding(),
+		PixelUnpackBuffer:       Get.PixelUnpackBufferBinding(),
+		UniformBuffer:           Get.UniformBufferBinding(),
+		TransformFeedbackBuffer: Get.TransformFeedbackBufferBinding(),
+
+		DrawFramebuffer:   Get.DrawFramebufferBinding(),
+		ReadFramebuffer:   Get.ReadFramebufferBinding(),
+		Renderbuffer:      Get.RenderbufferBinding(),
+		Program:           Get.CurrentProgram(),
+		VertexArray:       Get.VertexArrayBinding(),
+		TransformFeedback: Get.TransformFeedbackBinding(),
+
+		ActiveTexture: uint32(Get.ActiveTexture()),
+		Enabled:       map[Capability]bool{},
+
+		Blend: BlendState{
+			Color:         Get.BlendColor(),
+			EquationRGB:   BlendEquation(Get.BlendEquationRgb()),
+			EquationAlpha: BlendEquation(Get.BlendEquationAlpha()),
+			SrcRGB:        BlendFactor(Get.BlendSrcRgb()),
+			DstRGB:        BlendFactor(Get.BlendDstRgb()),
+			SrcAlpha:      BlendFactor(Get.BlendSrcAlpha()),
+			DstAlpha:      BlendFactor(Get.BlendDstAlpha()),
+		},
+		Depth:   captureDepth(),
+		Stencil: captureStencil(),
+		Raster: RasterState{
+			CullFace:             Face(Get.CullFaceMode()),
+			FrontFace:            Winding(Get.FrontFace()),
+			LineWidth:            Get.LineWidth(),
+			PolygonOffsetFactor:  Get.PolygonOffsetFactor(),
+			PolygonOffsetUnits:   Get.PolygonOffsetUnits(),
+			SampleCoverageValue:  Get.SampleCoverageValue(),
+			SampleCoverageInvert: Get.SampleCoverageInvert(),
+		},
+
+		Viewport:   Get.Viewport(),
+		Scissor:    Get.ScissorBox(),
+		ColorMask:  Get.ColorWritemask(),
+		ClearColor: Get.ColorClearValue(),
+
+		Pack: PixelStore{
+			Alignment:  Get.PackAlignment(),
+			RowLength:  Get.PackRowLength(),
+			SkipRows:   Get.PackSkipRows(),
+			SkipPixels: Get.PackSkipPixels(),
+		},
+		Unpack: PixelStore{
+			Alignment:   Get.UnpackAlignment(),
+			RowLength:   Get.UnpackRowLength(),
+			SkipRows:    Get.UnpackSkipRows(),
+			SkipPixels:  Get.UnpackSkipPixels(),
+			ImageHeight: Get.UnpackImageHeight(),
+			SkipImages:  Get.UnpackSkipImages(),
+		},
+	}
+
+	s.UniformBuffers = captureUniformBuffers(uniformBuffers)
+	s.TransformFeedbackBuffers = captureTransformFeedbackBuffers(feedbackBuffers)
+
+	s.Textures = make([]TextureUnit, units)
+	for i := range s.Textures {
+		backend.ActiveTexture(TEXTURE0 + uint32(i))
+		unit := TextureUnit{}
+		for _, b := range stateTextureBindings {
+			if t := b.get(Get); t != 0 {
+				unit[b.target] = t
+			}
+		}
+		for _, b := range profileTextureBindings {
+			if t := b.get(Get); t != 0 {
+				unit[b.target] = t
+			}
+		}
+		s.Textures[i] = unit
+	}
+	if units > 0 {
+		backend.ActiveTexture(s.ActiveTexture)
+	}
+
+	for _, c := range stateCapabilities {
+		s.Enabled[c] = isEnabled(c)
+	}
+	for _, c := range profileCapabilities() {
+		s.Enabled[c] = isEnabled(c)
+	}
+	s.captureProfile()
+	return s
+}
+
+func captureDepth() DepthState {
+	return DepthState{
+		Func:  DepthFunc(Get.DepthFunc()),
+		Mask:  Get.DepthWritemask(),
+		Range: Get.DepthRange(),
+		Clear: Get.DepthClearValue(),
+	}
+}
+
+func captureStencil() StencilState {
+	return StencilState{
+		Front: StencilFaceState{
+			Func:          StencilFunc(Get.StencilFunc()),
+			Ref:           Get.StencilRef(),
+			ValueMask:     uint32(Get.StencilValueMask()),
+			WriteMask:     uint32(Get.StencilWritemask()),
+			Fail:          StencilOp(Get.StencilFail()),
+			PassDepthFail: StencilOp(Get.StencilPassDepthFail()),
+			PassDepthPass: StencilOp(Get.StencilPassDepthPass()),
+		},
+		Back: StencilFaceState{
+			Func:          StencilFunc(Get.StencilBackFunc()),
+			Ref:           Get.StencilBackRef(),
+			ValueMask:     uint32(Get.StencilBackValueMask()),
+			WriteMask:     uint32(Get.StencilBackWritemask()),
+			Fail:          StencilOp(Get.StencilBackFail()),
+			PassDepthFail: StencilOp(Get.StencilBackPassDepthFail()),
+			PassDepthPass: StencilOp(Get.StencilBackPassDepthPass()),
+		},
+		Clear: Get.StencilClearValue(),
+	}
+}
+
+func captureUniformBuffers(n int) []BufferRange {
+	b := make([]BufferRange, n)
+	for i := range b {
+		b[i] = BufferRange{
+			Buffer: Get.IndexedUniformBufferBinding(uint32(i)),
+			Offset: Get.UniformBufferStart(uint32(i)),
+			Size:   Get.UniformBufferSize(uint32(i)),
+		}
+	}
+	return b
+}
+
+func captureTransformFeedbackBuffers(n int) []BufferRange {
+	b := make([]BufferRange, n)
+	for i := range b {
+		b[i] = BufferRange{
+			Buffer: Get.IndexedTransformFeedbackBufferBinding(uint32(i)),
+			Offset: Get.TransformFeedbackBufferStart(uint32(i)),
+			Size:   Get.TransformFeedbackBufferSize(uint32(i)),
+		}
+	}
+	return b
+}
+
+// trimBufferRanges drops the binding points after the last one with a buffer
+// bound.
+func trimBufferRanges(b []BufferRange) []BufferRange {
+	n := len(b)
+	for n > 0 && b[n-1] == (BufferRange{}) {
+		n--
+	}
+	return b[:n]
+}
+
+func isEnabled(c Capability) bool {
+	var v bool
+	Get.GetBooleanv(uint32(c), &v)
+	return v
+}
+
+// Apply restores s. It reads the current state first and only makes the
+// calls that change something, the bindings go through the wrappers so the
+// safety checks see them. It only reads and restores the texture units and
+// indexed buffer binding points s has, the ones after them are left as they
+// are.
+func (s *State) Apply() {
+	cur := captureState(len(s.Textures), len(s.UniformBuffers), len(s.TransformFeedbackBuffers))
+
+	// The indexed bindings also set the generic binding point, restore them
+	// first. The transform feedback ones belong to the transform feedback
+	// object.
+	if cur.TransformFeedback != s.TransformFeedback {
+		s.TransformFeedback.Bind()
+		cur.TransformFeedbackBuffers = captureTransformFeedbackBuffers(len(s.TransformFeedbackBuffers))
+	}
+	applyBufferRanges(UNIFORM_BUFFER, cur.UniformBuffers, s.UniformBuffers)
+	applyBufferRanges(TRANSFORM_FEEDBACK_BUFFER, cur.TransformFeedbackBuffers, s.TransformFeedbackBuffers)
+	cur.UniformBuffer = Get.UniformBufferBinding()
+	cur.TransformFeedbackBuffer = Get.TransformFeedbackBufferBinding()
+
+	applyBuffer(ARRAY_BUFFER, cur.ArrayBuffer, s.ArrayBuffer)
+	applyBuffer(COPY_READ_BUFFER, cur.CopyReadBuffer, s.CopyReadBuffer)
+	applyBuffer(COPY_WRITE_BUFFER, cur.CopyWriteBuffer, s.CopyWriteBuffer)
+	applyBuffer(PIXEL_PACK_BUFFER, cur.PixelPackBuffer, s.PixelPackBuffer)
+	applyBuffer(PIXEL_UNPACK_BUFFER, cur.PixelUnpackBuffer, s.PixelUnpackBuffer)
+	applyBuffer(UNIFORM_BUFFER, cur.UniformBuffer, s.UniformBuffer)
+	applyBuffer(TRANSFORM_FEEDBACK_BUFFER, cur.TransformFeedbackBuffer, s.TransformFeedbackBuffer)
+
+	// The element array binding belongs to the vertex array.
+	if cur.VertexArray != s.VertexArray {
+		s.VertexArray.Bind()
+		cur.ElementArrayBuffer = Get.ElementArrayBufferBinding()
+	}
+	applyBuffer(ELEMENT_ARRAY_BUFFER, cur.ElementArrayBuffer, s.ElementArrayBuffer)
+
+	if cur.DrawFramebuffer != s.DrawFramebuffer {
+		s.DrawFramebuffer.Bind(DRAW_FRAMEBUFFER)
+	}
+	if cur.ReadFramebuffer != s.ReadFramebuffer {
+		s.ReadFramebuffer.Bind(READ_FRAMEBUFFER)
+	}
+	if cur.Renderbuffer != s.Renderbuffer {
+		s.Renderbuffer.Bind()
+	}
+	if cur.Program != s.Program {
+		s.Program.Use()
+	}
+
+	targets := append(stateTextureBindings[:len(stateTextureBindings):len(stateTextureBindings)], profileTextureBindings...)
+	active := cur.ActiveTexture
+	for i := 0; i < len(s.Textures) && i < len(cur.Textures); i++ {
+		for _, b := range targets {
+			want, have := s.Textures[i][b.target], cur.Textures[i][b.target]
+			if want == have {
+				continue
+			}
+			if unit := TEXTURE0 + uint32(i); active != unit {
+				ActiveTexture(unit)
+				active = unit
+			}
+			want.Bind(b.target)
+		}
+	}
+	if active != s.ActiveTexture {
+		ActiveTexture(s.ActiveTexture)
+	}
+
+	for c, on := range s.Enabled {
+		if cur.Enabled[c] != on {
+			setCapability(c, on)
+		}
+	}
+
+	if b := s.Blend; b != cur.Blend {
+		if b.Color != cur.Blend.Color {
+			backend.BlendColor(b.Color[0], b.Color[1], b.Color[2], b.Color[3])
+		}
+		if b.EquationRGB != cur.Blend.EquationRGB || b.EquationAlpha != cur.Blend.EquationAlpha {
+			backend.BlendEquationSeparate(uint32(b.EquationRGB), uint32(b.EquationAlpha))
+		}
+		if b.SrcRGB != cur.Blend.SrcRGB || b.DstRGB != cur.Blend.DstRGB || b.SrcAlpha != cur.Blend.SrcAlpha || b.DstAlpha != cur.Blend.DstAlpha {
+			backend.BlendFuncSeparate(uint32(b.SrcRGB), uint32(b.DstRGB), uint32(b.SrcAlpha), uint32(b.DstAlpha))
+		}
+	}
+
+	applyDepth(s.Depth, cur.Depth)
+	applyStencil(s.Stencil, cur.Stencil)
+
+	if r := s.Raster; r != cur.Raster {
+		if r.CullFace != cur.Raster.CullFace {
+			backend.CullFace(uint32(r.CullFace))
+		}
+		if r.FrontFace != cur.Raster.FrontFace {
+			backend.FrontFace(uint32(r.FrontFace))
+		}
+		if r.LineWidth != cur.Raster.LineWidth {
+			backend.LineWidth(r.LineWidth)
+		}
+		if r.PolygonOffsetFactor != cur.Raster.PolygonOffsetFactor || r.PolygonOffsetUnits != cur.Raster.PolygonOffsetUnits {
+			backend.PolygonOffset(r.PolygonOffsetFactor, r.PolygonOffsetUnits)
+		}
+		if r.SampleCoverageValue != cur.Raster.SampleCoverageValue || r.SampleCoverageInvert != cur.Raster.SampleCoverageInvert {
+			backend.SampleCoverage(r.SampleCoverageValue, r.SampleCoverageInvert)
+		}
+	}
+
+	if v := s.Viewport; v != cur.Viewport {
+		backend.Viewport(v[0], v[1], v[2], v[3])
+	}
+	if v := s.Scissor; v != cur.Scissor {
+		backend.Scissor(v[0], v[1], v[2], v[3])
+	}
+	if m := s.ColorMask; m != cur.ColorMask {
+		backend.ColorMask(m[0], m[1], m[2], m[3])
+	}
+	if c := s.ClearColor; c != cur.ClearColor {
+		backend.ClearColor(c[0], c[1], c[2], c[3])
+	}
+
+	applyPixelStore(PACK_ALIGNMENT, cur.Pack.Alignment, s.Pack.Alignment)
+	applyPixelStore(PACK_ROW_LENGTH, cur.Pack.RowLength, s.Pack.RowLength)
+	applyPixelStore(PACK_SKIP_ROWS, cur.Pack.SkipRows, s.Pack.SkipRows)
+	applyPixelStore(PACK_SKIP_PIXELS, cur.Pack.SkipPixels, s.Pack.SkipPixels)
+	applyPixelStore(UNPACK_ALIGNMENT, cur.Unpack.Alignment, s.Unpack.Alignment)
+	applyPixelStore(UNPACK_ROW_LENGTH, cur.Unpack.RowLength, s.Unpack.RowLength)
+	applyPixelStore(UNPACK_SKIP_ROWS, cur.Unpack.SkipRows, s.Unpack.SkipRows)
+	applyPixelStore(UNPACK_SKIP_PIXELS, cur.Unpack.SkipPixels, s.Unpack.SkipPixels)
+	applyPixelStore(UNPACK_IMAGE_HEIGHT, cur.Unpack.ImageHeight, s.Unpack.ImageHeight)
+	applyPixelStore(UNPACK_SKIP_IMAGES, cur.Unpack.SkipImages, s.Unpack.SkipImages)
+
+	s.applyProfile(cur)
+}
+
+func applyBuffer(target BufferTarget, have, want Buffer) {
+	if have != want {
+		want.Bind(target)
+	}
+}
+
+func applyBufferRanges(target BufferTarget, have, want []BufferRange) {
+	for i := 0; i < len(want) && i < len(have); i++ {
+		w := want[i]
+		if w == have[i] {
+			continue
+		}
+		if w.Size == 0 {
+			TransformFeedback(0).BindBufferBase(target, uint32(i), w.Buffer)
+		} else {
+			TransformFeedback(0).BindBufferRange(target, uint32(i), w.Buffer, int(w.Offset), int(w.Size))
+		}
+	}
+}
+
+func applyDepth(want, have DepthState) {
+	if want.Func != have.Func {
+		backend.DepthFunc(uint32(want.Func))
+	}
+	if want.Mask != have.Mask {
+		backend.DepthMask(want.Mask)
+	}
+	if want.Range != have.Range {
+		backend.DepthRangef(want.Range[0], want.Range[1])
+	}
+	if want.Clear != have.Clear {
+		backend.ClearDepthf(want.Clear)
+	}
+}
+
+// applyStencil sets the stencil state of both faces at once when they are
+// the same, one face at a time otherwise.
+func applyStencil(want, have StencilState) {
+	if want.Clear != have.Clear {
+		backend.ClearStencil(want.Clear)
+	}
+	f, b := want.Front, want.Back
+	hf, hb := have.Front, have.Back
+	funcChanged := func(w, h StencilFaceState) bool {
+		return w.Func != h.Func || w.Ref != h.Ref || w.ValueMask != h.ValueMask
+	}
+	opChanged := func(w, h StencilFaceState) bool {
+		return w.Fail != h.Fail || w.PassDepthFail != h.PassDepthFail || w.PassDepthPass != h.PassDepthPass
+	}
+	same := func(w, h StencilFaceState) bool {
+		return w.Func == h.Func && w.Ref == h.Ref && w.ValueMask == h.ValueMask
+	}
+	switch {
+	case same(f, b) && funcChanged(f, hf) && funcChanged(b, hb):
+		backend.StencilFuncSeparate(FRONT_AND_BACK, uint32(f.Func), f.Ref, f.ValueMask)
+	default:
+		if funcChanged(f, hf) {
+			backend.StencilFuncSeparate(FRONT, uint32(f.Func), f.Ref, f.ValueMask)
+		}
+		if funcChanged(b, hb) {
+			backend.StencilFuncSeparate(BACK, uint32(b.Func), b.Ref, b.ValueMask)
+		}
+	}
+	sameOp := f.Fail == b.Fail && f.PassDepthFail == b.PassDepthFail && f.PassDepthPass == b.PassDepthPass
+	switch {
+	case sameOp && opChanged(f, hf) && opChanged(b, hb):
+		backend.StencilOpSeparate(FRONT_AND_BACK, uint32(f.Fail), uint32(f.PassDepthFail), uint32(f.PassDepthPass))
+	default:
+		if opChanged(f, hf) {
+			backend.StencilOpSeparate(FRONT, uint32(f.Fail), uint32(f.PassDepthFail), uint32(f.PassDepthPass))
+		}
+		if opChanged(b, hb) {
+			backend.StencilOpSeparate(BACK, uint32(b.Fail), uint32(b.PassDepthFail), uint32(b.PassDepthPass))
+		}
+	}
+	switch {
+	case f.WriteMask == b.WriteMask && f.WriteMask != hf.WriteMask && b.WriteMask != hb.WriteMask:
+		backend.StencilMaskSeparate(FRONT_AND_BACK, f.WriteMask)
+	default:
+		if f.WriteMask != hf.WriteMask {
+			backend.StencilMaskSeparate(FRONT, f.WriteMask)
+		}
+		if b.WriteMask != hb.WriteMask {
+			backend.StencilMaskSeparate(BACK, b.WriteMask)
+		}
+	}
+}
+
+func applyPixelStore(pname uint32, have, want int32) {
+	if have != want {
+		backend.PixelStorei(pname, want)
+	}
+}
@@ -0,0 +1,75 @@
+//go:build !gles30
+
+package gl
+
+// profileCapabilities returns the capabilities State tracks in the desktop
+// profiles, with one CLIP_DISTANCEi per clip distance of the context.
+func profileCapabilities() []Capability {
+	caps := []Capability{
+		COLOR_LOGIC_OP,
+		DEPTH_CLAMP,
+		FRAMEBUFFER_SRGB,
+		LINE_SMOOTH,
+		MULTISAMPLE,
+		POLYGON_OFFSET_LINE,
+		POLYGON_OFFSET_POINT,
+		POLYGON_SMOOTH,
+		PRIMITIVE_RESTART,
+		PROGRAM_POINT_SIZE,
+		SAMPLE_ALPHA_TO_ONE,
+		SAMPLE_MASK,
+		TEXTURE_CUBE_MAP_SEAMLESS,
+	}
+	for i := int32(0); i < Get.MaxClipDistances(); i++ {
+		caps = append(caps, CLIP_DISTANCE0+Capability(i))
+	}
+	return caps
+}
+
+// profileTextureBindings are the texture targets State tracks in the desktop
+// profiles only.
+var profileTextureBindings = []textureBinding{
+	{TEXTURE_1D, GetObj.TextureBinding1D},
+	{TEXTURE_1D_ARRAY, GetObj.TextureBinding1DArray},
+	{TEXTURE_2D_MULTISAMPLE, GetObj.TextureBinding2DMultisample},
+	{TEXTURE_2D_MULTISAMPLE_ARRAY, GetObj.TextureBinding2DMultisampleArray},
+	{TEXTURE_BUFFER, GetObj.TextureBindingBuffer},
+	{TEXTURE_RECTANGLE, GetObj.TextureBindingRectangle},
+}
+
+// captureProfile reads the state only the desktop profiles have. The core
+// profile uses the same polygon mode for both faces, only the front one is
+// kept.
+func (s *State) captureProfile() {
+	s.Raster.PolygonMode = PolygonMode(Get.PolygonMode()[0])
+	s.Pack.ImageHeight = Get.PackImageHeight()
+	s.Pack.SkipImages = Get.PackSkipImages()
+	s.Pack.SwapBytes = Get.PackSwapBytes()
+	s.Pack.LSBFirst = Get.PackLsbFirst()
+	s.Unpack.SwapBytes = Get.UnpackSwapBytes()
+	s.Unpack.LSBFirst = Get.UnpackLsbFirst()
+}
+
+// applyProfile restores the state captureProfile reads, cur is the current
+// state.
+func (s *State) applyProfile(cur *State) {
+	if s.Raster.PolygonMode != cur.Raster.PolygonMode {
+		backend.PolygonMode(FRONT_AND_BACK, uint32(s.Raster.PolygonMode))
+	}
+	applyPixelStore(PACK_IMAGE_HEIGHT, cur.Pack.ImageHeight, s.Pack.ImageHeight)
+	applyPixelStore(PACK_SKIP_IMAGES, cur.Pack.SkipImages, s.Pack.SkipImages)
+	applyPixelStoreBool(PACK_SWAP_BYTES, cur.Pack.SwapBytes, s.Pack.SwapBytes)
+	applyPixelStoreBool(PACK_LSB_FIRST, cur.Pack.LSBFirst, s.Pack.LSBFirst)
+	applyPixelStoreBool(UNPACK_SWAP_BYTES, cur.Unpack.SwapBytes, s.Unpack.SwapBytes)
+	applyPixelStoreBool(UNPACK_LSB_FIRST, cur.Unpack.LSBFirst, s.Unpack.LSBFirst)
+}
+
+func applyPixelStoreBool(pname uint32, have, want bool) {
+	if have != want {
+		var v int32 = FALSE
+		if want {
+			v = TRUE
+		}
+		backend.PixelStorei(pname, v)
+	}
+}
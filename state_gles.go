@@ -0,0 +1,19 @@
+//go:build gles30
+
+package gl
+
+// profileCapabilities returns the capabilities State tracks in OpenGL ES
+// only.
+func profileCapabilities() []Capability {
+	return []Capability{PRIMITIVE_RESTART_FIXED_INDEX}
+}
+
+// profileTextureBindings are the texture targets State tracks in OpenGL ES
+// only, it has none the desktop profiles don't have.
+var profileTextureBindings []textureBinding
+
+// captureProfile does nothing, OpenGL ES has no state State doesn't read in
+// every profile.
+func (s *State) captureProfile() {}
+
+func (s *State) applyProfile(cur *State) {}
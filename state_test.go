@@ -0,0 +1,155 @@
+package gl
+
+import "testing"
+
+// newStateFake installs a FakeBackend with texture units for the state
+// tests.
+func newStateFake(t *testing.T) *FakeBackend {
+	t.Helper()
+	f := newFake(t)
+	f.Integers[MAX_COMBINED_TEXTURE_IMAGE_UNITS] = []int32{16}
+	return f
+}
+
+func TestCaptureStateTextureUnits(t *testing.T) {
+	tests := []struct {
+		name    string
+		do      func(textures []Texture)
+		wantLen int
+	}{
+		{"none bound", func([]Texture) {}, 1},
+		{"unit 2 bound", func(textures []Texture) {
+			ActiveTexture(TEXTURE2)
+			textures[0].Bind(TEXTURE_2D)
+			ActiveTexture(TEXTURE0)
+		}, 3},
+		{"unit 5 active", func([]Texture) { ActiveTexture(TEXTURE5) }, 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newStateFake(t)
+			textures := GenTextures(1)
+			tt.do(textures)
+			s := CaptureState()
+			if len(s.Textures) != tt.wantLen {
+				t.Errorf("captured %d texture units, want %d", len(s.Textures), tt.wantLen)
+			}
+			if s.UniformBuffers == nil || len(s.UniformBuffers) != 0 {
+				t.Errorf("captured uniform buffers %v, want none", s.UniformBuffers)
+			}
+		})
+	}
+}
+
+func TestStateApply(t *testing.T) {
+	tests := []struct {
+		name string
+		do   func(textures []Texture)
+		// want is what Apply leaves different, the units after the ones
+		// the snapshot has.
+		want []string
+	}{
+		{"nothing", func([]Texture) {}, nil},
+		{"capabilities", func([]Texture) {
+			Enable(BLEND)
+			backend.Disable(uint32(DITHER))
+		}, nil},
+		{"fixed function", func([]Texture) {
+			backend.DepthFunc(uint32(GREATER))
+			backend.CullFace(uint32(FRONT))
+			Viewport.Set(1, 2, 3, 4)
+			ClearColor.Set(1, 0, 0, 1)
+		}, nil},
+		{"textures", func(textures []Texture) {
+			textures[1].Bind(TEXTURE_2D)
+			ActiveTexture(TEXTURE1)
+			Texture(0).Bind(TEXTURE_2D)
+		}, nil},
+		{"unit after the snapshot", func(textures []Texture) {
+			ActiveTexture(TEXTURE7)
+			textures[0].Bind(TEXTURE_3D)
+		}, []string{"Textures[7][GL_TEXTURE_3D]: 0 -> 1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newStateFake(t)
+			textures := GenTextures(2)
+			ActiveTexture(TEXTURE1)
+			textures[0].Bind(TEXTURE_2D)
+			ActiveTexture(TEXTURE0)
+			s := CaptureState()
+			tt.do(textures)
+			f.Reset()
+			s.Apply()
+			// Apply only reads the texture units s has, and the active one
+			// it restores.
+			for _, c := range f.Calls {
+				if unit := c.Args; c.Name == "ActiveTexture" && unit[0].(uint32) >= TEXTURE0+uint32(len(s.Textures)) && unit[0] != uint32(TEXTURE7) {
+					t.Errorf("Apply() switched to texture unit %d, s only has %d", unit[0].(uint32)-TEXTURE0, len(s.Textures))
+				}
+			}
+			var got []string
+			for _, c := range DiffState(s, CaptureState()) {
+				got = append(got, c.String())
+			}
+			if !equalStrings(got, tt.want) {
+				t.Errorf("state differs after Apply() by %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStateApplySafetyBindings(t *testing.T) {
+	if !safetyflag {
+		t.Skip("the bindings are only tracked with -tags safety")
+	}
+	newStateFake(t)
+	textures := GenTextures(2)
+	for i, tex := range textures {
+		ActiveTexture(TEXTURE0 + uint32(i))
+		tex.Bind(TEXTURE_2D)
+	}
+	s := CaptureState()
+	for i, tex := range textures {
+		ActiveTexture(TEXTURE0 + uint32(i))
+		tex.Unbind(TEXTURE_2D)
+	}
+	ActiveTexture(TEXTURE0)
+	s.Apply()
+	// Every restored unit is known to the checks, not only the active one.
+	for i, tex := range textures {
+		ActiveTexture(TEXTURE0 + uint32(i))
+		if v := catchPanic(func() { tex.Unbind(TEXTURE_2D) }); v != nil {
+			t.Errorf("unit %d: %v", i, v)
+		}
+	}
+}
+
+func TestDiffState(t *testing.T) {
+	a := &State{
+		Enabled:  map[Capability]bool{BLEND: false},
+		Textures: []TextureUnit{{}},
+		Depth:    DepthState{Func: LESS},
+	}
+	b := &State{
+		Enabled:  map[Capability]bool{BLEND: true},
+		Textures: []TextureUnit{{}, {TEXTURE_2D: 3}},
+		Depth:    DepthState{Func: GREATER},
+	}
+	want := []string{
+		"Textures[1][GL_TEXTURE_2D]: 0 -> 3",
+		"Enabled[GL_BLEND]: false -> true",
+		"Depth.Func: GL_LESS -> GL_GREATER",
+	}
+	diff := DiffState(a, b)
+	got := make([]string, len(diff))
+	for i, c := range diff {
+		got[i] = c.String()
+	}
+	if !equalStrings(got, want) {
+		t.Errorf("DiffState() = %q, want %q", got, want)
+	}
+	if diff := DiffState(b, b); diff != nil {
+		t.Errorf("DiffState() of equal states = %q", diff)
+	}
+}
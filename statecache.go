@@ -0,0 +1,505 @@
+package gl
+
+import "errors"
+
+// ErrStateCaching is returned by EnableStateCache when the cache is already
+// enabled.
+var ErrStateCaching = errors.New("gl: state cache already enabled")
+
+// StateCacheStats counts the calls the state cache saw. Issued calls reached
+// the driver, Skipped ones would have set the value already in place. Only
+// the calls the cache tracks are counted, SkippedCalls breaks Skipped down by
+// go-gl function name, like "BindTexture".
+type StateCacheStats struct {
+	Issued       uint64
+	Skipped      uint64
+	SkippedCalls map[string]uint64
+}
+
+// stateCache is the Backend installed by EnableStateCache. It remembers the
+// bindings and the fixed-function state set through it and drops the calls
+// that wouldn't change them. Anything it hasn't seen set is unknown and goes
+// through.
+type stateCache struct {
+	Backend
+
+	stats StateCacheStats
+
+	bindings map[cacheBinding]uint32
+	enabled  map[uint32]bool
+	pixel    map[uint32]int32
+	values   map[string]interface{}
+
+	// activeTexture is the active texture unit, 0 while unknown. The texture
+	// bindings are per unit, they aren't cached while it is unknown.
+	activeTexture uint32
+}
+
+func (c *stateCache) next() *Backend {
+	return &c.Backend
+}
+
+// cacheBinding identifies a binding point. unit is only used by textures,
+// target isn't used by vertex arrays and programs.
+type cacheBinding struct {
+	kind   objectKind
+	unit   uint32
+	target uint32
+}
+
+// stateCacheLayer is the cache installed by EnableStateCache, it may be
+// below another Backend.
+var stateCacheLayer *stateCache
+
+// EnableStateCache makes the calls that would set a binding or a
+// fixed-function value to what it already is, like binding the texture
+// already bound or enabling GL_DEPTH_TEST twice, return without reaching the
+// driver. The cache only knows what was set through this package since it
+// was enabled or last invalidated, call InvalidateStateCache after code that
+// calls OpenGL directly, like a UI library, ran. The cache belongs to the
+// current context, InitBackend drops it.
+func EnableStateCache() error {
+	if stateCacheLayer != nil {
+		return ErrStateCaching
+	}
+	c := &stateCache{Backend: backend}
+	c.invalidate()
+	c.stats.SkippedCalls = map[string]uint64{}
+	stateCacheLayer = c
+	backend = c
+	return nil
+}
+
+// DisableStateCache removes the cache installed by EnableStateCache.
+func DisableStateCache() {
+	c := stateCacheLayer
+	if c == nil {
+		return
+	}
+	stateCacheLayer = nil
+	unlinkLayer(c)
+}
+
+// InvalidateStateCache forgets everything the cache knows, the next call of
+// every kind reaches the driver. It does nothing if the cache isn't enabled.
+func InvalidateStateCache() {
+	if c := stateCacheLayer; c != nil {
+		c.invalidate()
+	}
+}
+
+// GetStateCacheStats returns the counters of the cache since it was enabled
+// or its counters last reset. They are zero if the cache isn't enabled.
+func GetStateCacheStats() StateCacheStats {
+	c := stateCacheLayer
+	if c == nil {
+		return StateCacheStats{}
+	}
+	stats := c.stats
+	stats.SkippedCalls = make(map[string]uint64, len(c.stats.SkippedCalls))
+	for call, n := range c.stats.SkippedCalls {
+		stats.SkippedCalls[call] = n
+	}
+	return stats
+}
+
+// ResetStateCacheStats zeroes the counters of the cache, like at the start
+// of a frame.
+func ResetStateCacheStats() {
+	if c := stateCacheLayer; c != nil {
+		c.stats = StateCacheStats{SkippedCalls: map[string]uint64{}}
+	}
+}
+
+func (c *stateCache) invalidate() {
+	c.bindings = map[cacheBinding]uint32{}
+	c.enabled = map[uint32]bool{}
+	c.pixel = map[uint32]int32{}
+	c.values = map[string]interface{}{}
+	c.activeTexture = 0
+}
+
+// skip counts call as issued or skipped.
+func (c *stateCache) skip(call string, skip bool) bool {
+	if skip {
+		c.stats.Skipped++
+		c.stats.SkippedCalls[call]++
+	} else {
+		c.stats.Issued++
+	}
+	return skip
+}
+
+// bind returns true if name is already bound to b, and records it otherwise.
+func (c *stateCache) bind(call string, b cacheBinding, name uint32) bool {
+	if bound, ok := c.bindings[b]; ok && bound == name {
+		return c.skip(call, true)
+	}
+	c.bindings[b] = name
+	return c.skip(call, false)
+}
+
+// set returns true if v is already the value of key, and records it
+// otherwise. v must be comparable, the calls with several values use arrays.
+func (c *stateCache) set(call, key string, v interface{}) bool {
+	if old, ok := c.values[key]; ok && old == v {
+		return c.skip(call, true)
+	}
+	c.values[key] = v
+	return c.skip(call, false)
+}
+
+// setFaces is set for the state set per face, face is gl.FRONT, gl.BACK
+// or gl.FRONT_AND_BACK.
+func (c *stateCache) setFaces(call, key string, face uint32, v interface{}) bool {
+	front, back := face != BACK, face != FRONT
+	frontSame := c.values[key+".front"] == v
+	backSame := c.values[key+".back"] == v
+	if (!front || frontSame) && (!back || backSame) {
+		return c.skip(call, true)
+	}
+	if front {
+		c.values[key+".front"] = v
+	}
+	if back {
+		c.values[key+".back"] = v
+	}
+	return c.skip(call, false)
+}
+
+// forget drops the bindings of kind to any of names, OpenGL reverts them to
+// 0 when the objects are deleted.
+func (c *stateCache) forget(kind objectKind, n int32, names *uint32) {
+	deleted := map[uint32]bool{}
+	for _, name := range trackedNames(n, names) {
+		deleted[name] = true
+	}
+	for b, name := range c.bindings {
+		if b.kind == kind && deleted[name] {
+			delete(c.bindings, b)
+		}
+	}
+}
+
+func (c *stateCache) ActiveTexture(texture uint32) {
+	if c.skip("ActiveTexture", c.activeTexture == texture) {
+		return
+	}
+	c.activeTexture = texture
+	c.Backend.ActiveTexture(texture)
+}
+
+func (c *stateCache) BindBuffer(target, buffer uint32) {
+	b := cacheBinding{kind: kindBuffer, target: target}
+	if target == uint32(ELEMENT_ARRAY_BUFFER) {
+		// The element array binding belongs to the vertex array, it is only
+		// known once the vertex array is.
+		if _, ok := c.bindings[cacheBinding{kind: kindVertexArray}]; !ok {
+			c.Backend.BindBuffer(target, buffer)
+			return
+		}
+	}
+	if c.bind("BindBuffer", b, buffer) {
+		return
+	}
+	c.Backend.BindBuffer(target, buffer)
+}
+
+func (c *stateCache) BindBufferBase(target, index, buffer uint32) {
+	// The indexed bindings aren't cached but they set the generic one too.
+	c.bindings[cacheBinding{kind: kindBuffer, target: target}] = buffer
+	c.Backend.BindBufferBase(target, index, buffer)
+}
+
+func (c *stateCache) BindBufferRange(target, index, buffer uint32, offset, size int) {
+	c.bindings[cacheBinding{kind: kindBuffer, target: target}] = buffer
+	c.Backend.BindBufferRange(target, index, buffer, offset, size)
+}
+
+func (c *stateCache) BindFramebuffer(target, framebuffer uint32) {
+	draw := cacheBinding{kind: kindFramebuffer, target: uint32(DRAW_FRAMEBUFFER)}
+	read := cacheBinding{kind: kindFramebuffer, target: uint32(READ_FRAMEBUFFER)}
+	if target == uint32(FRAMEBUFFER) {
+		d, dok := c.bindings[draw]
+		r, rok := c.bindings[read]
+		if c.skip("BindFramebuffer", dok && rok && d == framebuffer && r == framebuffer) {
+			return
+		}
+		c.bindings[draw], c.bindings[read] = framebuffer, framebuffer
+	} else if c.bind("BindFramebuffer", cacheBinding{kind: kindFramebuffer, target: target}, framebuffer) {
+		return
+	}
+	c.Backend.BindFramebuffer(target, framebuffer)
+}
+
+func (c *stateCache) BindRenderbuffer(target, renderbuffer uint32) {
+	if c.bind("BindRenderbuffer", cacheBinding{kind: kindRenderBuffer, target: target}, renderbuffer) {
+		return
+	}
+	c.Backend.BindRenderbuffer(target, renderbuffer)
+}
+
+func (c *stateCache) BindTexture(target, texture uint32) {
+	if c.activeTexture == 0 {
+		c.Backend.BindTexture(target, texture)
+		return
+	}
+	if c.bind("BindTexture", cacheBinding{kind: kindTexture, unit: c.activeTexture, target: target}, texture) {
+		return
+	}
+	c.Backend.BindTexture(target, texture)
+}
+
+func (c *stateCache) BindTransformFeedback(target, id uint32) {
+	if c.bind("BindTransformFeedback", cacheBinding{kind: kindTransformFeedback, target: target}, id) {
+		return
+	}
+	c.Backend.BindTransformFeedback(target, id)
+}
+
+func (c *stateCache) BindVertexArray(array uint32) {
+	if c.bind("BindVertexArray", cacheBinding{kind: kindVertexArray}, array) {
+		return
+	}
+	delete(c.bindings, cacheBinding{kind: kindBuffer, target: uint32(ELEMENT_ARRAY_BUFFER)})
+	c.Backend.BindVertexArray(array)
+}
+
+func (c *stateCache) UseProgram(program uint32) {
+	if c.bind("UseProgram", cacheBinding{kind: kindProgram}, program) {
+		return
+	}
+	c.Backend.UseProgram(program)
+}
+
+func (c *stateCache) DeleteBuffers(n int32, buffers *uint32) {
+	c.forget(kindBuffer, n, buffers)
+	c.Backend.DeleteBuffers(n, buffers)
+}
+
+func (c *stateCache) DeleteFramebuffers(n int32, framebuffers *uint32) {
+	c.forget(kindFramebuffer, n, framebuffers)
+	c.Backend.DeleteFramebuffers(n, framebuffers)
+}
+
+func (c *stateCache) DeleteProgram(program uint32) {
+	c.forget(kindProgram, 1, &program)
+	c.Backend.DeleteProgram(program)
+}
+
+func (c *stateCache) DeleteRenderbuffers(n int32, renderbuffers *uint32) {
+	c.forget(kindRenderBuffer, n, renderbuffers)
+	c.Backend.DeleteRenderbuffers(n, renderbuffers)
+}
+
+func (c *stateCache) DeleteTextures(n int32, textures *uint32) {
+	c.forget(kindTexture, n, textures)
+	c.Backend.DeleteTextures(n, textures)
+}
+
+func (c *stateCache) DeleteTransformFeedbacks(n int32, ids *uint32) {
+	c.forget(kindTransformFeedback, n, ids)
+	c.Backend.DeleteTransformFeedbacks(n, ids)
+}
+
+func (c *stateCache) DeleteVertexArrays(n int32, arrays *uint32) {
+	c.forget(kindVertexArray, n, arrays)
+	// The element array binding of a deleted vertex array goes with it.
+	delete(c.bindings, cacheBinding{kind: kindBuffer, target: uint32(ELEMENT_ARRAY_BUFFER)})
+	c.Backend.DeleteVertexArrays(n, arrays)
+}
+
+func (c *stateCache) Enable(cap uint32) {
+	on, ok := c.enabled[cap]
+	if c.skip("Enable", ok && on) {
+		return
+	}
+	c.enabled[cap] = true
+	c.Backend.Enable(cap)
+}
+
+func (c *stateCache) Disable(cap uint32) {
+	on, ok := c.enabled[cap]
+	if c.skip("Disable", ok && !on) {
+		return
+	}
+	c.enabled[cap] = false
+	c.Backend.Disable(cap)
+}
+
+func (c *stateCache) PixelStorei(pname uint32, param int32) {
+	v, ok := c.pixel[pname]
+	if c.skip("PixelStorei", ok && v == param) {
+		return
+	}
+	c.pixel[pname] = param
+	c.Backend.PixelStorei(pname, param)
+}
+
+func (c *stateCache) BlendColor(red, green, blue, alpha float32) {
+	if c.set("BlendColor", "BlendColor", [4]float32{red, green, blue, alpha}) {
+		return
+	}
+	c.Backend.BlendColor(red, green, blue, alpha)
+}
+
+func (c *stateCache) BlendEquationSeparate(modeRGB, modeAlpha uint32) {
+	if c.set("BlendEquationSeparate", "BlendEquation", [2]uint32{modeRGB, modeAlpha}) {
+		return
+	}
+	c.Backend.BlendEquationSeparate(modeRGB, modeAlpha)
+}
+
+func (c *stateCache) BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha uint32) {
+	if c.set("BlendFuncSeparate", "BlendFunc", [4]uint32{srcRGB, dstRGB, srcAlpha, dstAlpha}) {
+		return
+	}
+	c.Backend.BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha)
+}
+
+func (c *stateCache) ClearColor(red, green, blue, alpha float32) {
+	if c.set("ClearColor", "ClearColor", [4]float32{red, green, blue, alpha}) {
+		return
+	}
+	c.Backend.ClearColor(red, green, blue, alpha)
+}
+
+func (c *stateCache) ClearDepthf(d float32) {
+	if c.set("ClearDepthf", "ClearDepth", d) {
+		return
+	}
+	c.Backend.ClearDepthf(d)
+}
+
+func (c *stateCache) ClearStencil(s int32) {
+	if c.set("ClearStencil", "ClearStencil", s) {
+		return
+	}
+	c.Backend.ClearStencil(s)
+}
+
+func (c *stateCache) ColorMask(red, green, blue, alpha bool) {
+	if c.set("ColorMask", "ColorMask", [4]bool{red, green, blue, alpha}) {
+		return
+	}
+	c.Backend.ColorMask(red, green, blue, alpha)
+}
+
+func (c *stateCache) CullFace(mode uint32) {
+	if c.set("CullFace", "CullFace", mode) {
+		return
+	}
+	c.Backend.CullFace(mode)
+}
+
+func (c *stateCache) DepthFunc(xfunc uint32) {
+	if c.set("DepthFunc", "DepthFunc", xfunc) {
+		return
+	}
+	c.Backend.DepthFunc(xfunc)
+}
+
+func (c *stateCache) DepthMask(flag bool) {
+	if c.set("DepthMask", "DepthMask", flag) {
+		return
+	}
+	c.Backend.DepthMask(flag)
+}
+
+func (c *stateCache) DepthRangef(near, far float32) {
+	if c.set("DepthRangef", "DepthRange", [2]float32{near, far}) {
+		return
+	}
+	c.Backend.DepthRangef(near, far)
+}
+
+func (c *stateCache) FrontFace(mode uint32) {
+	if c.set("FrontFace", "FrontFace", mode) {
+		return
+	}
+	c.Backend.FrontFace(mode)
+}
+
+func (c *stateCache) LineWidth(width float32) {
+	if c.set("LineWidth", "LineWidth", width) {
+		return
+	}
+	c.Backend.LineWidth(width)
+}
+
+func (c *stateCache) PolygonOffset(factor, units float32) {
+	if c.set("PolygonOffset", "PolygonOffset", [2]float32{factor, units}) {
+		return
+	}
+	c.Backend.PolygonOffset(factor, units)
+}
+
+func (c *stateCache) SampleCoverage(value float32, invert bool) {
+	if c.set("SampleCoverage", "SampleCoverage", [2]interface{}{value, invert}) {
+		return
+	}
+	c.Backend.SampleCoverage(value, invert)
+}
+
+func (c *stateCache) Scissor(x, y, width, height int32) {
+	if c.set("Scissor", "Scissor", [4]int32{x, y, width, height}) {
+		return
+	}
+	c.Backend.Scissor(x, y, width, height)
+}
+
+func (c *stateCache) Viewport(x, y, width, height int32) {
+	if c.set("Viewport", "Viewport", [4]int32{x, y, width, height}) {
+		return
+	}
+	c.Backend.Viewport(x, y, width, height)
+}
+
+// stencilFunc is the value of the stencil function of a face.
+type stencilFunc struct {
+	xfunc uint32
+	ref   int32
+	mask  uint32
+}
+
+func (c *stateCache) StencilFunc(xfunc uint32, ref int32, mask uint32) {
+	if c.setFaces("StencilFunc", "StencilFunc", FRONT_AND_BACK, stencilFunc{xfunc, ref, mask}) {
+		return
+	}
+	c.Backend.StencilFunc(xfunc, ref, mask)
+}
+
+func (c *stateCache) StencilFuncSeparate(face, xfunc uint32, ref int32, mask uint32) {
+	if c.setFaces("StencilFuncSeparate", "StencilFunc", face, stencilFunc{xfunc, ref, mask}) {
+		return
+	}
+	c.Backend.StencilFuncSeparate(face, xfunc, ref, mask)
+}
+
+func (c *stateCache) StencilMask(mask uint32) {
+	if c.setFaces("StencilMask", "StencilMask", FRONT_AND_BACK, mask) {
+		return
+	}
+	c.Backend.StencilMask(mask)
+}
+
+func (c *stateCache) StencilMaskSeparate(face, mask uint32) {
+	if c.setFaces("StencilMaskSeparate", "StencilMask", face, mask) {
+		return
+	}
+	c.Backend.StencilMaskSeparate(face, mask)
+}
+
+func (c *stateCache) StencilOp(fail, zfail, zpass uint32) {
+	if c.setFaces("StencilOp", "StencilOp", FRONT_AND_BACK, [3]uint32{fail, zfail, zpass}) {
+		return
+	}
+	c.Backend.StencilOp(fail, zfail, zpass)
+}
+
+func (c *stateCache) StencilOpSeparate(face, sfail, dpfail, dppass uint32) {
+	if c.setFaces("StencilOpSeparate", "StencilOp", face, [3]uint32{sfail, dpfail, dppass}) {
+		return
+	}
+	c.Backend.StencilOpSeparate(face, sfail, dpfail, dppass)
+}
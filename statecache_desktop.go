@@ -0,0 +1,10 @@
+//go:build !gles30
+
+package gl
+
+func (c *stateCache) PolygonMode(face, mode uint32) {
+	if c.setFaces("PolygonMode", "PolygonMode", face, mode) {
+		return
+	}
+	c.Backend.PolygonMode(face, mode)
+}
@@ -0,0 +1,143 @@
+package gl
+
+import "testing"
+
+func TestStateCache(t *testing.T) {
+	tests := []struct {
+		name        string
+		do          func()
+		want        []string
+		wantSkipped map[string]uint64
+	}{
+		{"enable twice", func() {
+			backend.Enable(uint32(DEPTH_TEST))
+			backend.Enable(uint32(DEPTH_TEST))
+			backend.Disable(uint32(DEPTH_TEST))
+			backend.Disable(uint32(DEPTH_TEST))
+		}, []string{"Enable", "Disable"}, map[string]uint64{"Enable": 1, "Disable": 1}},
+		{"bind the same buffer", func() {
+			backend.BindBuffer(uint32(ARRAY_BUFFER), 1)
+			backend.BindBuffer(uint32(ARRAY_BUFFER), 1)
+			backend.BindBuffer(uint32(COPY_READ_BUFFER), 1)
+			backend.BindBuffer(uint32(ARRAY_BUFFER), 2)
+		}, []string{"BindBuffer", "BindBuffer", "BindBuffer"}, map[string]uint64{"BindBuffer": 1}},
+		{"element array of an unknown vertex array", func() {
+			backend.BindBuffer(uint32(ELEMENT_ARRAY_BUFFER), 1)
+			backend.BindBuffer(uint32(ELEMENT_ARRAY_BUFFER), 1)
+		}, []string{"BindBuffer", "BindBuffer"}, map[string]uint64{}},
+		{"element array follows the vertex array", func() {
+			backend.BindVertexArray(1)
+			backend.BindBuffer(uint32(ELEMENT_ARRAY_BUFFER), 1)
+			backend.BindBuffer(uint32(ELEMENT_ARRAY_BUFFER), 1)
+			backend.BindVertexArray(2)
+			backend.BindBuffer(uint32(ELEMENT_ARRAY_BUFFER), 1)
+		}, []string{"BindVertexArray", "BindBuffer", "BindVertexArray", "BindBuffer"}, map[string]uint64{"BindBuffer": 1}},
+		{"textures per unit", func() {
+			backend.BindTexture(uint32(TEXTURE_2D), 1)
+			backend.ActiveTexture(uint32(TEXTURE0))
+			backend.BindTexture(uint32(TEXTURE_2D), 1)
+			backend.BindTexture(uint32(TEXTURE_2D), 1)
+			backend.ActiveTexture(uint32(TEXTURE1))
+			backend.BindTexture(uint32(TEXTURE_2D), 1)
+			backend.ActiveTexture(uint32(TEXTURE1))
+		}, []string{"BindTexture", "ActiveTexture", "BindTexture", "ActiveTexture", "BindTexture"}, map[string]uint64{"BindTexture": 1, "ActiveTexture": 1}},
+		{"framebuffer sets draw and read", func() {
+			backend.BindFramebuffer(uint32(FRAMEBUFFER), 1)
+			backend.BindFramebuffer(uint32(DRAW_FRAMEBUFFER), 1)
+			backend.BindFramebuffer(uint32(READ_FRAMEBUFFER), 2)
+			backend.BindFramebuffer(uint32(FRAMEBUFFER), 2)
+			backend.BindFramebuffer(uint32(FRAMEBUFFER), 2)
+		}, []string{"BindFramebuffer", "BindFramebuffer", "BindFramebuffer"}, map[string]uint64{"BindFramebuffer": 2}},
+		{"deleted objects unbind", func() {
+			backend.UseProgram(3)
+			backend.DeleteProgram(3)
+			backend.UseProgram(3)
+		}, []string{"UseProgram", "DeleteProgram", "UseProgram"}, map[string]uint64{}},
+		{"values", func() {
+			backend.Viewport(0, 0, 640, 480)
+			backend.Viewport(0, 0, 640, 480)
+			backend.ClearColor(0, 0, 0, 1)
+			backend.ClearColor(0, 0, 0, 0)
+			backend.DepthMask(false)
+			backend.DepthMask(false)
+		}, []string{"Viewport", "ClearColor", "ClearColor", "DepthMask"}, map[string]uint64{"Viewport": 1, "DepthMask": 1}},
+		{"stencil faces", func() {
+			backend.StencilMaskSeparate(uint32(FRONT), 0xff)
+			backend.StencilMask(0xff)
+			backend.StencilMaskSeparate(uint32(BACK), 0xff)
+			backend.StencilMaskSeparate(uint32(FRONT), 0xff)
+		}, []string{"StencilMaskSeparate", "StencilMask"}, map[string]uint64{"StencilMaskSeparate": 2}},
+		{"invalidated", func() {
+			backend.CullFace(uint32(BACK))
+			InvalidateStateCache()
+			backend.CullFace(uint32(BACK))
+		}, []string{"CullFace", "CullFace"}, map[string]uint64{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFake(t)
+			if err := EnableStateCache(); err != nil {
+				t.Fatal(err)
+			}
+			defer DisableStateCache()
+			f.Reset()
+			tt.do()
+			if got := callNames(f); !equalStrings(got, tt.want) {
+				t.Errorf("calls = %v, want %v", got, tt.want)
+			}
+			stats := GetStateCacheStats()
+			var skipped uint64
+			for _, n := range tt.wantSkipped {
+				skipped += n
+			}
+			if stats.Skipped != skipped || len(stats.SkippedCalls) != len(tt.wantSkipped) {
+				t.Errorf("stats = %+v, want %v skipped", stats, tt.wantSkipped)
+			}
+			for call, n := range tt.wantSkipped {
+				if stats.SkippedCalls[call] != n {
+					t.Errorf("skipped %d %s, want %d", stats.SkippedCalls[call], call, n)
+				}
+			}
+		})
+	}
+}
+
+func TestStateCacheEnableDisable(t *testing.T) {
+	f := newFake(t)
+	if err := EnableStateCache(); err != nil {
+		t.Fatal(err)
+	}
+	if err := EnableStateCache(); err != ErrStateCaching {
+		t.Errorf("second EnableStateCache() = %v, want %v", err, ErrStateCaching)
+	}
+	backend.Enable(uint32(BLEND))
+	backend.Enable(uint32(BLEND))
+	ResetStateCacheStats()
+	if stats := GetStateCacheStats(); stats.Issued != 0 || stats.Skipped != 0 {
+		t.Errorf("stats after ResetStateCacheStats() = %+v, want zero", stats)
+	}
+
+	// A layer on top of the cache doesn't keep it in the chain, enabling and
+	// disabling it again doesn't stack caches.
+	if err := TrackObjects(nil); err != nil {
+		t.Fatal(err)
+	}
+	defer StopTrackingObjects()
+	DisableStateCache()
+	for i := 0; i < 2; i++ {
+		if err := EnableStateCache(); err != nil {
+			t.Errorf("EnableStateCache() after DisableStateCache() = %v", err)
+		}
+		DisableStateCache()
+	}
+	for b := backend; b != Backend(f); b = *b.(backendLayer).next() {
+		if _, ok := b.(*stateCache); ok {
+			t.Fatal("state cache still installed after DisableStateCache")
+		}
+	}
+	f.Reset()
+	backend.Enable(uint32(BLEND))
+	if got := callNames(f); !equalStrings(got, []string{"Enable"}) {
+		t.Errorf("calls without the cache = %v, want [Enable]", got)
+	}
+}
@@ -0,0 +1,206 @@
+package gl
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// StateChange is a field that differs between two States. Field is the path
+// of the field in State, like "Depth.Func", "Enabled[GL_BLEND]" or
+// "Textures[1][GL_TEXTURE_2D]". From and To are its values, the enums
+// decoded to their GL_* names.
+type StateChange struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+func (c StateChange) String() string {
+	return c.Field + ": " + c.From + " -> " + c.To
+}
+
+// StateDiff is the list of changes between two States, in the order of the
+// fields of State. It marshals to a JSON array of StateChange.
+type StateDiff []StateChange
+
+// String returns the changes of d, one per line.
+func (d StateDiff) String() string {
+	var b strings.Builder
+	for _, c := range d {
+		b.WriteString(c.String())
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// DiffState returns the fields of b that differ from a. Either may be nil, a
+// nil State has no field.
+func DiffState(a, b *State) StateDiff {
+	var d StateDiff
+	var va, vb reflect.Value
+	if a != nil {
+		va = reflect.ValueOf(a).Elem()
+	}
+	if b != nil {
+		vb = reflect.ValueOf(b).Elem()
+	}
+	d.diff("", va, vb)
+	return d
+}
+
+// diff appends the changes between a and b to d. An invalid Value stands for
+// a nil State, or a field of one.
+func (d *StateDiff) diff(path string, a, b reflect.Value) {
+	v := a
+	if !v.IsValid() {
+		v = b
+	}
+	if !v.IsValid() {
+		return
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			name := v.Type().Field(i).Name
+			if path != "" {
+				name = path + "." + name
+			}
+			d.diff(name, stateField(a, i), stateField(b, i))
+		}
+	case reflect.Slice:
+		n := max(stateLen(a), stateLen(b))
+		for i := 0; i < n; i++ {
+			d.diff(fmt.Sprintf("%s[%d]", path, i), stateIndex(a, i), stateIndex(b, i))
+		}
+	case reflect.Map:
+		keys := map[string]reflect.Value{}
+		for _, m := range []reflect.Value{a, b} {
+			if m.IsValid() {
+				for _, k := range m.MapKeys() {
+					keys[formatStateValue("", k)] = k
+				}
+			}
+		}
+		names := make([]string, 0, len(keys))
+		for name := range keys {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			k := keys[name]
+			elem := v.Type().Elem()
+			d.diff(path+"["+name+"]", stateMapIndex(a, k, elem), stateMapIndex(b, k, elem))
+		}
+	default:
+		if a.IsValid() && b.IsValid() && a.Interface() == b.Interface() {
+			return
+		}
+		*d = append(*d, StateChange{
+			Field: path,
+			From:  formatStateValue(path, a),
+			To:    formatStateValue(path, b),
+		})
+	}
+}
+
+func stateField(v reflect.Value, i int) reflect.Value {
+	if !v.IsValid() {
+		return v
+	}
+	return v.Field(i)
+}
+
+func stateLen(v reflect.Value) int {
+	if !v.IsValid() {
+		return 0
+	}
+	return v.Len()
+}
+
+// stateIndex returns the element i of the slice v, the zero value of its
+// elements when it is shorter: State leaves the trailing empty texture units
+// and binding points out.
+func stateIndex(v reflect.Value, i int) reflect.Value {
+	if !v.IsValid() {
+		return v
+	}
+	if i >= v.Len() {
+		return reflect.Zero(v.Type().Elem())
+	}
+	return v.Index(i)
+}
+
+// stateMapIndex returns the entry k of m, the zero value of elem when it has
+// none: TextureUnit omits the unbound targets.
+func stateMapIndex(m, k reflect.Value, elem reflect.Type) reflect.Value {
+	if m.IsValid() {
+		if e := m.MapIndex(k); e.IsValid() {
+			return e
+		}
+	}
+	return reflect.Zero(elem)
+}
+
+// formatStateValue formats the value of the field at path, "none" if v is
+// missing. The enums print their GL_* names through their String methods,
+// ActiveTexture is the only untyped one.
+func formatStateValue(path string, v reflect.Value) string {
+	if !v.IsValid() {
+		return "none"
+	}
+	if path == "ActiveTexture" {
+		return fmt.Sprintf("GL_TEXTURE%d", v.Uint()-TEXTURE0)
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+// stateCheckpoints is the state of LogStateCheckpoints.
+var stateCheckpoints struct {
+	report func(from, to string, diff StateDiff)
+	last   *State
+	name   string
+}
+
+// LogStateCheckpoints makes StateCheckpoint capture the state and report
+// what changed since the previous checkpoint to report, or log it with the
+// log package when report is nil. Checkpoints where nothing changed aren't
+// reported. The first checkpoint after LogStateCheckpoints is only recorded.
+func LogStateCheckpoints(report func(from, to string, diff StateDiff)) {
+	if report == nil {
+		report = func(from, to string, diff StateDiff) {
+			log.Printf("gl: state changed between %s and %s:\n%s", from, to, diff)
+		}
+	}
+	stateCheckpoints.report = report
+	stateCheckpoints.last = nil
+	stateCheckpoints.name = ""
+}
+
+// StopLoggingStateCheckpoints stops LogStateCheckpoints, StateCheckpoint
+// does nothing again.
+func StopLoggingStateCheckpoints() {
+	stateCheckpoints.report = nil
+	stateCheckpoints.last = nil
+	stateCheckpoints.name = ""
+}
+
+// StateCheckpoint marks a point of the frame, like "after shadows". It does
+// nothing unless LogStateCheckpoints was called, so checkpoints can be left
+// in the code. Naming the last checkpoint of the frame like the first one
+// reports what the frame leaked into the next.
+func StateCheckpoint(name string) {
+	c := &stateCheckpoints
+	if c.report == nil {
+		return
+	}
+	s := CaptureState()
+	if c.last != nil {
+		if diff := DiffState(c.last, s); len(diff) > 0 {
+			c.report(c.name, name, diff)
+		}
+	}
+	c.last, c.name = s, name
+}
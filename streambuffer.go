@@ -0,0 +1,221 @@
+package gl
+
+import "fmt"
+
+// StreamBuffer is a ring buffer for data rewritten every frame, like UI
+// vertices, particles or debug lines, that never waits on the draws still
+// reading the previous frames unless it runs out of room.
+//
+// Alloc carves regions out of one large buffer and returns the memory to
+// write them through. Flush makes the writes visible to OpenGL before the
+// draws using them, and Fence, after those draws, marks the regions as in
+// flight: Alloc waits on their fence before writing over them again, when the
+// ring wraps around.
+//
+// In the 4.5 profile, the only one whose Backend has glBufferStorage, the whole
+// buffer stays mapped and Alloc only slices it. Otherwise every Alloc maps its
+// region with gl.MAP_UNSYNCHRONIZED_BIT and the previous region is unmapped.
+//
+// The methods work on the buffer bound to Target, bind it first.
+type StreamBuffer struct {
+	Buffer
+	Target BufferTarget
+
+	size int
+
+	// Positions in the ring only grow, a position modulo size is an offset
+	// in the buffer. head is the end of the last allocation and fenced the
+	// end of the allocations fences cover.
+	head   int
+	fenced int
+	fences []streamFence
+
+	// persistent maps the whole buffer, flushed is the position it was
+	// flushed up to. mapping is the region of the last Alloc when the buffer
+	// isn't mapped persistently.
+	persistent *Mapping
+	flushed    int
+	mapping    *Mapping
+
+	// err is the first error of Alloc, returned by the next Flush.
+	err error
+}
+
+// streamFence is a fence covering the allocations from start to the start of
+// the next fence, or to StreamBuffer.fenced for the last one.
+type streamFence struct {
+	start int
+	sync  Sync
+}
+
+// NewStreamBuffer generates a buffer of size bytes used on target and leaves
+// it bound. It returns an error if the buffer can't be mapped persistently
+// while glBufferStorage is available.
+func NewStreamBuffer(target BufferTarget, size int) (*StreamBuffer, error) {
+	s := &StreamBuffer{Buffer: GenBuffer(), Target: target, size: size}
+	s.Bind()
+	if !bufferStorageAvailable() {
+		backend.BufferData(uint32(target), size, nil, uint32(STREAM_DRAW))
+		return s, nil
+	}
+	bufferStorage(target, size, MAP_WRITE_BIT|MAP_PERSISTENT_BIT)
+	m, err := s.Buffer.MapRange(target, 0, size, MAP_WRITE_BIT|MAP_PERSISTENT_BIT|MAP_FLUSH_EXPLICIT_BIT)
+	if err != nil {
+		s.Buffer.Delete()
+		return nil, err
+	}
+	s.persistent = m
+	return s, nil
+}
+
+// Size returns the size of the buffer in bytes.
+func (s *StreamBuffer) Size() int {
+	return s.size
+}
+
+// Persistent returns true if the buffer is mapped persistently.
+func (s *StreamBuffer) Persistent() bool {
+	return s.persistent != nil
+}
+
+// Bind binds the buffer to Target.
+func (s *StreamBuffer) Bind() {
+	s.Buffer.Bind(s.Target)
+}
+
+// Unbind binds 0 to Target.
+func (s *StreamBuffer) Unbind() {
+	s.Buffer.Unbind(s.Target)
+}
+
+// Alloc reserves size bytes aligned on align bytes and returns their offset
+// in the buffer and the memory to write them through, valid until the next
+// Alloc or Flush. It waits for the draws still reading the region, if any.
+//
+// It panics if size is larger than the buffer or if the allocations since
+// the last Fence don't leave room for it: call Fence at least once per frame
+// and don't allocate more than Size bytes between two fences.
+func (s *StreamBuffer) Alloc(size, align int) (offset int, data []byte) {
+	if safetyflag {
+		safetyCheckBound("StreamBuffer.Alloc", kindBuffer, uint32(s.Target), uint32(s.Buffer))
+	}
+	if size <= 0 || size > s.size {
+		panic(fmt.Sprintf("gl: StreamBuffer.Alloc of %d bytes in a buffer of %d", size, s.size))
+	}
+	if align < 1 {
+		align = 1
+	}
+	lap := s.head - s.head%s.size
+	offset = (s.head%s.size + align - 1) / align * align
+	if offset+size > s.size {
+		lap, offset = lap+s.size, 0
+	}
+	start := lap + offset
+	s.wait(start + size)
+	if s.persistent != nil {
+		// A flush can't cross the end of the buffer.
+		if start/s.size != s.flushed/s.size {
+			s.flushPersistent()
+			s.flushed = start
+		}
+		s.head = start + size
+		return offset, s.persistent.Bytes()[offset : offset+size]
+	}
+	s.unmap()
+	s.head = start + size
+	m, err := s.Buffer.MapRange(s.Target, offset, size, MAP_WRITE_BIT|MAP_INVALIDATE_RANGE_BIT|MAP_UNSYNCHRONIZED_BIT)
+	if err != nil {
+		if s.err == nil {
+			s.err = err
+		}
+		return offset, make([]byte, size)
+	}
+	s.mapping = m
+	return offset, m.Bytes()
+}
+
+// wait waits for the fences covering the regions an allocation ending at end
+// writes over and deletes them.
+func (s *StreamBuffer) wait(end int) {
+	reused := end - s.size
+	if s.fenced < reused && s.head > s.fenced {
+		panic(fmt.Sprintf("gl: StreamBuffer.Alloc needs %d bytes since the last Fence in a buffer of %d", end-s.fenced, s.size))
+	}
+	n := 0
+	for n < len(s.fences) && s.fences[n].start < reused {
+		n++
+	}
+	if n == 0 {
+		return
+	}
+	// Fences are signaled in order, the last one covers the others.
+	if err := s.fences[n-1].sync.Wait(); err != nil && s.err == nil {
+		s.err = err
+	}
+	for _, f := range s.fences[:n] {
+		f.sync.Delete()
+	}
+	s.fences = append(s.fences[:0], s.fences[n:]...)
+}
+
+// Flush makes the writes to the allocations visible to OpenGL, call it
+// before the draws reading them. It returns the first error since the last
+// Flush.
+func (s *StreamBuffer) Flush() error {
+	if safetyflag {
+		safetyCheckBound("StreamBuffer.Flush", kindBuffer, uint32(s.Target), uint32(s.Buffer))
+	}
+	if s.persistent != nil {
+		s.flushPersistent()
+	} else {
+		s.unmap()
+	}
+	err := s.err
+	s.err = nil
+	return err
+}
+
+// flushPersistent flushes the allocations of the persistent mapping since the
+// last flush.
+func (s *StreamBuffer) flushPersistent() {
+	if s.head > s.flushed {
+		s.persistent.FlushRange(s.flushed%s.size, s.head-s.flushed)
+		s.flushed = s.head
+	}
+}
+
+// unmap unmaps the region of the last Alloc, if any.
+func (s *StreamBuffer) unmap() {
+	if s.mapping == nil {
+		return
+	}
+	if err := s.mapping.Unmap(); err != nil && s.err == nil {
+		s.err = err
+	}
+	s.mapping = nil
+}
+
+// Fence marks the allocations since the last Fence as in use by the commands
+// issued so far, call it after the draws reading them.
+func (s *StreamBuffer) Fence() {
+	if s.head == s.fenced {
+		return
+	}
+	s.fences = append(s.fences, streamFence{start: s.fenced, sync: FenceSync()})
+	s.fenced = s.head
+}
+
+// Delete unmaps the buffer, deletes its fences and then the buffer itself.
+// The buffer must be bound to Target.
+func (s *StreamBuffer) Delete() {
+	s.unmap()
+	if s.persistent != nil {
+		s.persistent.Unmap()
+		s.persistent = nil
+	}
+	for _, f := range s.fences {
+		f.sync.Delete()
+	}
+	s.fences = nil
+	s.Buffer.Delete()
+}
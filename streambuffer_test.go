@@ -0,0 +1,134 @@
+package gl
+
+import (
+	"bytes"
+	"testing"
+	"unsafe"
+)
+
+// streamModes are the ways a StreamBuffer maps its buffer, by the version of
+// the context: 4.4 has glBufferStorage in the 4.5 profile only.
+var streamModes = []struct {
+	version    string
+	persistent bool
+}{
+	{"3.3.0", false},
+	{"4.4.0", Profile == "4.5-core"},
+}
+
+func TestStreamBufferAlloc(t *testing.T) {
+	type alloc struct {
+		size, align int
+		fence       bool
+		wantOffset  int
+	}
+	tests := []struct {
+		name      string
+		allocs    []alloc
+		wantWaits int
+	}{
+		{"aligned", []alloc{{16, 1, false, 0}, {10, 4, false, 16}, {8, 16, false, 32}}, 0},
+		{"wrap around", []alloc{{40, 1, true, 0}, {30, 1, false, 0}}, 1},
+		{"wrap around fenced twice", []alloc{{20, 1, true, 0}, {20, 1, true, 20}, {30, 1, false, 0}}, 1},
+		{"fence without allocations", []alloc{{16, 1, true, 0}, {16, 1, true, 16}, {16, 1, true, 32}}, 0},
+	}
+	for _, mode := range streamModes {
+		for _, tt := range tests {
+			t.Run(mode.version+"/"+tt.name, func(t *testing.T) {
+				f := newFake(t)
+				f.Strings[VERSION] = mode.version
+				s, err := NewStreamBuffer(ARRAY_BUFFER, 64)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if s.Persistent() != mode.persistent {
+					t.Errorf("Persistent() = %v, want %v", s.Persistent(), mode.persistent)
+				}
+				for i, a := range tt.allocs {
+					offset, data := s.Alloc(a.size, a.align)
+					if offset != a.wantOffset || len(data) != a.size {
+						t.Errorf("Alloc(%d, %d) #%d = %d, %d bytes, want %d, %d bytes", a.size, a.align, i, offset, len(data), a.wantOffset, a.size)
+					}
+					for j := range data {
+						data[j] = byte(i + 1)
+					}
+					if err := s.Flush(); err != nil {
+						t.Errorf("Flush() = %v", err)
+					}
+					if got, want := f.buffers[uint32(s.Buffer)][offset:offset+a.size], bytes.Repeat([]byte{byte(i + 1)}, a.size); !bytes.Equal(got, want) {
+						t.Errorf("Alloc #%d wrote %v, want %v", i, got, want)
+					}
+					if a.fence {
+						s.Fence()
+					}
+				}
+				waits := 0
+				for _, c := range f.Calls {
+					if c.Name == "ClientWaitSync" {
+						waits++
+					}
+				}
+				if waits != tt.wantWaits {
+					t.Errorf("waited on %d fences, want %d", waits, tt.wantWaits)
+				}
+				s.Delete()
+				if n := f.LiveObjects(); n != 0 {
+					t.Errorf("%d live objects after Delete, want 0", n)
+				}
+			})
+		}
+	}
+}
+
+func TestStreamBufferAllocPanics(t *testing.T) {
+	tests := []struct {
+		name  string
+		alloc func(s *StreamBuffer)
+	}{
+		{"larger than the buffer", func(s *StreamBuffer) { s.Alloc(65, 1) }},
+		{"empty", func(s *StreamBuffer) { s.Alloc(0, 1) }},
+		{"no fence", func(s *StreamBuffer) {
+			s.Alloc(40, 1)
+			s.Alloc(40, 1)
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newFake(t)
+			s, err := NewStreamBuffer(ARRAY_BUFFER, 64)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if catchPanic(func() { tt.alloc(s) }) == nil {
+				t.Error("Alloc didn't panic")
+			}
+		})
+	}
+}
+
+func TestStreamBufferMapFailed(t *testing.T) {
+	f := newFake(t)
+	backend = failMapBackend{f}
+	s, err := NewStreamBuffer(ARRAY_BUFFER, 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, data := s.Alloc(16, 1); len(data) != 16 {
+		t.Errorf("Alloc() returned %d bytes, want 16", len(data))
+	}
+	if err := s.Flush(); err != ErrMapFailed {
+		t.Errorf("Flush() = %v, want %v", err, ErrMapFailed)
+	}
+	if err := s.Flush(); err != nil {
+		t.Errorf("second Flush() = %v, want nil", err)
+	}
+}
+
+// failMapBackend is a FakeBackend that can't map buffers.
+type failMapBackend struct {
+	*FakeBackend
+}
+
+func (failMapBackend) MapBufferRange(target uint32, offset, length int, access uint32) unsafe.Pointer {
+	return nil
+}
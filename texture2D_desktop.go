@@ -0,0 +1,61 @@
+//go:build !gles30
+
+package gl
+
+import "unsafe"
+
+//unc (Texture2D) GetTexLevelParameteriv(target uit32, level int32, pname uint32, params *int32) {GetTexLevelParameteriv is an alias to glGetTexLevelParameteriv(target, level, pname, params)
+//
+//Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glGetTexLevelParameter.xml
+func (t Texture2D) GetTexLevelParameteriv(target TextureTarget, level int32, pname TextureParameter, params *int32) {
+	if safetyflag {
+		safetyCheckBound("Texture2D.GetTexLevelParameteriv", kindTexture, uint32(target), uint32(t))
+	}
+	backend.GetTexLevelParameteriv(uint32(target), level, uint32(pname), params)
+}
+
+//unc (Texture2D) GetTexImage(level in32, format, xtype uint32, pixels unsafe.Pointer) {GetTexImage is an alias to glGetTexImage(gl.TEXTURE_2D, level, format, xtype, pixels)
+//
+//Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glGetTexImage.xml
+func (t Texture2D) GetTexImage(level int32, format PixelFormat, xtype PixelType, pixels unsafe.Pointer) {
+	if safetyflag {
+		safetyCheckBound("Texture2D.GetTexImage", kindTexture, uint32(TEXTURE_2D), uint32(t))
+	}
+	backend.GetTexImage(uint32(TEXTURE_2D), level, uint32(format), uint32(xtype), pixels)
+}
+
+//Width is an alias to glGetTexLevelParameteriv(gl.TEXTURE_2D, miplevel, gl.TEXTURE_WIDTH, &w)
+//
+//Documentation reference:
+func (t Texture2D) Width(miplevel int32) int32 {
+	if safetyflag {
+		safetyCheckBound("Texture2D.Width", kindTexture, uint32(TEXTURE_2D), uint32(t))
+	}
+	var w int32
+	backend.GetTexLevelParameteriv(uint32(TEXTURE_2D), miplevel, uint32(TEXTURE_WIDTH), &w)
+	return w
+}
+
+//Height is an alias to glGetTexLevelParameteriv(gl.TEXTURE_2D, miplevel, gl.TEXTURE_HEIGHT, &h)
+//
+//Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glGetTexLevelParameter.xml
+func (t Texture2D) Height(miplevel int32) int32 {
+	if safetyflag {
+		safetyCheckBound("Texture2D.Height", kindTexture, uint32(TEXTURE_2D), uint32(t))
+	}
+	var h int32
+	backend.GetTexLevelParameteriv(uint32(TEXTURE_2D), miplevel, uint32(TEXTURE_HEIGHT), &h)
+	return h
+}
+
+//InternalFormat is an alias to glGetTexLevelParameteriv(gl.TEXTURE_2D, miplevel, gl.TEXTURE_INTERNAL_FORMAT, &x)
+//
+//Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glGetTexLevelParameter.xml
+func (t Texture2D) InternalFormat(miplevel int32) uint32 {
+	if safetyflag {
+		safetyCheckBound("Texture2D.InternalFormat", kindTexture, uint32(TEXTURE_2D), uint32(t))
+	}
+	var x int32
+	backend.GetTexLevelParameteriv(uint32(TEXTURE_2D), miplevel, uint32(TEXTURE_INTERNAL_FORMAT), &x)
+	return uint32(x)
+}
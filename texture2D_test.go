@@ -0,0 +1,46 @@
+package gl
+
+import "testing"
+
+func TestTexture2DParameters(t *testing.T) {
+	tests := []struct {
+		name string
+		do   func(tex Texture2D)
+		want Call
+	}{
+		{"BaseLevel", func(tex Texture2D) { tex.BaseLevel(2) }, call("TexParameteri", uint32(TEXTURE_2D), uint32(TEXTURE_BASE_LEVEL), int32(2))},
+		{"CompareFunc", func(tex Texture2D) { tex.CompareFunc(LEQUAL) }, call("TexParameteri", uint32(TEXTURE_2D), uint32(TEXTURE_COMPARE_FUNC), int32(LEQUAL))},
+		{"CompareMode", func(tex Texture2D) { tex.CompareMode(NONE) }, call("TexParameteri", uint32(TEXTURE_2D), uint32(TEXTURE_COMPARE_MODE), int32(NONE))},
+		{"LODBias", func(tex Texture2D) { tex.LODBias(0.5) }, call("TexParameterf", uint32(TEXTURE_2D), uint32(TEXTURE_LOD_BIAS), float32(0.5))},
+		{"MinFilter", func(tex Texture2D) { tex.MinFilter(LINEAR) }, call("TexParameteri", uint32(TEXTURE_2D), uint32(TEXTURE_MIN_FILTER), int32(LINEAR))},
+		{"MagFilter", func(tex Texture2D) { tex.MagFilter(NEAREST) }, call("TexParameteri", uint32(TEXTURE_2D), uint32(TEXTURE_MAG_FILTER), int32(NEAREST))},
+		{"TexParameteri", func(tex Texture2D) { tex.TexParameteri(TEXTURE_WRAP_S, REPEAT) }, call("TexParameteri", uint32(TEXTURE_2D), uint32(TEXTURE_WRAP_S), int32(REPEAT))},
+		{"TexParameterf", func(tex Texture2D) { tex.TexParameterf(TEXTURE_MIN_LOD, -1) }, call("TexParameterf", uint32(TEXTURE_2D), uint32(TEXTURE_MIN_LOD), float32(-1))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFake(t)
+			tex := GenTexture2D()
+			tex.Bind()
+			f.Reset()
+			tt.do(tex)
+			checkCalls(t, f, tt.want)
+		})
+	}
+}
+
+func TestTexture2DImage(t *testing.T) {
+	f := newFake(t)
+	tex := GenTexture2D()
+	tex.Bind()
+	if got := Get.TextureBinding2D(); got != Texture(tex) {
+		t.Errorf("TEXTURE_BINDING_2D = %d, want %d", got, tex)
+	}
+	f.Reset()
+	tex.TexImage2D(0, RGBA8, 4, 2, 0, RGBA, UNSIGNED_BYTE, nil)
+	checkCalls(t, f, call("TexImage2D", uint32(TEXTURE_2D), int32(0), int32(RGBA8), int32(4), int32(2), int32(0), uint32(RGBA), uint32(UNSIGNED_BYTE), anyArg{}))
+	tex.Unbind()
+	if got := Get.TextureBinding2D(); got != 0 {
+		t.Errorf("TEXTURE_BINDING_2D = %d after Unbind, want 0", got)
+	}
+}
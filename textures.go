@@ -1,9 +1,6 @@
 package gl
 
-import (
-	"github.com/go-gl/gl/v3.3-core/gl"
-	"unsafe"
-)
+import "unsafe"
 
 //texture interface maybe ?
 /*
@@ -16,89 +13,69 @@ type Texture interface{
 }
 */
 
-//Texture is a high-level representation of the OpenGL texture object, can be any type (TEXTURE_2D, TEXTURE_1D, etc).
-type Texture uint32
-
-func GenTexture() Texture {
-	var tex uint32
-	gl.GenTextures(1, &tex)
-	return Texture(tex)
-}
-
-func GenTextures(n int32) []Texture {
-	tex := make([]Texture, n)
-	gl.GenTextures(1, (*uint32)(&tex[0]))
-	return tex
-}
-
-func (t Texture) Delete() {
-	gl.DeleteTextures(1, (*uint32)(&t))
-}
-
-func (t Texture) Bind(target uint32) {
-	gl.BindTexture(target, uint32(t))
-}
-
-func (t Texture) Unbind(target uint32) {
-	gl.BindTexture(target, 0)
-}
-
-func (Texture) CopyTexImage1D(target uint32, level int32, internalformat uint32, x, y, width, border int32) {
-	gl.CopyTexImage1D(target, level, internalformat, x, y, width, border)
+// ActiveTexture is an alias to glActiveTexture(texture), it selects the texture
+// unit the texture functions act on, gl.TEXTURE0 + i for unit i.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glActiveTexture.xml
+func ActiveTexture(texture uint32) {
+	backend.ActiveTexture(texture)
 }
 
-func (Texture) CopyTexImage2D(target uint32, level int32, internalformat uint32, x, y, width, height, border int32) {
-	gl.CopyTexImage2D(target, level, internalformat, x, y, width, height, border)
-}
-
-func (Texture) TexImage1D(target uint32, level, internalFormat, width, border int32, format, xtype uint32, data unsafe.Pointer) {
-	gl.TexImage1D(target, level, internalFormat, width, border, format, xtype, data)
-}
-
-func (Texture) TexImage2D(target uint32, level, internalFormat, width, height, border int32, format, xtype uint32, data unsafe.Pointer) {
-	gl.TexImage2D(target, level, internalFormat, width, height, border, format, xtype, data)
-}
-
-func (Texture) TexImage3D(target uint32, level, internalFormat, width, height, depth, border int32, format, xtype uint32, data unsafe.Pointer) {
-	gl.TexImage3D(target, level, internalFormat, width, height, depth, border, format, xtype, data)
-}
-
-func (Texture) TexParameterfv(target, pname uint32, params *float32) {
-	gl.TexParameterfv(target, pname, params)
-}
-
-func (Texture) TexParameteriv(target, pname uint32, params *int32) {
-	gl.TexParameteriv(target, pname, params)
-}
+// Texture is a high-level representation of the OpenGL texture object, can be any type (TEXTURE_2D, TEXTURE_1D, etc).
+type Texture uint32
 
-func (Texture) TexParameterIiv(target, pname uint32, params *int32) {
-	gl.TexParameterIiv(target, pname, params)
+func (t Texture) CopyTexImage2D(target TextureTarget, level int32, internalformat InternalFormat, x, y, width, height, border int32) {
+	if safetyflag {
+		safetyCheckBound("Texture.CopyTexImage2D", kindTexture, uint32(target), uint32(t))
+	}
+	backend.CopyTexImage2D(uint32(target), level, uint32(internalformat), x, y, width, height, border)
 }
 
-func (Texture) TexParameteri(target, pname uint32, param int32) {
-	gl.TexParameteri(target, pname, param)
+func (t Texture) TexImage2D(target TextureTarget, level int32, internalFormat InternalFormat, width, height, border int32, format PixelFormat, xtype PixelType, data unsafe.Pointer) {
+	if safetyflag {
+		safetyCheckBound("Texture.TexImage2D", kindTexture, uint32(target), uint32(t))
+	}
+	backend.TexImage2D(uint32(target), level, int32(internalFormat), width, height, border, uint32(format), uint32(xtype), data)
 }
 
-func (Texture) TexParameterIuiv(target, pname uint32, params *uint32) {
-	gl.TexParameterIuiv(target, pname, params)
+func (t Texture) TexImage3D(target TextureTarget, level int32, internalFormat InternalFormat, width, height, depth, border int32, format PixelFormat, xtype PixelType, data unsafe.Pointer) {
+	if safetyflag {
+		safetyCheckBound("Texture.TexImage3D", kindTexture, uint32(target), uint32(t))
+	}
+	backend.TexImage3D(uint32(target), level, int32(internalFormat), width, height, depth, border, uint32(format), uint32(xtype), data)
 }
 
-func (Texture) GetTexParameterfv(target, pname uint32, params *float32) {
-	gl.GetTexParameterfv(target, pname, params)
+func (t Texture) TexParameterfv(target TextureTarget, pname TextureParameter, params *float32) {
+	if safetyflag {
+		safetyCheckBound("Texture.TexParameterfv", kindTexture, uint32(target), uint32(t))
+	}
+	backend.TexParameterfv(uint32(target), uint32(pname), params)
 }
 
-func (Texture) GetTexParameteriv(target, pname uint32, params *int32) {
-	gl.GetTexParameteriv(target, pname, params)
+func (t Texture) TexParameteriv(target TextureTarget, pname TextureParameter, params *int32) {
+	if safetyflag {
+		safetyCheckBound("Texture.TexParameteriv", kindTexture, uint32(target), uint32(t))
+	}
+	backend.TexParameteriv(uint32(target), uint32(pname), params)
 }
 
-func (Texture) GetTexParameterIiv(target, pname uint32, params *int32) {
-	gl.GetTexParameterIiv(target, pname, params)
+func (t Texture) TexParameteri(target TextureTarget, pname TextureParameter, param int32) {
+	if safetyflag {
+		safetyCheckBound("Texture.TexParameteri", kindTexture, uint32(target), uint32(t))
+	}
+	backend.TexParameteri(uint32(target), uint32(pname), param)
 }
 
-func (Texture) GetTexParameterIuiv(target, pname uint32, params *uint32) {
-	gl.GetTexParameterIuiv(target, pname, params)
+func (t Texture) GetTexParameterfv(target TextureTarget, pname TextureParameter, params *float32) {
+	if safetyflag {
+		safetyCheckBound("Texture.GetTexParameterfv", kindTexture, uint32(target), uint32(t))
+	}
+	backend.GetTexParameterfv(uint32(target), uint32(pname), params)
 }
 
-func (t Texture) IsTexture() bool {
-	return gl.IsTexture(uint32(t))
+func (t Texture) GetTexParameteriv(target TextureTarget, pname TextureParameter, params *int32) {
+	if safetyflag {
+		safetyCheckBound("Texture.GetTexParameteriv", kindTexture, uint32(target), uint32(t))
+	}
+	backend.GetTexParameteriv(uint32(target), uint32(pname), params)
 }
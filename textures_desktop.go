@@ -0,0 +1,47 @@
+//go:build !gles30
+
+package gl
+
+import "unsafe"
+
+func (t Texture) CopyTexImage1D(target TextureTarget, level int32, internalformat InternalFormat, x, y, width, border int32) {
+	if safetyflag {
+		safetyCheckBound("Texture.CopyTexImage1D", kindTexture, uint32(target), uint32(t))
+	}
+	backend.CopyTexImage1D(uint32(target), level, uint32(internalformat), x, y, width, border)
+}
+
+func (t Texture) TexImage1D(target TextureTarget, level int32, internalFormat InternalFormat, width, border int32, format PixelFormat, xtype PixelType, data unsafe.Pointer) {
+	if safetyflag {
+		safetyCheckBound("Texture.TexImage1D", kindTexture, uint32(target), uint32(t))
+	}
+	backend.TexImage1D(uint32(target), level, int32(internalFormat), width, border, uint32(format), uint32(xtype), data)
+}
+
+func (t Texture) TexParameterIiv(target TextureTarget, pname TextureParameter, params *int32) {
+	if safetyflag {
+		safetyCheckBound("Texture.TexParameterIiv", kindTexture, uint32(target), uint32(t))
+	}
+	backend.TexParameterIiv(uint32(target), uint32(pname), params)
+}
+
+func (t Texture) TexParameterIuiv(target TextureTarget, pname TextureParameter, params *uint32) {
+	if safetyflag {
+		safetyCheckBound("Texture.TexParameterIuiv", kindTexture, uint32(target), uint32(t))
+	}
+	backend.TexParameterIuiv(uint32(target), uint32(pname), params)
+}
+
+func (t Texture) GetTexParameterIiv(target TextureTarget, pname TextureParameter, params *int32) {
+	if safetyflag {
+		safetyCheckBound("Texture.GetTexParameterIiv", kindTexture, uint32(target), uint32(t))
+	}
+	backend.GetTexParameterIiv(uint32(target), uint32(pname), params)
+}
+
+func (t Texture) GetTexParameterIuiv(target TextureTarget, pname TextureParameter, params *uint32) {
+	if safetyflag {
+		safetyCheckBound("Texture.GetTexParameterIuiv", kindTexture, uint32(target), uint32(t))
+	}
+	backend.GetTexParameterIuiv(uint32(target), uint32(pname), params)
+}
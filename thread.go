@@ -0,0 +1,176 @@
+package gl
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+// These errors are about the thread owning the context.
+var (
+	ErrWrongThread    = errors.New("gl: called from a thread that doesn't own the context")
+	ErrCheckingThread = errors.New("gl: already checking the thread")
+)
+
+// contextThread is the OS thread that called Init, where the context is
+// current. 0 until Init is called.
+var contextThread uintptr
+
+// lockContextThread locks the calling goroutine to its OS thread and records
+// it as the context thread. OpenGL contexts are current to a thread and the Go
+// scheduler would otherwise move the goroutine to another one.
+func lockContextThread() {
+	runtime.LockOSThread()
+	contextThread = threadID()
+}
+
+// OnContextThread returns true if the caller is running on the thread that
+// called Init.
+func OnContextThread() bool {
+	return contextThread != 0 && threadID() == contextThread
+}
+
+// threadCheck is the backend installed by CheckThread.
+var threadCheck *checkedBackend
+
+// CheckThread makes every OpenGL call verify it is made from the thread that
+// called Init before reaching the driver. Violations are passed to report, or
+// panic when report is nil, which beats the crash the driver would cause. It
+// costs a system call per OpenGL call and is meant for debugging.
+func CheckThread(report func(error)) error {
+	if threadCheck != nil {
+		return ErrCheckingThread
+	}
+	if report == nil {
+		report = func(err error) { panic(err) }
+	}
+	c := &checkedBackend{Backend: backend, after: noCheckAfter}
+	c.before = func(call string) {
+		if id := threadID(); id != contextThread {
+			fn, caller := callerInfo()
+			report(fmt.Errorf("%w: %s (%s) called at %s from thread %d, the context belongs to thread %d", ErrWrongThread, fn, call, caller, id, contextThread))
+		}
+	}
+	threadCheck = c
+	backend = c
+	return nil
+}
+
+// StopCheckingThread stops the checks installed by CheckThread.
+func StopCheckingThread() {
+	c := threadCheck
+	if c == nil {
+		return
+	}
+	threadCheck = nil
+	// A Backend from outside of the package may keep c in the chain, keep it
+	// going but stop checking.
+	c.before = noCheckBefore
+	unlinkLayer(c)
+}
+
+// queue holds the closures waiting to run on the context thread. It has no
+// bound: DoAsync can be called from the closures it runs without deadlocking
+// the context thread.
+var (
+	queueMu sync.Mutex
+	queue   []func()
+	// queued wakes Loop up when closures were queued.
+	queued = make(chan struct{}, 1)
+)
+
+func enqueue(fn func()) {
+	queueMu.Lock()
+	queue = append(queue, fn)
+	queueMu.Unlock()
+	wake()
+}
+
+func wake() {
+	select {
+	case queued <- struct{}{}:
+	default:
+	}
+}
+
+// runQueue runs the closures queued so far and returns how many it ran, the
+// ones they queue wait for the next call. If one panics the ones after it
+// stay queued.
+func runQueue() (n int) {
+	queueMu.Lock()
+	fns := queue
+	queue = nil
+	queueMu.Unlock()
+	defer func() {
+		if n < len(fns) {
+			queueMu.Lock()
+			queue = append(fns[n+1:], queue...)
+			queueMu.Unlock()
+			wake()
+		}
+	}()
+	for n < len(fns) {
+		fns[n]()
+		n++
+	}
+	return n
+}
+
+// Do runs fn on the context thread and waits for it to return. Called from the
+// context thread, it runs fn directly. Otherwise the context thread must be
+// running Loop or calling RunQueued for fn to ever run. If fn panics, Do
+// panics with the same value in the calling goroutine.
+func Do(fn func()) {
+	if OnContextThread() {
+		fn()
+		return
+	}
+	done := make(chan interface{})
+	enqueue(func() {
+		defer func() {
+			done <- recover()
+		}()
+		fn()
+	})
+	if r := <-done; r != nil {
+		panic(r)
+	}
+}
+
+// DoAsync queues fn to run on the context thread and returns immediately.
+// Closures queued by Do and DoAsync run in the order they were queued, even
+// when DoAsync is called from the context thread.
+func DoAsync(fn func()) {
+	enqueue(fn)
+}
+
+// RunQueued runs the closures queued by Do and DoAsync without waiting for new
+// ones, and returns how many it ran. Closures queued while it runs, like by a
+// closure calling DoAsync, wait for the next call. It must be called from the
+// context thread, typically once per frame.
+func RunQueued() int {
+	mustBeContextThread("RunQueued")
+	return runQueue()
+}
+
+// Loop runs the closures queued by Do and DoAsync until done is closed. It
+// must be called from the context thread, for programs whose thread does
+// nothing else but serve OpenGL calls.
+func Loop(done <-chan struct{}) {
+	mustBeContextThread("Loop")
+	for {
+		select {
+		case <-queued:
+			runQueue()
+		case <-done:
+			return
+		}
+	}
+}
+
+func mustBeContextThread(fn string) {
+	if !OnContextThread() {
+		panic(fmt.Errorf("%w: %s must run on the context thread, did you call Init?", ErrWrongThread, fn))
+	}
+}
@@ -0,0 +1,110 @@
+package gl
+
+import (
+	"testing"
+)
+
+func TestRunQueued(t *testing.T) {
+	newFake(t)
+	runQueue()
+	const n = 1000
+	var ran []int
+	for i := 0; i < n; i++ {
+		i := i
+		DoAsync(func() { ran = append(ran, i) })
+	}
+	if got := RunQueued(); got != n {
+		t.Fatalf("RunQueued() = %d, want %d", got, n)
+	}
+	for i, v := range ran {
+		if v != i {
+			t.Fatalf("closure %d ran at %d", v, i)
+		}
+	}
+
+	// A closure queueing another one doesn't run it in the same call.
+	var nested bool
+	DoAsync(func() { DoAsync(func() { nested = true }) })
+	if got := RunQueued(); got != 1 || nested {
+		t.Errorf("RunQueued() = %d and ran the nested closure", got)
+	}
+	if got := RunQueued(); got != 1 || !nested {
+		t.Errorf("second RunQueued() = %d, nested closure ran: %v", got, nested)
+	}
+}
+
+func TestRunQueuedPanic(t *testing.T) {
+	newFake(t)
+	runQueue()
+	var after bool
+	DoAsync(func() { panic("queued") })
+	DoAsync(func() { after = true })
+	if v := catchPanic(func() { RunQueued() }); v != "queued" {
+		t.Fatalf("RunQueued() panicked with %v, want queued", v)
+	}
+	if got := RunQueued(); got != 1 || !after {
+		t.Errorf("RunQueued() = %d after a panic, want the remaining closure", got)
+	}
+}
+
+func TestDo(t *testing.T) {
+	newFake(t)
+	runQueue()
+	var onContext bool
+	Do(func() { onContext = OnContextThread() })
+	if !onContext {
+		t.Errorf("Do() from the context thread didn't run fn there")
+	}
+
+	done := make(chan struct{})
+	results := make(chan interface{})
+	go func() {
+		var ran bool
+		Do(func() { ran = OnContextThread() })
+		results <- ran
+		results <- catchPanic(func() { Do(func() { panic("do") }) })
+	}()
+	go func() {
+		if ran := <-results; ran != true {
+			t.Errorf("Do() didn't run fn on the context thread")
+		}
+		if v := <-results; v != "do" {
+			t.Errorf("Do() panicked with %v, want do", v)
+		}
+		close(done)
+	}()
+	Loop(done)
+
+	off := make(chan interface{})
+	go func() { off <- catchPanic(func() { RunQueued() }) }()
+	if <-off == nil {
+		t.Errorf("RunQueued() off the context thread didn't panic")
+	}
+}
+
+func TestCheckThreadAfterInitBackend(t *testing.T) {
+	newFake(t)
+	if err := CheckThread(nil); err != nil {
+		t.Fatal(err)
+	}
+	newFake(t)
+	if err := CheckThread(nil); err != nil {
+		t.Errorf("CheckThread() after InitBackend = %v, want nil", err)
+	}
+	StopCheckingThread()
+}
+
+func TestStopCheckingThreadBelowLayers(t *testing.T) {
+	f := newFake(t)
+	if err := CheckThread(nil); err != nil {
+		t.Fatal(err)
+	}
+	EnableStateCache()
+	defer DisableStateCache()
+	StopCheckingThread()
+	for b := backend; b != Backend(f); b = *b.(backendLayer).next() {
+		if _, ok := b.(*checkedBackend); ok {
+			t.Fatal("StopCheckingThread left its backend below the state cache")
+		}
+	}
+}
@@ -0,0 +1,7 @@
+package gl
+
+import "syscall"
+
+func threadID() uintptr {
+	return uintptr(syscall.Gettid())
+}
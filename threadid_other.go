@@ -0,0 +1,13 @@
+//go:build !linux && !windows
+
+package gl
+
+// #include <pthread.h>
+// #include <stdint.h>
+//
+// static uintptr_t threadID(void) { return (uintptr_t)pthread_self(); }
+import "C"
+
+func threadID() uintptr {
+	return uintptr(C.threadID())
+}
@@ -0,0 +1,10 @@
+package gl
+
+import "syscall"
+
+var procGetCurrentThreadId = syscall.NewLazyDLL("kernel32.dll").NewProc("GetCurrentThreadId")
+
+func threadID() uintptr {
+	id, _, _ := procGetCurrentThreadId.Call()
+	return id
+}
@@ -0,0 +1,1009 @@
+package gl
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"unsafe"
+)
+
+// traceMagic starts every trace, the last byte is the format version.
+var traceMagic = [8]byte{'l', 'u', 'x', 'g', 'l', 't', 'r', 1}
+
+// traceOp identifies a call in a trace. Values are written to disk, only ever
+// append to this list, right before traceOpCount.
+type traceOp uint32
+
+const (
+	traceInvalid traceOp = iota
+	traceAttachShader
+	traceBeginTransformFeedback
+	traceBindBuffer
+	traceBindBufferBase
+	traceBindFragDataLocation
+	traceBindFramebuffer
+	traceBindRenderbuffer
+	traceBindTexture
+	traceBindTransformFeedback
+	traceBindVertexArray
+	traceBufferData
+	traceClearColor
+	traceColorMask
+	traceCompileShader
+	traceCopyTexImage1D
+	traceCopyTexImage2D
+	traceCreateProgram
+	traceCreateShader
+	traceCullFace
+	traceDeleteBuffers
+	traceDeleteFramebuffers
+	traceDeleteProgram
+	traceDeleteRenderbuffers
+	traceDeleteShader
+	traceDeleteTextures
+	traceDeleteTransformFeedbacks
+	traceDeleteVertexArrays
+	traceDepthMask
+	traceDisable
+	traceDisableVertexAttribArray
+	traceDrawBuffer
+	traceDrawBuffers
+	traceEnable
+	traceEnableVertexAttribArray
+	traceEndTransformFeedback
+	traceFramebufferRenderbuffer
+	traceFramebufferTexture
+	traceGenBuffers
+	traceGenFramebuffers
+	traceGenRenderbuffers
+	traceGenTextures
+	traceGenTransformFeedbacks
+	traceGenVertexArrays
+	traceLinkProgram
+	tracePauseTransformFeedback
+	traceReadBuffer
+	traceRenderbufferStorage
+	traceResumeTransformFeedback
+	traceShaderSource
+	traceStencilFunc
+	traceStencilMask
+	traceStencilOp
+	traceTexImage1D
+	traceTexImage2D
+	traceTexImage3D
+	traceTexParameterIiv
+	traceTexParameterIuiv
+	traceTexParameterf
+	traceTexParameterfv
+	traceTexParameteri
+	traceTexParameteriv
+	traceUniform1f
+	traceUniform1fv
+	traceUniform1i
+	traceUniform1iv
+	traceUniform1ui
+	traceUniform1uiv
+	traceUniform2f
+	traceUniform2fv
+	traceUniform2i
+	traceUniform2iv
+	traceUniform2ui
+	traceUniform2uiv
+	traceUniform3f
+	traceUniform3fv
+	traceUniform3i
+	traceUniform3iv
+	traceUniform3ui
+	traceUniform3uiv
+	traceUniform4f
+	traceUniform4fv
+	traceUniform4i
+	traceUniform4iv
+	traceUniform4ui
+	traceUniform4uiv
+	traceUniformMatrix2fv
+	traceUniformMatrix2x3fv
+	traceUniformMatrix2x4fv
+	traceUniformMatrix3fv
+	traceUniformMatrix3x2fv
+	traceUniformMatrix3x4fv
+	traceUniformMatrix4fv
+	traceUniformMatrix4x2fv
+	traceUniformMatrix4x3fv
+	traceUseProgram
+	traceVertexAttribIPointer
+	traceVertexAttribLPointer
+	traceVertexAttribPointer
+	traceViewport
+	traceGetUniformLocation
+	traceActiveTexture
+	traceBindBufferRange
+	traceBlendColor
+	traceBlendEquationSeparate
+	traceBlendFuncSeparate
+	traceClearDepthf
+	traceClearStencil
+	traceDepthFunc
+	traceDepthRangef
+	traceFrontFace
+	traceLineWidth
+	tracePixelStorei
+	tracePolygonOffset
+	traceSampleCoverage
+	traceScissor
+	traceStencilFuncSeparate
+	traceStencilMaskSeparate
+	traceStencilOpSeparate
+	tracePolygonMode
+	traceBufferSubData
+	traceCopyBufferSubData
+	traceInvalidateBufferData
+	traceInvalidateBufferSubData
+	traceBufferStorage
+	traceVertexAttribDivisor
+
+	// traceOpCount isn't an op, it must stay last.
+	traceOpCount
+)
+
+// ErrTracing is returned by StartTrace when a trace is already being recorded.
+var ErrTracing = errors.New("gl: already tracing")
+
+// tracer is the Backend installed by StartTrace. It forwards every call to
+// the Backend that was in place and writes the ones that change the OpenGL
+// state to the trace. Queries are forwarded untouched by the embedded Backend.
+type tracer struct {
+	Backend
+	w *traceWriter
+
+	// mappings are the ranges mapped for writing since StartTrace, by target.
+	mappings map[uint32]traceMapping
+}
+
+// traceMapping is a buffer range mapped for writing. Mapping calls aren't
+// traced, the writes made through the mapping are written as BufferSubData
+// when they are flushed or unmapped.
+type traceMapping struct {
+	p              unsafe.Pointer
+	offset, length int
+	explicit       bool
+}
+
+// errTraceStopped is the error of the writer of a tracer that StopTrace
+// couldn't remove from the chain of Backends, it drops the calls still going
+// through it.
+var errTraceStopped = errors.New("gl: trace stopped")
+
+// traceLayer is the tracer installed by StartTrace, it may be below another
+// Backend.
+var traceLayer *tracer
+
+func (t *tracer) next() *Backend {
+	return &t.Backend
+}
+
+// StartTrace starts writing every call that changes the OpenGL state to w,
+// including the buffer, pixel and uniform data they carry, until StopTrace is
+// called. The trace can be fed to Replay to issue the same calls again.
+//
+// Objects created before StartTrace are referenced by their name as is, start
+// the trace right after Init to be able to replay it on a fresh context. The
+// trace belongs to the current context, InitBackend drops it without
+// flushing it.
+func StartTrace(w io.Writer) error {
+	if traceLayer != nil {
+		return ErrTracing
+	}
+	t := &tracer{Backend: backend, w: &traceWriter{w: bufio.NewWriter(w)}, mappings: map[uint32]traceMapping{}}
+	t.w.write(traceMagic[:])
+	if t.w.err != nil {
+		return t.w.err
+	}
+	traceLayer = t
+	backend = t
+	return nil
+}
+
+// StopTrace stops the trace started by StartTrace and flushes it. It returns
+// the first error encountered while writing the trace, if any. The tracer is
+// removed from the Backends even when others were installed over it, like
+// the state cache.
+func StopTrace() error {
+	t := traceLayer
+	if t == nil {
+		return nil
+	}
+	traceLayer = nil
+	unlinkLayer(t)
+	err := t.w.err
+	if err == nil {
+		err = t.w.w.Flush()
+	}
+	// A tracer still in the chain must not write anymore.
+	t.w.err = errTraceStopped
+	return err
+}
+
+// traceWriter encodes the trace. Integers are varints, floats their IEEE 754
+// bits and blobs are length prefixed raw memory. The first error is kept and
+// every following write is dropped.
+type traceWriter struct {
+	w   *bufio.Writer
+	buf [binary.MaxVarintLen64]byte
+	err error
+}
+
+func (w *traceWriter) write(b []byte) {
+	if w.err == nil {
+		_, w.err = w.w.Write(b)
+	}
+}
+
+func (w *traceWriter) op(op traceOp) *traceWriter {
+	return w.u32(uint32(op))
+}
+
+func (w *traceWriter) u32(v uint32) *traceWriter {
+	w.write(w.buf[:binary.PutUvarint(w.buf[:], uint64(v))])
+	return w
+}
+
+func (w *traceWriter) i32(v int32) *traceWriter {
+	return w.int(int(v))
+}
+
+func (w *traceWriter) int(v int) *traceWriter {
+	w.write(w.buf[:binary.PutVarint(w.buf[:], int64(v))])
+	return w
+}
+
+func (w *traceWriter) f32(v float32) *traceWriter {
+	binary.LittleEndian.PutUint32(w.buf[:4], math.Float32bits(v))
+	w.write(w.buf[:4])
+	return w
+}
+
+func (w *traceWriter) bool(v bool) *traceWriter {
+	if v {
+		w.buf[0] = 1
+	} else {
+		w.buf[0] = 0
+	}
+	w.write(w.buf[:1])
+	return w
+}
+
+// blob writes size bytes starting at p. A nil p is written as a zero length
+// prefix, any other blob as its length plus one.
+func (w *traceWriter) blob(p unsafe.Pointer, size int) *traceWriter {
+	if p == nil {
+		return w.u32(0)
+	}
+	w.u32(uint32(size) + 1)
+	w.write(unsafe.Slice((*byte)(p), size))
+	return w
+}
+
+func (w *traceWriter) str(s string) *traceWriter {
+	w.u32(uint32(len(s)))
+	w.write([]byte(s))
+	return w
+}
+
+// offset writes a pointer that is an offset in a buffer object.
+func (w *traceWriter) offset(p unsafe.Pointer) *traceWriter {
+	w.write(w.buf[:binary.PutUvarint(w.buf[:], uint64(uintptr(p)))])
+	return w
+}
+
+// names writes n object names starting at p.
+func (w *traceWriter) names(n int32, p *uint32) *traceWriter {
+	w.i32(n)
+	if n > 0 {
+		for _, name := range unsafe.Slice(p, n) {
+			w.u32(name)
+		}
+	}
+	return w
+}
+
+// pixels writes the pixels of a texture image. When a pixel unpack buffer is
+// bound pixels is an offset in it, otherwise the client memory it points to
+// is sized from the current unpack alignment and row length.
+func (t *tracer) pixels(width, height, depth int32, format, xtype uint32, pixels unsafe.Pointer) {
+	var unpack int32
+	t.Backend.GetIntegerv(PIXEL_UNPACK_BUFFER_BINDING, &unpack)
+	if unpack != 0 {
+		t.w.bool(true).offset(pixels)
+		return
+	}
+	var alignment, rowLength, imageHeight int32
+	t.Backend.GetIntegerv(UNPACK_ALIGNMENT, &alignment)
+	t.Backend.GetIntegerv(UNPACK_ROW_LENGTH, &rowLength)
+	t.Backend.GetIntegerv(UNPACK_IMAGE_HEIGHT, &imageHeight)
+	t.w.bool(false).blob(pixels, imageSize(width, height, depth, format, xtype, alignment, rowLength, imageHeight))
+}
+
+// imageSize returns the number of bytes of client memory read by a texture
+// upload of the given dimensions under the given unpack state.
+func imageSize(width, height, depth int32, format, xtype uint32, alignment, rowLength, imageHeight int32) int {
+	if width <= 0 || height <= 0 || depth <= 0 {
+		return 0
+	}
+	pixel := pixelSize(format, xtype)
+	if rowLength <= 0 {
+		rowLength = width
+	}
+	if imageHeight <= 0 {
+		imageHeight = height
+	}
+	if alignment <= 0 {
+		alignment = 1
+	}
+	row := (int(rowLength)*pixel + int(alignment) - 1) / int(alignment) * int(alignment)
+	image := row * int(imageHeight)
+	return image*int(depth-1) + row*int(height-1) + int(width)*pixel
+}
+
+// pixelSize returns the size in bytes of a single pixel of the given format
+// and type.
+func pixelSize(format, xtype uint32) int {
+	switch PixelType(xtype) {
+	case UNSIGNED_BYTE_3_3_2, UNSIGNED_BYTE_2_3_3_REV:
+		return 1
+	case UNSIGNED_SHORT_5_6_5, UNSIGNED_SHORT_5_6_5_REV, UNSIGNED_SHORT_4_4_4_4,
+		UNSIGNED_SHORT_4_4_4_4_REV, UNSIGNED_SHORT_5_5_5_1, UNSIGNED_SHORT_1_5_5_5_REV:
+		return 2
+	case UNSIGNED_INT_8_8_8_8, UNSIGNED_INT_8_8_8_8_REV, UNSIGNED_INT_10_10_10_2,
+		UNSIGNED_INT_2_10_10_10_REV, UNSIGNED_INT_24_8, UNSIGNED_INT_10F_11F_11F_REV,
+		UNSIGNED_INT_5_9_9_9_REV:
+		return 4
+	case FLOAT_32_UNSIGNED_INT_24_8_REV:
+		return 8
+	}
+	var size int
+	switch xtype {
+	case UNSIGNED_BYTE, BYTE:
+		size = 1
+	case UNSIGNED_SHORT, SHORT, HALF_FLOAT:
+		size = 2
+	default:
+		size = 4
+	}
+	switch PixelFormat(format) {
+	case RG, RG_INTEGER, DEPTH_STENCIL:
+		return 2 * size
+	case RGB, BGR, RGB_INTEGER, BGR_INTEGER:
+		return 3 * size
+	case RGBA, BGRA, RGBA_INTEGER, BGRA_INTEGER:
+		return 4 * size
+	default:
+		return size
+	}
+}
+
+// texParameterCount returns the number of values read by glTexParameter*v for
+// pname.
+func texParameterCount(pname uint32) int {
+	switch TextureParameter(pname) {
+	case TEXTURE_BORDER_COLOR, TEXTURE_SWIZZLE_RGBA:
+		return 4
+	default:
+		return 1
+	}
+}
+
+func (t *tracer) BufferData(target uint32, size int, data unsafe.Pointer, usage uint32) {
+	t.Backend.BufferData(target, size, data, usage)
+	t.w.op(traceBufferData).u32(target).int(size).blob(data, size).u32(usage)
+}
+
+func (t *tracer) CreateProgram() uint32 {
+	p := t.Backend.CreateProgram()
+	t.w.op(traceCreateProgram).u32(p)
+	return p
+}
+
+func (t *tracer) CreateShader(xtype uint32) uint32 {
+	s := t.Backend.CreateShader(xtype)
+	t.w.op(traceCreateShader).u32(xtype).u32(s)
+	return s
+}
+
+func (t *tracer) DeleteBuffers(n int32, buffers *uint32) {
+	t.w.op(traceDeleteBuffers).names(n, buffers)
+	t.Backend.DeleteBuffers(n, buffers)
+}
+
+func (t *tracer) DeleteFramebuffers(n int32, framebuffers *uint32) {
+	t.w.op(traceDeleteFramebuffers).names(n, framebuffers)
+	t.Backend.DeleteFramebuffers(n, framebuffers)
+}
+
+func (t *tracer) DeleteRenderbuffers(n int32, renderbuffers *uint32) {
+	t.w.op(traceDeleteRenderbuffers).names(n, renderbuffers)
+	t.Backend.DeleteRenderbuffers(n, renderbuffers)
+}
+
+func (t *tracer) DeleteTextures(n int32, textures *uint32) {
+	t.w.op(traceDeleteTextures).names(n, textures)
+	t.Backend.DeleteTextures(n, textures)
+}
+
+func (t *tracer) DeleteTransformFeedbacks(n int32, ids *uint32) {
+	t.w.op(traceDeleteTransformFeedbacks).names(n, ids)
+	t.Backend.DeleteTransformFeedbacks(n, ids)
+}
+
+func (t *tracer) DeleteVertexArrays(n int32, arrays *uint32) {
+	t.w.op(traceDeleteVertexArrays).names(n, arrays)
+	t.Backend.DeleteVertexArrays(n, arrays)
+}
+
+func (t *tracer) DrawBuffers(n int32, bufs *uint32) {
+	t.Backend.DrawBuffers(n, bufs)
+	t.w.op(traceDrawBuffers).i32(n).blob(unsafe.Pointer(bufs), int(n)*4)
+}
+
+func (t *tracer) GenBuffers(n int32, buffers *uint32) {
+	t.Backend.GenBuffers(n, buffers)
+	t.w.op(traceGenBuffers).names(n, buffers)
+}
+
+func (t *tracer) GenFramebuffers(n int32, framebuffers *uint32) {
+	t.Backend.GenFramebuffers(n, framebuffers)
+	t.w.op(traceGenFramebuffers).names(n, framebuffers)
+}
+
+func (t *tracer) GenRenderbuffers(n int32, renderbuffers *uint32) {
+	t.Backend.GenRenderbuffers(n, renderbuffers)
+	t.w.op(traceGenRenderbuffers).names(n, renderbuffers)
+}
+
+func (t *tracer) GenTextures(n int32, textures *uint32) {
+	t.Backend.GenTextures(n, textures)
+	t.w.op(traceGenTextures).names(n, textures)
+}
+
+func (t *tracer) GenTransformFeedbacks(n int32, ids *uint32) {
+	t.Backend.GenTransformFeedbacks(n, ids)
+	t.w.op(traceGenTransformFeedbacks).names(n, ids)
+}
+
+func (t *tracer) GenVertexArrays(n int32, arrays *uint32) {
+	t.Backend.GenVertexArrays(n, arrays)
+	t.w.op(traceGenVertexArrays).names(n, arrays)
+}
+
+// GetUniformLocation is traced so Replay can map the recorded locations to the
+// ones of the replaying context.
+func (t *tracer) GetUniformLocation(program uint32, name *uint8) int32 {
+	loc := t.Backend.GetUniformLocation(program, name)
+	t.w.op(traceGetUniformLocation).u32(program).str(glGoStr(name)).i32(loc)
+	return loc
+}
+
+func (t *tracer) MapBufferRange(target uint32, offset, length int, access uint32) unsafe.Pointer {
+	p := t.Backend.MapBufferRange(target, offset, length, access)
+	if p != nil && access&MAP_WRITE_BIT != 0 {
+		t.mappings[target] = traceMapping{p: p, offset: offset, length: length, explicit: access&MAP_FLUSH_EXPLICIT_BIT != 0}
+	}
+	return p
+}
+
+// FlushMappedBufferRange writes the flushed range, offset is relative to the
+// start of the mapping.
+func (t *tracer) FlushMappedBufferRange(target uint32, offset, length int) {
+	if m, ok := t.mappings[target]; ok && offset >= 0 && length >= 0 && offset+length <= m.length {
+		t.w.op(traceBufferSubData).u32(target).int(m.offset+offset).int(length).blob(unsafe.Add(m.p, offset), length)
+	}
+	t.Backend.FlushMappedBufferRange(target, offset, length)
+}
+
+// UnmapBuffer writes the whole mapped range while it is still readable,
+// unless it was mapped with gl.MAP_FLUSH_EXPLICIT_BIT: then only the flushed
+// ranges were written.
+func (t *tracer) UnmapBuffer(target uint32) bool {
+	if m, ok := t.mappings[target]; ok {
+		if !m.explicit {
+			t.w.op(traceBufferSubData).u32(target).int(m.offset).int(m.length).blob(m.p, m.length)
+		}
+		delete(t.mappings, target)
+	}
+	return t.Backend.UnmapBuffer(target)
+}
+
+func (t *tracer) ShaderSource(shader uint32, count int32, xstring **uint8, length *int32) {
+	t.Backend.ShaderSource(shader, count, xstring, length)
+	t.w.op(traceShaderSource).u32(shader).i32(count)
+	if count <= 0 {
+		return
+	}
+	strs := unsafe.Slice(xstring, count)
+	for i, s := range strs {
+		if length != nil && unsafe.Slice(length, count)[i] >= 0 {
+			t.w.str(string(unsafe.Slice(s, unsafe.Slice(length, count)[i])))
+		} else {
+			t.w.str(glGoStr(s))
+		}
+	}
+}
+
+func (t *tracer) TexImage2D(target uint32, level, internalformat, width, height, border int32, format, xtype uint32, pixels unsafe.Pointer) {
+	t.Backend.TexImage2D(target, level, internalformat, width, height, border, format, xtype, pixels)
+	t.w.op(traceTexImage2D).u32(target).i32(level).i32(internalformat).i32(width).i32(height).i32(border).u32(format).u32(xtype)
+	t.pixels(width, height, 1, format, xtype, pixels)
+}
+
+func (t *tracer) TexImage3D(target uint32, level, internalformat, width, height, depth, border int32, format, xtype uint32, pixels unsafe.Pointer) {
+	t.Backend.TexImage3D(target, level, internalformat, width, height, depth, border, format, xtype, pixels)
+	t.w.op(traceTexImage3D).u32(target).i32(level).i32(internalformat).i32(width).i32(height).i32(depth).i32(border).u32(format).u32(xtype)
+	t.pixels(width, height, depth, format, xtype, pixels)
+}
+
+func (t *tracer) TexParameterfv(target, pname uint32, params *float32) {
+	t.Backend.TexParameterfv(target, pname, params)
+	t.w.op(traceTexParameterfv).u32(target).u32(pname).blob(unsafe.Pointer(params), texParameterCount(pname)*4)
+}
+
+func (t *tracer) TexParameteriv(target, pname uint32, params *int32) {
+	t.Backend.TexParameteriv(target, pname, params)
+	t.w.op(traceTexParameteriv).u32(target).u32(pname).blob(unsafe.Pointer(params), texParameterCount(pname)*4)
+}
+
+func (t *tracer) UseProgram(program uint32) {
+	t.Backend.UseProgram(program)
+	t.w.op(traceUseProgram).u32(program)
+}
+
+func (t *tracer) VertexAttribIPointer(index uint32, size int32, xtype uint32, stride int32, pointer unsafe.Pointer) {
+	t.Backend.VertexAttribIPointer(index, size, xtype, stride, pointer)
+	t.w.op(traceVertexAttribIPointer).u32(index).i32(size).u32(xtype).i32(stride).offset(pointer)
+}
+
+func (t *tracer) VertexAttribPointer(index uint32, size int32, xtype uint32, normalized bool, stride int32, pointer unsafe.Pointer) {
+	t.Backend.VertexAttribPointer(index, size, xtype, normalized, stride, pointer)
+	t.w.op(traceVertexAttribPointer).u32(index).i32(size).u32(xtype).bool(normalized).i32(stride).offset(pointer)
+}
+
+func (t *tracer) ActiveTexture(texture uint32) {
+	t.Backend.ActiveTexture(texture)
+	t.w.op(traceActiveTexture).u32(texture)
+}
+
+func (t *tracer) AttachShader(program, shader uint32) {
+	t.Backend.AttachShader(program, shader)
+	t.w.op(traceAttachShader).u32(program).u32(shader)
+}
+
+func (t *tracer) BeginTransformFeedback(primitiveMode uint32) {
+	t.Backend.BeginTransformFeedback(primitiveMode)
+	t.w.op(traceBeginTransformFeedback).u32(primitiveMode)
+}
+
+func (t *tracer) BindBuffer(target, buffer uint32) {
+	t.Backend.BindBuffer(target, buffer)
+	t.w.op(traceBindBuffer).u32(target).u32(buffer)
+}
+
+func (t *tracer) BindBufferBase(target, index, buffer uint32) {
+	t.Backend.BindBufferBase(target, index, buffer)
+	t.w.op(traceBindBufferBase).u32(target).u32(index).u32(buffer)
+}
+
+func (t *tracer) BindBufferRange(target, index, buffer uint32, offset, size int) {
+	t.Backend.BindBufferRange(target, index, buffer, offset, size)
+	t.w.op(traceBindBufferRange).u32(target).u32(index).u32(buffer).int(offset).int(size)
+}
+
+func (t *tracer) BindFramebuffer(target, framebuffer uint32) {
+	t.Backend.BindFramebuffer(target, framebuffer)
+	t.w.op(traceBindFramebuffer).u32(target).u32(framebuffer)
+}
+
+func (t *tracer) BindRenderbuffer(target, renderbuffer uint32) {
+	t.Backend.BindRenderbuffer(target, renderbuffer)
+	t.w.op(traceBindRenderbuffer).u32(target).u32(renderbuffer)
+}
+
+func (t *tracer) BindTexture(target, texture uint32) {
+	t.Backend.BindTexture(target, texture)
+	t.w.op(traceBindTexture).u32(target).u32(texture)
+}
+
+func (t *tracer) BindTransformFeedback(target, id uint32) {
+	t.Backend.BindTransformFeedback(target, id)
+	t.w.op(traceBindTransformFeedback).u32(target).u32(id)
+}
+
+func (t *tracer) BindVertexArray(array uint32) {
+	t.Backend.BindVertexArray(array)
+	t.w.op(traceBindVertexArray).u32(array)
+}
+
+func (t *tracer) BlendColor(red, green, blue, alpha float32) {
+	t.Backend.BlendColor(red, green, blue, alpha)
+	t.w.op(traceBlendColor).f32(red).f32(green).f32(blue).f32(alpha)
+}
+
+func (t *tracer) BlendEquationSeparate(modeRGB, modeAlpha uint32) {
+	t.Backend.BlendEquationSeparate(modeRGB, modeAlpha)
+	t.w.op(traceBlendEquationSeparate).u32(modeRGB).u32(modeAlpha)
+}
+
+func (t *tracer) BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha uint32) {
+	t.Backend.BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha)
+	t.w.op(traceBlendFuncSeparate).u32(srcRGB).u32(dstRGB).u32(srcAlpha).u32(dstAlpha)
+}
+
+func (t *tracer) BufferSubData(target uint32, offset, size int, data unsafe.Pointer) {
+	t.Backend.BufferSubData(target, offset, size, data)
+	t.w.op(traceBufferSubData).u32(target).int(offset).int(size).blob(data, size)
+}
+
+func (t *tracer) ClearColor(red, green, blue, alpha float32) {
+	t.Backend.ClearColor(red, green, blue, alpha)
+	t.w.op(traceClearColor).f32(red).f32(green).f32(blue).f32(alpha)
+}
+
+func (t *tracer) ClearDepthf(d float32) {
+	t.Backend.ClearDepthf(d)
+	t.w.op(traceClearDepthf).f32(d)
+}
+
+func (t *tracer) ClearStencil(s int32) {
+	t.Backend.ClearStencil(s)
+	t.w.op(traceClearStencil).i32(s)
+}
+
+func (t *tracer) ColorMask(red, green, blue, alpha bool) {
+	t.Backend.ColorMask(red, green, blue, alpha)
+	t.w.op(traceColorMask).bool(red).bool(green).bool(blue).bool(alpha)
+}
+
+func (t *tracer) CompileShader(shader uint32) {
+	t.Backend.CompileShader(shader)
+	t.w.op(traceCompileShader).u32(shader)
+}
+
+func (t *tracer) CopyBufferSubData(readTarget, writeTarget uint32, readOffset, writeOffset, size int) {
+	t.Backend.CopyBufferSubData(readTarget, writeTarget, readOffset, writeOffset, size)
+	t.w.op(traceCopyBufferSubData).u32(readTarget).u32(writeTarget).int(readOffset).int(writeOffset).int(size)
+}
+
+func (t *tracer) CopyTexImage2D(target uint32, level int32, internalformat uint32, x, y, width, height, border int32) {
+	t.Backend.CopyTexImage2D(target, level, internalformat, x, y, width, height, border)
+	t.w.op(traceCopyTexImage2D).u32(target).i32(level).u32(internalformat).i32(x).i32(y).i32(width).i32(height).i32(border)
+}
+
+func (t *tracer) CullFace(mode uint32) {
+	t.Backend.CullFace(mode)
+	t.w.op(traceCullFace).u32(mode)
+}
+
+func (t *tracer) DeleteProgram(program uint32) {
+	t.Backend.DeleteProgram(program)
+	t.w.op(traceDeleteProgram).u32(program)
+}
+
+func (t *tracer) DeleteShader(shader uint32) {
+	t.Backend.DeleteShader(shader)
+	t.w.op(traceDeleteShader).u32(shader)
+}
+
+func (t *tracer) DepthFunc(xfunc uint32) {
+	t.Backend.DepthFunc(xfunc)
+	t.w.op(traceDepthFunc).u32(xfunc)
+}
+
+func (t *tracer) DepthMask(flag bool) {
+	t.Backend.DepthMask(flag)
+	t.w.op(traceDepthMask).bool(flag)
+}
+
+func (t *tracer) DepthRangef(near, far float32) {
+	t.Backend.DepthRangef(near, far)
+	t.w.op(traceDepthRangef).f32(near).f32(far)
+}
+
+func (t *tracer) Disable(cap uint32) {
+	t.Backend.Disable(cap)
+	t.w.op(traceDisable).u32(cap)
+}
+
+func (t *tracer) DisableVertexAttribArray(index uint32) {
+	t.Backend.DisableVertexAttribArray(index)
+	t.w.op(traceDisableVertexAttribArray).u32(index)
+}
+
+func (t *tracer) Enable(cap uint32) {
+	t.Backend.Enable(cap)
+	t.w.op(traceEnable).u32(cap)
+}
+
+func (t *tracer) EnableVertexAttribArray(index uint32) {
+	t.Backend.EnableVertexAttribArray(index)
+	t.w.op(traceEnableVertexAttribArray).u32(index)
+}
+
+func (t *tracer) EndTransformFeedback() {
+	t.Backend.EndTransformFeedback()
+	t.w.op(traceEndTransformFeedback)
+}
+
+func (t *tracer) FramebufferRenderbuffer(target, attachment, renderbuffertarget, renderbuffer uint32) {
+	t.Backend.FramebufferRenderbuffer(target, attachment, renderbuffertarget, renderbuffer)
+	t.w.op(traceFramebufferRenderbuffer).u32(target).u32(attachment).u32(renderbuffertarget).u32(renderbuffer)
+}
+
+func (t *tracer) FrontFace(mode uint32) {
+	t.Backend.FrontFace(mode)
+	t.w.op(traceFrontFace).u32(mode)
+}
+
+func (t *tracer) LineWidth(width float32) {
+	t.Backend.LineWidth(width)
+	t.w.op(traceLineWidth).f32(width)
+}
+
+func (t *tracer) LinkProgram(program uint32) {
+	t.Backend.LinkProgram(program)
+	t.w.op(traceLinkProgram).u32(program)
+}
+
+func (t *tracer) PauseTransformFeedback() {
+	t.Backend.PauseTransformFeedback()
+	t.w.op(tracePauseTransformFeedback)
+}
+
+func (t *tracer) PixelStorei(pname uint32, param int32) {
+	t.Backend.PixelStorei(pname, param)
+	t.w.op(tracePixelStorei).u32(pname).i32(param)
+}
+
+func (t *tracer) PolygonOffset(factor, units float32) {
+	t.Backend.PolygonOffset(factor, units)
+	t.w.op(tracePolygonOffset).f32(factor).f32(units)
+}
+
+func (t *tracer) ReadBuffer(src uint32) {
+	t.Backend.ReadBuffer(src)
+	t.w.op(traceReadBuffer).u32(src)
+}
+
+func (t *tracer) RenderbufferStorage(target, internalformat uint32, width, height int32) {
+	t.Backend.RenderbufferStorage(target, internalformat, width, height)
+	t.w.op(traceRenderbufferStorage).u32(target).u32(internalformat).i32(width).i32(height)
+}
+
+func (t *tracer) ResumeTransformFeedback() {
+	t.Backend.ResumeTransformFeedback()
+	t.w.op(traceResumeTransformFeedback)
+}
+
+func (t *tracer) SampleCoverage(value float32, invert bool) {
+	t.Backend.SampleCoverage(value, invert)
+	t.w.op(traceSampleCoverage).f32(value).bool(invert)
+}
+
+func (t *tracer) Scissor(x, y, width, height int32) {
+	t.Backend.Scissor(x, y, width, height)
+	t.w.op(traceScissor).i32(x).i32(y).i32(width).i32(height)
+}
+
+func (t *tracer) StencilFunc(xfunc uint32, ref int32, mask uint32) {
+	t.Backend.StencilFunc(xfunc, ref, mask)
+	t.w.op(traceStencilFunc).u32(xfunc).i32(ref).u32(mask)
+}
+
+func (t *tracer) StencilFuncSeparate(face, xfunc uint32, ref int32, mask uint32) {
+	t.Backend.StencilFuncSeparate(face, xfunc, ref, mask)
+	t.w.op(traceStencilFuncSeparate).u32(face).u32(xfunc).i32(ref).u32(mask)
+}
+
+func (t *tracer) StencilMask(mask uint32) {
+	t.Backend.StencilMask(mask)
+	t.w.op(traceStencilMask).u32(mask)
+}
+
+func (t *tracer) StencilMaskSeparate(face, mask uint32) {
+	t.Backend.StencilMaskSeparate(face, mask)
+	t.w.op(traceStencilMaskSeparate).u32(face).u32(mask)
+}
+
+func (t *tracer) StencilOp(fail, zfail, zpass uint32) {
+	t.Backend.StencilOp(fail, zfail, zpass)
+	t.w.op(traceStencilOp).u32(fail).u32(zfail).u32(zpass)
+}
+
+func (t *tracer) StencilOpSeparate(face, sfail, dpfail, dppass uint32) {
+	t.Backend.StencilOpSeparate(face, sfail, dpfail, dppass)
+	t.w.op(traceStencilOpSeparate).u32(face).u32(sfail).u32(dpfail).u32(dppass)
+}
+
+func (t *tracer) TexParameterf(target, pname uint32, param float32) {
+	t.Backend.TexParameterf(target, pname, param)
+	t.w.op(traceTexParameterf).u32(target).u32(pname).f32(param)
+}
+
+func (t *tracer) TexParameteri(target, pname uint32, param int32) {
+	t.Backend.TexParameteri(target, pname, param)
+	t.w.op(traceTexParameteri).u32(target).u32(pname).i32(param)
+}
+
+func (t *tracer) Uniform1f(location int32, v0 float32) {
+	t.Backend.Uniform1f(location, v0)
+	t.w.op(traceUniform1f).i32(location).f32(v0)
+}
+
+func (t *tracer) Uniform1i(location, v0 int32) {
+	t.Backend.Uniform1i(location, v0)
+	t.w.op(traceUniform1i).i32(location).i32(v0)
+}
+
+func (t *tracer) Uniform1ui(location int32, v0 uint32) {
+	t.Backend.Uniform1ui(location, v0)
+	t.w.op(traceUniform1ui).i32(location).u32(v0)
+}
+
+func (t *tracer) Uniform2f(location int32, v0, v1 float32) {
+	t.Backend.Uniform2f(location, v0, v1)
+	t.w.op(traceUniform2f).i32(location).f32(v0).f32(v1)
+}
+
+func (t *tracer) Uniform2i(location, v0, v1 int32) {
+	t.Backend.Uniform2i(location, v0, v1)
+	t.w.op(traceUniform2i).i32(location).i32(v0).i32(v1)
+}
+
+func (t *tracer) Uniform2ui(location int32, v0, v1 uint32) {
+	t.Backend.Uniform2ui(location, v0, v1)
+	t.w.op(traceUniform2ui).i32(location).u32(v0).u32(v1)
+}
+
+func (t *tracer) Uniform3f(location int32, v0, v1, v2 float32) {
+	t.Backend.Uniform3f(location, v0, v1, v2)
+	t.w.op(traceUniform3f).i32(location).f32(v0).f32(v1).f32(v2)
+}
+
+func (t *tracer) Uniform3i(location, v0, v1, v2 int32) {
+	t.Backend.Uniform3i(location, v0, v1, v2)
+	t.w.op(traceUniform3i).i32(location).i32(v0).i32(v1).i32(v2)
+}
+
+func (t *tracer) Uniform3ui(location int32, v0, v1, v2 uint32) {
+	t.Backend.Uniform3ui(location, v0, v1, v2)
+	t.w.op(traceUniform3ui).i32(location).u32(v0).u32(v1).u32(v2)
+}
+
+func (t *tracer) Uniform4f(location int32, v0, v1, v2, v3 float32) {
+	t.Backend.Uniform4f(location, v0, v1, v2, v3)
+	t.w.op(traceUniform4f).i32(location).f32(v0).f32(v1).f32(v2).f32(v3)
+}
+
+func (t *tracer) Uniform4i(location, v0, v1, v2, v3 int32) {
+	t.Backend.Uniform4i(location, v0, v1, v2, v3)
+	t.w.op(traceUniform4i).i32(location).i32(v0).i32(v1).i32(v2).i32(v3)
+}
+
+func (t *tracer) Uniform4ui(location int32, v0, v1, v2, v3 uint32) {
+	t.Backend.Uniform4ui(location, v0, v1, v2, v3)
+	t.w.op(traceUniform4ui).i32(location).u32(v0).u32(v1).u32(v2).u32(v3)
+}
+
+func (t *tracer) VertexAttribDivisor(index, divisor uint32) {
+	t.Backend.VertexAttribDivisor(index, divisor)
+	t.w.op(traceVertexAttribDivisor).u32(index).u32(divisor)
+}
+
+func (t *tracer) Viewport(x, y, width, height int32) {
+	t.Backend.Viewport(x, y, width, height)
+	t.w.op(traceViewport).i32(x).i32(y).i32(width).i32(height)
+}
+
+func (t *tracer) Uniform1fv(location, count int32, value *float32) {
+	t.Backend.Uniform1fv(location, count, value)
+	t.w.op(traceUniform1fv).i32(location).i32(count).blob(unsafe.Pointer(value), int(count)*1*4)
+}
+
+func (t *tracer) Uniform1iv(location, count int32, value *int32) {
+	t.Backend.Uniform1iv(location, count, value)
+	t.w.op(traceUniform1iv).i32(location).i32(count).blob(unsafe.Pointer(value), int(count)*1*4)
+}
+
+func (t *tracer) Uniform1uiv(location, count int32, value *uint32) {
+	t.Backend.Uniform1uiv(location, count, value)
+	t.w.op(traceUniform1uiv).i32(location).i32(count).blob(unsafe.Pointer(value), int(count)*1*4)
+}
+
+func (t *tracer) Uniform2fv(location, count int32, value *float32) {
+	t.Backend.Uniform2fv(location, count, value)
+	t.w.op(traceUniform2fv).i32(location).i32(count).blob(unsafe.Pointer(value), int(count)*2*4)
+}
+
+func (t *tracer) Uniform2iv(location, count int32, value *int32) {
+	t.Backend.Uniform2iv(location, count, value)
+	t.w.op(traceUniform2iv).i32(location).i32(count).blob(unsafe.Pointer(value), int(count)*2*4)
+}
+
+func (t *tracer) Uniform2uiv(location, count int32, value *uint32) {
+	t.Backend.Uniform2uiv(location, count, value)
+	t.w.op(traceUniform2uiv).i32(location).i32(count).blob(unsafe.Pointer(value), int(count)*2*4)
+}
+
+func (t *tracer) Uniform3fv(location, count int32, value *float32) {
+	t.Backend.Uniform3fv(location, count, value)
+	t.w.op(traceUniform3fv).i32(location).i32(count).blob(unsafe.Pointer(value), int(count)*3*4)
+}
+
+func (t *tracer) Uniform3iv(location, count int32, value *int32) {
+	t.Backend.Uniform3iv(location, count, value)
+	t.w.op(traceUniform3iv).i32(location).i32(count).blob(unsafe.Pointer(value), int(count)*3*4)
+}
+
+func (t *tracer) Uniform3uiv(location, count int32, value *uint32) {
+	t.Backend.Uniform3uiv(location, count, value)
+	t.w.op(traceUniform3uiv).i32(location).i32(count).blob(unsafe.Pointer(value), int(count)*3*4)
+}
+
+func (t *tracer) Uniform4fv(location, count int32, value *float32) {
+	t.Backend.Uniform4fv(location, count, value)
+	t.w.op(traceUniform4fv).i32(location).i32(count).blob(unsafe.Pointer(value), int(count)*4*4)
+}
+
+func (t *tracer) Uniform4iv(location, count int32, value *int32) {
+	t.Backend.Uniform4iv(location, count, value)
+	t.w.op(traceUniform4iv).i32(location).i32(count).blob(unsafe.Pointer(value), int(count)*4*4)
+}
+
+func (t *tracer) Uniform4uiv(location, count int32, value *uint32) {
+	t.Backend.Uniform4uiv(location, count, value)
+	t.w.op(traceUniform4uiv).i32(location).i32(count).blob(unsafe.Pointer(value), int(count)*4*4)
+}
+
+func (t *tracer) UniformMatrix2fv(location, count int32, transpose bool, value *float32) {
+	t.Backend.UniformMatrix2fv(location, count, transpose, value)
+	t.w.op(traceUniformMatrix2fv).i32(location).i32(count).bool(transpose).blob(unsafe.Pointer(value), int(count)*4*4)
+}
+
+func (t *tracer) UniformMatrix2x3fv(location, count int32, transpose bool, value *float32) {
+	t.Backend.UniformMatrix2x3fv(location, count, transpose, value)
+	t.w.op(traceUniformMatrix2x3fv).i32(location).i32(count).bool(transpose).blob(unsafe.Pointer(value), int(count)*6*4)
+}
+
+func (t *tracer) UniformMatrix2x4fv(location, count int32, transpose bool, value *float32) {
+	t.Backend.UniformMatrix2x4fv(location, count, transpose, value)
+	t.w.op(traceUniformMatrix2x4fv).i32(location).i32(count).bool(transpose).blob(unsafe.Pointer(value), int(count)*8*4)
+}
+
+func (t *tracer) UniformMatrix3fv(location, count int32, transpose bool, value *float32) {
+	t.Backend.UniformMatrix3fv(location, count, transpose, value)
+	t.w.op(traceUniformMatrix3fv).i32(location).i32(count).bool(transpose).blob(unsafe.Pointer(value), int(count)*9*4)
+}
+
+func (t *tracer) UniformMatrix3x2fv(location, count int32, transpose bool, value *float32) {
+	t.Backend.UniformMatrix3x2fv(location, count, transpose, value)
+	t.w.op(traceUniformMatrix3x2fv).i32(location).i32(count).bool(transpose).blob(unsafe.Pointer(value), int(count)*6*4)
+}
+
+func (t *tracer) UniformMatrix3x4fv(location, count int32, transpose bool, value *float32) {
+	t.Backend.UniformMatrix3x4fv(location, count, transpose, value)
+	t.w.op(traceUniformMatrix3x4fv).i32(location).i32(count).bool(transpose).blob(unsafe.Pointer(value), int(count)*12*4)
+}
+
+func (t *tracer) UniformMatrix4fv(location, count int32, transpose bool, value *float32) {
+	t.Backend.UniformMatrix4fv(location, count, transpose, value)
+	t.w.op(traceUniformMatrix4fv).i32(location).i32(count).bool(transpose).blob(unsafe.Pointer(value), int(count)*16*4)
+}
+
+func (t *tracer) UniformMatrix4x2fv(location, count int32, transpose bool, value *float32) {
+	t.Backend.UniformMatrix4x2fv(location, count, transpose, value)
+	t.w.op(traceUniformMatrix4x2fv).i32(location).i32(count).bool(transpose).blob(unsafe.Pointer(value), int(count)*8*4)
+}
+
+func (t *tracer) UniformMatrix4x3fv(location, count int32, transpose bool, value *float32) {
+	t.Backend.UniformMatrix4x3fv(location, count, transpose, value)
+	t.w.op(traceUniformMatrix4x3fv).i32(location).i32(count).bool(transpose).blob(unsafe.Pointer(value), int(count)*12*4)
+}
@@ -0,0 +1,46 @@
+//go:build !gles30
+
+package gl
+
+import "unsafe"
+
+func (t *tracer) BindFragDataLocation(program, color uint32, name *uint8) {
+	t.Backend.BindFragDataLocation(program, color, name)
+	t.w.op(traceBindFragDataLocation).u32(program).u32(color).str(glGoStr(name))
+}
+
+func (t *tracer) TexImage1D(target uint32, level, internalformat, width, border int32, format, xtype uint32, pixels unsafe.Pointer) {
+	t.Backend.TexImage1D(target, level, internalformat, width, border, format, xtype, pixels)
+	t.w.op(traceTexImage1D).u32(target).i32(level).i32(internalformat).i32(width).i32(border).u32(format).u32(xtype)
+	t.pixels(width, 1, 1, format, xtype, pixels)
+}
+
+func (t *tracer) TexParameterIiv(target, pname uint32, params *int32) {
+	t.Backend.TexParameterIiv(target, pname, params)
+	t.w.op(traceTexParameterIiv).u32(target).u32(pname).blob(unsafe.Pointer(params), texParameterCount(pname)*4)
+}
+
+func (t *tracer) TexParameterIuiv(target, pname uint32, params *uint32) {
+	t.Backend.TexParameterIuiv(target, pname, params)
+	t.w.op(traceTexParameterIuiv).u32(target).u32(pname).blob(unsafe.Pointer(params), texParameterCount(pname)*4)
+}
+
+func (t *tracer) CopyTexImage1D(target uint32, level int32, internalformat uint32, x, y, width, border int32) {
+	t.Backend.CopyTexImage1D(target, level, internalformat, x, y, width, border)
+	t.w.op(traceCopyTexImage1D).u32(target).i32(level).u32(internalformat).i32(x).i32(y).i32(width).i32(border)
+}
+
+func (t *tracer) DrawBuffer(buf uint32) {
+	t.Backend.DrawBuffer(buf)
+	t.w.op(traceDrawBuffer).u32(buf)
+}
+
+func (t *tracer) FramebufferTexture(target, attachment, texture uint32, level int32) {
+	t.Backend.FramebufferTexture(target, attachment, texture, level)
+	t.w.op(traceFramebufferTexture).u32(target).u32(attachment).u32(texture).i32(level)
+}
+
+func (t *tracer) PolygonMode(face, mode uint32) {
+	t.Backend.PolygonMode(face, mode)
+	t.w.op(tracePolygonMode).u32(face).u32(mode)
+}
@@ -0,0 +1,10 @@
+//go:build gl41 || gl45
+
+package gl
+
+import "unsafe"
+
+func (t *tracer) VertexAttribLPointer(index uint32, size int32, xtype uint32, stride int32, pointer unsafe.Pointer) {
+	t.Backend.VertexAttribLPointer(index, size, xtype, stride, pointer)
+	t.w.op(traceVertexAttribLPointer).u32(index).i32(size).u32(xtype).i32(stride).offset(pointer)
+}
@@ -0,0 +1,20 @@
+//go:build gl45
+
+package gl
+
+import "unsafe"
+
+func (t *tracer) InvalidateBufferData(buffer uint32) {
+	t.Backend.InvalidateBufferData(buffer)
+	t.w.op(traceInvalidateBufferData).u32(buffer)
+}
+
+func (t *tracer) InvalidateBufferSubData(buffer uint32, offset, length int) {
+	t.Backend.InvalidateBufferSubData(buffer, offset, length)
+	t.w.op(traceInvalidateBufferSubData).u32(buffer).int(offset).int(length)
+}
+
+func (t *tracer) BufferStorage(target uint32, size int, data unsafe.Pointer, flags uint32) {
+	t.Backend.BufferStorage(target, size, data, flags)
+	t.w.op(traceBufferStorage).u32(target).int(size).blob(data, size).u32(flags)
+}
@@ -0,0 +1,184 @@
+package gl
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"math"
+	"strings"
+	"testing"
+	"unsafe"
+)
+
+func TestTraceReplay(t *testing.T) {
+	data := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+	tests := []struct {
+		name string
+		do   func()
+		want []string
+	}{
+		{
+			name: "buffer",
+			do: func() {
+				b := GenBuffer()
+				b.Bind(ARRAY_BUFFER)
+				b.Data(ARRAY_BUFFER, len(data), unsafe.Pointer(&data[0]), STATIC_DRAW)
+				b.SubData(ARRAY_BUFFER, 2, 2, unsafe.Pointer(&data[0]))
+			},
+			want: []string{"GenBuffers", "BindBuffer", "BufferData", "BufferSubData"},
+		},
+		{
+			name: "state",
+			do: func() {
+				Enable(DEPTH_TEST)
+				Viewport.Set(0, 0, 640, 480)
+				ClearColor.Set(0, 0, 0, 1)
+			},
+			want: []string{"Enable", "Viewport", "ClearColor"},
+		},
+		{
+			name: "mapping",
+			do: func() {
+				b := GenBuffer()
+				b.Bind(ARRAY_BUFFER)
+				b.Data(ARRAY_BUFFER, 8, nil, DYNAMIC_DRAW)
+				m, err := b.MapRange(ARRAY_BUFFER, 0, 8, MAP_WRITE_BIT)
+				if err != nil {
+					t.Fatal(err)
+				}
+				copy(m.Bytes(), data)
+				m.Unmap()
+			},
+			want: []string{"GenBuffers", "BindBuffer", "BufferData", "BufferSubData"},
+		},
+		{
+			name: "queries",
+			do: func() {
+				Get.Viewport()
+				GetError()
+			},
+			want: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newFake(t)
+			var trace bytes.Buffer
+			if err := StartTrace(&trace); err != nil {
+				t.Fatal(err)
+			}
+			tt.do()
+			if err := StopTrace(); err != nil {
+				t.Fatal(err)
+			}
+			f := newFake(t)
+			f.Reset()
+			if err := Replay(&trace); err != nil {
+				t.Fatal(err)
+			}
+			if got := callNames(f); !equalStrings(got, tt.want) {
+				t.Errorf("replayed %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStopTraceBelowLayers(t *testing.T) {
+	layers := []struct {
+		name          string
+		install, stop func()
+	}{
+		{"state cache", func() { EnableStateCache() }, DisableStateCache},
+		{"thread check", func() { CheckThread(nil) }, StopCheckingThread},
+		{"object tracker", func() { TrackObjects(nil) }, StopTrackingObjects},
+	}
+	for _, l := range layers {
+		t.Run(l.name, func(t *testing.T) {
+			f := newFake(t)
+			var trace bytes.Buffer
+			if err := StartTrace(&trace); err != nil {
+				t.Fatal(err)
+			}
+			l.install()
+			defer l.stop()
+			Enable(BLEND)
+			if err := StartTrace(&trace); err != ErrTracing {
+				t.Errorf("second StartTrace() = %v, want ErrTracing", err)
+			}
+			if err := StopTrace(); err != nil {
+				t.Fatal(err)
+			}
+			if trace.Len() <= len(traceMagic) {
+				t.Fatalf("StopTrace() flushed %d bytes", trace.Len())
+			}
+			n := trace.Len()
+			Enable(DEPTH_TEST)
+			for b := backend; b != Backend(f); b = *b.(backendLayer).next() {
+				if _, ok := b.(*tracer); ok {
+					t.Fatalf("tracer still installed after StopTrace")
+				}
+			}
+			if trace.Len() != n {
+				t.Errorf("calls traced after StopTrace")
+			}
+			if err := StartTrace(&bytes.Buffer{}); err != nil {
+				t.Errorf("StartTrace() after StopTrace = %v", err)
+			}
+			StopTrace()
+		})
+	}
+}
+
+func TestReplayBadTrace(t *testing.T) {
+	newFake(t)
+	if err := Replay(bytes.NewReader([]byte("not a trace"))); err != ErrBadTrace {
+		t.Errorf("Replay() = %v, want ErrBadTrace", err)
+	}
+}
+
+func TestReplayProfileEntryPoints(t *testing.T) {
+	var trace bytes.Buffer
+	w := &traceWriter{w: bufio.NewWriter(&trace)}
+	w.write(traceMagic[:])
+	w.op(traceInvalidateBufferData).u32(0)
+	w.w.Flush()
+
+	f := newFake(t)
+	f.Reset()
+	err := Replay(&trace)
+	if Profile == "4.5-core" {
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkCalls(t, f, call("InvalidateBufferData", uint32(0)))
+		return
+	}
+	if err == nil || !strings.Contains(err.Error(), "glInvalidateBufferData isn't available") {
+		t.Errorf("Replay() = %v, want glInvalidateBufferData to be unavailable", err)
+	}
+}
+
+func TestReplayCorruptLength(t *testing.T) {
+	tests := []struct {
+		name  string
+		write func(w *traceWriter)
+	}{
+		{"blob", func(w *traceWriter) { w.op(traceBufferData).u32(uint32(ARRAY_BUFFER)).int(4).u32(math.MaxUint32) }},
+		{"string", func(w *traceWriter) { w.op(traceGetUniformLocation).u32(1).u32(math.MaxUint32) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var trace bytes.Buffer
+			w := &traceWriter{w: bufio.NewWriter(&trace)}
+			w.write(traceMagic[:])
+			tt.write(w)
+			w.write([]byte{1, 2, 3, 4})
+			w.w.Flush()
+
+			newFake(t)
+			if err := Replay(&trace); err != io.ErrUnexpectedEOF {
+				t.Errorf("Replay() = %v, want io.ErrUnexpectedEOF", err)
+			}
+		})
+	}
+}
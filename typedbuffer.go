@@ -0,0 +1,158 @@
+package gl
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"unsafe"
+)
+
+// TypedBuffer is a Buffer holding a sequence of T, like vertices or indices.
+// Its methods take and return []T and count in elements, they work on the
+// buffer bound to Target, bind it first.
+//
+// T must not contain Go pointers, strings, slices, maps, channels, functions
+// or interfaces: the garbage collector can't see them once they are copied
+// to OpenGL. A type set can't express that constraint without ruling out
+// the vertex structs, T is any instead and NewTypedBuffer, Upload, Update
+// and Read panic when T breaks it, so a TypedBuffer that wasn't made by
+// NewTypedBuffer is checked too.
+type TypedBuffer[T any] struct {
+	Buffer
+	Target BufferTarget
+
+	len int
+}
+
+// NewTypedBuffer generates a buffer used on target. It panics if T contains
+// Go pointers.
+func NewTypedBuffer[T any](target BufferTarget) *TypedBuffer[T] {
+	b := &TypedBuffer[T]{Target: target}
+	b.checkElem()
+	b.Buffer = GenBuffer()
+	return b
+}
+
+// bufferElementErrs caches checkBufferElement by type, the methods of
+// TypedBuffer check T on every call.
+var bufferElementErrs sync.Map // reflect.Type to error
+
+// checkElem panics if T contains Go pointers.
+func (b *TypedBuffer[T]) checkElem() {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	v, ok := bufferElementErrs.Load(t)
+	if !ok {
+		v, _ = bufferElementErrs.LoadOrStore(t, checkBufferElement(t))
+	}
+	if err, _ := v.(error); err != nil {
+		panic(err)
+	}
+}
+
+// checkBufferElement returns an error if values of t can't be copied to a
+// buffer as raw memory.
+func checkBufferElement(t reflect.Type) error {
+	if p := pointerField(t, t.String()); p != "" {
+		return fmt.Errorf("gl: buffer element type %s contains a Go pointer: %s", t, p)
+	}
+	return nil
+}
+
+// pointerField returns the path from t, named path, to its first value
+// holding a Go pointer along with the type of that value, "" if it has none.
+func pointerField(t reflect.Type, path string) string {
+	switch t.Kind() {
+	case reflect.Pointer, reflect.UnsafePointer, reflect.String, reflect.Slice, reflect.Map,
+		reflect.Chan, reflect.Func, reflect.Interface:
+		return path + " is a " + t.String()
+	case reflect.Array:
+		return pointerField(t.Elem(), path+"[i]")
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if p := pointerField(f.Type, path+"."+f.Name); p != "" {
+				return p
+			}
+		}
+	}
+	return ""
+}
+
+// elemSize is the size of a T in bytes, its stride in the buffer.
+func (b *TypedBuffer[T]) elemSize() int {
+	var v T
+	return int(unsafe.Sizeof(v))
+}
+
+// Len returns the number of elements of the buffer, as of the last Upload.
+func (b *TypedBuffer[T]) Len() int {
+	return b.len
+}
+
+// Size returns the size of the buffer in bytes.
+func (b *TypedBuffer[T]) Size() int {
+	return b.len * b.elemSize()
+}
+
+// Bind binds the buffer to Target.
+func (b *TypedBuffer[T]) Bind() {
+	b.Buffer.Bind(b.Target)
+}
+
+// Unbind binds 0 to Target.
+func (b *TypedBuffer[T]) Unbind() {
+	b.Buffer.Unbind(b.Target)
+}
+
+// Upload replaces the data store of the buffer with a copy of data, it is an
+// alias to glBufferData. It panics if T contains Go pointers.
+func (b *TypedBuffer[T]) Upload(data []T, usage BufferUsage) {
+	b.checkElem()
+	if safetyflag {
+		safetyCheckBound("TypedBuffer.Upload", kindBuffer, uint32(b.Target), uint32(b.Buffer))
+	}
+	backend.BufferData(uint32(b.Target), len(data)*b.elemSize(), unsafe.Pointer(unsafe.SliceData(data)), uint32(usage))
+	b.len = len(data)
+}
+
+// Update copies data to the buffer starting at element offset, it is an
+// alias to glBufferSubData. It panics if T contains Go pointers or if the
+// range is out of the buffer.
+func (b *TypedBuffer[T]) Update(offset int, data []T) {
+	b.checkElem()
+	b.checkRange("Update", offset, len(data))
+	if safetyflag {
+		safetyCheckBound("TypedBuffer.Update", kindBuffer, uint32(b.Target), uint32(b.Buffer))
+	}
+	if len(data) == 0 {
+		return
+	}
+	size := b.elemSize()
+	backend.BufferSubData(uint32(b.Target), offset*size, len(data)*size, unsafe.Pointer(&data[0]))
+}
+
+// Read returns n elements of the buffer starting at element offset, see
+// Buffer.GetSubData for its errors. It panics if T contains Go pointers or if
+// the range is out of the buffer.
+func (b *TypedBuffer[T]) Read(offset, n int) ([]T, error) {
+	b.checkElem()
+	b.checkRange("Read", offset, n)
+	if safetyflag {
+		safetyCheckBound("TypedBuffer.Read", kindBuffer, uint32(b.Target), uint32(b.Buffer))
+	}
+	data := make([]T, n)
+	if n == 0 {
+		return data, nil
+	}
+	size := b.elemSize()
+	if err := getBufferSubData(uint32(b.Target), offset*size, n*size, unsafe.Pointer(&data[0])); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (b *TypedBuffer[T]) checkRange(method string, offset, n int) {
+	if offset < 0 || n < 0 || offset+n > b.len {
+		panic(fmt.Sprintf("gl: TypedBuffer.%s of [%d:%d] out of a buffer of %d elements", method, offset, offset+n, b.len))
+	}
+}
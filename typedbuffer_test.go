@@ -0,0 +1,61 @@
+package gl
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type typedVertex struct {
+	Position [3]float32
+	Color    [4]uint8
+}
+
+type pointerVertex struct {
+	Position [3]float32
+	Name     string
+}
+
+func TestTypedBuffer(t *testing.T) {
+	newFake(t)
+	b := NewTypedBuffer[typedVertex](ARRAY_BUFFER)
+	b.Bind()
+	data := []typedVertex{{Position: [3]float32{1, 2, 3}}, {Color: [4]uint8{4, 5, 6, 7}}, {Position: [3]float32{8}}}
+	b.Upload(data, STATIC_DRAW)
+	if b.Len() != 3 || b.Size() != 3*16 {
+		t.Errorf("Len(), Size() = %d, %d, want 3, 48", b.Len(), b.Size())
+	}
+	b.Update(1, data[2:])
+	got, err := b.Read(0, 3)
+	if want := []typedVertex{data[0], data[2], data[2]}; err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("Read() = %v, %v, want %v", got, err, want)
+	}
+	if v := catchPanic(func() { b.Read(2, 2) }); v == nil {
+		t.Errorf("Read() out of the buffer didn't panic")
+	}
+}
+
+func TestTypedBufferPointers(t *testing.T) {
+	tests := []struct {
+		name string
+		do   func()
+		want string
+	}{
+		{"NewTypedBuffer", func() { NewTypedBuffer[pointerVertex](ARRAY_BUFFER) }, "gl.pointerVertex.Name is a string"},
+		{"NewTypedBuffer pointer", func() { NewTypedBuffer[*float32](ARRAY_BUFFER) }, "*float32 is a *float32"},
+		{"NewTypedBuffer array", func() { NewTypedBuffer[[2][]byte](ARRAY_BUFFER) }, "[2][]uint8[i] is a []uint8"},
+		{"zero Upload", func() { (&TypedBuffer[pointerVertex]{}).Upload(nil, STATIC_DRAW) }, "contains a Go pointer"},
+		{"zero Update", func() { (&TypedBuffer[map[int]int]{}).Update(0, nil) }, "contains a Go pointer"},
+		{"zero Read", func() { (&TypedBuffer[[]float32]{}).Read(0, 0) }, "contains a Go pointer"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newFake(t)
+			v := catchPanic(tt.do)
+			err, ok := v.(error)
+			if !ok || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("panicked with %v, want an error containing %q", v, tt.want)
+			}
+		})
+	}
+}
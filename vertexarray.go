@@ -1,86 +1,59 @@
 package gl
 
-import (
-	"github.com/go-gl/gl/v3.3-core/gl"
-	"unsafe"
-)
+import "unsafe"
 
 //TODO:
 //Add all the glGet
 
-//VertexArray is the high level representation of OpenGL vertex array object.
+// VertexArray is the high level representation of OpenGL vertex array object.
 type VertexArray uint32
 
-//GenVertexArray is an alias for glGenVertexArrays(1, &vao).
+// EnableVertexAttribArray is an alias to glEnableVertexAttribArray(index).
 //
-//Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glGenVertexArrays.xml
-func GenVertexArray() VertexArray {
-	var vao uint32
-	gl.GenVertexArrays(1, &vao)
-	return VertexArray(vao)
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glEnableVertexAttribArray.xml
+func (vao VertexArray) EnableVertexAttribArray(index uint32) {
+	if safetyflag {
+		safetyCheckBound("VertexArray.EnableVertexAttribArray", kindVertexArray, VERTEX_ARRAY_BINDING, uint32(vao))
+	}
+	backend.EnableVertexAttribArray(index)
 }
 
-//GenVertexArrays is an alias for glGenVertexArrays(n, &vao[0]).
+// DisableVertexAttribArray is an alias to backend.DisableVertexAttribArray(index).
 //
-//Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glGenVertexArrays.xml
-func GenVertexArrays(n int32) []VertexArray {
-	vao := make([]VertexArray, n)
-	gl.GenVertexArrays(n, (*uint32)(&vao[0]))
-	return vao
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glDisableVertexAttribArray.xml
+func (vao VertexArray) DisableVertexAttribArray(index uint32) {
+	if safetyflag {
+		safetyCheckBound("VertexArray.DisableVertexAttribArray", kindVertexArray, VERTEX_ARRAY_BINDING, uint32(vao))
+	}
+	backend.DisableVertexAttribArray(index)
 }
 
-//Bind is an alias to glBindVertexArray(vao).
+// VertexAttribPointer is an alias for glVertexAttribPointer.
 //
-//Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindVertexArray.xml
-func (vao VertexArray) Bind() {
-	gl.BindVertexArray(uint32(vao))
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glVertexAttribPointer.xml
+func (vao VertexArray) VertexAttribPointer(index uint32, size int32, xtype VertexAttribType, normalized bool, stride int32, pointer unsafe.Pointer) {
+	if safetyflag {
+		safetyCheckBound("VertexArray.VertexAttribPointer", kindVertexArray, VERTEX_ARRAY_BINDING, uint32(vao))
+	}
+	backend.VertexAttribPointer(index, size, uint32(xtype), normalized, stride, pointer)
 }
 
-//Unbind is an alias to glBindVertexArray(0).
+// VertexAttribIPointer is an alias for glVertexAttribIPointer.
 //
-//Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glBindVertexArray.xml
-func (VertexArray) Unbind() {
-	gl.BindVertexArray(0)
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glVertexAttribPointer.xml
+func (vao VertexArray) VertexAttribIPointer(index uint32, size int32, xtype VertexAttribType, stride int32, pointer unsafe.Pointer) {
+	if safetyflag {
+		safetyCheckBound("VertexArray.VertexAttribIPointer", kindVertexArray, VERTEX_ARRAY_BINDING, uint32(vao))
+	}
+	backend.VertexAttribIPointer(index, size, uint32(xtype), stride, pointer)
 }
 
-//Delete is an alias to glDeleteVertexArray(vao). The vao should not be used after calling this.
+// VertexAttribDivisor is an alias for glVertexAttribDivisor.
 //
-//Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glDeleteVertexArrays.xml
-func (vao VertexArray) Delete() {
-	gl.DeleteVertexArrays(1, (*uint32)(&vao))
-}
-
-//EnableVertexAttribArray is an alias to glEnableVertexAttribArray(index).
-//
-//Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glEnableVertexAttribArray.xml
-func (VertexArray) EnableVertexAttribArray(index uint32) {
-	gl.EnableVertexAttribArray(index)
-}
-
-//DisableVertexAttribArray is an alias to gl.DisableVertexAttribArray(index).
-//
-//Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glDisableVertexAttribArray.xml
-func (VertexArray) DisableVertexAttribArray(index uint32) {
-	gl.DisableVertexAttribArray(index)
-}
-
-//VertexAttribPointer is an alias for glVertexAttribPointer.
-//
-//Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glVertexAttribPointer.xml
-func (VertexArray) VertexAttribPointer(index uint32, size int32, xtype uint32, normalized bool, stride int32, pointer unsafe.Pointer) {
-	gl.VertexAttribPointer(index, size, xtype, normalized, stride, pointer)
-}
-
-//VertexAttribIPointer is an alias for glVertexAttribIPointer.
-//
-//Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glVertexAttribPointer.xml
-func (VertexArray) VertexAttribIPointer(index uint32, size int32, xtype uint32, stride int32, pointer unsafe.Pointer) {
-	gl.VertexAttribIPointer(index, size, xtype, stride, pointer)
-}
-
-//VertexAttribLPointer is an alias for glVertexAttribLPointer.
-//
-//Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glVertexAttribPointer.xml
-func (VertexArray) VertexAttribLPointer(index uint32, size int32, xtype uint32, stride int32, pointer unsafe.Pointer) {
-	gl.VertexAttribLPointer(index, size, xtype, stride, pointer)
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glVertexAttribDivisor.xml
+func (vao VertexArray) VertexAttribDivisor(index, divisor uint32) {
+	if safetyflag {
+		safetyCheckBound("VertexArray.VertexAttribDivisor", kindVertexArray, VERTEX_ARRAY_BINDING, uint32(vao))
+	}
+	backend.VertexAttribDivisor(index, divisor)
 }
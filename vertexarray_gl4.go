@@ -0,0 +1,15 @@
+//go:build gl41 || gl45
+
+package gl
+
+import "unsafe"
+
+// VertexAttribLPointer is an alias for glVertexAttribLPointer.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glVertexAttribPointer.xml
+func (vao VertexArray) VertexAttribLPointer(index uint32, size int32, xtype VertexAttribType, stride int32, pointer unsafe.Pointer) {
+	if safetyflag {
+		safetyCheckBound("VertexArray.VertexAttribLPointer", kindVertexArray, VERTEX_ARRAY_BINDING, uint32(vao))
+	}
+	backend.VertexAttribLPointer(index, size, uint32(xtype), stride, pointer)
+}
@@ -0,0 +1,185 @@
+package gl
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ActiveAttribute is an active attribute of a program, an input of its
+// vertex shader that the program reads.
+type ActiveAttribute struct {
+	Name string
+	// Location is the first location of the attribute, -1 for the built-in
+	// inputs like gl_VertexID.
+	Location int32
+	Type     AttributeType
+	// Size is the length of an array attribute, 1 otherwise.
+	Size int32
+}
+
+// ActiveAttributes returns the active attributes of the linked program p,
+// with glGetActiveAttrib and glGetAttribLocation.
+//
+// Documentation reference: https://www.opengl.org/sdk/docs/man3/xhtml/glGetActiveAttrib.xml
+func (p Program) ActiveAttributes() []ActiveAttribute {
+	n := p.GetActiveAttributes()
+	if n == 0 {
+		return nil
+	}
+	name := make([]uint8, max(p.GetActiveAttributeMaxLength(), 1))
+	attribs := make([]ActiveAttribute, n)
+	for i := range attribs {
+		var length, size int32
+		var xtype uint32
+		backend.GetActiveAttrib(uint32(p), uint32(i), int32(len(name)), &length, &size, &xtype, &name[0])
+		a := ActiveAttribute{Name: string(name[:length]), Location: -1, Type: AttributeType(xtype), Size: size}
+		if !strings.HasPrefix(a.Name, "gl_") {
+			a.Location = p.GetAttribLocation(a.Name)
+		}
+		attribs[i] = a
+	}
+	return attribs
+}
+
+// attributeShape is the component type of an AttributeType, the number of
+// components it reads from a location and its number of columns, the
+// locations every element takes.
+type attributeShape struct {
+	component  uint32
+	components int32
+	columns    int32
+}
+
+var attributeShapes = map[AttributeType]attributeShape{
+	FLOAT:             {FLOAT, 1, 1},
+	FLOAT_VEC2:        {FLOAT, 2, 1},
+	FLOAT_VEC3:        {FLOAT, 3, 1},
+	FLOAT_VEC4:        {FLOAT, 4, 1},
+	INT:               {INT, 1, 1},
+	INT_VEC2:          {INT, 2, 1},
+	INT_VEC3:          {INT, 3, 1},
+	INT_VEC4:          {INT, 4, 1},
+	UNSIGNED_INT:      {UNSIGNED_INT, 1, 1},
+	UNSIGNED_INT_VEC2: {UNSIGNED_INT, 2, 1},
+	UNSIGNED_INT_VEC3: {UNSIGNED_INT, 3, 1},
+	UNSIGNED_INT_VEC4: {UNSIGNED_INT, 4, 1},
+	FLOAT_MAT2:        {FLOAT, 2, 2},
+	FLOAT_MAT3:        {FLOAT, 3, 3},
+	FLOAT_MAT4:        {FLOAT, 4, 4},
+	FLOAT_MAT2x3:      {FLOAT, 3, 2},
+	FLOAT_MAT2x4:      {FLOAT, 4, 2},
+	FLOAT_MAT3x2:      {FLOAT, 2, 3},
+	FLOAT_MAT3x4:      {FLOAT, 4, 3},
+	FLOAT_MAT4x2:      {FLOAT, 2, 4},
+	FLOAT_MAT4x3:      {FLOAT, 3, 4},
+	DOUBLE:            {DOUBLE, 1, 1},
+	DOUBLE_VEC2:       {DOUBLE, 2, 1},
+	DOUBLE_VEC3:       {DOUBLE, 3, 1},
+	DOUBLE_VEC4:       {DOUBLE, 4, 1},
+	DOUBLE_MAT2:       {DOUBLE, 2, 2},
+	DOUBLE_MAT3:       {DOUBLE, 3, 3},
+	DOUBLE_MAT4:       {DOUBLE, 4, 4},
+	DOUBLE_MAT2x3:     {DOUBLE, 3, 2},
+	DOUBLE_MAT2x4:     {DOUBLE, 4, 2},
+	DOUBLE_MAT3x2:     {DOUBLE, 2, 3},
+	DOUBLE_MAT3x4:     {DOUBLE, 4, 3},
+	DOUBLE_MAT4x2:     {DOUBLE, 2, 4},
+	DOUBLE_MAT4x3:     {DOUBLE, 3, 4},
+}
+
+// VertexInputMismatch is a location of an active attribute that the vertex
+// array doesn't feed the way the shader reads it.
+type VertexInputMismatch struct {
+	Attribute ActiveAttribute
+	// Location is the location of the mismatch, past the first location of
+	// the attribute for the columns of matrices and the elements of arrays.
+	Location int32
+	// Problem describes the mismatch, like "no enabled array" or "integer
+	// input fed through the float path".
+	Problem string
+}
+
+func (m VertexInputMismatch) String() string {
+	return fmt.Sprintf("%s %s (location %d): %s", m.Attribute.Type, m.Attribute.Name, m.Location, m.Problem)
+}
+
+// CheckVertexInput compares the active attributes of the linked program to
+// the arrays of the vertex array vao and returns the locations it doesn't
+// feed as the shader reads them, nil if it feeds all of them. vao must be
+// bound.
+//
+// A location is reported when its array isn't enabled or has no buffer, when
+// an int, ivec, uint or uvec input is fed through the float path of
+// glVertexAttribPointer or a float input through the integer path of
+// glVertexAttribIPointer, when a uint input is fed signed integers, when a
+// double input isn't fed doubles through glVertexAttribLPointer or a float
+// input is, and when the array has more components than the input reads, the
+// extra ones being wasted. An array with fewer components is fine, the
+// shader reads the defaults (0, 0, 0, 1) past them, like a vec4 position fed
+// 3 components.
+func CheckVertexInput(program Program, vao VertexArray) []VertexInputMismatch {
+	if safetyflag {
+		safetyCheckBound("CheckVertexInput", kindVertexArray, VERTEX_ARRAY_BINDING, uint32(vao))
+	}
+	var mismatches []VertexInputMismatch
+	for _, a := range program.ActiveAttributes() {
+		shape, ok := attributeShapes[a.Type]
+		if a.Location < 0 || !ok {
+			continue
+		}
+		for i := int32(0); i < a.Size*shape.columns; i++ {
+			location := a.Location + i
+			if problem := checkVertexInput(uint32(location), shape); problem != "" {
+				mismatches = append(mismatches, VertexInputMismatch{Attribute: a, Location: location, Problem: problem})
+			}
+		}
+	}
+	return mismatches
+}
+
+// checkVertexInput returns the mismatch between the array of the bound vertex
+// array at index and an input of shape, "" if there is none.
+func checkVertexInput(index uint32, shape attributeShape) string {
+	get := func(pname uint32) int32 {
+		var v int32
+		backend.GetVertexAttribiv(index, pname, &v)
+		return v
+	}
+	if get(VERTEX_ATTRIB_ARRAY_ENABLED) == FALSE {
+		return "no enabled array"
+	}
+	if get(VERTEX_ATTRIB_ARRAY_BUFFER_BINDING) == 0 {
+		return "array without a buffer"
+	}
+	xtype := VertexAttribType(get(VERTEX_ATTRIB_ARRAY_TYPE))
+	integer := get(VERTEX_ATTRIB_ARRAY_INTEGER) != FALSE
+	// Only glVertexAttribLPointer feeds the 64-bit path, always with doubles,
+	// and GL_VERTEX_ATTRIB_ARRAY_LONG can be queried since OpenGL 4.3 only.
+	// Before, a double array is assumed to be fed through it.
+	long := xtype == DOUBLE
+	if info := contextInfo(); long && !info.ES && info.AtLeast(4, 3) {
+		long = get(VERTEX_ATTRIB_ARRAY_LONG) != FALSE
+	}
+	switch {
+	case shape.component == FLOAT && integer:
+		return "float input fed through the integer path"
+	case shape.component == FLOAT && long:
+		return "float input fed through the 64-bit path"
+	case (shape.component == INT || shape.component == UNSIGNED_INT) && !integer:
+		return "integer input fed through the float path"
+	case shape.component == UNSIGNED_INT && (xtype == BYTE || xtype == SHORT || xtype == INT):
+		return fmt.Sprintf("unsigned input fed %s", xtype)
+	case shape.component == DOUBLE && xtype != DOUBLE:
+		return fmt.Sprintf("double input fed %s", xtype)
+	case shape.component == DOUBLE && !long:
+		return "double input fed through the float path"
+	}
+	size := get(VERTEX_ATTRIB_ARRAY_SIZE)
+	if size == int32(BGRA) {
+		size = 4
+	}
+	if size > shape.components {
+		return fmt.Sprintf("array of %d components for an input of %d", size, shape.components)
+	}
+	return ""
+}
@@ -0,0 +1,50 @@
+//go:build gl41 || gl45
+
+package gl
+
+import "testing"
+
+func TestCheckVertexInputDouble(t *testing.T) {
+	tests := []struct {
+		name    string
+		version string
+		input   AttributeType
+		feed    func(vao VertexArray)
+		want    []string
+	}{
+		{"dvec3 fed doubles", "4.3.0", DOUBLE_VEC3, func(vao VertexArray) {
+			vao.EnableVertexAttribArray(0)
+			vao.VertexAttribLPointer(0, 3, DOUBLE, 0, nil)
+		}, nil},
+		{"dvec3 fed floats", "4.3.0", DOUBLE_VEC3, func(vao VertexArray) {
+			vao.EnableVertexAttribArray(0)
+			vao.VertexAttribPointer(0, 3, FLOAT, false, 0, nil)
+		}, []string{"double input fed GL_FLOAT"}},
+		{"dvec3 fed doubles through the float path", "4.3.0", DOUBLE_VEC3, func(vao VertexArray) {
+			vao.EnableVertexAttribArray(0)
+			vao.VertexAttribPointer(0, 3, DOUBLE, false, 0, nil)
+		}, []string{"double input fed through the float path"}},
+		{"dvec3 fed doubles through the float path before 4.3", "4.1.0", DOUBLE_VEC3, func(vao VertexArray) {
+			vao.EnableVertexAttribArray(0)
+			vao.VertexAttribPointer(0, 3, DOUBLE, false, 0, nil)
+		}, nil},
+		{"vec3 fed through the 64-bit path", "4.3.0", FLOAT_VEC3, func(vao VertexArray) {
+			vao.EnableVertexAttribArray(0)
+			vao.VertexAttribLPointer(0, 3, DOUBLE, 0, nil)
+		}, []string{"float input fed through the 64-bit path"}},
+		{"dvec2 fed 3 doubles", "4.3.0", DOUBLE_VEC2, func(vao VertexArray) {
+			vao.EnableVertexAttribArray(0)
+			vao.VertexAttribLPointer(0, 3, DOUBLE, 0, nil)
+		}, []string{"array of 3 components for an input of 2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFake(t)
+			f.Strings[VERSION] = tt.version
+			got := inputProblems(t, f, []ActiveAttribute{{Name: "in", Location: 0, Type: tt.input, Size: 1}}, tt.feed)
+			if !equalStrings(got, tt.want) {
+				t.Errorf("CheckVertexInput() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
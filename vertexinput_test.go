@@ -0,0 +1,99 @@
+package gl
+
+import "testing"
+
+// inputProblems returns the problems CheckVertexInput reports for a program
+// with the active attributes attribs and a vertex array set by feed, with a
+// buffer bound to ARRAY_BUFFER.
+func inputProblems(t *testing.T, f *FakeBackend, attribs []ActiveAttribute, feed func(vao VertexArray)) []string {
+	t.Helper()
+	p := CreateProgram()
+	f.Attributes[uint32(p)] = attribs
+	vao := GenVertexArray()
+	vao.Bind()
+	buf := GenBuffer()
+	buf.Bind(ARRAY_BUFFER)
+	feed(vao)
+	var problems []string
+	for _, m := range CheckVertexInput(p, vao) {
+		problems = append(problems, m.Problem)
+	}
+	return problems
+}
+
+func TestCheckVertexInput(t *testing.T) {
+	tests := []struct {
+		name  string
+		input AttributeType
+		feed  func(vao VertexArray)
+		want  []string
+	}{
+		{"vec3 fed 3 floats", FLOAT_VEC3, func(vao VertexArray) {
+			vao.EnableVertexAttribArray(0)
+			vao.VertexAttribPointer(0, 3, FLOAT, false, 0, nil)
+		}, nil},
+		{"vec4 fed 3 floats", FLOAT_VEC4, func(vao VertexArray) {
+			vao.EnableVertexAttribArray(0)
+			vao.VertexAttribPointer(0, 3, FLOAT, false, 0, nil)
+		}, nil},
+		{"vec2 fed 3 floats", FLOAT_VEC2, func(vao VertexArray) {
+			vao.EnableVertexAttribArray(0)
+			vao.VertexAttribPointer(0, 3, FLOAT, false, 0, nil)
+		}, []string{"array of 3 components for an input of 2"}},
+		{"vec4 fed BGRA", FLOAT_VEC4, func(vao VertexArray) {
+			vao.EnableVertexAttribArray(0)
+			vao.VertexAttribPointer(0, int32(BGRA), UNSIGNED_BYTE, true, 0, nil)
+		}, nil},
+		{"disabled", FLOAT_VEC3, func(vao VertexArray) {
+			vao.VertexAttribPointer(0, 3, FLOAT, false, 0, nil)
+		}, []string{"no enabled array"}},
+		{"ivec2 fed floats", INT_VEC2, func(vao VertexArray) {
+			vao.EnableVertexAttribArray(0)
+			vao.VertexAttribPointer(0, 2, INT, false, 0, nil)
+		}, []string{"integer input fed through the float path"}},
+		{"vec2 fed integers", FLOAT_VEC2, func(vao VertexArray) {
+			vao.EnableVertexAttribArray(0)
+			vao.VertexAttribIPointer(0, 2, INT, 0, nil)
+		}, []string{"float input fed through the integer path"}},
+		{"uint fed signed integers", UNSIGNED_INT, func(vao VertexArray) {
+			vao.EnableVertexAttribArray(0)
+			vao.VertexAttribIPointer(0, 1, SHORT, 0, nil)
+		}, []string{"unsigned input fed GL_SHORT"}},
+		{"mat2 with one column fed", FLOAT_MAT2, func(vao VertexArray) {
+			vao.EnableVertexAttribArray(0)
+			vao.VertexAttribPointer(0, 2, FLOAT, false, 0, nil)
+		}, []string{"no enabled array"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFake(t)
+			got := inputProblems(t, f, []ActiveAttribute{{Name: "in", Location: 0, Type: tt.input, Size: 1}}, tt.feed)
+			if !equalStrings(got, tt.want) {
+				t.Errorf("CheckVertexInput() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFakeAttributesByProgram(t *testing.T) {
+	f := newFake(t)
+	p, q := CreateProgram(), CreateProgram()
+	f.Attributes[uint32(p)] = []ActiveAttribute{{Name: "position", Location: 0, Type: FLOAT_VEC3, Size: 1}}
+	if got := p.ActiveAttributes(); len(got) != 1 || got[0].Name != "position" {
+		t.Errorf("ActiveAttributes() = %v, want position", got)
+	}
+	if got := q.ActiveAttributes(); got != nil {
+		t.Errorf("ActiveAttributes() of another program = %v, want none", got)
+	}
+	if got := q.GetAttribLocation("position"); got != -1 {
+		t.Errorf("GetAttribLocation() of another program = %d, want -1", got)
+	}
+
+	var length, size int32 = -1, 0
+	var xtype uint32
+	var name uint8 = 'x'
+	backend.GetActiveAttrib(uint32(p), 0, 0, &length, &size, &xtype, &name)
+	if length != 0 || name != 'x' || AttributeType(xtype) != FLOAT_VEC3 {
+		t.Errorf("GetActiveAttrib() with bufSize 0 wrote length %d, name %q, type %v", length, name, AttributeType(xtype))
+	}
+}
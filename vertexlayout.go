@@ -0,0 +1,192 @@
+package gl
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// VertexLayout describes how the vertex attributes of a shader read a buffer
+// of interleaved vertices.
+type VertexLayout struct {
+	// Stride is the size of a vertex in bytes.
+	Stride int32
+	// Attribs are the attributes of a vertex, in the order of its fields.
+	Attribs []VertexAttrib
+}
+
+// VertexAttrib is a vertex attribute of a VertexLayout.
+type VertexAttrib struct {
+	// Field is the name of the Go field the attribute reads.
+	Field string
+	// Name is the name of the attribute in the shaders, empty when the
+	// location is fixed. Location is the index of the attribute, -1 until
+	// it is resolved by VertexLayout.Locate for named attributes.
+	Name     string
+	Location int32
+	// Size is the number of components, from 1 to 4, and Type their type.
+	Size int32
+	Type VertexAttribType
+	// Normalized maps integers to [0, 1] or [-1, 1] on the float path and
+	// Integer selects the integer path, glVertexAttribIPointer, for the
+	// int, ivec and uvec inputs of the shaders.
+	Normalized bool
+	Integer    bool
+	// Offset is the offset of the attribute in a vertex and Divisor the
+	// number of instances sharing a value, 0 for a value per vertex.
+	Offset  int
+	Divisor uint32
+}
+
+// vertexComponentTypes maps the kinds of the components to their types.
+var vertexComponentTypes = map[reflect.Kind]VertexAttribType{
+	reflect.Int8:    BYTE,
+	reflect.Uint8:   UNSIGNED_BYTE,
+	reflect.Int16:   SHORT,
+	reflect.Uint16:  UNSIGNED_SHORT,
+	reflect.Int32:   INT,
+	reflect.Uint32:  UNSIGNED_INT,
+	reflect.Float32: FLOAT,
+}
+
+// VertexLayoutOf returns the layout of the vertices of type T, a struct with
+// an attribute per exported field. Fields are scalars or arrays of 1 to 4
+// float32, int8, uint8, int16, uint16, int32 or uint32, like [3]float32 for a
+// position or [4]uint8 for a color.
+//
+// The gl key of the field tags sets the attribute of a field, in the format
+// of encoding/json: the name of the attribute in the shaders, the name of
+// the field by default, followed by options separated by commas.
+//
+//	Position [3]float32 `gl:"a_position"`
+//	Color    [4]uint8   `gl:"location=2,normalized"`
+//	Offset   [2]float32 `gl:"a_offset,divisor=1"`
+//	Pad      float32    `gl:"-"`
+//
+// The options are:
+//
+//	location=N  binds the attribute to location N instead of looking its name up
+//	normalized  reads integers as normalized floats
+//	float       reads integers as floats, without normalizing them
+//	divisor=N   advances the attribute once every N instances
+//
+// Integer fields without normalized or float take the integer path and must
+// be read by int, ivec or uvec inputs. Fields tagged "-" and unexported fields
+// are skipped, their memory still counts in the stride.
+//
+// It panics if T isn't a struct, contains Go pointers or has a field or tag
+// it can't map to an attribute.
+func VertexLayoutOf[T any]() VertexLayout {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("gl: vertex type %s isn't a struct", t))
+	}
+	if err := checkBufferElement(t); err != nil {
+		panic(err)
+	}
+	l := VertexLayout{Stride: int32(t.Size())}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("gl")
+		if !f.IsExported() || tag == "-" {
+			continue
+		}
+		a, err := vertexAttribOf(f, tag)
+		if err != nil {
+			panic(fmt.Sprintf("gl: vertex type %s: field %s: %v", t, f.Name, err))
+		}
+		l.Attribs = append(l.Attribs, a)
+	}
+	return l
+}
+
+// vertexAttribOf returns the attribute of field f tagged with tag.
+func vertexAttribOf(f reflect.StructField, tag string) (VertexAttrib, error) {
+	a := VertexAttrib{Field: f.Name, Name: f.Name, Location: -1, Size: 1, Offset: int(f.Offset)}
+	t := f.Type
+	if t.Kind() == reflect.Array {
+		if t.Len() < 1 || t.Len() > 4 {
+			return a, fmt.Errorf("%s has %d components instead of 1 to 4", t, t.Len())
+		}
+		a.Size = int32(t.Len())
+		t = t.Elem()
+	}
+	xtype, ok := vertexComponentTypes[t.Kind()]
+	if !ok {
+		return a, fmt.Errorf("unsupported type %s", f.Type)
+	}
+	a.Type = xtype
+	a.Integer = xtype != FLOAT
+	options := strings.Split(tag, ",")
+	if name := options[0]; !strings.Contains(name, "=") {
+		if name != "" {
+			a.Name = name
+		}
+		options = options[1:]
+	}
+	for _, o := range options {
+		key, value, _ := strings.Cut(o, "=")
+		switch key {
+		case "location":
+			n, err := strconv.ParseUint(value, 10, 31)
+			if err != nil {
+				return a, fmt.Errorf("invalid location %q", value)
+			}
+			a.Name, a.Location = "", int32(n)
+		case "divisor":
+			n, err := strconv.ParseUint(value, 10, 32)
+			if err != nil {
+				return a, fmt.Errorf("invalid divisor %q", value)
+			}
+			a.Divisor = uint32(n)
+		case "normalized", "float":
+			if xtype == FLOAT {
+				return a, fmt.Errorf("option %s on a %s", key, f.Type)
+			}
+			a.Integer, a.Normalized = false, key == "normalized"
+		default:
+			return a, fmt.Errorf("unknown option %q", o)
+		}
+	}
+	return a, nil
+}
+
+// Locate returns a copy of l with the locations of the named attributes
+// looked up in the linked program p, -1 for the attributes p doesn't use.
+func (l VertexLayout) Locate(p Program) VertexLayout {
+	attribs := make([]VertexAttrib, len(l.Attribs))
+	for i, a := range l.Attribs {
+		if a.Name != "" {
+			a.Location = p.GetAttribLocation(a.Name)
+		}
+		attribs[i] = a
+	}
+	l.Attribs = attribs
+	return l
+}
+
+// ApplyLayout points the attributes of the vertex array to the vertices of
+// layout stored in buf: it binds buf to gl.ARRAY_BUFFER, where it stays bound,
+// and enables every attribute and sets its pointer and divisor. Attributes
+// with a negative location are skipped, call VertexLayout.Locate first for
+// the named ones. The vertex array must be bound.
+func (vao VertexArray) ApplyLayout(buf Buffer, layout VertexLayout) {
+	if safetyflag {
+		safetyCheckBound("VertexArray.ApplyLayout", kindVertexArray, VERTEX_ARRAY_BINDING, uint32(vao))
+	}
+	buf.Bind(ARRAY_BUFFER)
+	for _, a := range layout.Attribs {
+		if a.Location < 0 {
+			continue
+		}
+		index := uint32(a.Location)
+		backend.EnableVertexAttribArray(index)
+		if a.Integer {
+			backend.VertexAttribIPointer(index, a.Size, uint32(a.Type), layout.Stride, glPtrOffset(a.Offset))
+		} else {
+			backend.VertexAttribPointer(index, a.Size, uint32(a.Type), a.Normalized, layout.Stride, glPtrOffset(a.Offset))
+		}
+		backend.VertexAttribDivisor(index, a.Divisor)
+	}
+}
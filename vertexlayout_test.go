@@ -0,0 +1,107 @@
+package gl
+
+import (
+	"reflect"
+	"testing"
+)
+
+type layoutVertex struct {
+	Position [3]float32 `gl:"a_position"`
+	Color    [4]uint8   `gl:"location=2,normalized"`
+	Bone     uint16
+	Weight   [2]int16   `gl:",float"`
+	Offset   [2]float32 `gl:"a_offset,divisor=1"`
+	Pad      float32    `gl:"-"`
+	id       int32
+}
+
+func TestVertexLayoutOf(t *testing.T) {
+	want := VertexLayout{Stride: 40, Attribs: []VertexAttrib{
+		{Field: "Position", Name: "a_position", Location: -1, Size: 3, Type: FLOAT, Offset: 0},
+		{Field: "Color", Location: 2, Size: 4, Type: UNSIGNED_BYTE, Normalized: true, Offset: 12},
+		{Field: "Bone", Name: "Bone", Location: -1, Size: 1, Type: UNSIGNED_SHORT, Integer: true, Offset: 16},
+		{Field: "Weight", Name: "Weight", Location: -1, Size: 2, Type: SHORT, Offset: 18},
+		{Field: "Offset", Name: "a_offset", Location: -1, Size: 2, Type: FLOAT, Offset: 24, Divisor: 1},
+	}}
+	if got := VertexLayoutOf[layoutVertex](); !reflect.DeepEqual(got, want) {
+		t.Errorf("VertexLayoutOf() = %+v, want %+v", got, want)
+	}
+}
+
+func TestVertexLayoutOfPanics(t *testing.T) {
+	tests := []struct {
+		name   string
+		layout func()
+	}{
+		{"not a struct", func() { VertexLayoutOf[[3]float32]() }},
+		{"pointer", func() {
+			VertexLayoutOf[struct{ P *float32 }]()
+		}},
+		{"too many components", func() {
+			VertexLayoutOf[struct{ P [5]float32 }]()
+		}},
+		{"unsupported type", func() {
+			VertexLayoutOf[struct{ P float64 }]()
+		}},
+		{"normalized float", func() {
+			VertexLayoutOf[struct {
+				P float32 `gl:",normalized"`
+			}]()
+		}},
+		{"invalid location", func() {
+			VertexLayoutOf[struct {
+				P float32 `gl:"location=a"`
+			}]()
+		}},
+		{"unknown option", func() {
+			VertexLayoutOf[struct {
+				P float32 `gl:"p,packed"`
+			}]()
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if catchPanic(tt.layout) == nil {
+				t.Error("VertexLayoutOf didn't panic")
+			}
+		})
+	}
+}
+
+func TestVertexArrayApplyLayout(t *testing.T) {
+	f := newFake(t)
+	p := CreateProgram()
+	f.Attributes[uint32(p)] = []ActiveAttribute{
+		{Name: "a_position", Location: 0, Type: FLOAT_VEC3, Size: 1},
+		{Name: "color", Location: 2, Type: FLOAT_VEC4, Size: 1},
+		{Name: "Bone", Location: 3, Type: UNSIGNED_INT, Size: 1},
+		{Name: "Weight", Location: 4, Type: FLOAT_VEC2, Size: 1},
+		{Name: "a_offset", Location: 5, Type: FLOAT_VEC2, Size: 1},
+	}
+	layout := VertexLayoutOf[layoutVertex]().Locate(p)
+	var locations []int32
+	for _, a := range layout.Attribs {
+		locations = append(locations, a.Location)
+	}
+	if want := []int32{0, 2, 3, 4, 5}; !reflect.DeepEqual(locations, want) {
+		t.Errorf("Locate() located %v, want %v", locations, want)
+	}
+
+	vao := GenVertexArray()
+	vao.Bind()
+	buf := GenBuffer()
+	f.Reset()
+	vao.ApplyLayout(buf, layout)
+	divisors := map[uint32]uint32{}
+	for _, c := range f.Calls {
+		if c.Name == "VertexAttribDivisor" {
+			divisors[c.Args[0].(uint32)] = c.Args[1].(uint32)
+		}
+	}
+	if want := map[uint32]uint32{0: 0, 2: 0, 3: 0, 4: 0, 5: 1}; !reflect.DeepEqual(divisors, want) {
+		t.Errorf("ApplyLayout() set the divisors %v, want %v", divisors, want)
+	}
+	if got := CheckVertexInput(p, vao); got != nil {
+		t.Errorf("CheckVertexInput() = %v, want nil", got)
+	}
+}